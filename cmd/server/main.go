@@ -1,16 +1,21 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"gcstatus/cmd/server/routes"
 	"gcstatus/di"
 	"gcstatus/internal/crons"
+	"gcstatus/internal/domain"
 	"gcstatus/internal/jobs"
+	"gcstatus/internal/usecases"
+	"gcstatus/pkg/scheduler"
 	"log"
 	"os"
+	"strings"
+	"time"
 
-	"github.com/robfig/cron/v3"
 	"gorm.io/gorm"
 )
 
@@ -29,13 +34,33 @@ func main() {
 		missionService,
 		gameService,
 		bannerService,
-		adminCategoryService,
-		adminGenreService,
-		adminPlatformService,
-		adminTagService,
+		adminReferenceServices,
 		adminGameService,
 		heartService,
 		commentService,
+		libraryService,
+		priceAlertService,
+		crackService,
+		gameFollowService,
+		feedService,
+		adminJobService,
+		adminUserService,
+		adminRoleService,
+		adminPermissionService,
+		adminAuditLogService,
+		adminMissionService,
+		adminTitleService,
+		adminLevelService,
+		adminSchedulerService,
+		leaderboardService,
+		checkInService,
+		adminCheckInRewardService,
+		multiplierService,
+		adminMultiplierEventService,
+		adminBoosterService,
+		adminActionPolicyService,
+		quizService,
+		adminQuizService,
 		db := di.InitDependencies()
 
 	// Setup routes with dependency injection
@@ -53,33 +78,50 @@ func main() {
 		missionService,
 		gameService,
 		bannerService,
-		adminCategoryService,
-		adminGenreService,
-		adminPlatformService,
-		adminTagService,
+		adminReferenceServices,
 		adminGameService,
 		heartService,
 		commentService,
+		libraryService,
+		priceAlertService,
+		crackService,
+		gameFollowService,
+		feedService,
+		adminJobService,
+		adminUserService,
+		adminRoleService,
+		adminPermissionService,
+		adminAuditLogService,
+		adminMissionService,
+		adminTitleService,
+		adminLevelService,
+		adminSchedulerService,
+		leaderboardService,
+		checkInService,
+		adminCheckInRewardService,
+		multiplierService,
+		adminMultiplierEventService,
+		adminBoosterService,
+		adminActionPolicyService,
+		quizService,
+		adminQuizService,
 		db,
 	)
 
-	c := cron.New()
-
-	if _, err := c.AddFunc("@midnight", func() {
-		crons.ResetMissions(db)
-	}); err != nil {
-		log.Fatalf("Failed to start cron: %+v", err)
-	}
+	s := scheduler.NewScheduler(db)
+	registerScheduledJobs(s, db, leaderboardService)
 
 	// Register command to populate database
 	populateSteamDBCmd := flag.Bool("populate-steam-db", false, "Populate the database with Steam games data")
+	fullSteamImportCmd := flag.Bool("full-steam-import", false, "Restart the catalog import from the first item instead of resuming from the last checkpoint")
 	populateSteamOneDBCmd := flag.Bool("populate-steam-db-one", false, "Populate the database with only one Steam game data")
+	refreshSteamPricesCmd := flag.Bool("refresh-steam-prices", false, "Refresh the Steam prices of every game and DLC store")
+	resyncSteamGamesCmd := flag.Bool("resync-steam-games", false, "Re-sync the recently released and most viewed games with their store listings")
+	rebuildLeaderboardsCmd := flag.Bool("rebuild-leaderboards", false, "Rebuild the current leaderboards from the database")
+	populateCatalogCmd := flag.String("populate-catalog", "", fmt.Sprintf("Populate the database with the games of a store catalog (%s)", strings.Join(jobs.CatalogSourceNames(), ", ")))
 	appID := flag.Int("appID", 0, "App ID of the Steam game to populate (required if using populate-steam-db-one)")
 	flag.Parse()
 
-	// Start the cron scheduler
-	c.Start()
-
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
@@ -87,8 +129,32 @@ func main() {
 
 	log.Printf("Starting server on port %s", port)
 
-	if *populateSteamDBCmd || *populateSteamOneDBCmd {
+	if *refreshSteamPricesCmd {
+		jobs.RefreshSteamPricesJob(db)
+		fmt.Println("Steam prices refresh job executed via command.")
+	} else if *resyncSteamGamesCmd {
+		jobs.ResyncCatalogGamesJob(db)
+		fmt.Println("Catalog re-sync job executed via command.")
+	} else if *rebuildLeaderboardsCmd {
+		if err := leaderboardService.Rebuild(time.Now()); err != nil {
+			log.Fatalf("Failed to rebuild the leaderboards: %+v", err)
+		}
+		fmt.Println("Leaderboards rebuild executed via command.")
+	} else if *populateCatalogCmd != "" {
+		if *fullSteamImportCmd {
+			if err := jobs.ResetCatalogImportCheckpoint(db, *populateCatalogCmd); err != nil {
+				log.Fatalf("Failed to reset the %s import checkpoint: %+v", *populateCatalogCmd, err)
+			}
+		}
+		jobs.PopulateCatalogJob(db, *populateCatalogCmd)
+		fmt.Println("Catalog population job executed via command.")
+	} else if *populateSteamDBCmd || *populateSteamOneDBCmd {
 		if *populateSteamDBCmd {
+			if *fullSteamImportCmd {
+				if err := jobs.ResetCatalogImportCheckpoint(db, jobs.SteamImportSource); err != nil {
+					log.Fatalf("Failed to reset the steam import checkpoint: %+v", err)
+				}
+			}
 			jobs.PopulateSteamDatabaseJob(db)
 			fmt.Println("Database population job executed via command.")
 		} else if *populateSteamOneDBCmd {
@@ -99,6 +165,10 @@ func main() {
 			fmt.Println("Database population for only one app job executed via command.")
 		}
 	} else {
+		// Only the leading process runs the scheduled jobs, and jobs missed
+		// while every process was down run once a leader is elected.
+		go s.Start(context.Background())
+
 		if err := r.Run(fmt.Sprintf(":%s", port)); err != nil {
 			log.Fatalf("Failed to start server: %v", err)
 		}
@@ -110,3 +180,44 @@ func BackgroundJobRunner(db *gorm.DB) {
 
 	fmt.Println("Database population job started asynchronously.")
 }
+
+func registerScheduledJobs(s *scheduler.Scheduler, db *gorm.DB, leaderboardService *usecases.LeaderboardService) {
+	tasks := map[string]struct {
+		spec string
+		task scheduler.Task
+	}{
+		// Missions reset on the clock of each user, so every hour is the
+		// reset time of some timezone.
+		domain.ScheduledJobResetMissions: {"@hourly", func(ctx context.Context) error {
+			return crons.ResetMissions(db)
+		}},
+		domain.ScheduledJobNotifyReleases: {"@daily", func(ctx context.Context) error {
+			return crons.NotifyFollowedReleases(db)
+		}},
+		domain.ScheduledJobPruneAuditLogs: {"@daily", func(ctx context.Context) error {
+			return crons.PruneAuditLogs(db)
+		}},
+		domain.ScheduledJobPrunePasswordResets: {"@hourly", func(ctx context.Context) error {
+			return crons.PrunePasswordResets(db)
+		}},
+		domain.ScheduledJobRefreshSteamPrices: {"@every 12h", func(ctx context.Context) error {
+			jobs.RefreshSteamPricesJob(db)
+			return nil
+		}},
+		domain.ScheduledJobResyncCatalogGames: {"@every 24h", func(ctx context.Context) error {
+			jobs.ResyncCatalogGamesJob(db)
+			return nil
+		}},
+		// Boards are updated as points are earned, so the rebuild only fixes
+		// drifts, such as points lost while Redis was down.
+		domain.ScheduledJobRebuildLeaderboards: {"@daily", func(ctx context.Context) error {
+			return leaderboardService.Rebuild(time.Now())
+		}},
+	}
+
+	for name, job := range tasks {
+		if err := s.Register(name, job.spec, job.task); err != nil {
+			log.Fatalf("Failed to register scheduled job: %+v", err)
+		}
+	}
+}
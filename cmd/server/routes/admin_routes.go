@@ -10,36 +10,125 @@ import (
 func RegisterAdminRoutes(
 	r *gin.RouterGroup,
 	userService *usecases.UserService,
+	auditService middlewares.AuditServiceInterface,
+	scopeCatalog *middlewares.ScopeCatalog,
+	superuserRole string,
 	handlers *AdminHandlers,
 ) {
-	permissionMiddleware := middlewares.NewPermissionMiddleware(userService)
+	permissionMiddleware := middlewares.NewPermissionMiddleware(userService, scopeCatalog, superuserRole)
 	r.Use(middlewares.JWTAuthMiddleware(userService))
+	r.Use(middlewares.AuditMiddleware(auditService))
 
 	r.POST("/login", handlers.AdminAuthHandler.Login)
 	r.GET("/me", handlers.AdminAuthHandler.Me)
 	r.POST("/logout", handlers.AdminAuthHandler.Logout)
 	r.POST("/steam/register/:appID", permissionMiddleware("create:steam-jobs-games"), handlers.AdminSteamHandler.RegisterByAppID)
+	r.POST("/steam/sync/:id", permissionMiddleware("view:games", "update:games"), handlers.AdminSteamHandler.SyncGame)
 
-	r.GET("/categories", permissionMiddleware("view:categories"), handlers.AdminCategoryHandler.GetAll)
-	r.POST("/categories", permissionMiddleware("view:categories", "create:categories"), handlers.AdminCategoryHandler.Create)
-	r.PUT("/categories/:id", permissionMiddleware("view:categories", "update:categories"), handlers.AdminCategoryHandler.Update)
-	r.DELETE("/categories/:id", permissionMiddleware("view:categories", "delete:categories"), handlers.AdminCategoryHandler.Delete)
+	for _, handler := range handlers.AdminReferenceHandlers {
+		resource := handler.Resource()
+		r.GET("/"+resource, permissionMiddleware("view:"+resource), handler.GetAll)
+		r.GET("/"+resource+"/:id", permissionMiddleware("view:"+resource), handler.FindByID)
+		r.POST("/"+resource, permissionMiddleware("view:"+resource, "create:"+resource), handler.Create)
+		r.PUT("/"+resource+"/:id", permissionMiddleware("view:"+resource, "update:"+resource), handler.Update)
+		r.DELETE("/"+resource+"/:id", permissionMiddleware("view:"+resource, "delete:"+resource), handler.Delete)
+	}
 
-	r.GET("/genres", permissionMiddleware("view:genres"), handlers.AdminGenreHandler.GetAll)
-	r.POST("/genres", permissionMiddleware("view:genres", "create:genres"), handlers.AdminGenreHandler.Create)
-	r.PUT("/genres/:id", permissionMiddleware("view:genres", "update:genres"), handlers.AdminGenreHandler.Update)
-	r.DELETE("/genres/:id", permissionMiddleware("view:genres", "delete:genres"), handlers.AdminGenreHandler.Delete)
+	r.GET("/games", permissionMiddleware("view:games"), handlers.AdminGameHandler.GetAll)
+	r.GET("/games/:id", permissionMiddleware("view:games"), handlers.AdminGameHandler.FindByID)
+	r.POST("/games", permissionMiddleware("view:games", "create:games"), handlers.AdminGameHandler.Create)
+	r.PUT("/games/:id", permissionMiddleware("view:games", "update:games"), handlers.AdminGameHandler.Update)
+	r.DELETE("/games/:id", permissionMiddleware("view:games", "delete:games"), handlers.AdminGameHandler.Delete)
+	r.PUT("/games/:id/crack", permissionMiddleware("view:games", "update:games"), handlers.AdminGameHandler.UpdateCrack)
+	r.GET("/games/:id/locks", permissionMiddleware("view:games"), handlers.AdminGameHandler.GetSyncLocks)
+	r.PUT("/games/:id/locks", permissionMiddleware("view:games", "update:games"), handlers.AdminGameHandler.UpdateSyncLocks)
 
-	r.GET("/platforms", permissionMiddleware("view:platforms"), handlers.AdminPlatformHandler.GetAll)
-	r.POST("/platforms", permissionMiddleware("view:platforms", "create:platforms"), handlers.AdminPlatformHandler.Create)
-	r.PUT("/platforms/:id", permissionMiddleware("view:platforms", "update:platforms"), handlers.AdminPlatformHandler.Update)
-	r.DELETE("/platforms/:id", permissionMiddleware("view:platforms", "delete:platforms"), handlers.AdminPlatformHandler.Delete)
+	r.GET("/jobs", permissionMiddleware("view:jobs"), handlers.AdminJobHandler.GetAll)
+	r.GET("/jobs/:id", permissionMiddleware("view:jobs"), handlers.AdminJobHandler.FindByID)
+	r.POST("/jobs/:id/retry", permissionMiddleware("view:jobs", "update:jobs"), handlers.AdminJobHandler.Retry)
+	r.POST("/jobs/:id/cancel", permissionMiddleware("view:jobs", "update:jobs"), handlers.AdminJobHandler.Cancel)
 
-	r.GET("/tags", permissionMiddleware("view:tags"), handlers.AdminTagHandler.GetAll)
-	r.POST("/tags", permissionMiddleware("view:tags", "create:tags"), handlers.AdminTagHandler.Create)
-	r.PUT("/tags/:id", permissionMiddleware("view:tags", "update:tags"), handlers.AdminTagHandler.Update)
-	r.DELETE("/tags/:id", permissionMiddleware("view:tags", "delete:tags"), handlers.AdminTagHandler.Delete)
+	r.GET("/users", permissionMiddleware("view:users"), handlers.AdminUserHandler.GetAll)
+	r.GET("/users/:id", permissionMiddleware("view:users"), handlers.AdminUserHandler.FindByID)
+	r.POST("/users/:id/block", permissionMiddleware("view:users", "block:users"), handlers.AdminUserHandler.Block)
+	r.POST("/users/:id/unblock", permissionMiddleware("view:users", "block:users"), handlers.AdminUserHandler.Unblock)
+	r.POST("/users/:id/wallet", permissionMiddleware("view:users", "update:users-wallets"), handlers.AdminUserHandler.AdjustWallet)
+	r.POST("/users/:id/titles", permissionMiddleware("view:users", "create:users-titles"), handlers.AdminUserHandler.GrantTitle)
+	r.POST("/users/:id/password-reset", permissionMiddleware("view:users", "reset:users-passwords"), handlers.AdminUserHandler.ResetPassword)
+	r.POST("/users/:id/logout", permissionMiddleware("view:users", "logout:users"), handlers.AdminUserHandler.Logout)
+	r.PUT("/users/:id/roles", permissionMiddleware("view:users", "update:users-roles"), handlers.AdminUserHandler.SyncRoles)
+	r.PUT("/users/:id/permissions", permissionMiddleware("view:users", "update:users-permissions"), handlers.AdminUserHandler.SyncPermissions)
 
-	r.GET("/games", permissionMiddleware("view:games"), handlers.AdminGameHandler.GetAll)
-	r.GET("/games/:id", permissionMiddleware("view:games"), handlers.AdminGameHandler.FindByID)
+	r.GET("/roles", permissionMiddleware("view:roles"), handlers.AdminRoleHandler.GetAll)
+	r.GET("/roles/:id", permissionMiddleware("view:roles"), handlers.AdminRoleHandler.FindByID)
+	r.POST("/roles", permissionMiddleware("view:roles", "create:roles"), handlers.AdminRoleHandler.Create)
+	r.PUT("/roles/:id", permissionMiddleware("view:roles", "update:roles"), handlers.AdminRoleHandler.Update)
+	r.DELETE("/roles/:id", permissionMiddleware("view:roles", "delete:roles"), handlers.AdminRoleHandler.Delete)
+
+	r.GET("/permissions", permissionMiddleware("view:permissions"), handlers.AdminPermissionHandler.GetAll)
+	r.GET("/permissions/catalog", permissionMiddleware("view:permissions"), handlers.AdminPermissionHandler.Catalog)
+	r.POST("/permissions/catalog/sync", permissionMiddleware("view:permissions", "create:permissions"), handlers.AdminPermissionHandler.SyncCatalog)
+	r.GET("/permissions/:id", permissionMiddleware("view:permissions"), handlers.AdminPermissionHandler.FindByID)
+	r.POST("/permissions", permissionMiddleware("view:permissions", "create:permissions"), handlers.AdminPermissionHandler.Create)
+	r.PUT("/permissions/:id", permissionMiddleware("view:permissions", "update:permissions"), handlers.AdminPermissionHandler.Update)
+	r.DELETE("/permissions/:id", permissionMiddleware("view:permissions", "delete:permissions"), handlers.AdminPermissionHandler.Delete)
+
+	r.GET("/missions", permissionMiddleware("view:missions"), handlers.AdminMissionHandler.GetAll)
+	r.GET("/missions/action-keys", permissionMiddleware("view:missions"), handlers.AdminMissionHandler.ActionKeys)
+	r.GET("/missions/:id", permissionMiddleware("view:missions"), handlers.AdminMissionHandler.FindByID)
+	r.POST("/missions", permissionMiddleware("view:missions", "create:missions"), handlers.AdminMissionHandler.Create)
+	r.PUT("/missions/:id", permissionMiddleware("view:missions", "update:missions"), handlers.AdminMissionHandler.Update)
+	r.DELETE("/missions/:id", permissionMiddleware("view:missions", "delete:missions"), handlers.AdminMissionHandler.Delete)
+	r.PUT("/missions/:id/users", permissionMiddleware("view:missions", "update:missions-users"), handlers.AdminMissionHandler.SyncAssignments)
+	r.GET("/missions/:id/requirements/:requirement/achievers", permissionMiddleware("view:missions", "view:users"), handlers.AdminMissionHandler.RequirementAchievers)
+
+	r.GET("/titles", permissionMiddleware("view:titles"), handlers.AdminTitleHandler.GetAll)
+	r.GET("/titles/action-keys", permissionMiddleware("view:titles"), handlers.AdminTitleHandler.ActionKeys)
+	r.GET("/titles/:id", permissionMiddleware("view:titles"), handlers.AdminTitleHandler.FindByID)
+	r.POST("/titles", permissionMiddleware("view:titles", "create:titles"), handlers.AdminTitleHandler.Create)
+	r.PUT("/titles/:id", permissionMiddleware("view:titles", "update:titles"), handlers.AdminTitleHandler.Update)
+	r.DELETE("/titles/:id", permissionMiddleware("view:titles", "delete:titles"), handlers.AdminTitleHandler.Delete)
+	r.GET("/titles/:id/requirements/:requirement/achievers", permissionMiddleware("view:titles", "view:users"), handlers.AdminTitleHandler.RequirementAchievers)
+
+	r.GET("/levels", permissionMiddleware("view:levels"), handlers.AdminLevelHandler.GetAll)
+	r.GET("/levels/:id", permissionMiddleware("view:levels"), handlers.AdminLevelHandler.FindByID)
+	r.POST("/levels", permissionMiddleware("view:levels", "create:levels"), handlers.AdminLevelHandler.Create)
+	r.PUT("/levels/:id", permissionMiddleware("view:levels", "update:levels"), handlers.AdminLevelHandler.Update)
+	r.DELETE("/levels/:id", permissionMiddleware("view:levels", "delete:levels"), handlers.AdminLevelHandler.Delete)
+	r.GET("/check-in-rewards", permissionMiddleware("view:check-in-rewards"), handlers.AdminCheckInRewardHandler.GetAll)
+	r.GET("/check-in-rewards/:id", permissionMiddleware("view:check-in-rewards"), handlers.AdminCheckInRewardHandler.FindByID)
+	r.POST("/check-in-rewards", permissionMiddleware("view:check-in-rewards", "create:check-in-rewards"), handlers.AdminCheckInRewardHandler.Create)
+	r.PUT("/check-in-rewards/:id", permissionMiddleware("view:check-in-rewards", "update:check-in-rewards"), handlers.AdminCheckInRewardHandler.Update)
+	r.DELETE("/check-in-rewards/:id", permissionMiddleware("view:check-in-rewards", "delete:check-in-rewards"), handlers.AdminCheckInRewardHandler.Delete)
+
+	r.GET("/multiplier-events", permissionMiddleware("view:multiplier-events"), handlers.AdminMultiplierEventHandler.GetAll)
+	r.GET("/multiplier-events/:id", permissionMiddleware("view:multiplier-events"), handlers.AdminMultiplierEventHandler.FindByID)
+	r.POST("/multiplier-events", permissionMiddleware("view:multiplier-events", "create:multiplier-events"), handlers.AdminMultiplierEventHandler.Create)
+	r.PUT("/multiplier-events/:id", permissionMiddleware("view:multiplier-events", "update:multiplier-events"), handlers.AdminMultiplierEventHandler.Update)
+	r.DELETE("/multiplier-events/:id", permissionMiddleware("view:multiplier-events", "delete:multiplier-events"), handlers.AdminMultiplierEventHandler.Delete)
+
+	r.GET("/boosters", permissionMiddleware("view:boosters"), handlers.AdminBoosterHandler.GetAll)
+	r.GET("/boosters/:id", permissionMiddleware("view:boosters"), handlers.AdminBoosterHandler.FindByID)
+	r.POST("/boosters", permissionMiddleware("view:boosters", "create:boosters"), handlers.AdminBoosterHandler.Create)
+	r.PUT("/boosters/:id", permissionMiddleware("view:boosters", "update:boosters"), handlers.AdminBoosterHandler.Update)
+	r.DELETE("/boosters/:id", permissionMiddleware("view:boosters", "delete:boosters"), handlers.AdminBoosterHandler.Delete)
+
+	r.GET("/action-policies", permissionMiddleware("view:action-policies"), handlers.AdminActionPolicyHandler.GetAll)
+	r.GET("/action-policies/decisions", permissionMiddleware("view:action-policies"), handlers.AdminActionPolicyHandler.Decisions)
+	r.GET("/action-policies/:id", permissionMiddleware("view:action-policies"), handlers.AdminActionPolicyHandler.FindByID)
+	r.POST("/action-policies", permissionMiddleware("view:action-policies", "create:action-policies"), handlers.AdminActionPolicyHandler.Create)
+	r.PUT("/action-policies/:id", permissionMiddleware("view:action-policies", "update:action-policies"), handlers.AdminActionPolicyHandler.Update)
+	r.DELETE("/action-policies/:id", permissionMiddleware("view:action-policies", "delete:action-policies"), handlers.AdminActionPolicyHandler.Delete)
+
+	r.GET("/quizzes", permissionMiddleware("view:quizzes"), handlers.AdminQuizHandler.GetAll)
+	r.GET("/quizzes/:id", permissionMiddleware("view:quizzes"), handlers.AdminQuizHandler.FindByID)
+	r.POST("/quizzes", permissionMiddleware("view:quizzes", "create:quizzes"), handlers.AdminQuizHandler.Create)
+	r.PUT("/quizzes/:id", permissionMiddleware("view:quizzes", "update:quizzes"), handlers.AdminQuizHandler.Update)
+	r.DELETE("/quizzes/:id", permissionMiddleware("view:quizzes", "delete:quizzes"), handlers.AdminQuizHandler.Delete)
+
+	r.GET("/scheduler/jobs", permissionMiddleware("view:scheduler"), handlers.AdminSchedulerHandler.GetJobs)
+	r.GET("/scheduler/runs", permissionMiddleware("view:scheduler"), handlers.AdminSchedulerHandler.GetRuns)
+	r.POST("/scheduler/jobs/:name/run", permissionMiddleware("view:scheduler", "run:scheduler"), handlers.AdminSchedulerHandler.Trigger)
+
+	r.GET("/audit-logs", permissionMiddleware("view:audit-logs"), handlers.AdminAuditLogHandler.GetAll)
 }
@@ -25,6 +25,7 @@ func RegisterProtectedRoutes(
 	r.PUT("/profile/password", handlers.PasswordResetHandler.ResetPasswordProfile)
 	r.PUT("/profile/picture", handlers.ProfileHandler.UpdatePicture)
 	r.PUT("/profile/socials", handlers.ProfileHandler.UpdateSocials)
+	r.PUT("/profile/region", handlers.ProfileHandler.UpdateRegion)
 
 	r.PUT("/user/update/basics", handlers.UserHandler.UpdateUserBasics)
 	r.PUT("/user/update/sensitive", handlers.UserHandler.UpdateUserNickAndEmail)
@@ -46,4 +47,28 @@ func RegisterProtectedRoutes(
 
 	r.POST("/comments", handlers.CommentHandler.Create)
 	r.DELETE("/comments/:id", handlers.CommentHandler.Delete)
+
+	r.GET("/library", handlers.LibraryHandler.GetAllForUser)
+	r.POST("/library", handlers.LibraryHandler.Upsert)
+	r.DELETE("/library/:id", handlers.LibraryHandler.Delete)
+
+	r.GET("/price-alerts", handlers.PriceAlertHandler.GetAllForUser)
+	r.POST("/price-alerts", handlers.PriceAlertHandler.Upsert)
+	r.DELETE("/price-alerts/:id", handlers.PriceAlertHandler.Delete)
+	r.GET("/follows", handlers.GameFollowHandler.GetAllForUser)
+	r.POST("/follows", handlers.GameFollowHandler.Upsert)
+	r.DELETE("/follows/:id", handlers.GameFollowHandler.Delete)
+	r.GET("/feeds/token", handlers.FeedHandler.GetToken)
+	r.POST("/feeds/token", handlers.FeedHandler.RotateToken)
+	r.GET("/leaderboards/:board/me", handlers.LeaderboardHandler.GetMyRank)
+	r.GET("/leaderboards/:board/around-me", handlers.LeaderboardHandler.GetAroundMe)
+	r.GET("/check-ins", handlers.CheckInHandler.GetStatus)
+	r.POST("/check-ins", handlers.CheckInHandler.CheckIn)
+	r.POST("/check-ins/freezes", handlers.CheckInHandler.BuyFreeze)
+	r.GET("/boosters", handlers.BoosterHandler.GetAll)
+	r.POST("/boosters/:id/buy", handlers.BoosterHandler.Buy)
+	r.GET("/quizzes", handlers.QuizHandler.GetOpen)
+	r.GET("/quizzes/:id", handlers.QuizHandler.FindByID)
+	r.POST("/quizzes/:id/answers", handlers.QuizHandler.Answer)
+	r.GET("/quizzes/:id/results", handlers.QuizHandler.GetResults)
 }
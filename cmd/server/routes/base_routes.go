@@ -19,4 +19,15 @@ func RegisterCommonRoutes(
 	r.GET("/games/calendar", handlers.GameHandler.CalendarGames)
 	r.GET("/games/condition/:condition", handlers.GameHandler.FindByCondition)
 	r.GET("/games/filters/:classification/:filterable", handlers.GameHandler.FindByClassification)
+	r.GET("/games/:slug/cracks", handlers.CrackHandler.GetHistory)
+	r.GET("/cracks/stats/protections", handlers.CrackHandler.ProtectionStats)
+	r.GET("/cracks/stats/crackers", handlers.CrackHandler.CrackerStats)
+	r.GET("/follows/unsubscribe/:token", handlers.GameFollowHandler.Unsubscribe)
+	r.GET("/feeds/releases.ics", handlers.FeedHandler.ReleasesCalendar)
+	r.GET("/feeds/users/:token/releases.ics", handlers.FeedHandler.FollowedReleasesCalendar)
+	r.GET("/feeds/games", handlers.FeedHandler.GamesFeed)
+	r.GET("/feeds/dlcs", handlers.FeedHandler.DLCsFeed)
+	r.GET("/feeds/cracks", handlers.FeedHandler.CracksFeed)
+	r.GET("/users/:nickname/library", handlers.LibraryHandler.GetPublicForUser)
+	r.GET("/leaderboards/:board", handlers.LeaderboardHandler.GetBoard)
 }
@@ -3,6 +3,7 @@ package routes
 import (
 	"gcstatus/internal/adapters/api"
 	api_admin "gcstatus/internal/adapters/api/admin"
+	"gcstatus/internal/middlewares"
 	"gcstatus/internal/usecases"
 	usecases_admin "gcstatus/internal/usecases/admin"
 
@@ -23,16 +24,36 @@ type Handlers struct {
 	HomeHandler          *api.HomeHandler
 	HeartHandler         *api.HeartHandler
 	CommentHandler       *api.CommentHandler
+	LibraryHandler       *api.LibraryHandler
+	PriceAlertHandler    *api.PriceAlertHandler
+	CrackHandler         *api.CrackHandler
+	GameFollowHandler    *api.GameFollowHandler
+	FeedHandler          *api.FeedHandler
+	LeaderboardHandler   *api.LeaderboardHandler
+	CheckInHandler       *api.CheckInHandler
+	BoosterHandler       *api.BoosterHandler
+	QuizHandler          *api.QuizHandler
 }
 
 type AdminHandlers struct {
-	AdminAuthHandler     *api_admin.AuthHandler
-	AdminCategoryHandler *api_admin.AdminCategoryHandler
-	AdminGenreHandler    *api_admin.AdminGenreHandler
-	AdminPlatformHandler *api_admin.AdminPlatformHandler
-	AdminTagHandler      *api_admin.AdminTagHandler
-	AdminGameHandler     *api_admin.AdminGameHandler
-	AdminSteamHandler    *api_admin.SteamHandler
+	AdminAuthHandler            *api_admin.AuthHandler
+	AdminReferenceHandlers      []api_admin.ReferenceHandler
+	AdminGameHandler            *api_admin.AdminGameHandler
+	AdminSteamHandler           *api_admin.SteamHandler
+	AdminJobHandler             *api_admin.AdminJobHandler
+	AdminUserHandler            *api_admin.AdminUserHandler
+	AdminRoleHandler            *api_admin.AdminRoleHandler
+	AdminPermissionHandler      *api_admin.AdminPermissionHandler
+	AdminAuditLogHandler        *api_admin.AdminAuditLogHandler
+	AdminMissionHandler         *api_admin.AdminMissionHandler
+	AdminTitleHandler           *api_admin.AdminTitleHandler
+	AdminLevelHandler           *api_admin.AdminLevelHandler
+	AdminSchedulerHandler       *api_admin.AdminSchedulerHandler
+	AdminCheckInRewardHandler   *api_admin.AdminCheckInRewardHandler
+	AdminMultiplierEventHandler *api_admin.AdminMultiplierEventHandler
+	AdminBoosterHandler         *api_admin.AdminBoosterHandler
+	AdminActionPolicyHandler    *api_admin.AdminActionPolicyHandler
+	AdminQuizHandler            *api_admin.AdminQuizHandler
 }
 
 func InitHandlers(
@@ -49,17 +70,38 @@ func InitHandlers(
 	missionService *usecases.MissionService,
 	gameService *usecases.GameService,
 	bannerService *usecases.BannerService,
-	adminCategoryService *usecases_admin.AdminCategoryService,
-	adminGenreService *usecases_admin.AdminGenreService,
-	AdminPlatformService *usecases_admin.AdminPlatformService,
-	adminTagService *usecases_admin.AdminTagService,
+	adminReferenceServices usecases_admin.AdminReferenceServices,
 	adminGameService *usecases_admin.AdminGameService,
 	heartService *usecases.HeartService,
 	commentService *usecases.CommentService,
+	libraryService *usecases.LibraryService,
+	priceAlertService *usecases.PriceAlertService,
+	crackService *usecases.CrackService,
+	gameFollowService *usecases.GameFollowService,
+	feedService *usecases.FeedService,
+	adminJobService *usecases_admin.AdminJobService,
+	adminUserService *usecases_admin.AdminUserService,
+	adminRoleService *usecases_admin.AdminRoleService,
+	adminPermissionService *usecases_admin.AdminPermissionService,
+	adminAuditLogService *usecases_admin.AdminAuditLogService,
+	adminMissionService *usecases_admin.AdminMissionService,
+	adminTitleService *usecases_admin.AdminTitleService,
+	adminLevelService *usecases_admin.AdminLevelService,
+	adminSchedulerService *usecases_admin.AdminSchedulerService,
+	leaderboardService *usecases.LeaderboardService,
+	checkInService *usecases.CheckInService,
+	adminCheckInRewardService *usecases_admin.AdminCheckInRewardService,
+	multiplierService *usecases.MultiplierService,
+	adminMultiplierEventService *usecases_admin.AdminMultiplierEventService,
+	adminBoosterService *usecases_admin.AdminBoosterService,
+	adminActionPolicyService *usecases_admin.AdminActionPolicyService,
+	quizService *usecases.QuizService,
+	adminQuizService *usecases_admin.AdminQuizService,
+	scopeCatalog *middlewares.ScopeCatalog,
 	db *gorm.DB,
 ) (*Handlers, *AdminHandlers) {
 	return &Handlers{
-			AuthHandler:          api.NewAuthHandler(authService, userService),
+			AuthHandler:          api.NewAuthHandler(authService, userService, libraryService, multiplierService),
 			PasswordResetHandler: api.NewPasswordResetHandler(passwordResetService, userService, authService),
 			LevelHandler:         api.NewLevelHandler(levelService),
 			ProfileHandler:       api.NewProfileHandler(profileService, userService),
@@ -72,14 +114,34 @@ func InitHandlers(
 			HomeHandler:          api.NewHomeHandler(userService, gameService, bannerService),
 			HeartHandler:         api.NewHeartHandler(userService, heartService),
 			CommentHandler:       api.NewCommentHandler(userService, commentService),
+			LibraryHandler:       api.NewLibraryHandler(libraryService, userService),
+			PriceAlertHandler:    api.NewPriceAlertHandler(priceAlertService, userService),
+			CrackHandler:         api.NewCrackHandler(crackService),
+			GameFollowHandler:    api.NewGameFollowHandler(gameFollowService, userService),
+			FeedHandler:          api.NewFeedHandler(feedService, userService),
+			LeaderboardHandler:   api.NewLeaderboardHandler(leaderboardService, userService),
+			CheckInHandler:       api.NewCheckInHandler(checkInService, userService, notificationService),
+			BoosterHandler:       api.NewBoosterHandler(multiplierService, userService, notificationService),
+			QuizHandler:          api.NewQuizHandler(quizService, userService),
 		},
 		&AdminHandlers{
-			AdminAuthHandler:     api_admin.NewAuthHandler(authService, userService),
-			AdminCategoryHandler: api_admin.NewAdminCategoryHandler(adminCategoryService),
-			AdminGenreHandler:    api_admin.NewAdminGenreHandler(adminGenreService),
-			AdminPlatformHandler: api_admin.NewAdminPlatformHandler(AdminPlatformService),
-			AdminTagHandler:      api_admin.NewAdminTagHandler(adminTagService),
-			AdminGameHandler:     api_admin.NewAdminGameHandler(adminGameService),
-			AdminSteamHandler:    api_admin.NewSteamHandler(gameService, db),
+			AdminAuthHandler:            api_admin.NewAuthHandler(authService, userService),
+			AdminReferenceHandlers:      api_admin.NewAdminReferenceHandlers(adminReferenceServices),
+			AdminGameHandler:            api_admin.NewAdminGameHandler(adminGameService),
+			AdminSteamHandler:           api_admin.NewSteamHandler(gameService, db),
+			AdminJobHandler:             api_admin.NewAdminJobHandler(adminJobService),
+			AdminUserHandler:            api_admin.NewAdminUserHandler(adminUserService, userService),
+			AdminRoleHandler:            api_admin.NewAdminRoleHandler(adminRoleService),
+			AdminPermissionHandler:      api_admin.NewAdminPermissionHandler(adminPermissionService, scopeCatalog),
+			AdminAuditLogHandler:        api_admin.NewAdminAuditLogHandler(adminAuditLogService),
+			AdminMissionHandler:         api_admin.NewAdminMissionHandler(adminMissionService),
+			AdminTitleHandler:           api_admin.NewAdminTitleHandler(adminTitleService),
+			AdminLevelHandler:           api_admin.NewAdminLevelHandler(adminLevelService),
+			AdminSchedulerHandler:       api_admin.NewAdminSchedulerHandler(adminSchedulerService, userService),
+			AdminCheckInRewardHandler:   api_admin.NewAdminCheckInRewardHandler(adminCheckInRewardService),
+			AdminMultiplierEventHandler: api_admin.NewAdminMultiplierEventHandler(adminMultiplierEventService),
+			AdminBoosterHandler:         api_admin.NewAdminBoosterHandler(adminBoosterService),
+			AdminActionPolicyHandler:    api_admin.NewAdminActionPolicyHandler(adminActionPolicyService),
+			AdminQuizHandler:            api_admin.NewAdminQuizHandler(adminQuizService),
 		}
 }
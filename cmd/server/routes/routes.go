@@ -26,13 +26,33 @@ func SetupRouter(
 	missionService *usecases.MissionService,
 	gameService *usecases.GameService,
 	bannerService *usecases.BannerService,
-	adminCategoryService *usecases_admin.AdminCategoryService,
-	adminGenreService *usecases_admin.AdminGenreService,
-	adminPlatformService *usecases_admin.AdminPlatformService,
-	adminTagService *usecases_admin.AdminTagService,
+	adminReferenceServices usecases_admin.AdminReferenceServices,
 	adminGameService *usecases_admin.AdminGameService,
 	heartService *usecases.HeartService,
 	commentService *usecases.CommentService,
+	libraryService *usecases.LibraryService,
+	priceAlertService *usecases.PriceAlertService,
+	crackService *usecases.CrackService,
+	gameFollowService *usecases.GameFollowService,
+	feedService *usecases.FeedService,
+	adminJobService *usecases_admin.AdminJobService,
+	adminUserService *usecases_admin.AdminUserService,
+	adminRoleService *usecases_admin.AdminRoleService,
+	adminPermissionService *usecases_admin.AdminPermissionService,
+	adminAuditLogService *usecases_admin.AdminAuditLogService,
+	adminMissionService *usecases_admin.AdminMissionService,
+	adminTitleService *usecases_admin.AdminTitleService,
+	adminLevelService *usecases_admin.AdminLevelService,
+	adminSchedulerService *usecases_admin.AdminSchedulerService,
+	leaderboardService *usecases.LeaderboardService,
+	checkInService *usecases.CheckInService,
+	adminCheckInRewardService *usecases_admin.AdminCheckInRewardService,
+	multiplierService *usecases.MultiplierService,
+	adminMultiplierEventService *usecases_admin.AdminMultiplierEventService,
+	adminBoosterService *usecases_admin.AdminBoosterService,
+	adminActionPolicyService *usecases_admin.AdminActionPolicyService,
+	quizService *usecases.QuizService,
+	adminQuizService *usecases_admin.AdminQuizService,
 	db *gorm.DB,
 ) *gin.Engine {
 	r := gin.Default()
@@ -52,6 +72,8 @@ func SetupRouter(
 		AllowCredentials: true,
 	}))
 
+	scopeCatalog := middlewares.NewScopeCatalog()
+
 	handlers, adminHandlers := InitHandlers(
 		authService,
 		userService,
@@ -66,13 +88,34 @@ func SetupRouter(
 		missionService,
 		gameService,
 		bannerService,
-		adminCategoryService,
-		adminGenreService,
-		adminPlatformService,
-		adminTagService,
+		adminReferenceServices,
 		adminGameService,
 		heartService,
 		commentService,
+		libraryService,
+		priceAlertService,
+		crackService,
+		gameFollowService,
+		feedService,
+		adminJobService,
+		adminUserService,
+		adminRoleService,
+		adminPermissionService,
+		adminAuditLogService,
+		adminMissionService,
+		adminTitleService,
+		adminLevelService,
+		adminSchedulerService,
+		leaderboardService,
+		checkInService,
+		adminCheckInRewardService,
+		multiplierService,
+		adminMultiplierEventService,
+		adminBoosterService,
+		adminActionPolicyService,
+		quizService,
+		adminQuizService,
+		scopeCatalog,
 		db,
 	)
 
@@ -81,7 +124,7 @@ func SetupRouter(
 	RegisterCommonRoutes(r, handlers)
 	RegisterAuthRoutes(r.Group("/auth"), handlers)
 	RegisterProtectedRoutes(r.Group("/"), userService, handlers)
-	RegisterAdminRoutes(r.Group("/admin"), userService, adminHandlers)
+	RegisterAdminRoutes(r.Group("/admin"), userService, adminAuditLogService, scopeCatalog, env.SuperuserRole, adminHandlers)
 
 	return r
 }
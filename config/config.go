@@ -32,6 +32,14 @@ type Config struct {
 	AwsBucketRegion string
 	AwsSqsRegion    string
 	AwsSqsUrl       string
+	SaleThreshold   string
+	SteamRegions    string
+	SteamWorkers    string
+	JobWorkers      string
+	SuperuserRole   string
+	AuditRetention  string
+	FreezeCost      string
+	FreezeLimit     string
 }
 
 func LoadConfig() *Config {
@@ -64,6 +72,14 @@ func LoadConfig() *Config {
 		AwsBucketRegion: getEnv("AWS_BUCKET_REGION", ""),
 		AwsSqsRegion:    getEnv("AWS_SQS_REGION", ""),
 		AwsSqsUrl:       getEnv("AWS_SQS_URL", ""),
+		SaleThreshold:   getEnv("SALE_DISCOUNT_THRESHOLD", "0"), // in percentage, 0 disables it
+		SteamRegions:    getEnv("STEAM_REGIONS", "us"),          // comma separated, the first one is the default
+		SteamWorkers:    getEnv("STEAM_IMPORT_WORKERS", "4"),
+		JobWorkers:      getEnv("JOB_WORKERS", "4"),
+		SuperuserRole:   getEnv("SUPERUSER_ROLE", "Technology"),    // granted every admin scope
+		AuditRetention:  getEnv("AUDIT_LOG_RETENTION_DAYS", "365"), // 0 keeps the audit logs forever
+		FreezeCost:      getEnv("STREAK_FREEZE_COST", "100"),       // in coins
+		FreezeLimit:     getEnv("STREAK_FREEZE_LIMIT", "2"),        // freezes a user can hold at once
 	}
 }
 
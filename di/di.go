@@ -6,8 +6,10 @@ import (
 	"gcstatus/internal/usecases"
 	usecases_admin "gcstatus/internal/usecases/admin"
 	"gcstatus/pkg/cache"
+	"gcstatus/pkg/leaderboard"
 	"gcstatus/pkg/s3"
 	"gcstatus/pkg/sqs"
+	"gcstatus/pkg/worker"
 	"log"
 
 	"gorm.io/driver/mysql"
@@ -28,13 +30,33 @@ func InitDependencies() (
 	*usecases.MissionService,
 	*usecases.GameService,
 	*usecases.BannerService,
-	*usecases_admin.AdminCategoryService,
-	*usecases_admin.AdminGenreService,
-	*usecases_admin.AdminPlatformService,
-	*usecases_admin.AdminTagService,
+	usecases_admin.AdminReferenceServices,
 	*usecases_admin.AdminGameService,
 	*usecases.HeartService,
 	*usecases.CommentService,
+	*usecases.LibraryService,
+	*usecases.PriceAlertService,
+	*usecases.CrackService,
+	*usecases.GameFollowService,
+	*usecases.FeedService,
+	*usecases_admin.AdminJobService,
+	*usecases_admin.AdminUserService,
+	*usecases_admin.AdminRoleService,
+	*usecases_admin.AdminPermissionService,
+	*usecases_admin.AdminAuditLogService,
+	*usecases_admin.AdminMissionService,
+	*usecases_admin.AdminTitleService,
+	*usecases_admin.AdminLevelService,
+	*usecases_admin.AdminSchedulerService,
+	*usecases.LeaderboardService,
+	*usecases.CheckInService,
+	*usecases_admin.AdminCheckInRewardService,
+	*usecases.MultiplierService,
+	*usecases_admin.AdminMultiplierEventService,
+	*usecases_admin.AdminBoosterService,
+	*usecases_admin.AdminActionPolicyService,
+	*usecases.QuizService,
+	*usecases_admin.AdminQuizService,
 	*gorm.DB,
 ) {
 	cfg := config.LoadConfig()
@@ -63,19 +85,43 @@ func InitDependencies() (
 		missionService,
 		gameService,
 		bannerService,
-		adminCategoryService,
-		adminGenreService,
-		adminPlatformService,
-		adminTagService,
+		adminReferenceServices,
 		adminGameService,
 		heartService,
-		commentService := Setup(dbConn)
+		commentService,
+		libraryService,
+		priceAlertService,
+		crackService,
+		gameFollowService,
+		feedService,
+		adminJobService,
+		adminUserService,
+		adminRoleService,
+		adminPermissionService,
+		adminAuditLogService,
+		adminMissionService,
+		adminTitleService,
+		adminLevelService,
+		adminSchedulerService,
+		leaderboardService,
+		checkInService,
+		adminCheckInRewardService,
+		rewardService,
+		multiplierService,
+		adminMultiplierEventService,
+		adminBoosterService,
+		adminActionPolicyService,
+		quizService,
+		adminQuizService := Setup(dbConn)
+
+	worker.GlobalDispatcher = worker.NewDispatcher(dbConn)
 
 	// Setup clients for non-test environment
 	if cfg.ENV != "testing" {
 		sqsClient := sqs.NewSQSClient()
 		cache.GlobalCache = cache.NewRedisCache()
 		s3.GlobalS3Client = s3.NewS3Client()
+		leaderboard.GlobalLeaderboard = leaderboard.NewRedisLeaderboard()
 		sqs.GlobalSQSClient = sqsClient
 
 		consumer := sqs.NewSQSConsumer(
@@ -86,10 +132,16 @@ func InitDependencies() (
 			notificationService,
 			taskService,
 			missionService,
-			walletService,
+			gameFollowService,
+			rewardService,
 		)
 
 		go consumer.Start(context.Background())
+
+		pool := worker.NewPool(dbConn, jobWorkers(cfg))
+		registerJobHandlers(pool, dbConn, gameFollowService, notificationService)
+
+		go pool.Start(context.Background())
 	}
 
 	return userService,
@@ -105,12 +157,32 @@ func InitDependencies() (
 		missionService,
 		gameService,
 		bannerService,
-		adminCategoryService,
-		adminGenreService,
-		adminPlatformService,
-		adminTagService,
+		adminReferenceServices,
 		adminGameService,
 		heartService,
 		commentService,
+		libraryService,
+		priceAlertService,
+		crackService,
+		gameFollowService,
+		feedService,
+		adminJobService,
+		adminUserService,
+		adminRoleService,
+		adminPermissionService,
+		adminAuditLogService,
+		adminMissionService,
+		adminTitleService,
+		adminLevelService,
+		adminSchedulerService,
+		leaderboardService,
+		checkInService,
+		adminCheckInRewardService,
+		multiplierService,
+		adminMultiplierEventService,
+		adminBoosterService,
+		adminActionPolicyService,
+		quizService,
+		adminQuizService,
 		dbConn
 }
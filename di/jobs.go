@@ -0,0 +1,37 @@
+package di
+
+import (
+	"gcstatus/config"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/jobs"
+	"gcstatus/internal/usecases"
+	"gcstatus/pkg/ses"
+	"gcstatus/pkg/sqs/messages"
+	"gcstatus/pkg/worker"
+	"log"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+func registerJobHandlers(
+	pool *worker.Pool,
+	dbConn *gorm.DB,
+	gameFollowService *usecases.GameFollowService,
+	notificationService *usecases.NotificationService,
+) {
+	jobs.RegisterJobHandlers(pool, dbConn)
+
+	pool.Register(domain.JobTypeSendEmail, ses.HandleEmailJob)
+	pool.Register(domain.JobTypeGameFollowEvent, messages.NewGameFollowMessageHandler(gameFollowService, notificationService).HandleGameFollowJob)
+}
+
+func jobWorkers(cfg *config.Config) int {
+	workers, err := strconv.Atoi(cfg.JobWorkers)
+	if err != nil || workers < 1 {
+		log.Printf("Invalid JOB_WORKERS value %q, using a single worker", cfg.JobWorkers)
+		return 1
+	}
+
+	return workers
+}
@@ -42,6 +42,7 @@ func MigrateModels(dbConn *gorm.DB) {
 		&domain.Protection{},
 		&domain.Cracker{},
 		&domain.Crack{},
+		&domain.CrackStatusChange{},
 		&domain.TorrentProvider{},
 		&domain.Torrent{},
 		&domain.Publisher{},
@@ -57,6 +58,15 @@ func MigrateModels(dbConn *gorm.DB) {
 		&domain.Store{},
 		&domain.GameStore{},
 		&domain.Commentable{},
+		&domain.Libraryable{},
+		&domain.PriceHistory{},
+		&domain.PriceAlert{},
+		&domain.GameFollow{},
+		&domain.FeedToken{},
+		&domain.ImportCheckpoint{},
+		&domain.GameSyncLock{},
+		&domain.Job{},
+		&domain.StorePrice{},
 		&domain.Galleriable{},
 		&domain.DLC{},
 		&domain.DLCStore{},
@@ -69,6 +79,31 @@ func MigrateModels(dbConn *gorm.DB) {
 		&domain.Permission{},
 		&domain.Roleable{},
 		&domain.Permissionable{},
+		&domain.AuditLog{},
+		&domain.UserAction{},
+		&domain.MissionResetRun{},
+		&domain.ScheduledJob{},
+		&domain.ScheduledRun{},
+		&domain.SchedulerLease{},
+		&domain.CheckInStreak{},
+		&domain.CheckIn{},
+		&domain.CheckInReward{},
+		&domain.Booster{},
+		&domain.UserBooster{},
+		&domain.Cosmetic{},
+		&domain.UserCosmetic{},
+		&domain.Item{},
+		&domain.UserItem{},
+		&domain.RewardGrant{},
+		&domain.MultiplierEvent{},
+		&domain.ActionPolicy{},
+		&domain.ActionDecision{},
+		&domain.Quiz{},
+		&domain.QuizQuestion{},
+		&domain.QuizOption{},
+		&domain.QuizReward{},
+		&domain.QuizAttempt{},
+		&domain.QuizAnswer{},
 	}
 
 	for _, model := range models {
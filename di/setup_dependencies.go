@@ -1,10 +1,14 @@
 package di
 
 import (
+	"gcstatus/config"
 	"gcstatus/internal/adapters/db"
 	db_admin "gcstatus/internal/adapters/db/admin"
 	"gcstatus/internal/usecases"
 	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/pkg/leaderboard"
+	"log"
+	"strconv"
 
 	"gorm.io/gorm"
 )
@@ -23,13 +27,34 @@ func Setup(dbConn *gorm.DB) (
 	*usecases.MissionService,
 	*usecases.GameService,
 	*usecases.BannerService,
-	*usecases_admin.AdminCategoryService,
-	*usecases_admin.AdminGenreService,
-	*usecases_admin.AdminPlatformService,
-	*usecases_admin.AdminTagService,
+	usecases_admin.AdminReferenceServices,
 	*usecases_admin.AdminGameService,
 	*usecases.HeartService,
 	*usecases.CommentService,
+	*usecases.LibraryService,
+	*usecases.PriceAlertService,
+	*usecases.CrackService,
+	*usecases.GameFollowService,
+	*usecases.FeedService,
+	*usecases_admin.AdminJobService,
+	*usecases_admin.AdminUserService,
+	*usecases_admin.AdminRoleService,
+	*usecases_admin.AdminPermissionService,
+	*usecases_admin.AdminAuditLogService,
+	*usecases_admin.AdminMissionService,
+	*usecases_admin.AdminTitleService,
+	*usecases_admin.AdminLevelService,
+	*usecases_admin.AdminSchedulerService,
+	*usecases.LeaderboardService,
+	*usecases.CheckInService,
+	*usecases_admin.AdminCheckInRewardService,
+	*usecases.RewardService,
+	*usecases.MultiplierService,
+	*usecases_admin.AdminMultiplierEventService,
+	*usecases_admin.AdminBoosterService,
+	*usecases_admin.AdminActionPolicyService,
+	*usecases.QuizService,
+	*usecases_admin.AdminQuizService,
 ) {
 	// Create repository instances
 	userRepo := db.NewUserRepositoryMySQL(dbConn)
@@ -44,13 +69,33 @@ func Setup(dbConn *gorm.DB) (
 	missionRepo := db.NewMissionRepositoryMySQL(dbConn)
 	gameRepo := db.NewGameRepositoryMySQL(dbConn)
 	bannerRepo := db.NewBannerRepositoryMySQL(dbConn)
-	adminCategoryRepo := db_admin.NewAdminCategoryRepositoryMySQL(dbConn)
-	adminGenreRepo := db_admin.NewAdminGenreRepositoryMySQL(dbConn)
-	adminPlatformRepo := db_admin.NewAdminPlatformRepositoryMySQL(dbConn)
-	adminTagRepo := db_admin.NewAdminTagRepositoryMySQL(dbConn)
 	adminGameRepo := db_admin.NewAdminGameRepositoryMySQL(dbConn)
 	heartRepo := db.NewHeartRepositoryMySQL(dbConn)
 	commentRepo := db.NewCommentRepositoryMySQL(dbConn)
+	libraryRepo := db.NewLibraryRepositoryMySQL(dbConn)
+	priceAlertRepo := db.NewPriceAlertRepositoryMySQL(dbConn)
+	crackRepo := db.NewCrackRepositoryMySQL(dbConn)
+	gameFollowRepo := db.NewGameFollowRepositoryMySQL(dbConn)
+	feedRepo := db.NewFeedRepositoryMySQL(dbConn)
+	adminJobRepo := db_admin.NewAdminJobRepositoryMySQL(dbConn)
+	adminUserRepo := db_admin.NewAdminUserRepositoryMySQL(dbConn)
+	adminRoleRepo := db_admin.NewAdminRoleRepositoryMySQL(dbConn)
+	adminPermissionRepo := db_admin.NewAdminPermissionRepositoryMySQL(dbConn)
+	adminAuditLogRepo := db_admin.NewAdminAuditLogRepositoryMySQL(dbConn)
+	adminMissionRepo := db_admin.NewAdminMissionRepositoryMySQL(dbConn)
+	adminTitleRepo := db_admin.NewAdminTitleRepositoryMySQL(dbConn)
+	adminLevelRepo := db_admin.NewAdminLevelRepositoryMySQL(dbConn)
+	adminSchedulerRepo := db_admin.NewAdminSchedulerRepositoryMySQL(dbConn)
+	leaderboardRepo := db.NewLeaderboardRepositoryMySQL(dbConn)
+	checkInRepo := db.NewCheckInRepositoryMySQL(dbConn)
+	adminCheckInRewardRepo := db_admin.NewAdminCheckInRewardRepositoryMySQL(dbConn)
+	rewardRepo := db.NewRewardRepositoryMySQL(dbConn)
+	multiplierRepo := db.NewMultiplierRepositoryMySQL(dbConn)
+	adminMultiplierEventRepo := db_admin.NewAdminMultiplierEventRepositoryMySQL(dbConn)
+	adminBoosterRepo := db_admin.NewAdminBoosterRepositoryMySQL(dbConn)
+	adminActionPolicyRepo := db_admin.NewAdminActionPolicyRepositoryMySQL(dbConn)
+	quizRepo := db.NewQuizRepositoryMySQL(dbConn)
+	adminQuizRepo := db_admin.NewAdminQuizRepositoryMySQL(dbConn)
 
 	// Create service instances
 	userService := usecases.NewUserService(userRepo)
@@ -66,13 +111,50 @@ func Setup(dbConn *gorm.DB) (
 	missionService := usecases.NewMissionService(missionRepo)
 	gameService := usecases.NewGameService(gameRepo)
 	bannerService := usecases.NewBannerService(bannerRepo)
-	adminCategoryService := usecases_admin.NewAdminCategoryService(adminCategoryRepo)
-	adminGenreService := usecases_admin.NewAdminGenreService(adminGenreRepo)
-	adminPlatformService := usecases_admin.NewAdminPlatformService(adminPlatformRepo)
-	adminTagService := usecases_admin.NewAdminTagService(adminTagRepo)
+	adminReferenceServices := usecases_admin.AdminReferenceServices{
+		Categories:       usecases_admin.NewAdminCategoryService(db_admin.NewAdminCategoryRepositoryMySQL(dbConn)),
+		Genres:           usecases_admin.NewAdminGenreService(db_admin.NewAdminGenreRepositoryMySQL(dbConn)),
+		Platforms:        usecases_admin.NewAdminPlatformService(db_admin.NewAdminPlatformRepositoryMySQL(dbConn)),
+		Tags:             usecases_admin.NewAdminTagService(db_admin.NewAdminTagRepositoryMySQL(dbConn)),
+		Crackers:         usecases_admin.NewAdminCrackerService(db_admin.NewAdminCrackerRepositoryMySQL(dbConn)),
+		Protections:      usecases_admin.NewAdminProtectionService(db_admin.NewAdminProtectionRepositoryMySQL(dbConn)),
+		Developers:       usecases_admin.NewAdminDeveloperService(db_admin.NewAdminDeveloperRepositoryMySQL(dbConn)),
+		Publishers:       usecases_admin.NewAdminPublisherService(db_admin.NewAdminPublisherRepositoryMySQL(dbConn)),
+		Stores:           usecases_admin.NewAdminStoreService(db_admin.NewAdminStoreRepositoryMySQL(dbConn)),
+		Critics:          usecases_admin.NewAdminCriticService(db_admin.NewAdminCriticRepositoryMySQL(dbConn)),
+		Languages:        usecases_admin.NewAdminLanguageService(db_admin.NewAdminLanguageRepositoryMySQL(dbConn)),
+		MediaTypes:       usecases_admin.NewAdminMediaTypeService(db_admin.NewAdminMediaTypeRepositoryMySQL(dbConn)),
+		RequirementTypes: usecases_admin.NewAdminRequirementTypeService(db_admin.NewAdminRequirementTypeRepositoryMySQL(dbConn)),
+		TorrentProviders: usecases_admin.NewAdminTorrentProviderService(db_admin.NewAdminTorrentProviderRepositoryMySQL(dbConn)),
+	}
 	adminGameService := usecases_admin.NewAdminGameService(adminGameRepo)
 	heartService := usecases.NewHeartService(heartRepo)
 	commentService := usecases.NewCommentService(commentRepo)
+	libraryService := usecases.NewLibraryService(libraryRepo)
+	priceAlertService := usecases.NewPriceAlertService(priceAlertRepo)
+	crackService := usecases.NewCrackService(crackRepo)
+	gameFollowService := usecases.NewGameFollowService(gameFollowRepo)
+	feedService := usecases.NewFeedService(feedRepo)
+	adminJobService := usecases_admin.NewAdminJobService(adminJobRepo)
+	adminUserService := usecases_admin.NewAdminUserService(adminUserRepo)
+	adminRoleService := usecases_admin.NewAdminRoleService(adminRoleRepo, config.LoadConfig().SuperuserRole)
+	adminPermissionService := usecases_admin.NewAdminPermissionService(adminPermissionRepo)
+	adminAuditLogService := usecases_admin.NewAdminAuditLogService(adminAuditLogRepo)
+	adminMissionService := usecases_admin.NewAdminMissionService(adminMissionRepo)
+	adminTitleService := usecases_admin.NewAdminTitleService(adminTitleRepo)
+	adminLevelService := usecases_admin.NewAdminLevelService(adminLevelRepo)
+	adminSchedulerService := usecases_admin.NewAdminSchedulerService(adminSchedulerRepo)
+	leaderboardService := usecases.NewLeaderboardService(leaderboard.NewRedisLeaderboard(), leaderboardRepo)
+	freezeCost, freezeLimit := streakFreezeSettings(config.LoadConfig())
+	checkInService := usecases.NewCheckInService(checkInRepo, freezeCost, freezeLimit)
+	adminCheckInRewardService := usecases_admin.NewAdminCheckInRewardService(adminCheckInRewardRepo)
+	rewardService := usecases.NewRewardService(rewardRepo)
+	multiplierService := usecases.NewMultiplierService(multiplierRepo)
+	adminMultiplierEventService := usecases_admin.NewAdminMultiplierEventService(adminMultiplierEventRepo)
+	adminBoosterService := usecases_admin.NewAdminBoosterService(adminBoosterRepo)
+	adminActionPolicyService := usecases_admin.NewAdminActionPolicyService(adminActionPolicyRepo)
+	quizService := usecases.NewQuizService(quizRepo)
+	adminQuizService := usecases_admin.NewAdminQuizService(adminQuizRepo)
 
 	return userService,
 		authService,
@@ -87,11 +169,50 @@ func Setup(dbConn *gorm.DB) (
 		missionService,
 		gameService,
 		bannerService,
-		adminCategoryService,
-		adminGenreService,
-		adminPlatformService,
-		adminTagService,
+		adminReferenceServices,
 		adminGameService,
 		heartService,
-		commentService
+		commentService,
+		libraryService,
+		priceAlertService,
+		crackService,
+		gameFollowService,
+		feedService,
+		adminJobService,
+		adminUserService,
+		adminRoleService,
+		adminPermissionService,
+		adminAuditLogService,
+		adminMissionService,
+		adminTitleService,
+		adminLevelService,
+		adminSchedulerService,
+		leaderboardService,
+		checkInService,
+		adminCheckInRewardService,
+		rewardService,
+		multiplierService,
+		adminMultiplierEventService,
+		adminBoosterService,
+		adminActionPolicyService,
+		quizService,
+		adminQuizService
+}
+
+// streakFreezeSettings reads the cost and the limit of the streak freezes,
+// falling back to the defaults when they are invalid.
+func streakFreezeSettings(cfg *config.Config) (uint, uint) {
+	cost, err := strconv.ParseUint(cfg.FreezeCost, 10, 32)
+	if err != nil {
+		log.Printf("Invalid STREAK_FREEZE_COST value %q, using 100 coins", cfg.FreezeCost)
+		cost = 100
+	}
+
+	limit, err := strconv.ParseUint(cfg.FreezeLimit, 10, 32)
+	if err != nil {
+		log.Printf("Invalid STREAK_FREEZE_LIMIT value %q, using 2 freezes", cfg.FreezeLimit)
+		limit = 2
+	}
+
+	return uint(cost), uint(limit)
 }
@@ -0,0 +1,183 @@
+package api_admin
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/api"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/middlewares"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdminActionPolicyHandler struct {
+	actionPolicyService *usecases_admin.AdminActionPolicyService
+}
+
+func NewAdminActionPolicyHandler(
+	actionPolicyService *usecases_admin.AdminActionPolicyService,
+) *AdminActionPolicyHandler {
+	return &AdminActionPolicyHandler{
+		actionPolicyService: actionPolicyService,
+	}
+}
+
+func (h *AdminActionPolicyHandler) GetAll(c *gin.Context) {
+	actionPolicies, err := h.actionPolicyService.GetAll()
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch action policies: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformActionPolicies(actionPolicies),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminActionPolicyHandler) FindByID(c *gin.Context) {
+	id, ok := parseActionPolicyID(c)
+	if !ok {
+		return
+	}
+
+	actionPolicy, err := h.actionPolicyService.FindByID(id)
+	if err != nil {
+		respondWithActionPolicyError(c, err, "Failed to fetch action policy: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformActionPolicy(actionPolicy),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminActionPolicyHandler) Create(c *gin.Context) {
+	var request ports_admin.ActionPolicyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	actionPolicy, err := h.actionPolicyService.Create(request)
+	if err != nil {
+		respondWithActionPolicyError(c, err, "Failed to create action policy: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformActionPolicy(actionPolicy),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminActionPolicyHandler) Update(c *gin.Context) {
+	id, ok := parseActionPolicyID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.ActionPolicyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	actionPolicy, err := h.actionPolicyService.Update(id, request)
+	if err != nil {
+		respondWithActionPolicyError(c, err, "Failed to update action policy: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformActionPolicy(actionPolicy),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminActionPolicyHandler) Delete(c *gin.Context) {
+	id, ok := parseActionPolicyID(c)
+	if !ok {
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	if err := h.actionPolicyService.Delete(id); err != nil {
+		respondWithActionPolicyError(c, err, "Failed to delete action policy: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The action policy was successfully removed!"})
+}
+
+// Decisions lists what the policies decided about the actions of users, so
+// admins can tell why progress did or did not move.
+func (h *AdminActionPolicyHandler) Decisions(c *gin.Context) {
+	var filters ports_admin.ActionDecisionFilters
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid filters: "+err.Error())
+		return
+	}
+
+	decisions, page, total, err := h.actionPolicyService.GetDecisions(filters)
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch action decisions: "+err.Error())
+		return
+	}
+
+	response := resources.PaginatedResponse{
+		Data: resources_admin.TransformActionDecisions(decisions),
+		Meta: resources.NewPaginationMeta(page.Page, page.PerPage, total),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// auditBefore snapshots the action policy about to change for the audit log.
+func (h *AdminActionPolicyHandler) auditBefore(c *gin.Context, id uint) {
+	if actionPolicy, err := h.actionPolicyService.FindByID(id); err == nil {
+		middlewares.SetAuditBefore(c, resources_admin.TransformActionPolicy(actionPolicy))
+	}
+}
+
+func parseActionPolicyID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid action policy ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func respondWithActionPolicyError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The action policy could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
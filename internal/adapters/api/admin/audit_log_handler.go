@@ -0,0 +1,45 @@
+package api_admin
+
+import (
+	"gcstatus/internal/adapters/api"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminAuditLogHandler struct {
+	auditLogService *usecases_admin.AdminAuditLogService
+}
+
+func NewAdminAuditLogHandler(
+	auditLogService *usecases_admin.AdminAuditLogService,
+) *AdminAuditLogHandler {
+	return &AdminAuditLogHandler{
+		auditLogService: auditLogService,
+	}
+}
+
+func (h *AdminAuditLogHandler) GetAll(c *gin.Context) {
+	var filters ports_admin.AuditLogFilters
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid filters: "+err.Error())
+		return
+	}
+
+	auditLogs, page, total, err := h.auditLogService.GetAll(filters)
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch audit logs: "+err.Error())
+		return
+	}
+
+	response := resources.PaginatedResponse{
+		Data: resources_admin.TransformAuditLogs(auditLogs),
+		Meta: resources.NewPaginationMeta(page.Page, page.PerPage, total),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,160 @@
+package api_admin
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/api"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/middlewares"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdminBoosterHandler struct {
+	boosterService *usecases_admin.AdminBoosterService
+}
+
+func NewAdminBoosterHandler(
+	boosterService *usecases_admin.AdminBoosterService,
+) *AdminBoosterHandler {
+	return &AdminBoosterHandler{
+		boosterService: boosterService,
+	}
+}
+
+func (h *AdminBoosterHandler) GetAll(c *gin.Context) {
+	boosters, err := h.boosterService.GetAll()
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch boosters: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformBoosters(boosters),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminBoosterHandler) FindByID(c *gin.Context) {
+	id, ok := parseBoosterID(c)
+	if !ok {
+		return
+	}
+
+	booster, err := h.boosterService.FindByID(id)
+	if err != nil {
+		respondWithBoosterError(c, err, "Failed to fetch booster: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformBooster(booster),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminBoosterHandler) Create(c *gin.Context) {
+	var request ports_admin.BoosterRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	booster, err := h.boosterService.Create(request)
+	if err != nil {
+		respondWithBoosterError(c, err, "Failed to create booster: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformBooster(booster),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminBoosterHandler) Update(c *gin.Context) {
+	id, ok := parseBoosterID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.BoosterRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	booster, err := h.boosterService.Update(id, request)
+	if err != nil {
+		respondWithBoosterError(c, err, "Failed to update booster: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformBooster(booster),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminBoosterHandler) Delete(c *gin.Context) {
+	id, ok := parseBoosterID(c)
+	if !ok {
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	if err := h.boosterService.Delete(id); err != nil {
+		respondWithBoosterError(c, err, "Failed to delete booster: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The booster was successfully removed!"})
+}
+
+// auditBefore snapshots the booster about to change for the audit log.
+func (h *AdminBoosterHandler) auditBefore(c *gin.Context, id uint) {
+	if booster, err := h.boosterService.FindByID(id); err == nil {
+		middlewares.SetAuditBefore(c, resources_admin.TransformBooster(booster))
+	}
+}
+
+func parseBoosterID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid booster ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func respondWithBoosterError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The booster could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
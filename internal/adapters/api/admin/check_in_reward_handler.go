@@ -0,0 +1,160 @@
+package api_admin
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/api"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/middlewares"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdminCheckInRewardHandler struct {
+	checkInRewardService *usecases_admin.AdminCheckInRewardService
+}
+
+func NewAdminCheckInRewardHandler(
+	checkInRewardService *usecases_admin.AdminCheckInRewardService,
+) *AdminCheckInRewardHandler {
+	return &AdminCheckInRewardHandler{
+		checkInRewardService: checkInRewardService,
+	}
+}
+
+func (h *AdminCheckInRewardHandler) GetAll(c *gin.Context) {
+	checkInRewards, err := h.checkInRewardService.GetAll()
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch check-in rewards: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformCheckInRewards(checkInRewards),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminCheckInRewardHandler) FindByID(c *gin.Context) {
+	id, ok := parseCheckInRewardID(c)
+	if !ok {
+		return
+	}
+
+	checkInReward, err := h.checkInRewardService.FindByID(id)
+	if err != nil {
+		respondWithCheckInRewardError(c, err, "Failed to fetch check-in reward: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformCheckInReward(checkInReward),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminCheckInRewardHandler) Create(c *gin.Context) {
+	var request ports_admin.CheckInRewardRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	checkInReward, err := h.checkInRewardService.Create(request)
+	if err != nil {
+		respondWithCheckInRewardError(c, err, "Failed to create check-in reward: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformCheckInReward(checkInReward),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminCheckInRewardHandler) Update(c *gin.Context) {
+	id, ok := parseCheckInRewardID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.CheckInRewardRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	checkInReward, err := h.checkInRewardService.Update(id, request)
+	if err != nil {
+		respondWithCheckInRewardError(c, err, "Failed to update check-in reward: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformCheckInReward(checkInReward),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminCheckInRewardHandler) Delete(c *gin.Context) {
+	id, ok := parseCheckInRewardID(c)
+	if !ok {
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	if err := h.checkInRewardService.Delete(id); err != nil {
+		respondWithCheckInRewardError(c, err, "Failed to delete check-in reward: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The check-in reward was successfully removed!"})
+}
+
+// auditBefore snapshots the check-in reward about to change for the audit log.
+func (h *AdminCheckInRewardHandler) auditBefore(c *gin.Context, id uint) {
+	if checkInReward, err := h.checkInRewardService.FindByID(id); err == nil {
+		middlewares.SetAuditBefore(c, resources_admin.TransformCheckInReward(checkInReward))
+	}
+}
+
+func parseCheckInRewardID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid check-in reward ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func respondWithCheckInRewardError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The check-in reward could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
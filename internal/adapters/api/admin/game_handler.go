@@ -1,15 +1,28 @@
 package api_admin
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"gcstatus/internal/adapters/api"
+	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/jobs"
+	"gcstatus/internal/middlewares"
+	ports_admin "gcstatus/internal/ports/admin"
 	"gcstatus/internal/resources"
 	resources_admin "gcstatus/internal/resources/admin"
 	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
 	"gcstatus/pkg/s3"
+	"gcstatus/pkg/sqs"
+	"log"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 type AdminGameHandler struct {
@@ -63,3 +76,275 @@ func (h *AdminGameHandler) FindByID(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+func (h *AdminGameHandler) UpdateCrack(c *gin.Context) {
+	idStr := c.Param("id")
+
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid game ID: "+err.Error())
+		return
+	}
+
+	var request ports_admin.UpdateCrackRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	crack, change, err := h.gameService.UpdateCrack(uint(id), request)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			api.RespondWithError(c, http.StatusNotFound, "The game could not be found.")
+			return
+		}
+
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to update game crack: "+err.Error())
+		return
+	}
+
+	if change != nil {
+		enqueueCrackStatusChanged(c, crack, change)
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformCrack(crack),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminGameHandler) GetSyncLocks(c *gin.Context) {
+	idStr := c.Param("id")
+
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid game ID: "+err.Error())
+		return
+	}
+
+	locks, err := h.gameService.GetSyncLocks(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			api.RespondWithError(c, http.StatusNotFound, "The game could not be found.")
+			return
+		}
+
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch game sync locks: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformGameSyncLocks(locks),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminGameHandler) UpdateSyncLocks(c *gin.Context) {
+	idStr := c.Param("id")
+
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid game ID: "+err.Error())
+		return
+	}
+
+	var request ports_admin.UpdateSyncLocksRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	locks, err := h.gameService.UpdateSyncLocks(uint(id), request)
+	if err != nil {
+		if httpErr, ok := err.(*self_errors.HttpError); ok {
+			api.RespondWithError(c, httpErr.Code, httpErr.Error())
+			return
+		}
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			api.RespondWithError(c, http.StatusNotFound, "The game could not be found.")
+			return
+		}
+
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to update game sync locks: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformGameSyncLocks(locks),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminGameHandler) Create(c *gin.Context) {
+	var request ports_admin.UpsertGameRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	game, change, err := h.gameService.Create(request)
+	if err != nil {
+		respondWithGameWriteError(c, err, "Failed to create game: ")
+		return
+	}
+
+	if change != nil && game.Crack != nil {
+		enqueueCrackStatusChanged(c, game.Crack, change)
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformGame(game, s3.GlobalS3Client),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminGameHandler) Update(c *gin.Context) {
+	idStr := c.Param("id")
+
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid game ID: "+err.Error())
+		return
+	}
+
+	var request ports_admin.UpsertGameRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	current, _ := h.auditBefore(c, uint(id))
+
+	game, change, err := h.gameService.Update(uint(id), request)
+	if err != nil {
+		respondWithGameWriteError(c, err, "Failed to update game: ")
+		return
+	}
+
+	removeDroppedGalleries(c, current, game)
+
+	if change != nil && game.Crack != nil {
+		enqueueCrackStatusChanged(c, game.Crack, change)
+	}
+
+	enqueueNewDLCs(game, request.DLCs)
+
+	response := resources.Response{
+		Data: resources_admin.TransformGame(game, s3.GlobalS3Client),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminGameHandler) Delete(c *gin.Context) {
+	idStr := c.Param("id")
+
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid game ID: "+err.Error())
+		return
+	}
+
+	h.auditBefore(c, uint(id))
+
+	if err := h.gameService.Delete(uint(id)); err != nil {
+		respondWithGameWriteError(c, err, "Failed to delete game: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The game was successfully removed!"})
+}
+
+// auditBefore snapshots the game about to change for the audit log and
+// returns it.
+func (h *AdminGameHandler) auditBefore(c *gin.Context, id uint) (domain.Game, error) {
+	game, err := h.gameService.FindByID(id)
+	if err == nil {
+		middlewares.SetAuditBefore(c, resources_admin.TransformGame(game, s3.GlobalS3Client))
+	}
+
+	return game, err
+}
+
+// removeDroppedGalleries removes from the bucket the uploaded galleries the
+// update left out of the game.
+func removeDroppedGalleries(c *gin.Context, before domain.Game, after domain.Game) {
+	kept := make(map[string]bool, len(after.Galleries))
+	for _, gallery := range after.Galleries {
+		if gallery.S3 {
+			kept[gallery.Path] = true
+		}
+	}
+
+	for _, gallery := range before.Galleries {
+		if !gallery.S3 || kept[gallery.Path] {
+			continue
+		}
+
+		if err := s3.GlobalS3Client.RemoveFile(c.Request.Context(), gallery.Path); err != nil {
+			log.Printf("failed to remove gallery %s of game %d: %+v", gallery.Path, before.ID, err)
+		}
+	}
+}
+
+func respondWithGameWriteError(c *gin.Context, err error, prefix string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The game could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, prefix+err.Error())
+}
+
+// enqueueNewDLCs notifies the followers of the game about the DLCs added
+// by the request, the ones sent without an id.
+func enqueueNewDLCs(game domain.Game, dlcs []ports_admin.GameDLCRequest) {
+	for _, dlc := range dlcs {
+		if dlc.ID != nil {
+			continue
+		}
+
+		title := fmt.Sprintf("%s got a new DLC: %s", game.Title, dlc.Name)
+		if err := jobs.EnqueueGameFollowEvent(game.ID, domain.FollowDLCEvent, title, fmt.Sprintf("/games/%s", game.Slug)); err != nil {
+			log.Printf("failed to enqueue new dlc of game %d: %+v", game.ID, err)
+		}
+	}
+}
+
+func enqueueCrackStatusChanged(c *gin.Context, crack *domain.Crack, change *domain.CrackStatusChange) {
+	crackStatusMessage := map[string]any{
+		"type": "CrackStatusChanged",
+		"body": map[string]any{
+			"game_id":         crack.GameID,
+			"game_title":      crack.Game.Title,
+			"game_slug":       crack.Game.Slug,
+			"status":          change.Status,
+			"previous_status": change.PreviousStatus,
+			"days_to_crack":   change.DaysToCrack,
+		},
+	}
+
+	crackStatusMessageBody, err := json.Marshal(crackStatusMessage)
+	if err != nil {
+		log.Printf("failed to serialize crack status message to JSON: %+v", err)
+		return
+	}
+
+	if err := sqs.GlobalSQSClient.SendMessage(c.Request.Context(), sqs.GetAwsQueue(), string(crackStatusMessageBody)); err != nil {
+		log.Printf("failed to enqueue crack status message to SQS: %+v", err)
+	}
+}
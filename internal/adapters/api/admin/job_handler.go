@@ -0,0 +1,129 @@
+package api_admin
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/api"
+	self_errors "gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdminJobHandler struct {
+	jobService *usecases_admin.AdminJobService
+}
+
+func NewAdminJobHandler(
+	jobService *usecases_admin.AdminJobService,
+) *AdminJobHandler {
+	return &AdminJobHandler{
+		jobService: jobService,
+	}
+}
+
+func (h *AdminJobHandler) GetAll(c *gin.Context) {
+	var filters ports_admin.JobFilters
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid filters: "+err.Error())
+		return
+	}
+
+	jobs, err := h.jobService.GetAll(filters)
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch jobs: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformJobs(jobs),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminJobHandler) FindByID(c *gin.Context) {
+	id, ok := parseJobID(c)
+	if !ok {
+		return
+	}
+
+	job, err := h.jobService.FindByID(id)
+	if err != nil {
+		respondWithJobError(c, err, "Failed to fetch job: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformJob(job),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminJobHandler) Retry(c *gin.Context) {
+	id, ok := parseJobID(c)
+	if !ok {
+		return
+	}
+
+	job, err := h.jobService.Retry(id)
+	if err != nil {
+		respondWithJobError(c, err, "Failed to retry job: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformJob(job),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminJobHandler) Cancel(c *gin.Context) {
+	id, ok := parseJobID(c)
+	if !ok {
+		return
+	}
+
+	job, err := h.jobService.Cancel(id)
+	if err != nil {
+		respondWithJobError(c, err, "Failed to cancel job: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformJob(job),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func parseJobID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid job ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func respondWithJobError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The job could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
@@ -0,0 +1,160 @@
+package api_admin
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/api"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/middlewares"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdminLevelHandler struct {
+	levelService *usecases_admin.AdminLevelService
+}
+
+func NewAdminLevelHandler(
+	levelService *usecases_admin.AdminLevelService,
+) *AdminLevelHandler {
+	return &AdminLevelHandler{
+		levelService: levelService,
+	}
+}
+
+func (h *AdminLevelHandler) GetAll(c *gin.Context) {
+	levels, err := h.levelService.GetAll()
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch levels: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformLevels(levels),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminLevelHandler) FindByID(c *gin.Context) {
+	id, ok := parseLevelID(c)
+	if !ok {
+		return
+	}
+
+	level, err := h.levelService.FindByID(id)
+	if err != nil {
+		respondWithLevelError(c, err, "Failed to fetch level: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformLevel(level),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminLevelHandler) Create(c *gin.Context) {
+	var request ports_admin.LevelRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	level, err := h.levelService.Create(request)
+	if err != nil {
+		respondWithLevelError(c, err, "Failed to create level: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformLevel(level),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminLevelHandler) Update(c *gin.Context) {
+	id, ok := parseLevelID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.LevelRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	level, err := h.levelService.Update(id, request)
+	if err != nil {
+		respondWithLevelError(c, err, "Failed to update level: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformLevel(level),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminLevelHandler) Delete(c *gin.Context) {
+	id, ok := parseLevelID(c)
+	if !ok {
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	if err := h.levelService.Delete(id); err != nil {
+		respondWithLevelError(c, err, "Failed to delete level: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The level was successfully removed!"})
+}
+
+// auditBefore snapshots the level about to change for the audit log.
+func (h *AdminLevelHandler) auditBefore(c *gin.Context, id uint) {
+	if level, err := h.levelService.FindByID(id); err == nil {
+		middlewares.SetAuditBefore(c, resources_admin.TransformLevel(level))
+	}
+}
+
+func parseLevelID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid level ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func respondWithLevelError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The level could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
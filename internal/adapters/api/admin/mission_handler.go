@@ -0,0 +1,252 @@
+package api_admin
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/api"
+	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/middlewares"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdminMissionHandler struct {
+	missionService *usecases_admin.AdminMissionService
+}
+
+func NewAdminMissionHandler(
+	missionService *usecases_admin.AdminMissionService,
+) *AdminMissionHandler {
+	return &AdminMissionHandler{
+		missionService: missionService,
+	}
+}
+
+func (h *AdminMissionHandler) GetAll(c *gin.Context) {
+	missions, err := h.missionService.GetAll()
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch missions: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformMissions(missions),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminMissionHandler) FindByID(c *gin.Context) {
+	id, ok := parseMissionID(c)
+	if !ok {
+		return
+	}
+
+	mission, err := h.missionService.FindByID(id)
+	if err != nil {
+		respondWithMissionError(c, err, "Failed to fetch mission: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformMission(mission),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminMissionHandler) Create(c *gin.Context) {
+	var request ports_admin.MissionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	mission, err := h.missionService.Create(request)
+	if err != nil {
+		respondWithMissionError(c, err, "Failed to create mission: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformMission(mission),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminMissionHandler) Update(c *gin.Context) {
+	id, ok := parseMissionID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.MissionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	mission, err := h.missionService.Update(id, request)
+	if err != nil {
+		respondWithMissionError(c, err, "Failed to update mission: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformMission(mission),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminMissionHandler) Delete(c *gin.Context) {
+	id, ok := parseMissionID(c)
+	if !ok {
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	if err := h.missionService.Delete(id); err != nil {
+		respondWithMissionError(c, err, "Failed to delete mission: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The mission was successfully removed!"})
+}
+
+func (h *AdminMissionHandler) SyncAssignments(c *gin.Context) {
+	id, ok := parseMissionID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.AssignMissionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	mission, err := h.missionService.SyncAssignments(id, request)
+	if err != nil {
+		respondWithMissionError(c, err, "Failed to assign mission: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformMission(mission),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminMissionHandler) RequirementAchievers(c *gin.Context) {
+	id, ok := parseMissionID(c)
+	if !ok {
+		return
+	}
+
+	requirementID, ok := parseRequirementID(c)
+	if !ok {
+		return
+	}
+
+	var page ports_admin.PageRequest
+	if err := c.ShouldBindQuery(&page); err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid filters: "+err.Error())
+		return
+	}
+
+	users, page, total, err := h.missionService.RequirementAchievers(id, requirementID, page)
+	if err != nil {
+		respondWithMissionError(c, err, "Failed to fetch requirement achievers: ")
+		return
+	}
+
+	respondWithAchievers(c, users, page, total)
+}
+
+func (h *AdminMissionHandler) ActionKeys(c *gin.Context) {
+	respondWithActionKeys(c)
+}
+
+// auditBefore snapshots the mission about to change for the audit log.
+func (h *AdminMissionHandler) auditBefore(c *gin.Context, id uint) {
+	if mission, err := h.missionService.FindByID(id); err == nil {
+		middlewares.SetAuditBefore(c, resources_admin.TransformMission(mission))
+	}
+}
+
+func respondWithAchievers(c *gin.Context, users []domain.User, page ports_admin.PageRequest, total int64) {
+	achievers := make([]resources_admin.MinimalUserResource, 0, len(users))
+	for _, user := range users {
+		achievers = append(achievers, resources_admin.TransformMinimalUser(user))
+	}
+
+	response := resources.PaginatedResponse{
+		Data: achievers,
+		Meta: resources.NewPaginationMeta(page.Page, page.PerPage, total),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondWithActionKeys lists the action keys requirements can count.
+func respondWithActionKeys(c *gin.Context) {
+	response := resources.Response{
+		Data: domain.TrackedActionKeys,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func parseMissionID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid mission ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func parseRequirementID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("requirement"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid requirement ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func respondWithMissionError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The mission could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
@@ -0,0 +1,160 @@
+package api_admin
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/api"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/middlewares"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdminMultiplierEventHandler struct {
+	multiplierEventService *usecases_admin.AdminMultiplierEventService
+}
+
+func NewAdminMultiplierEventHandler(
+	multiplierEventService *usecases_admin.AdminMultiplierEventService,
+) *AdminMultiplierEventHandler {
+	return &AdminMultiplierEventHandler{
+		multiplierEventService: multiplierEventService,
+	}
+}
+
+func (h *AdminMultiplierEventHandler) GetAll(c *gin.Context) {
+	multiplierEvents, err := h.multiplierEventService.GetAll()
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch multiplier events: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformMultiplierEvents(multiplierEvents),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminMultiplierEventHandler) FindByID(c *gin.Context) {
+	id, ok := parseMultiplierEventID(c)
+	if !ok {
+		return
+	}
+
+	multiplierEvent, err := h.multiplierEventService.FindByID(id)
+	if err != nil {
+		respondWithMultiplierEventError(c, err, "Failed to fetch multiplier event: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformMultiplierEvent(multiplierEvent),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminMultiplierEventHandler) Create(c *gin.Context) {
+	var request ports_admin.MultiplierEventRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	multiplierEvent, err := h.multiplierEventService.Create(request)
+	if err != nil {
+		respondWithMultiplierEventError(c, err, "Failed to create multiplier event: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformMultiplierEvent(multiplierEvent),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminMultiplierEventHandler) Update(c *gin.Context) {
+	id, ok := parseMultiplierEventID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.MultiplierEventRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	multiplierEvent, err := h.multiplierEventService.Update(id, request)
+	if err != nil {
+		respondWithMultiplierEventError(c, err, "Failed to update multiplier event: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformMultiplierEvent(multiplierEvent),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminMultiplierEventHandler) Delete(c *gin.Context) {
+	id, ok := parseMultiplierEventID(c)
+	if !ok {
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	if err := h.multiplierEventService.Delete(id); err != nil {
+		respondWithMultiplierEventError(c, err, "Failed to delete multiplier event: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The multiplier event was successfully removed!"})
+}
+
+// auditBefore snapshots the multiplier event about to change for the audit log.
+func (h *AdminMultiplierEventHandler) auditBefore(c *gin.Context, id uint) {
+	if multiplierEvent, err := h.multiplierEventService.FindByID(id); err == nil {
+		middlewares.SetAuditBefore(c, resources_admin.TransformMultiplierEvent(multiplierEvent))
+	}
+}
+
+func parseMultiplierEventID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid multiplier event ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func respondWithMultiplierEventError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The multiplier event could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
@@ -0,0 +1,196 @@
+package api_admin
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/api"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/middlewares"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdminPermissionHandler struct {
+	permissionService *usecases_admin.AdminPermissionService
+	scopeCatalog      *middlewares.ScopeCatalog
+}
+
+func NewAdminPermissionHandler(
+	permissionService *usecases_admin.AdminPermissionService,
+	scopeCatalog *middlewares.ScopeCatalog,
+) *AdminPermissionHandler {
+	return &AdminPermissionHandler{
+		permissionService: permissionService,
+		scopeCatalog:      scopeCatalog,
+	}
+}
+
+func (h *AdminPermissionHandler) GetAll(c *gin.Context) {
+	permissions, err := h.permissionService.GetAll()
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch permissions: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformPermissions(permissions),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminPermissionHandler) FindByID(c *gin.Context) {
+	id, ok := parsePermissionID(c)
+	if !ok {
+		return
+	}
+
+	permission, err := h.permissionService.FindByID(id)
+	if err != nil {
+		respondWithPermissionError(c, err, "Failed to fetch permission: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformPermission(permission),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminPermissionHandler) Create(c *gin.Context) {
+	var request ports_admin.PermissionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	permission, err := h.permissionService.Create(request)
+	if err != nil {
+		respondWithPermissionError(c, err, "Failed to create permission: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformPermission(permission),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminPermissionHandler) Update(c *gin.Context) {
+	id, ok := parsePermissionID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.PermissionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	permission, userIDs, err := h.permissionService.Update(id, request)
+	if err != nil {
+		respondWithPermissionError(c, err, "Failed to update permission: ")
+		return
+	}
+
+	forgetUserScopes(userIDs...)
+
+	response := resources.Response{
+		Data: resources_admin.TransformPermission(permission),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminPermissionHandler) Delete(c *gin.Context) {
+	id, ok := parsePermissionID(c)
+	if !ok {
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	userIDs, err := h.permissionService.Delete(id)
+	if err != nil {
+		respondWithPermissionError(c, err, "Failed to delete permission: ")
+		return
+	}
+
+	forgetUserScopes(userIDs...)
+
+	c.JSON(http.StatusOK, gin.H{"message": "The permission was successfully removed!"})
+}
+
+func (h *AdminPermissionHandler) Catalog(c *gin.Context) {
+	catalog, err := h.permissionService.Catalog(h.scopeCatalog.Scopes())
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch the permission catalog: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformPermissionCatalog(catalog),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminPermissionHandler) SyncCatalog(c *gin.Context) {
+	permissions, err := h.permissionService.SyncCatalog(h.scopeCatalog.Scopes())
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to sync the permission catalog: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformPermissions(permissions),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// auditBefore snapshots the permission about to change for the audit log.
+func (h *AdminPermissionHandler) auditBefore(c *gin.Context, id uint) {
+	if permission, err := h.permissionService.FindByID(id); err == nil {
+		middlewares.SetAuditBefore(c, resources_admin.TransformPermission(permission))
+	}
+}
+
+func parsePermissionID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid permission ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func respondWithPermissionError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The permission could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
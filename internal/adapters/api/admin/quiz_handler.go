@@ -0,0 +1,160 @@
+package api_admin
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/api"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/middlewares"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdminQuizHandler struct {
+	quizService *usecases_admin.AdminQuizService
+}
+
+func NewAdminQuizHandler(
+	quizService *usecases_admin.AdminQuizService,
+) *AdminQuizHandler {
+	return &AdminQuizHandler{
+		quizService: quizService,
+	}
+}
+
+func (h *AdminQuizHandler) GetAll(c *gin.Context) {
+	quizzes, err := h.quizService.GetAll()
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch quizzes: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformQuizzes(quizzes),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminQuizHandler) FindByID(c *gin.Context) {
+	id, ok := parseQuizID(c)
+	if !ok {
+		return
+	}
+
+	quiz, err := h.quizService.FindByID(id)
+	if err != nil {
+		respondWithQuizError(c, err, "Failed to fetch quiz: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformQuiz(quiz),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminQuizHandler) Create(c *gin.Context) {
+	var request ports_admin.QuizRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	quiz, err := h.quizService.Create(request)
+	if err != nil {
+		respondWithQuizError(c, err, "Failed to create quiz: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformQuiz(quiz),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminQuizHandler) Update(c *gin.Context) {
+	id, ok := parseQuizID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.QuizRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	quiz, err := h.quizService.Update(id, request)
+	if err != nil {
+		respondWithQuizError(c, err, "Failed to update quiz: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformQuiz(quiz),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminQuizHandler) Delete(c *gin.Context) {
+	id, ok := parseQuizID(c)
+	if !ok {
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	if err := h.quizService.Delete(id); err != nil {
+		respondWithQuizError(c, err, "Failed to delete quiz: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The quiz was successfully removed!"})
+}
+
+// auditBefore snapshots the quiz about to change for the audit log.
+func (h *AdminQuizHandler) auditBefore(c *gin.Context, id uint) {
+	if quiz, err := h.quizService.FindByID(id); err == nil {
+		middlewares.SetAuditBefore(c, resources_admin.TransformQuiz(quiz))
+	}
+}
+
+func parseQuizID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid quiz ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func respondWithQuizError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The quiz could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
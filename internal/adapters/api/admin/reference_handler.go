@@ -0,0 +1,218 @@
+package api_admin
+
+import (
+	"errors"
+	"fmt"
+	"gcstatus/internal/adapters/api"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/middlewares"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// ReferenceHandler serves the admin CRUD of a catalog reference entity.
+type ReferenceHandler interface {
+	Resource() string
+	GetAll(c *gin.Context)
+	FindByID(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+}
+
+type AdminReferenceHandler[T any, R any] struct {
+	service   *usecases_admin.AdminReferenceService[T]
+	transform func(T) R
+}
+
+func NewAdminReferenceHandler[T any, R any](
+	service *usecases_admin.AdminReferenceService[T],
+	transform func(T) R,
+) *AdminReferenceHandler[T, R] {
+	return &AdminReferenceHandler[T, R]{
+		service:   service,
+		transform: transform,
+	}
+}
+
+// NewAdminReferenceHandlers builds the handlers of every reference entity,
+// in the order their routes are registered.
+func NewAdminReferenceHandlers(services usecases_admin.AdminReferenceServices) []ReferenceHandler {
+	return []ReferenceHandler{
+		NewAdminReferenceHandler(services.Categories, resources_admin.TransformCategory),
+		NewAdminReferenceHandler(services.Genres, resources_admin.TransformGenre),
+		NewAdminReferenceHandler(services.Platforms, resources_admin.TransformPlatform),
+		NewAdminReferenceHandler(services.Tags, resources_admin.TransformTag),
+		NewAdminReferenceHandler(services.Crackers, resources_admin.TransformCracker),
+		NewAdminReferenceHandler(services.Protections, resources_admin.TransformProtection),
+		NewAdminReferenceHandler(services.Developers, resources_admin.TransformDeveloper),
+		NewAdminReferenceHandler(services.Publishers, resources_admin.TransformPublisher),
+		NewAdminReferenceHandler(services.Stores, resources_admin.TransformStore),
+		NewAdminReferenceHandler(services.Critics, resources_admin.TransformCritic),
+		NewAdminReferenceHandler(services.Languages, resources_admin.TransformLanguage),
+		NewAdminReferenceHandler(services.MediaTypes, resources_admin.TransformMediaType),
+		NewAdminReferenceHandler(services.RequirementTypes, resources_admin.TransformRequirementType),
+		NewAdminReferenceHandler(services.TorrentProviders, resources_admin.TransformTorrentProvider),
+	}
+}
+
+func (h *AdminReferenceHandler[T, R]) Resource() string {
+	return h.service.Entity().Resource
+}
+
+func (h *AdminReferenceHandler[T, R]) GetAll(c *gin.Context) {
+	entities, usages, err := h.service.GetAll()
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, fmt.Sprintf("Failed to fetch %s: %s", h.Resource(), err.Error()))
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformReferences(entities, h.transform, usages, h.service.Entity().ID),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminReferenceHandler[T, R]) FindByID(c *gin.Context) {
+	id, ok := h.parseID(c)
+	if !ok {
+		return
+	}
+
+	entity, usages, err := h.service.FindByID(id)
+	if err != nil {
+		h.respondWithError(c, err, "Failed to fetch %s: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformReference(entity, h.transform, usages),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminReferenceHandler[T, R]) Create(c *gin.Context) {
+	var request ports_admin.ReferenceRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	entity, err := h.service.Create(request)
+	if err != nil {
+		h.respondWithError(c, err, "Failed to create %s: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformReference(entity, h.transform, 0),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminReferenceHandler[T, R]) Update(c *gin.Context) {
+	id, ok := h.parseID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.ReferenceRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	if _, err := h.service.Update(id, request); err != nil {
+		h.respondWithError(c, err, "Failed to update %s: ")
+		return
+	}
+
+	entity, usages, err := h.service.FindByID(id)
+	if err != nil {
+		h.respondWithError(c, err, "Failed to fetch %s: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformReference(entity, h.transform, usages),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Delete removes the entity. Records still using it can be moved to another
+// entity through the reassign_to query parameter.
+func (h *AdminReferenceHandler[T, R]) Delete(c *gin.Context) {
+	id, ok := h.parseID(c)
+	if !ok {
+		return
+	}
+
+	var reassignTo *uint
+	if value := c.Query("reassign_to"); value != "" {
+		target, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			api.RespondWithError(c, http.StatusBadRequest, "Invalid reassign_to ID: "+err.Error())
+			return
+		}
+
+		targetID := uint(target)
+		reassignTo = &targetID
+	}
+
+	h.auditBefore(c, id)
+
+	if err := h.service.Delete(id, reassignTo); err != nil {
+		h.respondWithError(c, err, "Failed to delete %s: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": fmt.Sprintf("The %s was successfully removed!", h.service.Entity().Label)})
+}
+
+// auditBefore snapshots the entity about to change for the audit log.
+func (h *AdminReferenceHandler[T, R]) auditBefore(c *gin.Context, id uint) {
+	if entity, usages, err := h.service.FindByID(id); err == nil {
+		middlewares.SetAuditBefore(c, resources_admin.TransformReference(entity, h.transform, usages))
+	}
+}
+
+func (h *AdminReferenceHandler[T, R]) parseID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, fmt.Sprintf("Invalid %s ID: %s", h.service.Entity().Label, err.Error()))
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func (h *AdminReferenceHandler[T, R]) respondWithError(c *gin.Context, err error, format string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, fmt.Sprintf("The %s could not be found.", h.service.Entity().Label))
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, fmt.Sprintf(format, h.service.Entity().Label)+err.Error())
+}
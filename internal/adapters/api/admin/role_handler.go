@@ -0,0 +1,174 @@
+package api_admin
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/api"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/middlewares"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
+	"gcstatus/pkg/cache"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdminRoleHandler struct {
+	roleService *usecases_admin.AdminRoleService
+}
+
+func NewAdminRoleHandler(
+	roleService *usecases_admin.AdminRoleService,
+) *AdminRoleHandler {
+	return &AdminRoleHandler{
+		roleService: roleService,
+	}
+}
+
+func (h *AdminRoleHandler) GetAll(c *gin.Context) {
+	roles, err := h.roleService.GetAll()
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch roles: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformRoles(roles),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminRoleHandler) FindByID(c *gin.Context) {
+	id, ok := parseRoleID(c)
+	if !ok {
+		return
+	}
+
+	role, err := h.roleService.FindByID(id)
+	if err != nil {
+		respondWithRoleError(c, err, "Failed to fetch role: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformRole(role),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminRoleHandler) Create(c *gin.Context) {
+	var request ports_admin.RoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	role, err := h.roleService.Create(request)
+	if err != nil {
+		respondWithRoleError(c, err, "Failed to create role: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformRole(role),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminRoleHandler) Update(c *gin.Context) {
+	id, ok := parseRoleID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.RoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	role, userIDs, err := h.roleService.Update(id, request)
+	if err != nil {
+		respondWithRoleError(c, err, "Failed to update role: ")
+		return
+	}
+
+	forgetUserScopes(userIDs...)
+
+	response := resources.Response{
+		Data: resources_admin.TransformRole(role),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminRoleHandler) Delete(c *gin.Context) {
+	id, ok := parseRoleID(c)
+	if !ok {
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	userIDs, err := h.roleService.Delete(id)
+	if err != nil {
+		respondWithRoleError(c, err, "Failed to delete role: ")
+		return
+	}
+
+	forgetUserScopes(userIDs...)
+
+	c.JSON(http.StatusOK, gin.H{"message": "The role was successfully removed!"})
+}
+
+// auditBefore snapshots the role about to change for the audit log.
+func (h *AdminRoleHandler) auditBefore(c *gin.Context, id uint) {
+	if role, err := h.roleService.FindByID(id); err == nil {
+		middlewares.SetAuditBefore(c, resources_admin.TransformRole(role))
+	}
+}
+
+// forgetUserScopes drops the cached permission checks of users whose access
+// was changed, so their next request resolves it again.
+func forgetUserScopes(userIDs ...uint) {
+	if len(userIDs) > 0 {
+		cache.GlobalCache.RemoveUserScopesFromCache(userIDs...)
+	}
+}
+
+func parseRoleID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid role ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func respondWithRoleError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The role could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
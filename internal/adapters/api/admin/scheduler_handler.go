@@ -0,0 +1,102 @@
+package api_admin
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/api"
+	self_errors "gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	"gcstatus/internal/usecases"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdminSchedulerHandler struct {
+	schedulerService *usecases_admin.AdminSchedulerService
+	userService      *usecases.UserService
+}
+
+func NewAdminSchedulerHandler(
+	schedulerService *usecases_admin.AdminSchedulerService,
+	userService *usecases.UserService,
+) *AdminSchedulerHandler {
+	return &AdminSchedulerHandler{
+		schedulerService: schedulerService,
+		userService:      userService,
+	}
+}
+
+func (h *AdminSchedulerHandler) GetJobs(c *gin.Context) {
+	jobs, err := h.schedulerService.GetJobs()
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch scheduled jobs: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformScheduledJobs(jobs),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminSchedulerHandler) GetRuns(c *gin.Context) {
+	var filters ports_admin.ScheduledRunFilters
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid filters: "+err.Error())
+		return
+	}
+
+	runs, page, total, err := h.schedulerService.GetRuns(filters)
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch scheduled runs: "+err.Error())
+		return
+	}
+
+	response := resources.PaginatedResponse{
+		Data: resources_admin.TransformScheduledRuns(runs),
+		Meta: resources.NewPaginationMeta(page.Page, page.PerPage, total),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Trigger queues a run of the job, answering before the job runs.
+func (h *AdminSchedulerHandler) Trigger(c *gin.Context) {
+	admin, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		api.RespondWithError(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	run, err := h.schedulerService.Trigger(c.Param("name"), admin.ID)
+	if err != nil {
+		respondWithSchedulerError(c, err, "Failed to trigger job: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformScheduledRun(run),
+	}
+
+	c.JSON(http.StatusAccepted, response)
+}
+
+func respondWithSchedulerError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The job could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
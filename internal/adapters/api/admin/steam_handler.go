@@ -5,6 +5,7 @@ import (
 	"gcstatus/internal/domain"
 	"gcstatus/internal/jobs"
 	"gcstatus/internal/usecases"
+	"gcstatus/pkg/worker"
 	"net/http"
 	"strconv"
 
@@ -14,11 +15,11 @@ import (
 
 type SteamHandler struct {
 	gameService *usecases.GameService
-	db          *gorm.DB
+	dispatcher  *worker.Dispatcher
 }
 
 func NewSteamHandler(gameService *usecases.GameService, db *gorm.DB) *SteamHandler {
-	return &SteamHandler{gameService: gameService, db: db}
+	return &SteamHandler{gameService: gameService, dispatcher: worker.NewDispatcher(db)}
 }
 
 func (h *SteamHandler) RegisterByAppID(c *gin.Context) {
@@ -40,7 +41,31 @@ func (h *SteamHandler) RegisterByAppID(c *gin.Context) {
 		return
 	}
 
-	go jobs.FetchSteamOneByOneApp(h.db, int(appID))
+	job, err := h.dispatcher.Dispatch(domain.JobTypeCatalogImportItem, jobs.CatalogImportItemPayload{
+		Source: jobs.SteamImportSource,
+		ID:     uint(appID),
+	})
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to queue the game import: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The appID you requested is successfully queued to run in background.", "job_id": job.ID})
+}
+
+func (h *SteamHandler) SyncGame(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid game ID: "+err.Error())
+		return
+	}
+
+	job, err := h.dispatcher.Dispatch(domain.JobTypeCatalogSyncGame, jobs.CatalogSyncGamePayload{GameID: uint(id)})
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to queue the game re-sync: "+err.Error())
+		return
+	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "The appID you requested is successfully running in background."})
+	c.JSON(http.StatusOK, gin.H{"message": "The game re-sync you requested is successfully queued to run in background.", "job_id": job.ID})
 }
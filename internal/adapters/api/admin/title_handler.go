@@ -0,0 +1,190 @@
+package api_admin
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/api"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/middlewares"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdminTitleHandler struct {
+	titleService *usecases_admin.AdminTitleService
+}
+
+func NewAdminTitleHandler(
+	titleService *usecases_admin.AdminTitleService,
+) *AdminTitleHandler {
+	return &AdminTitleHandler{
+		titleService: titleService,
+	}
+}
+
+func (h *AdminTitleHandler) GetAll(c *gin.Context) {
+	titles, err := h.titleService.GetAll()
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch titles: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformTitles(titles),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminTitleHandler) FindByID(c *gin.Context) {
+	id, ok := parseTitleID(c)
+	if !ok {
+		return
+	}
+
+	title, err := h.titleService.FindByID(id)
+	if err != nil {
+		respondWithTitleError(c, err, "Failed to fetch title: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformTitle(title),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminTitleHandler) Create(c *gin.Context) {
+	var request ports_admin.TitleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	title, err := h.titleService.Create(request)
+	if err != nil {
+		respondWithTitleError(c, err, "Failed to create title: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformTitle(title),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminTitleHandler) Update(c *gin.Context) {
+	id, ok := parseTitleID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.TitleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	title, err := h.titleService.Update(id, request)
+	if err != nil {
+		respondWithTitleError(c, err, "Failed to update title: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformTitle(title),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminTitleHandler) Delete(c *gin.Context) {
+	id, ok := parseTitleID(c)
+	if !ok {
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	if err := h.titleService.Delete(id); err != nil {
+		respondWithTitleError(c, err, "Failed to delete title: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The title was successfully removed!"})
+}
+
+func (h *AdminTitleHandler) RequirementAchievers(c *gin.Context) {
+	id, ok := parseTitleID(c)
+	if !ok {
+		return
+	}
+
+	requirementID, ok := parseRequirementID(c)
+	if !ok {
+		return
+	}
+
+	var page ports_admin.PageRequest
+	if err := c.ShouldBindQuery(&page); err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid filters: "+err.Error())
+		return
+	}
+
+	users, page, total, err := h.titleService.RequirementAchievers(id, requirementID, page)
+	if err != nil {
+		respondWithTitleError(c, err, "Failed to fetch requirement achievers: ")
+		return
+	}
+
+	respondWithAchievers(c, users, page, total)
+}
+
+func (h *AdminTitleHandler) ActionKeys(c *gin.Context) {
+	respondWithActionKeys(c)
+}
+
+// auditBefore snapshots the title about to change for the audit log.
+func (h *AdminTitleHandler) auditBefore(c *gin.Context, id uint) {
+	if title, err := h.titleService.FindByID(id); err == nil {
+		middlewares.SetAuditBefore(c, resources_admin.TransformTitle(title))
+	}
+}
+
+func parseTitleID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid title ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func respondWithTitleError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The title could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
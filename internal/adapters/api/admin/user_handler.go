@@ -0,0 +1,317 @@
+package api_admin
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/api"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/middlewares"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/resources"
+	resources_admin "gcstatus/internal/resources/admin"
+	"gcstatus/internal/usecases"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"gcstatus/internal/utils"
+	"gcstatus/pkg/s3"
+	"gcstatus/pkg/ses"
+	"gcstatus/pkg/worker"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdminUserHandler struct {
+	adminUserService *usecases_admin.AdminUserService
+	userService      *usecases.UserService
+}
+
+func NewAdminUserHandler(
+	adminUserService *usecases_admin.AdminUserService,
+	userService *usecases.UserService,
+) *AdminUserHandler {
+	return &AdminUserHandler{
+		adminUserService: adminUserService,
+		userService:      userService,
+	}
+}
+
+func (h *AdminUserHandler) GetAll(c *gin.Context) {
+	var filters ports_admin.UserFilters
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid filters: "+err.Error())
+		return
+	}
+
+	users, err := h.adminUserService.GetAll(filters)
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Failed to fetch users: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformManagedUsers(users),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) FindByID(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.adminUserService.FindByID(id)
+	if err != nil {
+		respondWithUserError(c, err, "Failed to fetch user: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformManagedUserDetail(user, s3.GlobalS3Client),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) Block(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	admin, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		api.RespondWithError(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	var request ports_admin.BlockUserRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	user, err := h.adminUserService.Block(admin.ID, id, request)
+	if err != nil {
+		respondWithUserError(c, err, "Failed to block user: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformManagedUserDetail(user, s3.GlobalS3Client),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) Unblock(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	user, err := h.adminUserService.Unblock(id)
+	if err != nil {
+		respondWithUserError(c, err, "Failed to unblock user: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformManagedUserDetail(user, s3.GlobalS3Client),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) AdjustWallet(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.AdjustWalletRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	transaction, err := h.adminUserService.AdjustWallet(id, request)
+	if err != nil {
+		respondWithUserError(c, err, "Failed to adjust user wallet: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformTransaction(transaction),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminUserHandler) GrantTitle(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.GrantTitleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	userTitle, err := h.adminUserService.GrantTitle(id, request)
+	if err != nil {
+		respondWithUserError(c, err, "Failed to grant user title: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources_admin.TransformUserTitle(userTitle),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *AdminUserHandler) ResetPassword(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	token, err := utils.GenerateResetToken()
+	if err != nil {
+		api.RespondWithError(c, http.StatusInternalServerError, "Unable to generate a reset token: "+err.Error())
+		return
+	}
+
+	user, err := h.adminUserService.ResetPassword(id, token)
+	if err != nil {
+		respondWithUserError(c, err, "Failed to reset user password: ")
+		return
+	}
+
+	if err := ses.SendPasswordResetEmail(user.Email, token, worker.SendEmail); err != nil {
+		log.Printf("failed to send the password reset email to user %d: %+v", user.ID, err)
+		api.RespondWithError(c, http.StatusInternalServerError, "The user was logged out, but the reset email could not be sent.")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The user was logged out and a password reset link was sent to them."})
+}
+
+func (h *AdminUserHandler) Logout(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.adminUserService.Logout(id); err != nil {
+		respondWithUserError(c, err, "Failed to log user out: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The user was successfully logged out from every session!"})
+}
+
+func (h *AdminUserHandler) SyncRoles(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.SyncUserRolesRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	user, err := h.adminUserService.SyncRoles(id, request)
+	if err != nil {
+		respondWithUserError(c, err, "Failed to update user roles: ")
+		return
+	}
+
+	forgetUserScopes(id)
+
+	response := resources.Response{
+		Data: resources_admin.TransformManagedUserDetail(user, s3.GlobalS3Client),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) SyncPermissions(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	var request ports_admin.SyncUserPermissionsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		api.RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	h.auditBefore(c, id)
+
+	user, err := h.adminUserService.SyncPermissions(id, request)
+	if err != nil {
+		respondWithUserError(c, err, "Failed to update user permissions: ")
+		return
+	}
+
+	forgetUserScopes(id)
+
+	response := resources.Response{
+		Data: resources_admin.TransformManagedUserDetail(user, s3.GlobalS3Client),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// auditBefore snapshots the user about to change for the audit log.
+func (h *AdminUserHandler) auditBefore(c *gin.Context, id uint) {
+	if user, err := h.adminUserService.FindByID(id); err == nil {
+		middlewares.SetAuditBefore(c, resources_admin.TransformManagedUserDetail(user, s3.GlobalS3Client))
+	}
+}
+
+func parseUserID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		api.RespondWithError(c, http.StatusBadRequest, "Invalid user ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func respondWithUserError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		api.RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.RespondWithError(c, http.StatusNotFound, "The user could not be found.")
+		return
+	}
+
+	api.RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
@@ -17,12 +17,14 @@ import (
 )
 
 type AuthHandler struct {
-	authService *usecases.AuthService
-	userService *usecases.UserService
+	authService       *usecases.AuthService
+	userService       *usecases.UserService
+	libraryService    *usecases.LibraryService
+	multiplierService *usecases.MultiplierService
 }
 
-func NewAuthHandler(authService *usecases.AuthService, userService *usecases.UserService) *AuthHandler {
-	return &AuthHandler{authService: authService, userService: userService}
+func NewAuthHandler(authService *usecases.AuthService, userService *usecases.UserService, libraryService *usecases.LibraryService, multiplierService *usecases.MultiplierService) *AuthHandler {
+	return &AuthHandler{authService: authService, userService: userService, libraryService: libraryService, multiplierService: multiplierService}
 }
 
 func (h *AuthHandler) Login(c *gin.Context) {
@@ -44,7 +46,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	if user.Blocked {
+	if user.IsBlocked(time.Now()) {
 		RespondWithError(c, http.StatusForbidden, "You are blocked on GCStatus platform. If you think this is an error, please, contact support!")
 		return
 	}
@@ -75,6 +77,11 @@ func (h *AuthHandler) Login(c *gin.Context) {
 
 	h.authService.SetAuthCookies(c, env.AccessTokenKey, encryptedToken, env.IsAuthKey, expirationSeconds, httpSecure, httpOnly, env.Domain)
 
+	enqueueTrackActionProgress(c, domain.UserAction{
+		UserID: user.ID,
+		Key:    domain.LoginRequirementKey,
+	})
+
 	c.JSON(http.StatusOK, resources.Response{
 		Data: gin.H{"message": "Logged in successfully"},
 	})
@@ -210,6 +217,24 @@ func (h *AuthHandler) Me(c *gin.Context) {
 
 	transformedUser := resources.TransformUser(*user, s3.GlobalS3Client)
 
+	if transformedUser.Profile != nil {
+		stats, err := h.libraryService.GetStatsForUser(user.ID)
+		if err != nil {
+			RespondWithError(c, http.StatusInternalServerError, "Failed to fetch user library stats: "+err.Error())
+			return
+		}
+
+		transformedUser.Profile.LibraryStats = resources.TransformLibraryStats(stats)
+	}
+
+	multipliers, err := h.multiplierService.GetMultipliers(user.ID)
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to fetch user multipliers: "+err.Error())
+		return
+	}
+
+	transformedUser.Multipliers = resources.TransformMultipliers(multipliers)
+
 	c.JSON(http.StatusOK, resources.Response{
 		Data: transformedUser,
 	})
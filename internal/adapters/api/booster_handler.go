@@ -0,0 +1,101 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/usecases"
+	"gcstatus/internal/utils"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BoosterHandler struct {
+	multiplierService   *usecases.MultiplierService
+	userService         *usecases.UserService
+	notificationService *usecases.NotificationService
+}
+
+func NewBoosterHandler(
+	multiplierService *usecases.MultiplierService,
+	userService *usecases.UserService,
+	notificationService *usecases.NotificationService,
+) *BoosterHandler {
+	return &BoosterHandler{
+		multiplierService:   multiplierService,
+		userService:         userService,
+		notificationService: notificationService,
+	}
+}
+
+func (h *BoosterHandler) GetAll(c *gin.Context) {
+	boosters, err := h.multiplierService.GetPurchasableBoosters()
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to fetch boosters: "+err.Error())
+		return
+	}
+
+	transformedBoosters := make([]resources.BoosterResource, 0, len(boosters))
+	for _, booster := range boosters {
+		transformedBoosters = append(transformedBoosters, resources.TransformBooster(booster))
+	}
+
+	c.JSON(http.StatusOK, resources.Response{
+		Data: transformedBoosters,
+	})
+}
+
+func (h *BoosterHandler) Buy(c *gin.Context) {
+	boosterID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		RespondWithError(c, http.StatusBadRequest, "Invalid booster ID: "+err.Error())
+		return
+	}
+
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	userBooster, transaction, err := h.multiplierService.BuyBooster(user.ID, uint(boosterID))
+	if err != nil {
+		if httpErr, ok := err.(*self_errors.HttpError); ok {
+			RespondWithError(c, httpErr.Code, httpErr.Error())
+			return
+		}
+
+		RespondWithError(c, http.StatusInternalServerError, "Failed to purchase the booster: "+err.Error())
+		return
+	}
+
+	notificationContent := &domain.NotificationData{
+		Title:     fmt.Sprintf("You bought the booster %s by %d coins!", userBooster.Booster.Name, transaction.Amount),
+		ActionUrl: "/profile/?section=transactions",
+		Icon:      "CiCoinInsert",
+	}
+
+	dataJson, err := json.Marshal(notificationContent)
+	if err != nil {
+		log.Printf("Failed to marshal notification content: %+v", err)
+	}
+
+	notification := &domain.Notification{
+		Type:   "NewBoosterPurchase",
+		Data:   string(dataJson),
+		UserID: user.ID,
+	}
+
+	if err := h.notificationService.CreateNotification(notification); err != nil {
+		log.Printf("Failed to save the booster purchase notification: %+v", err)
+	}
+
+	c.JSON(http.StatusOK, resources.Response{
+		Data: resources.TransformUserBooster(userBooster),
+	})
+}
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/usecases"
+	"gcstatus/internal/utils"
+	"gcstatus/pkg/sqs"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CheckInHandler struct {
+	checkInService      *usecases.CheckInService
+	userService         *usecases.UserService
+	notificationService *usecases.NotificationService
+}
+
+func NewCheckInHandler(
+	checkInService *usecases.CheckInService,
+	userService *usecases.UserService,
+	notificationService *usecases.NotificationService,
+) *CheckInHandler {
+	return &CheckInHandler{
+		checkInService:      checkInService,
+		userService:         userService,
+		notificationService: notificationService,
+	}
+}
+
+func (h *CheckInHandler) GetStatus(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	streak, recent, checkedInToday, err := h.checkInService.GetStatus(user, time.Now())
+	if err != nil {
+		respondWithCheckInError(c, err, "Failed to fetch the check-in streak: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformCheckInStatus(streak, recent, checkedInToday, h.checkInService.FreezeCost()),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CheckInHandler) CheckIn(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	result, err := h.checkInService.CheckIn(user, time.Now())
+	if err != nil {
+		respondWithCheckInError(c, err, "Failed to check in: ")
+		return
+	}
+
+	// Check-ins are tracked at noon UTC of the day checked in, so the streak
+	// requirements count the days of the user's timezone.
+	for _, day := range append(result.FrozenDays, result.CheckIn.Day) {
+		enqueueTrackActionProgress(c, domain.UserAction{
+			UserID:     user.ID,
+			Key:        domain.CheckInRequirementKey,
+			OccurredAt: day.Add(12 * time.Hour),
+		})
+	}
+
+	enqueueCheckInReward(c, result)
+
+	response := resources.Response{
+		Data: resources.TransformCheckInResult(result),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CheckInHandler) BuyFreeze(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	streak, transaction, err := h.checkInService.BuyFreeze(user.ID)
+	if err != nil {
+		respondWithCheckInError(c, err, "Failed to purchase the streak freeze: ")
+		return
+	}
+
+	notificationContent := &domain.NotificationData{
+		Title:     fmt.Sprintf("You bought a streak freeze by %d coins!", transaction.Amount),
+		ActionUrl: "/profile/?section=transactions",
+		Icon:      "CiCoinInsert",
+	}
+
+	dataJson, err := json.Marshal(notificationContent)
+	if err != nil {
+		log.Printf("Failed to marshal notification content: %+v", err)
+	}
+
+	notification := &domain.Notification{
+		Type:   "NewStreakFreezePurchase",
+		Data:   string(dataJson),
+		UserID: user.ID,
+	}
+
+	if err := h.notificationService.CreateNotification(notification); err != nil {
+		log.Printf("Failed to save the streak freeze purchase notification: %+v", err)
+	}
+
+	response := resources.Response{
+		Data: resources.TransformCheckInStreak(streak),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// enqueueCheckInReward hands the rewards of the check-in over to the queue,
+// which grants them once and notifies the user.
+func enqueueCheckInReward(c *gin.Context, result domain.CheckInResult) {
+	rewards := result.RewardsToGrant()
+	if len(rewards) == 0 {
+		return
+	}
+
+	checkInRewardMessage := map[string]any{
+		"type": "CheckInReward",
+		"body": map[string]any{
+			"user_id": result.CheckIn.UserID,
+			"streak":  result.CheckIn.Streak,
+			"key":     result.RewardGrantKey(),
+			"rewards": rewards,
+		},
+	}
+
+	messageBody, err := json.Marshal(checkInRewardMessage)
+	if err != nil {
+		log.Printf("failed to serialize check-in reward message to JSON: %+v", err)
+		return
+	}
+
+	if err := sqs.GlobalSQSClient.SendMessage(c.Request.Context(), sqs.GetAwsQueue(), string(messageBody)); err != nil {
+		log.Printf("failed to enqueue check-in reward message to SQS: %+v", err)
+	}
+}
+
+func respondWithCheckInError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
@@ -61,6 +61,15 @@ func (h *CommentHandler) Create(c *gin.Context) {
 		return
 	}
 
+	if comment.CommentableType == domain.ActionTargetGames {
+		enqueueTrackActionProgress(c, domain.UserAction{
+			UserID:     user.ID,
+			Key:        domain.CommentGameRequirementKey,
+			TargetType: &comment.CommentableType,
+			TargetID:   &comment.CommentableID,
+		})
+	}
+
 	transformedComment := resources.TransformCommentable(*comment, s3.GlobalS3Client, user.ID)
 
 	response := resources.Response{
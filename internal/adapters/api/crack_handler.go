@@ -0,0 +1,65 @@
+package api
+
+import (
+	"gcstatus/internal/resources"
+	"gcstatus/internal/usecases"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CrackHandler struct {
+	crackService *usecases.CrackService
+}
+
+func NewCrackHandler(crackService *usecases.CrackService) *CrackHandler {
+	return &CrackHandler{
+		crackService: crackService,
+	}
+}
+
+func (h *CrackHandler) GetHistory(c *gin.Context) {
+	changes, err := h.crackService.GetHistoryByGameSlug(c.Param("slug"))
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to fetch crack history.")
+		log.Printf("failed to fetch crack history: %+v", err)
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformCrackStatusChanges(changes),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CrackHandler) ProtectionStats(c *gin.Context) {
+	stats, err := h.crackService.StatsByProtection()
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to fetch protection stats.")
+		log.Printf("failed to fetch protection crack stats: %+v", err)
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformCrackStatsList(stats),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CrackHandler) CrackerStats(c *gin.Context) {
+	stats, err := h.crackService.StatsByCracker()
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to fetch cracker stats.")
+		log.Printf("failed to fetch cracker crack stats: %+v", err)
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformCrackStatsList(stats),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,196 @@
+package api
+
+import (
+	"crypto/sha1"
+	"fmt"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/usecases"
+	"gcstatus/internal/utils"
+	"gcstatus/pkg/feeds"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FeedHandler struct {
+	feedService *usecases.FeedService
+	userService *usecases.UserService
+}
+
+func NewFeedHandler(
+	feedService *usecases.FeedService,
+	userService *usecases.UserService,
+) *FeedHandler {
+	return &FeedHandler{
+		feedService: feedService,
+		userService: userService,
+	}
+}
+
+func (h *FeedHandler) ReleasesCalendar(c *gin.Context) {
+	games, err := h.feedService.UpcomingReleases(c.Query("genre"), c.Query("platform"))
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to build releases calendar.")
+		log.Printf("failed to fetch upcoming releases: %+v", err)
+		return
+	}
+
+	respondWithCalendar(c, "GCStatus releases", resources.TransformReleaseEvents(games))
+}
+
+func (h *FeedHandler) FollowedReleasesCalendar(c *gin.Context) {
+	games, err := h.feedService.FollowedReleases(c.Param("token"))
+	if err != nil {
+		if httpErr, ok := err.(*self_errors.HttpError); ok {
+			RespondWithError(c, httpErr.Code, httpErr.Error())
+		} else {
+			RespondWithError(c, http.StatusInternalServerError, "Failed to build releases calendar.")
+			log.Printf("failed to fetch followed releases: %+v", err)
+		}
+		return
+	}
+
+	respondWithCalendar(c, "GCStatus followed releases", resources.TransformReleaseEvents(games))
+}
+
+func (h *FeedHandler) GamesFeed(c *gin.Context) {
+	games, err := h.feedService.LatestGames()
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to build games feed.")
+		log.Printf("failed to fetch latest games: %+v", err)
+		return
+	}
+
+	respondWithSyndication(c, feeds.Feed{
+		Title:       "GCStatus new games",
+		Link:        feeds.SiteURL + "/feeds/games",
+		Description: "Games recently added to GCStatus.",
+		Items:       resources.TransformGameFeedItems(games),
+	})
+}
+
+func (h *FeedHandler) DLCsFeed(c *gin.Context) {
+	dlcs, err := h.feedService.LatestDLCs()
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to build DLCs feed.")
+		log.Printf("failed to fetch latest dlcs: %+v", err)
+		return
+	}
+
+	respondWithSyndication(c, feeds.Feed{
+		Title:       "GCStatus new DLCs",
+		Link:        feeds.SiteURL + "/feeds/dlcs",
+		Description: "DLCs recently added to GCStatus.",
+		Items:       resources.TransformDLCFeedItems(dlcs),
+	})
+}
+
+func (h *FeedHandler) CracksFeed(c *gin.Context) {
+	changes, err := h.feedService.LatestCrackChanges()
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to build cracks feed.")
+		log.Printf("failed to fetch latest crack changes: %+v", err)
+		return
+	}
+
+	respondWithSyndication(c, feeds.Feed{
+		Title:       "GCStatus crack status changes",
+		Link:        feeds.SiteURL + "/feeds/cracks",
+		Description: "Latest crack status changes on GCStatus.",
+		Items:       resources.TransformCrackChangeFeedItems(changes),
+	})
+}
+
+func (h *FeedHandler) GetToken(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	feedToken, err := h.feedService.GetOrCreateToken(user.ID)
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to get your feed token: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, resources.Response{Data: followedCalendarURL(feedToken.Token)})
+}
+
+func (h *FeedHandler) RotateToken(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	feedToken, err := h.feedService.RotateToken(user.ID)
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to rotate your feed token: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, resources.Response{Data: followedCalendarURL(feedToken.Token)})
+}
+
+func followedCalendarURL(token string) gin.H {
+	return gin.H{
+		"token": token,
+		"url":   fmt.Sprintf("%s/feeds/users/%s/releases.ics", feeds.SiteURL, token),
+	}
+}
+
+func respondWithCalendar(c *gin.Context, name string, events []feeds.Event) {
+	var lastModified time.Time
+	for _, event := range events {
+		if event.Stamp.After(lastModified) {
+			lastModified = event.Stamp
+		}
+	}
+
+	respondWithFeed(c, "text/calendar; charset=utf-8", feeds.RenderCalendar(name, events), lastModified)
+}
+
+// respondWithSyndication renders the feed as Atom when asked through the
+// format query, falling back to RSS.
+func respondWithSyndication(c *gin.Context, feed feeds.Feed) {
+	for _, item := range feed.Items {
+		if item.Updated.After(feed.Updated) {
+			feed.Updated = item.Updated
+		}
+	}
+
+	render, contentType := feeds.RenderRSS, "application/rss+xml; charset=utf-8"
+	if c.Query("format") == "atom" {
+		render, contentType = feeds.RenderAtom, "application/atom+xml; charset=utf-8"
+	}
+
+	body, err := render(feed)
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to render feed.")
+		log.Printf("failed to render feed: %+v", err)
+		return
+	}
+
+	respondWithFeed(c, contentType, body, feed.Updated)
+}
+
+func respondWithFeed(c *gin.Context, contentType string, body string, lastModified time.Time) {
+	etag := fmt.Sprintf("\"%x\"", sha1.Sum([]byte(body)))
+
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "public, max-age=300")
+	if !lastModified.IsZero() {
+		c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if utils.IsNotModified(c.Request, etag, lastModified) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, []byte(body))
+}
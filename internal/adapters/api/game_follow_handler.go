@@ -0,0 +1,119 @@
+package api
+
+import (
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/usecases"
+	"gcstatus/internal/utils"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GameFollowHandler struct {
+	gameFollowService *usecases.GameFollowService
+	userService       *usecases.UserService
+}
+
+func NewGameFollowHandler(
+	gameFollowService *usecases.GameFollowService,
+	userService *usecases.UserService,
+) *GameFollowHandler {
+	return &GameFollowHandler{
+		gameFollowService: gameFollowService,
+		userService:       userService,
+	}
+}
+
+func (h *GameFollowHandler) GetAllForUser(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	follows, err := h.gameFollowService.GetAllForUser(user.ID)
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to fetch your followed games: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformGameFollows(follows),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *GameFollowHandler) Upsert(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	var request ports.UpsertGameFollowRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data")
+		return
+	}
+
+	follow, err := h.gameFollowService.Upsert(user.ID, request)
+	if err != nil {
+		if httpErr, ok := err.(*self_errors.HttpError); ok {
+			RespondWithError(c, httpErr.Code, httpErr.Error())
+		} else {
+			RespondWithError(c, http.StatusInternalServerError, "Failed to follow game.")
+			log.Printf("failed to save user game follow: %+v", err)
+		}
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformGameFollow(*follow),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *GameFollowHandler) Delete(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	followID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		RespondWithError(c, http.StatusBadRequest, "Invalid follow ID: "+err.Error())
+		return
+	}
+
+	if err := h.gameFollowService.Delete(uint(followID), user.ID); err != nil {
+		if httpErr, ok := err.(*self_errors.HttpError); ok {
+			RespondWithError(c, httpErr.Code, httpErr.Error())
+		} else {
+			RespondWithError(c, http.StatusInternalServerError, "Failed to unfollow game: "+err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The game was successfully unfollowed!"})
+}
+
+func (h *GameFollowHandler) Unsubscribe(c *gin.Context) {
+	if err := h.gameFollowService.Unsubscribe(c.Param("token"), c.Query("event")); err != nil {
+		if httpErr, ok := err.(*self_errors.HttpError); ok {
+			RespondWithError(c, httpErr.Code, httpErr.Error())
+		} else {
+			RespondWithError(c, http.StatusInternalServerError, "Failed to unsubscribe: "+err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "You were successfully unsubscribed!"})
+}
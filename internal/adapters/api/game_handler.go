@@ -2,6 +2,7 @@ package api
 
 import (
 	"gcstatus/internal/errors"
+	"gcstatus/internal/ports"
 	"gcstatus/internal/resources"
 	"gcstatus/internal/usecases"
 	"gcstatus/internal/utils"
@@ -29,14 +30,14 @@ func NewGameHandler(
 
 func (h *GameHandler) FindBySlug(c *gin.Context) {
 	slug := c.Param("slug")
-	authUserID := utils.GetAuthenticatedUserID(c, h.userService.GetUserByID)
+	authUser := utils.GetAuthenticatedUser(c, h.userService.GetUserByID)
 
 	var userID uint
-	if authUserID != nil {
-		userID = *authUserID
+	if authUser != nil {
+		userID = authUser.ID
 	}
 
-	game, err := h.gameService.FindBySlug(slug, userID)
+	game, err := h.gameService.FindBySlug(slug, userID, resolveRegion(c, authUser))
 	if err != nil {
 		RespondWithError(c, http.StatusInternalServerError, "Failed to fetch game: "+err.Error())
 		return
@@ -84,7 +85,17 @@ func (h *GameHandler) CalendarGames(c *gin.Context) {
 		userID = *authUserID
 	}
 
-	games, err := h.gameService.CalendarGames()
+	var followedBy uint
+	if c.Query("followed") == "true" {
+		if userID == 0 {
+			RespondWithError(c, http.StatusUnauthorized, "Unauthorized: you must be logged in to see your followed games.")
+			return
+		}
+
+		followedBy = userID
+	}
+
+	games, err := h.gameService.CalendarGames(followedBy)
 	if err != nil {
 		RespondWithError(c, http.StatusInternalServerError, "Failed to find calendar games.")
 		log.Printf("failed to find calendar games: %+v", err)
@@ -101,11 +112,11 @@ func (h *GameHandler) CalendarGames(c *gin.Context) {
 }
 
 func (h *GameHandler) Search(c *gin.Context) {
-	authUserID := utils.GetAuthenticatedUserID(c, h.userService.GetUserByID)
+	authUser := utils.GetAuthenticatedUser(c, h.userService.GetUserByID)
 
 	var userID uint
-	if authUserID != nil {
-		userID = *authUserID
+	if authUser != nil {
+		userID = authUser.ID
 	}
 	searchQuery := c.Query("search")
 	if searchQuery == "" {
@@ -113,7 +124,13 @@ func (h *GameHandler) Search(c *gin.Context) {
 		return
 	}
 
-	games, err := h.gameService.Search(searchQuery)
+	filters := ports.GameSearchFilters{
+		Region:   resolveRegion(c, authUser),
+		MinPrice: parsePriceQuery(c, "min_price"),
+		MaxPrice: parsePriceQuery(c, "max_price"),
+	}
+
+	games, err := h.gameService.Search(searchQuery, filters)
 	if err != nil {
 		RespondWithError(c, http.StatusInternalServerError, "Failed to search games")
 		log.Printf("failed to search games: %+v", err)
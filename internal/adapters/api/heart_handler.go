@@ -1,6 +1,7 @@
 package api
 
 import (
+	"gcstatus/internal/domain"
 	"gcstatus/internal/usecases"
 	"gcstatus/internal/utils"
 	"log"
@@ -41,9 +42,19 @@ func (h *HeartHandler) ToggleHeartable(c *gin.Context) {
 		return
 	}
 
-	if err := h.heartService.ToggleHeartable(request.HeartableID, request.HeartableType, user.ID); err != nil {
+	hearted, err := h.heartService.ToggleHeartable(request.HeartableID, request.HeartableType, user.ID)
+	if err != nil {
 		RespondWithError(c, http.StatusInternalServerError, "Failed to save heart.")
 		log.Printf("failed to save user heart: %+v", err)
 		return
 	}
+
+	if hearted && request.HeartableType == domain.ActionTargetGames {
+		enqueueTrackActionProgress(c, domain.UserAction{
+			UserID:     user.ID,
+			Key:        domain.HeartGameRequirementKey,
+			TargetType: &request.HeartableType,
+			TargetID:   &request.HeartableID,
+		})
+	}
 }
@@ -0,0 +1,112 @@
+package api
+
+import (
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/usecases"
+	"gcstatus/internal/utils"
+	"gcstatus/pkg/s3"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LeaderboardHandler struct {
+	leaderboardService *usecases.LeaderboardService
+	userService        *usecases.UserService
+}
+
+func NewLeaderboardHandler(
+	leaderboardService *usecases.LeaderboardService,
+	userService *usecases.UserService,
+) *LeaderboardHandler {
+	return &LeaderboardHandler{
+		leaderboardService: leaderboardService,
+		userService:        userService,
+	}
+}
+
+func (h *LeaderboardHandler) GetBoard(c *gin.Context) {
+	var query ports.LeaderboardQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		RespondWithError(c, http.StatusBadRequest, "Invalid query: "+err.Error())
+		return
+	}
+
+	entries, page, total, err := h.leaderboardService.GetPage(c.Param("board"), query, time.Now())
+	if err != nil {
+		respondWithLeaderboardError(c, err)
+		return
+	}
+
+	response := resources.PaginatedResponse{
+		Data: resources.TransformLeaderboardEntries(entries, s3.GlobalS3Client),
+		Meta: resources.NewPaginationMeta(page.Page, page.PerPage, total),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *LeaderboardHandler) GetMyRank(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	var query ports.LeaderboardQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		RespondWithError(c, http.StatusBadRequest, "Invalid query: "+err.Error())
+		return
+	}
+
+	entry, err := h.leaderboardService.GetRank(c.Param("board"), query, user.ID, time.Now())
+	if err != nil {
+		respondWithLeaderboardError(c, err)
+		return
+	}
+
+	response := resources.Response{}
+	if entry != nil {
+		response.Data = resources.TransformLeaderboardEntry(*entry, s3.GlobalS3Client)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *LeaderboardHandler) GetAroundMe(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	var query ports.LeaderboardQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		RespondWithError(c, http.StatusBadRequest, "Invalid query: "+err.Error())
+		return
+	}
+
+	entries, err := h.leaderboardService.GetAround(c.Param("board"), query, user.ID, time.Now())
+	if err != nil {
+		respondWithLeaderboardError(c, err)
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformLeaderboardEntries(entries, s3.GlobalS3Client),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func respondWithLeaderboardError(c *gin.Context, err error) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	RespondWithError(c, http.StatusInternalServerError, "Failed to fetch the leaderboard: "+err.Error())
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"errors"
+	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/usecases"
+	"gcstatus/internal/utils"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type LibraryHandler struct {
+	libraryService *usecases.LibraryService
+	userService    *usecases.UserService
+}
+
+func NewLibraryHandler(
+	libraryService *usecases.LibraryService,
+	userService *usecases.UserService,
+) *LibraryHandler {
+	return &LibraryHandler{
+		libraryService: libraryService,
+		userService:    userService,
+	}
+}
+
+func (h *LibraryHandler) GetAllForUser(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	entries, err := h.libraryService.GetAllForUser(user.ID, c.Query("status"))
+	if err != nil {
+		if httpErr, ok := err.(*self_errors.HttpError); ok {
+			RespondWithError(c, httpErr.Code, httpErr.Error())
+		} else {
+			RespondWithError(c, http.StatusInternalServerError, "Failed to fetch your library: "+err.Error())
+		}
+		return
+	}
+
+	stats, err := h.libraryService.GetStatsForUser(user.ID)
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to fetch your library stats: "+err.Error())
+		return
+	}
+
+	response := resources.MapResponse{
+		Data: map[string]any{
+			"entries": resources.TransformLibraryables(entries),
+			"stats":   resources.TransformLibraryStats(stats),
+		},
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *LibraryHandler) GetPublicForUser(c *gin.Context) {
+	nickname := c.Param("nickname")
+
+	user, err := h.userService.FindUserByNickname(nickname)
+	if err != nil || user.IsBlocked(time.Now()) {
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			RespondWithError(c, http.StatusInternalServerError, "Failed to fetch user: "+err.Error())
+			return
+		}
+
+		RespondWithError(c, http.StatusNotFound, "User not found.")
+		return
+	}
+
+	entries, err := h.libraryService.GetPublicForUser(user.ID, c.Query("status"))
+	if err != nil {
+		if httpErr, ok := err.(*self_errors.HttpError); ok {
+			RespondWithError(c, httpErr.Code, httpErr.Error())
+		} else {
+			RespondWithError(c, http.StatusInternalServerError, "Failed to fetch user library: "+err.Error())
+		}
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformLibraryables(entries),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *LibraryHandler) Upsert(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	var request ports.UpsertLibraryRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data")
+		return
+	}
+
+	entry, actionKeys, err := h.libraryService.Upsert(user.ID, request)
+	if err != nil {
+		if httpErr, ok := err.(*self_errors.HttpError); ok {
+			RespondWithError(c, httpErr.Code, httpErr.Error())
+		} else {
+			RespondWithError(c, http.StatusInternalServerError, "Failed to save library entry.")
+			log.Printf("failed to save user library entry: %+v", err)
+		}
+		return
+	}
+
+	for _, actionKey := range actionKeys {
+		enqueueTrackActionProgress(c, domain.UserAction{
+			UserID:     user.ID,
+			Key:        actionKey,
+			TargetType: &entry.LibraryableType,
+			TargetID:   &entry.LibraryableID,
+		})
+	}
+
+	response := resources.Response{
+		Data: resources.TransformLibraryable(*entry),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *LibraryHandler) Delete(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	entryID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		RespondWithError(c, http.StatusBadRequest, "Invalid library entry ID: "+err.Error())
+		return
+	}
+
+	if err := h.libraryService.Delete(uint(entryID), user.ID); err != nil {
+		if httpErr, ok := err.(*self_errors.HttpError); ok {
+			RespondWithError(c, httpErr.Code, httpErr.Error())
+		} else {
+			RespondWithError(c, http.StatusInternalServerError, "Failed to remove library entry: "+err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The entry was successfully removed from your library!"})
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	self_errors "gcstatus/internal/errors"
 	"gcstatus/internal/resources"
 	"gcstatus/internal/usecases"
 	"gcstatus/internal/utils"
@@ -77,6 +78,16 @@ func (h *MissionHandler) CompleteMission(c *gin.Context) {
 		return
 	}
 
+	if err := h.missionService.CompleteMission(user.ID, uint(missionID)); err != nil {
+		if httpErr, ok := err.(*self_errors.HttpError); ok {
+			RespondWithError(c, httpErr.Code, httpErr.Error())
+			return
+		}
+
+		RespondWithError(c, http.StatusInternalServerError, "Failed to complete the mission: "+err.Error())
+		return
+	}
+
 	trackProgressMessage := map[string]any{
 		"type": "CompleteMission",
 		"body": map[string]any{
@@ -94,10 +105,5 @@ func (h *MissionHandler) CompleteMission(c *gin.Context) {
 		log.Fatalf("failed to enqueue complete mission message to SQS: %+v", err)
 	}
 
-	if err := h.missionService.CompleteMission(user.ID, uint(missionID)); err != nil {
-		RespondWithError(c, http.StatusInternalServerError, "Failed to complete the mission: "+err.Error())
-		return
-	}
-
 	c.JSON(http.StatusOK, gin.H{"message": "You have successfully completed the mission!"})
 }
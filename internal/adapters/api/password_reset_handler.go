@@ -7,6 +7,7 @@ import (
 	"gcstatus/internal/utils"
 	"gcstatus/pkg/cache"
 	"gcstatus/pkg/ses"
+	"gcstatus/pkg/worker"
 	"net/http"
 	"strings"
 	"time"
@@ -69,7 +70,7 @@ func (h *PasswordResetHandler) RequestPasswordReset(c *gin.Context) {
 		return
 	}
 
-	if err := ses.SendPasswordResetEmail(requestPasswordResetData.Email, token, ses.Send); err != nil {
+	if err := ses.SendPasswordResetEmail(requestPasswordResetData.Email, token, worker.SendEmail); err != nil {
 		RespondWithError(c, http.StatusInternalServerError, "We could not send you a reset email. Please, try again or contact the support.")
 		return
 	}
@@ -134,7 +135,7 @@ func (h *PasswordResetHandler) ResetUserPassword(c *gin.Context) {
 		return
 	}
 
-	if err := ses.SendPasswordResetConfirmationEmail(user.Email, user.Name, ses.Send); err != nil {
+	if err := ses.SendPasswordResetConfirmationEmail(user.Email, user.Name, worker.SendEmail); err != nil {
 		RespondWithError(c, http.StatusInternalServerError, "Unable to send the email reset confirmation.")
 		return
 	}
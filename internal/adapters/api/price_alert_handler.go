@@ -0,0 +1,106 @@
+package api
+
+import (
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/usecases"
+	"gcstatus/internal/utils"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PriceAlertHandler struct {
+	priceAlertService *usecases.PriceAlertService
+	userService       *usecases.UserService
+}
+
+func NewPriceAlertHandler(
+	priceAlertService *usecases.PriceAlertService,
+	userService *usecases.UserService,
+) *PriceAlertHandler {
+	return &PriceAlertHandler{
+		priceAlertService: priceAlertService,
+		userService:       userService,
+	}
+}
+
+func (h *PriceAlertHandler) GetAllForUser(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	alerts, err := h.priceAlertService.GetAllForUser(user.ID)
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to fetch your price alerts: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformPriceAlerts(alerts),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *PriceAlertHandler) Upsert(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	var request ports.UpsertPriceAlertRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data")
+		return
+	}
+
+	alert, err := h.priceAlertService.Upsert(user.ID, request)
+	if err != nil {
+		if httpErr, ok := err.(*self_errors.HttpError); ok {
+			RespondWithError(c, httpErr.Code, httpErr.Error())
+		} else {
+			RespondWithError(c, http.StatusInternalServerError, "Failed to save price alert.")
+			log.Printf("failed to save user price alert: %+v", err)
+		}
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformPriceAlert(*alert),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *PriceAlertHandler) Delete(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	alertID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		RespondWithError(c, http.StatusBadRequest, "Invalid price alert ID: "+err.Error())
+		return
+	}
+
+	if err := h.priceAlertService.Delete(uint(alertID), user.ID); err != nil {
+		if httpErr, ok := err.(*self_errors.HttpError); ok {
+			RespondWithError(c, httpErr.Code, httpErr.Error())
+		} else {
+			RespondWithError(c, http.StatusInternalServerError, "Failed to remove price alert: "+err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "The price alert was successfully removed!"})
+}
@@ -13,6 +13,7 @@ import (
 	"log"
 	"mime/multipart"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -135,6 +136,35 @@ func (h *ProfileHandler) UpdateSocials(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Your profile socials was successfully updated!"})
 }
 
+func (h *ProfileHandler) UpdateRegion(c *gin.Context) {
+	var request ports.UpdateRegionRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	region := strings.ToLower(strings.TrimSpace(request.Region))
+	if !slices.Contains(utils.SteamRegions(), region) {
+		RespondWithError(c, http.StatusUnprocessableEntity, "The given region is not supported.")
+		return
+	}
+
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	if err := h.profileService.UpdateRegion(user.Profile.ID, region); err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Could not update region: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Your store region was successfully updated!"})
+}
+
 func (h *ProfileHandler) createTrackProfilePictureSQS(c *gin.Context, user *domain.User) {
 	trackProgressMessage := map[string]any{
 		"type": "TrackProgressProfilePicture",
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/usecases"
+	"gcstatus/internal/utils"
+	"gcstatus/pkg/sqs"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type QuizHandler struct {
+	quizService *usecases.QuizService
+	userService *usecases.UserService
+}
+
+func NewQuizHandler(
+	quizService *usecases.QuizService,
+	userService *usecases.UserService,
+) *QuizHandler {
+	return &QuizHandler{
+		quizService: quizService,
+		userService: userService,
+	}
+}
+
+func (h *QuizHandler) GetOpen(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	var gameID *uint
+	if param := c.Query("game_id"); param != "" {
+		id, err := strconv.ParseUint(param, 10, 32)
+		if err != nil {
+			RespondWithError(c, http.StatusBadRequest, "Invalid game ID: "+err.Error())
+			return
+		}
+
+		value := uint(id)
+		gameID = &value
+	}
+
+	now := time.Now()
+	quizzes, attempts, err := h.quizService.GetOpen(user.ID, gameID, now)
+	if err != nil {
+		RespondWithError(c, http.StatusInternalServerError, "Failed to fetch quizzes: "+err.Error())
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformQuizzes(quizzes, attempts, now),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *QuizHandler) FindByID(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	id, ok := parseQuizID(c)
+	if !ok {
+		return
+	}
+
+	quiz, attempt, err := h.quizService.Find(user.ID, id)
+	if err != nil {
+		respondWithQuizError(c, err, "Failed to fetch quiz: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformQuiz(quiz, attempt, time.Now()),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *QuizHandler) Answer(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	id, ok := parseQuizID(c)
+	if !ok {
+		return
+	}
+
+	var request ports.AnswerQuizRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorMessages := utils.FormatValidationError(err)
+		RespondWithError(c, http.StatusUnprocessableEntity, "Invalid request data: "+strings.Join(errorMessages, " "))
+		return
+	}
+
+	now := time.Now()
+	quiz, attempt, err := h.quizService.Answer(user.ID, id, request, now)
+	if err != nil {
+		respondWithQuizError(c, err, "Failed to answer quiz: ")
+		return
+	}
+
+	enqueueQuizReward(c, quiz, attempt)
+
+	response := resources.Response{
+		Data: resources.TransformQuiz(quiz, &attempt, now),
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *QuizHandler) GetResults(c *gin.Context) {
+	user, err := utils.Auth(c, h.userService.GetUserByID)
+	if err != nil {
+		RespondWithError(c, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		return
+	}
+
+	id, ok := parseQuizID(c)
+	if !ok {
+		return
+	}
+
+	results, err := h.quizService.GetResults(user.ID, id, time.Now())
+	if err != nil {
+		respondWithQuizError(c, err, "Failed to fetch quiz results: ")
+		return
+	}
+
+	response := resources.Response{
+		Data: resources.TransformQuizResults(results),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// enqueueQuizReward hands the rewards of the tiers the answers reached over
+// to the queue, which grants them once and notifies the user.
+func enqueueQuizReward(c *gin.Context, quiz domain.Quiz, attempt domain.QuizAttempt) {
+	rewards := quiz.RewardsToGrant(attempt)
+	if len(rewards) == 0 {
+		return
+	}
+
+	quizRewardMessage := map[string]any{
+		"type": "QuizReward",
+		"body": map[string]any{
+			"user_id": attempt.UserID,
+			"quiz_id": quiz.ID,
+			"kind":    quiz.Kind,
+			"title":   quiz.Title,
+			"score":   attempt.Score,
+			"key":     quiz.RewardGrantKey(),
+			"rewards": rewards,
+		},
+	}
+
+	messageBody, err := json.Marshal(quizRewardMessage)
+	if err != nil {
+		log.Printf("failed to serialize quiz reward message to JSON: %+v", err)
+		return
+	}
+
+	if err := sqs.GlobalSQSClient.SendMessage(c.Request.Context(), sqs.GetAwsQueue(), string(messageBody)); err != nil {
+		log.Printf("failed to enqueue quiz reward message to SQS: %+v", err)
+	}
+}
+
+func parseQuizID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		RespondWithError(c, http.StatusBadRequest, "Invalid quiz ID: "+err.Error())
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func respondWithQuizError(c *gin.Context, err error, message string) {
+	if httpErr, ok := err.(*self_errors.HttpError); ok {
+		RespondWithError(c, httpErr.Code, httpErr.Error())
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		RespondWithError(c, http.StatusNotFound, "The quiz could not be found.")
+		return
+	}
+
+	RespondWithError(c, http.StatusInternalServerError, message+err.Error())
+}
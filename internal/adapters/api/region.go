@@ -0,0 +1,35 @@
+package api
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// resolveRegion picks the store region used to render prices: the "region"
+// query parameter first, then the user preference, then the default region.
+func resolveRegion(c *gin.Context, user *domain.User) string {
+	candidates := []string{c.Query("region")}
+	if user != nil {
+		candidates = append(candidates, user.Profile.Region)
+	}
+
+	return utils.ResolveRegion(utils.SteamRegions(), candidates...)
+}
+
+func parsePriceQuery(c *gin.Context, key string) *uint {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil
+	}
+
+	value, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	price := uint(value)
+	return &price
+}
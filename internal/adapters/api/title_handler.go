@@ -137,7 +137,7 @@ func (h *TitleHandler) BuyTitle(c *gin.Context) {
 
 	if err = h.taskService.AwardTitleToUser(user.ID, title.ID); err != nil {
 		RespondWithError(c, http.StatusInternalServerError, "Failed to process the title to user. "+err.Error())
-		err := h.walletService.Add(user.ID, uint(*title.Cost))
+		err := h.walletService.Refund(user.ID, uint(*title.Cost))
 		if err != nil {
 			log.Fatalf("failed to chargeback user wallet amount: %+v", err)
 			return
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"encoding/json"
+	"gcstatus/internal/domain"
+	"gcstatus/pkg/sqs"
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func enqueueTrackActionProgress(c *gin.Context, action domain.UserAction) {
+	if action.Increment == 0 {
+		action.Increment = 1
+	}
+
+	if action.OccurredAt.IsZero() {
+		action.OccurredAt = time.Now()
+	}
+
+	trackProgressMessage := map[string]any{
+		"type": "TrackActionProgress",
+		"body": map[string]any{
+			"user_id":     action.UserID,
+			"action_key":  action.Key,
+			"increment":   action.Increment,
+			"target_type": action.TargetType,
+			"target_id":   action.TargetID,
+			"occurred_at": action.OccurredAt,
+		},
+	}
+
+	trackProgressMessageBody, err := json.Marshal(trackProgressMessage)
+	if err != nil {
+		log.Printf("failed to serialize track action progress message to JSON: %+v", err)
+		return
+	}
+
+	if err := sqs.GlobalSQSClient.SendMessage(c.Request.Context(), sqs.GetAwsQueue(), string(trackProgressMessageBody)); err != nil {
+		log.Printf("failed to enqueue track action progress message to SQS: %+v", err)
+	}
+}
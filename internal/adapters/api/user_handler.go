@@ -151,6 +151,13 @@ func (h *UserHandler) UpdateUserBasics(c *gin.Context) {
 		return
 	}
 
+	if request.Timezone != nil {
+		if _, err := time.LoadLocation(*request.Timezone); err != nil || *request.Timezone == "" {
+			RespondWithError(c, http.StatusUnprocessableEntity, "Invalid timezone.")
+			return
+		}
+	}
+
 	err = h.userService.UpdateUserBasics(user.ID, request)
 	if err != nil {
 		RespondWithError(c, http.StatusInternalServerError, "Failed to update your informations: "+err.Error())
@@ -0,0 +1,112 @@
+package db_admin
+
+import (
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+
+	"gorm.io/gorm"
+)
+
+type AdminActionPolicyRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminActionPolicyRepositoryMySQL(db *gorm.DB) ports_admin.AdminActionPolicyRepository {
+	return &AdminActionPolicyRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminActionPolicyRepositoryMySQL) GetAll() ([]domain.ActionPolicy, error) {
+	var policies []domain.ActionPolicy
+	err := h.db.Order("action_key").Find(&policies).Error
+
+	return policies, err
+}
+
+func (h *AdminActionPolicyRepositoryMySQL) FindByID(id uint) (domain.ActionPolicy, error) {
+	var policy domain.ActionPolicy
+	err := h.db.First(&policy, id).Error
+
+	return policy, err
+}
+
+func (h *AdminActionPolicyRepositoryMySQL) ExistsByActionKey(actionKey string, exceptID uint) (bool, error) {
+	var count int64
+	err := h.db.Model(&domain.ActionPolicy{}).
+		Where("action_key = ? AND id <> ?", actionKey, exceptID).
+		Count(&count).
+		Error
+
+	return count > 0, err
+}
+
+func (h *AdminActionPolicyRepositoryMySQL) Create(policy *domain.ActionPolicy) error {
+	return h.db.Create(policy).Error
+}
+
+func (h *AdminActionPolicyRepositoryMySQL) Update(policy *domain.ActionPolicy) error {
+	return h.db.Model(&domain.ActionPolicy{}).Where("id = ?", policy.ID).Updates(map[string]any{
+		"action_key":         policy.ActionKey,
+		"cooldown_seconds":   policy.CooldownSeconds,
+		"max_per_day":        policy.MaxPerDay,
+		"once_per_target":    policy.OncePerTarget,
+		"diminish_after":     policy.DiminishAfter,
+		"diminishing_factor": policy.DiminishingFactor,
+		"enabled":            policy.Enabled,
+	}).Error
+}
+
+// Delete removes the policy for good, so its action key can be taken again,
+// leaving its decisions for audit.
+func (h *AdminActionPolicyRepositoryMySQL) Delete(id uint) error {
+	result := h.db.Unscoped().Delete(&domain.ActionPolicy{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (h *AdminActionPolicyRepositoryMySQL) GetDecisions(filters ports_admin.ActionDecisionFilters, offset int, limit int) ([]domain.ActionDecision, int64, error) {
+	query := h.db.Model(&domain.ActionDecision{})
+
+	if filters.UserID != 0 {
+		query = query.Where("user_id = ?", filters.UserID)
+	}
+
+	if filters.ActionKey != "" {
+		query = query.Where("action_key = ?", filters.ActionKey)
+	}
+
+	if filters.Outcome != "" {
+		query = query.Where("outcome = ?", filters.Outcome)
+	}
+
+	if filters.From != nil {
+		query = query.Where("occurred_at >= ?", *filters.From)
+	}
+
+	if filters.To != nil {
+		query = query.Where("occurred_at < ?", filters.To.AddDate(0, 0, 1))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var decisions []domain.ActionDecision
+	err := query.Preload("User").
+		Order("id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&decisions).
+		Error
+
+	return decisions, total, err
+}
@@ -0,0 +1,72 @@
+package db_admin
+
+import (
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type AdminAuditLogRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminAuditLogRepositoryMySQL(db *gorm.DB) ports_admin.AdminAuditLogRepository {
+	return &AdminAuditLogRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminAuditLogRepositoryMySQL) Create(auditLog *domain.AuditLog) error {
+	return h.db.Omit("User").Create(auditLog).Error
+}
+
+func (h *AdminAuditLogRepositoryMySQL) GetAll(filters ports_admin.AuditLogFilters, offset int, limit int) ([]domain.AuditLog, int64, error) {
+	query := h.db.Model(&domain.AuditLog{})
+
+	if filters.UserID != 0 {
+		query = query.Where("user_id = ?", filters.UserID)
+	}
+
+	if filters.Action != "" {
+		query = query.Where("action LIKE ?", "%"+filters.Action+"%")
+	}
+
+	if filters.TargetType != "" {
+		query = query.Where("target_type = ?", filters.TargetType)
+	}
+
+	if filters.TargetID != 0 {
+		query = query.Where("target_id = ?", filters.TargetID)
+	}
+
+	if filters.From != nil {
+		query = query.Where("created_at >= ?", *filters.From)
+	}
+
+	if filters.To != nil {
+		query = query.Where("created_at < ?", filters.To.AddDate(0, 0, 1))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var auditLogs []domain.AuditLog
+	err := query.Preload("User").
+		Order("id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&auditLogs).
+		Error
+
+	return auditLogs, total, err
+}
+
+func (h *AdminAuditLogRepositoryMySQL) DeleteOlderThan(before time.Time) (int64, error) {
+	result := h.db.Unscoped().Where("created_at < ?", before).Delete(&domain.AuditLog{})
+
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,62 @@
+package db_admin
+
+import (
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+
+	"gorm.io/gorm"
+)
+
+type AdminBoosterRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminBoosterRepositoryMySQL(db *gorm.DB) ports_admin.AdminBoosterRepository {
+	return &AdminBoosterRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminBoosterRepositoryMySQL) GetAll() ([]domain.Booster, error) {
+	var boosters []domain.Booster
+	err := h.db.Order("scope").Order("multiplier").Find(&boosters).Error
+
+	return boosters, err
+}
+
+func (h *AdminBoosterRepositoryMySQL) FindByID(id uint) (domain.Booster, error) {
+	var booster domain.Booster
+	err := h.db.First(&booster, id).Error
+
+	return booster, err
+}
+
+func (h *AdminBoosterRepositoryMySQL) Create(booster *domain.Booster) error {
+	return h.db.Create(booster).Error
+}
+
+// Update changes the booster for whoever gets it from now on. The boosters
+// users are running keep the scope and multiplier they were given with.
+func (h *AdminBoosterRepositoryMySQL) Update(booster *domain.Booster) error {
+	return h.db.Model(&domain.Booster{}).Where("id = ?", booster.ID).Updates(map[string]any{
+		"name":             booster.Name,
+		"scope":            booster.Scope,
+		"multiplier":       booster.Multiplier,
+		"duration_minutes": booster.DurationMinutes,
+		"cost":             booster.Cost,
+		"purchasable":      booster.Purchasable,
+	}).Error
+}
+
+func (h *AdminBoosterRepositoryMySQL) Delete(id uint) error {
+	result := h.db.Delete(&domain.Booster{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
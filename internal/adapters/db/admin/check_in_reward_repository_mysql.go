@@ -0,0 +1,102 @@
+package db_admin
+
+import (
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AdminCheckInRewardRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminCheckInRewardRepositoryMySQL(db *gorm.DB) ports_admin.AdminCheckInRewardRepository {
+	return &AdminCheckInRewardRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminCheckInRewardRepositoryMySQL) GetAll() ([]domain.CheckInReward, error) {
+	var checkInRewards []domain.CheckInReward
+	if err := h.db.Preload("Rewards").Order("streak").Order("milestone").Find(&checkInRewards).Error; err != nil {
+		return nil, err
+	}
+
+	var rewards []*domain.Reward
+	for i := range checkInRewards {
+		for j := range checkInRewards[i].Rewards {
+			rewards = append(rewards, &checkInRewards[i].Rewards[j])
+		}
+	}
+
+	return checkInRewards, loadRewardables(h.db, rewards)
+}
+
+func (h *AdminCheckInRewardRepositoryMySQL) FindByID(id uint) (domain.CheckInReward, error) {
+	var checkInReward domain.CheckInReward
+	if err := h.db.Preload("Rewards").First(&checkInReward, id).Error; err != nil {
+		return checkInReward, err
+	}
+
+	rewards := make([]*domain.Reward, 0, len(checkInReward.Rewards))
+	for i := range checkInReward.Rewards {
+		rewards = append(rewards, &checkInReward.Rewards[i])
+	}
+
+	return checkInReward, loadRewardables(h.db, rewards)
+}
+
+func (h *AdminCheckInRewardRepositoryMySQL) ExistsByStreak(streak uint, milestone bool, exceptID uint) (bool, error) {
+	var count int64
+	err := h.db.Model(&domain.CheckInReward{}).
+		Where("streak = ? AND milestone = ? AND id <> ?", streak, milestone, exceptID).
+		Count(&count).
+		Error
+
+	return count > 0, err
+}
+
+// Create writes a new row of the reward table along with its rewards.
+func (h *AdminCheckInRewardRepositoryMySQL) Create(checkInReward *domain.CheckInReward) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		rewards := checkInReward.Rewards
+		if err := tx.Omit(clause.Associations).Create(checkInReward).Error; err != nil {
+			return err
+		}
+
+		return replaceRewards(tx, checkInReward.ID, domain.SourceableTypeCheckInRewards, rewards)
+	})
+}
+
+func (h *AdminCheckInRewardRepositoryMySQL) Update(checkInReward *domain.CheckInReward) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.CheckInReward{}).Where("id = ?", checkInReward.ID).Updates(map[string]any{
+			"streak":     checkInReward.Streak,
+			"milestone":  checkInReward.Milestone,
+			"coins":      checkInReward.Coins,
+			"experience": checkInReward.Experience,
+		}).Error; err != nil {
+			return err
+		}
+
+		return replaceRewards(tx, checkInReward.ID, domain.SourceableTypeCheckInRewards, checkInReward.Rewards)
+	})
+}
+
+// Delete removes the row of the reward table along with its rewards.
+func (h *AdminCheckInRewardRepositoryMySQL) Delete(id uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&domain.CheckInReward{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return replaceRewards(tx, id, domain.SourceableTypeCheckInRewards, nil)
+	})
+}
@@ -1,11 +1,19 @@
 package db_admin
 
 import (
+	stdErrors "errors"
+	"fmt"
 	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+const gamesMorphType = "games"
+
 type AdminGameRepositoryMySQL struct {
 	db *gorm.DB
 }
@@ -67,3 +75,535 @@ func (h *AdminGameRepositoryMySQL) FindByID(id uint) (domain.Game, error) {
 
 	return game, nil
 }
+
+// UpdateCrack stores the crack of the given game, deriving its status and the
+// days it took to be cracked from the game release date. Status transitions are
+// appended to the crack timeline and returned so callers can notify followers.
+func (h *AdminGameRepositoryMySQL) UpdateCrack(gameID uint, request ports_admin.UpdateCrackRequest) (*domain.Crack, *domain.CrackStatusChange, error) {
+	var crack *domain.Crack
+	var change *domain.CrackStatusChange
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		var game domain.Game
+		if err := tx.First(&game, gameID).Error; err != nil {
+			return err
+		}
+
+		var err error
+		crack, change, err = saveCrack(tx, game, request)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return crack, change, nil
+}
+
+func saveCrack(tx *gorm.DB, game domain.Game, request ports_admin.UpdateCrackRequest) (*domain.Crack, *domain.CrackStatusChange, error) {
+	var crack domain.Crack
+	if err := tx.Where(domain.Crack{GameID: game.ID}).FirstOrInit(&crack).Error; err != nil {
+		return nil, nil, err
+	}
+
+	previousStatus := crack.Status
+	status, daysToCrack := domain.DeriveCrackStatus(game.ReleaseDate, request.CrackedAt)
+
+	crack.Status = status
+	crack.CrackedAt = request.CrackedAt
+	crack.DaysToCrack = daysToCrack
+	crack.CrackerID = request.CrackerID
+	crack.ProtectionID = request.ProtectionID
+
+	if err := tx.Save(&crack).Error; err != nil {
+		return nil, nil, err
+	}
+
+	crack.Game = game
+
+	if previousStatus == status {
+		return &crack, nil, nil
+	}
+
+	change := &domain.CrackStatusChange{
+		Status:         status,
+		PreviousStatus: previousStatus,
+		CrackedAt:      request.CrackedAt,
+		DaysToCrack:    daysToCrack,
+		CrackID:        crack.ID,
+		GameID:         game.ID,
+		CrackerID:      request.CrackerID,
+		ProtectionID:   request.ProtectionID,
+	}
+
+	if err := tx.Create(change).Error; err != nil {
+		return nil, nil, err
+	}
+
+	return &crack, change, nil
+}
+
+func (h *AdminGameRepositoryMySQL) GetSyncLocks(gameID uint) ([]domain.GameSyncLock, error) {
+	var locks []domain.GameSyncLock
+	if err := h.db.First(&domain.Game{}, gameID).Error; err != nil {
+		return nil, err
+	}
+
+	err := h.db.Where("game_id = ?", gameID).Order("field ASC").Find(&locks).Error
+
+	return locks, err
+}
+
+// UpdateSyncLocks replaces the locked sync fields of the given game.
+func (h *AdminGameRepositoryMySQL) UpdateSyncLocks(gameID uint, fields []string) ([]domain.GameSyncLock, error) {
+	var locks []domain.GameSyncLock
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&domain.Game{}, gameID).Error; err != nil {
+			return err
+		}
+
+		unlock := tx.Unscoped().Where("game_id = ?", gameID)
+		if len(fields) > 0 {
+			unlock = unlock.Where("field NOT IN ?", fields)
+		}
+
+		if err := unlock.Delete(&domain.GameSyncLock{}).Error; err != nil {
+			return err
+		}
+
+		for _, field := range fields {
+			lock := domain.GameSyncLock{GameID: gameID, Field: field}
+			if err := tx.Where(lock).FirstOrCreate(&lock).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Where("game_id = ?", gameID).Order("field ASC").Find(&locks).Error
+	})
+
+	return locks, err
+}
+
+// Create writes a new game along with its relations.
+func (h *AdminGameRepositoryMySQL) Create(game *domain.Game, crack *ports_admin.UpdateCrackRequest) (*domain.CrackStatusChange, error) {
+	var change *domain.CrackStatusChange
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		if err := ensureAvailableSlug(tx, game.Slug, 0); err != nil {
+			return err
+		}
+
+		relations := *game
+		if err := tx.Omit(clause.Associations).Create(game).Error; err != nil {
+			return err
+		}
+
+		if err := replaceGameRelations(tx, game.ID, &relations); err != nil {
+			return err
+		}
+
+		if crack == nil {
+			return nil
+		}
+
+		var err error
+		_, change, err = saveCrack(tx, *game, *crack)
+
+		return err
+	})
+
+	return change, err
+}
+
+// Update replaces a game along with its relations. The sync fields the edit
+// changes get locked, so the next store re-sync keeps the admin values.
+func (h *AdminGameRepositoryMySQL) Update(game *domain.Game, crack *ports_admin.UpdateCrackRequest) (*domain.CrackStatusChange, error) {
+	var change *domain.CrackStatusChange
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		var current domain.Game
+		if err := tx.Preload("Categories").
+			Preload("Genres").
+			Preload("Publishers").
+			Preload("Developers").
+			Preload("Languages").
+			Preload("Requirements.RequirementType").
+			Preload("Galleries").
+			Preload("DLCs").
+			Preload("Support").
+			First(&current, game.ID).
+			Error; err != nil {
+			return err
+		}
+
+		if err := ensureAvailableSlug(tx, game.Slug, game.ID); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&domain.Game{}).Where("id = ?", game.ID).Updates(map[string]any{
+			"slug":              game.Slug,
+			"title":             game.Title,
+			"age":               game.Age,
+			"condition":         game.Condition,
+			"cover":             game.Cover,
+			"about":             game.About,
+			"description":       game.Description,
+			"short_description": game.ShortDescription,
+			"free":              game.Free,
+			"great_release":     game.GreatRelease,
+			"legal":             game.Legal,
+			"website":           game.Website,
+			"release_date":      game.ReleaseDate,
+		}).Error; err != nil {
+			return err
+		}
+
+		if err := replaceGameRelations(tx, game.ID, game); err != nil {
+			return err
+		}
+
+		for _, field := range domain.ChangedSyncFields(&current, game) {
+			lock := domain.GameSyncLock{GameID: game.ID, Field: field}
+			if err := tx.Where(lock).FirstOrCreate(&lock).Error; err != nil {
+				return err
+			}
+		}
+
+		if crack == nil {
+			return nil
+		}
+
+		var err error
+		_, change, err = saveCrack(tx, *game, *crack)
+
+		return err
+	})
+
+	return change, err
+}
+
+// Delete soft deletes the game and its DLCs. Their store listings are removed
+// for good, so the next store sync imports the game again instead of
+// resolving a game that no longer loads.
+func (h *AdminGameRepositoryMySQL) Delete(id uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		var game domain.Game
+		if err := tx.Select("id", "slug").First(&game, id).Error; err != nil {
+			return err
+		}
+
+		var dlcIDs []uint
+		if err := tx.Model(&domain.DLC{}).Where("game_id = ?", id).Pluck("id", &dlcIDs).Error; err != nil {
+			return err
+		}
+
+		if len(dlcIDs) > 0 {
+			if err := tx.Unscoped().Where("dlc_id IN ?", dlcIDs).Delete(&domain.DLCStore{}).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Where("id IN ?", dlcIDs).Delete(&domain.DLC{}).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Unscoped().Where("game_id = ?", id).Delete(&domain.GameStore{}).Error; err != nil {
+			return err
+		}
+
+		// The unique index still holds soft deleted games, so the slug is
+		// released for a new game with the same title.
+		if err := tx.Model(&game).Update("slug", deletedSlug(game)).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&game).Error
+	})
+}
+
+func deletedSlug(game domain.Game) string {
+	slug := fmt.Sprintf("deleted-%d-%s", game.ID, game.Slug)
+	if len(slug) > 255 {
+		slug = slug[:255]
+	}
+
+	return slug
+}
+
+func ensureAvailableSlug(tx *gorm.DB, slug string, gameID uint) error {
+	var taken int64
+	if err := tx.Model(&domain.Game{}).Where("slug = ? AND id <> ?", slug, gameID).Count(&taken).Error; err != nil {
+		return err
+	}
+
+	if taken > 0 {
+		return errors.NewHttpError(http.StatusConflict, "A game with this title already exists.")
+	}
+
+	return nil
+}
+
+// replaceGameRelations writes the relations of the given game aggregate over
+// the stored ones. Pivots and dependent records are recreated, while store
+// listings and DLCs are updated in place to keep their history.
+func replaceGameRelations(tx *gorm.DB, gameID uint, game *domain.Game) error {
+	pivots := []struct {
+		model  any
+		column string
+		rows   []any
+	}{
+		{model: &domain.Categoriable{}, column: "categoriable"},
+		{model: &domain.Genreable{}, column: "genreable"},
+		{model: &domain.Taggable{}, column: "taggable"},
+		{model: &domain.Platformable{}, column: "platformable"},
+		{model: &domain.Galleriable{}, column: "galleriable"},
+	}
+
+	for _, pivot := range pivots {
+		if err := tx.Unscoped().
+			Where(pivot.column+"_id = ? AND "+pivot.column+"_type = ?", gameID, gamesMorphType).
+			Delete(pivot.model).
+			Error; err != nil {
+			return err
+		}
+	}
+
+	for _, model := range []any{&domain.GamePublisher{}, &domain.GameDeveloper{}, &domain.GameLanguage{}, &domain.Requirement{}} {
+		if err := tx.Unscoped().Where("game_id = ?", gameID).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, category := range game.Categories {
+		if err := tx.Create(&domain.Categoriable{CategoriableID: gameID, CategoriableType: gamesMorphType, CategoryID: category.CategoryID}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, genre := range game.Genres {
+		if err := tx.Create(&domain.Genreable{GenreableID: gameID, GenreableType: gamesMorphType, GenreID: genre.GenreID}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, tag := range game.Tags {
+		if err := tx.Create(&domain.Taggable{TaggableID: gameID, TaggableType: gamesMorphType, TagID: tag.TagID}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, platform := range game.Platforms {
+		if err := tx.Create(&domain.Platformable{PlatformableID: gameID, PlatformableType: gamesMorphType, PlatformID: platform.PlatformID}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, gallery := range game.Galleries {
+		if err := tx.Create(&domain.Galleriable{
+			S3:              gallery.S3,
+			Path:            gallery.Path,
+			GalleriableID:   gameID,
+			GalleriableType: gamesMorphType,
+			MediaTypeID:     gallery.MediaTypeID,
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, publisher := range game.Publishers {
+		if err := tx.Create(&domain.GamePublisher{GameID: gameID, PublisherID: publisher.PublisherID}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, developer := range game.Developers {
+		if err := tx.Create(&domain.GameDeveloper{GameID: gameID, DeveloperID: developer.DeveloperID}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, language := range game.Languages {
+		if err := tx.Create(&domain.GameLanguage{
+			Menu:       language.Menu,
+			Dubs:       language.Dubs,
+			Subtitles:  language.Subtitles,
+			LanguageID: language.LanguageID,
+			GameID:     gameID,
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, requirement := range game.Requirements {
+		var requirementType domain.RequirementType
+		if err := tx.Where("potential = ? AND os = ?", requirement.RequirementType.Potential, requirement.RequirementType.OS).
+			FirstOrCreate(&requirementType, domain.RequirementType{
+				Potential: requirement.RequirementType.Potential,
+				OS:        requirement.RequirementType.OS,
+			}).Error; err != nil {
+			return err
+		}
+
+		requirement.ID = 0
+		requirement.GameID = gameID
+		requirement.RequirementTypeID = requirementType.ID
+		requirement.RequirementType = domain.RequirementType{}
+
+		if err := tx.Omit(clause.Associations).Create(&requirement).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := replaceGameSupport(tx, gameID, game.Support); err != nil {
+		return err
+	}
+
+	if err := replaceGameStores(tx, gameID, game.Stores); err != nil {
+		return err
+	}
+
+	return replaceGameDLCs(tx, gameID, game.DLCs)
+}
+
+func replaceGameSupport(tx *gorm.DB, gameID uint, support *domain.GameSupport) error {
+	if support == nil {
+		return tx.Where("game_id = ?", gameID).Delete(&domain.GameSupport{}).Error
+	}
+
+	var current domain.GameSupport
+	if err := tx.Where(domain.GameSupport{GameID: gameID}).FirstOrInit(&current).Error; err != nil {
+		return err
+	}
+
+	current.URL = support.URL
+	current.Email = support.Email
+	current.Contact = support.Contact
+
+	return tx.Save(&current).Error
+}
+
+// replaceGameStores keeps a single listing per store, updating the stored
+// listings so their price history survives the edit. Dropped listings are
+// removed for good, as on game deletes.
+func replaceGameStores(tx *gorm.DB, gameID uint, stores []domain.GameStore) error {
+	storeIDs := make([]uint, 0, len(stores))
+	for _, store := range stores {
+		storeIDs = append(storeIDs, store.StoreID)
+	}
+
+	remove := tx.Where("game_id = ?", gameID)
+	if len(storeIDs) > 0 {
+		remove = remove.Where("store_id NOT IN ?", storeIDs)
+	}
+
+	if err := remove.Unscoped().Delete(&domain.GameStore{}).Error; err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		var gameStore domain.GameStore
+		err := tx.Where("game_id = ? AND store_id = ?", gameID, store.StoreID).First(&gameStore).Error
+		if err != nil && !stdErrors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if err == nil {
+			lowestPrice := gameStore.LowestPrice
+			if store.Price < lowestPrice {
+				lowestPrice = store.Price
+			}
+
+			if err := tx.Model(&domain.GameStore{}).Where("id = ?", gameStore.ID).Updates(map[string]any{
+				"price":         store.Price,
+				"url":           store.URL,
+				"store_game_id": store.StoreGameID,
+				"lowest_price":  lowestPrice,
+			}).Error; err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := tx.Create(&domain.GameStore{
+			Price:        store.Price,
+			URL:          store.URL,
+			GameID:       gameID,
+			StoreID:      store.StoreID,
+			StoreGameID:  store.StoreGameID,
+			InitialPrice: store.Price,
+			LowestPrice:  store.Price,
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replaceGameDLCs updates the listed DLCs of the game, creates the new ones
+// and deletes the DLCs left out for good, along with their store listings.
+func replaceGameDLCs(tx *gorm.DB, gameID uint, dlcs []domain.DLC) error {
+	keep := make([]uint, 0, len(dlcs))
+	for _, dlc := range dlcs {
+		if dlc.ID != 0 {
+			keep = append(keep, dlc.ID)
+		}
+	}
+
+	remove := tx.Model(&domain.DLC{}).Where("game_id = ?", gameID)
+	if len(keep) > 0 {
+		remove = remove.Where("id NOT IN ?", keep)
+	}
+
+	var removeIDs []uint
+	if err := remove.Pluck("id", &removeIDs).Error; err != nil {
+		return err
+	}
+
+	if len(removeIDs) > 0 {
+		if err := tx.Unscoped().Where("dlc_id IN ?", removeIDs).Delete(&domain.DLCStore{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("id IN ?", removeIDs).Delete(&domain.DLC{}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, dlc := range dlcs {
+		fields := map[string]any{
+			"name":              dlc.Name,
+			"cover":             dlc.Cover,
+			"about":             dlc.About,
+			"description":       dlc.Description,
+			"short_description": dlc.ShortDescription,
+			"free":              dlc.Free,
+			"legal":             dlc.Legal,
+			"release_date":      dlc.ReleaseDate,
+		}
+
+		if dlc.ID == 0 {
+			dlc.GameID = gameID
+			if err := tx.Omit(clause.Associations).Create(&dlc).Error; err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		result := tx.Model(&domain.DLC{}).Where("id = ? AND game_id = ?", dlc.ID, gameID).Updates(fields)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The DLC %d does not belong to the game.", dlc.ID))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package db_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type AdminJobRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminJobRepositoryMySQL(db *gorm.DB) ports_admin.AdminJobRepository {
+	return &AdminJobRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminJobRepositoryMySQL) GetAll(filters ports_admin.JobFilters, limit int) ([]domain.Job, error) {
+	query := h.db.Model(&domain.Job{})
+
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+
+	if filters.Type != "" {
+		query = query.Where("type = ?", filters.Type)
+	}
+
+	var jobs []domain.Job
+	err := query.Order("id DESC").
+		Limit(limit).
+		Find(&jobs).
+		Error
+
+	return jobs, err
+}
+
+func (h *AdminJobRepositoryMySQL) FindByID(id uint) (domain.Job, error) {
+	var job domain.Job
+	err := h.db.First(&job, id).Error
+
+	return job, err
+}
+
+// Retry sends a failed or cancelled job back to the queue with a fresh set of
+// attempts. The update only applies while the job keeps its status, so a job
+// picked by a worker in the meantime is reported as a conflict.
+func (h *AdminJobRepositoryMySQL) Retry(job *domain.Job) error {
+	now := time.Now()
+
+	result := h.db.Model(&domain.Job{}).
+		Where("id = ? AND status = ?", job.ID, job.Status).
+		Updates(map[string]any{
+			"status":       domain.JobStatusPending,
+			"attempts":     0,
+			"error":        nil,
+			"available_at": now,
+			"started_at":   nil,
+			"locked_until": nil,
+			"finished_at":  nil,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.NewHttpError(http.StatusConflict, "The job changed its status in the meantime, please try again.")
+	}
+
+	job.Status = domain.JobStatusPending
+	job.Attempts = 0
+	job.Error = nil
+	job.AvailableAt = now
+	job.StartedAt = nil
+	job.LockedUntil = nil
+	job.FinishedAt = nil
+
+	return nil
+}
+
+// Cancel stops a pending or running job. Running jobs are interrupted by
+// their worker on its next cancellation check.
+func (h *AdminJobRepositoryMySQL) Cancel(job *domain.Job) error {
+	now := time.Now()
+
+	result := h.db.Model(&domain.Job{}).
+		Where("id = ? AND status = ?", job.ID, job.Status).
+		Updates(map[string]any{
+			"status":      domain.JobStatusCancelled,
+			"finished_at": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.NewHttpError(http.StatusConflict, "The job changed its status in the meantime, please try again.")
+	}
+
+	job.Status = domain.JobStatusCancelled
+	job.FinishedAt = &now
+
+	return nil
+}
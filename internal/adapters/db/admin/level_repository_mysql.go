@@ -0,0 +1,98 @@
+package db_admin
+
+import (
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AdminLevelRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminLevelRepositoryMySQL(db *gorm.DB) ports_admin.AdminLevelRepository {
+	return &AdminLevelRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminLevelRepositoryMySQL) GetAll() ([]domain.Level, error) {
+	var levels []domain.Level
+	if err := h.db.Preload("Rewards").Order("level").Find(&levels).Error; err != nil {
+		return nil, err
+	}
+
+	var rewards []*domain.Reward
+	for i := range levels {
+		for j := range levels[i].Rewards {
+			rewards = append(rewards, &levels[i].Rewards[j])
+		}
+	}
+
+	return levels, loadRewardables(h.db, rewards)
+}
+
+func (h *AdminLevelRepositoryMySQL) FindByID(id uint) (domain.Level, error) {
+	var level domain.Level
+	if err := h.db.Preload("Rewards").First(&level, id).Error; err != nil {
+		return level, err
+	}
+
+	rewards := make([]*domain.Reward, 0, len(level.Rewards))
+	for i := range level.Rewards {
+		rewards = append(rewards, &level.Rewards[i])
+	}
+
+	return level, loadRewardables(h.db, rewards)
+}
+
+func (h *AdminLevelRepositoryMySQL) ExistsByLevel(level uint, exceptID uint) (bool, error) {
+	var count int64
+	err := h.db.Model(&domain.Level{}).Where("level = ? AND id <> ?", level, exceptID).Count(&count).Error
+
+	return count > 0, err
+}
+
+// Create writes a new level along with its rewards.
+func (h *AdminLevelRepositoryMySQL) Create(level *domain.Level) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		rewards := level.Rewards
+		if err := tx.Omit(clause.Associations).Create(level).Error; err != nil {
+			return err
+		}
+
+		return replaceRewards(tx, level.ID, domain.SourceableTypeLevels, rewards)
+	})
+}
+
+func (h *AdminLevelRepositoryMySQL) Update(level *domain.Level) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.Level{}).Where("id = ?", level.ID).Updates(map[string]any{
+			"level":      level.Level,
+			"experience": level.Experience,
+			"coins":      level.Coins,
+		}).Error; err != nil {
+			return err
+		}
+
+		return replaceRewards(tx, level.ID, domain.SourceableTypeLevels, level.Rewards)
+	})
+}
+
+// Delete removes the level along with its rewards.
+func (h *AdminLevelRepositoryMySQL) Delete(id uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&domain.Level{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return replaceRewards(tx, id, domain.SourceableTypeLevels, nil)
+	})
+}
@@ -0,0 +1,331 @@
+package db_admin
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AdminMissionRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminMissionRepositoryMySQL(db *gorm.DB) ports_admin.AdminMissionRepository {
+	return &AdminMissionRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminMissionRepositoryMySQL) GetAll() ([]domain.Mission, error) {
+	var missions []domain.Mission
+	if err := h.db.Preload("MissionRequirements").Preload("Rewards").Order("id DESC").Find(&missions).Error; err != nil {
+		return nil, err
+	}
+
+	var rewards []*domain.Reward
+	for i := range missions {
+		for j := range missions[i].Rewards {
+			rewards = append(rewards, &missions[i].Rewards[j])
+		}
+	}
+
+	return missions, loadRewardables(h.db, rewards)
+}
+
+func (h *AdminMissionRepositoryMySQL) FindByID(id uint) (domain.Mission, error) {
+	var mission domain.Mission
+	if err := h.db.Preload("MissionRequirements").
+		Preload("Rewards").
+		Preload("Assignments.User").
+		First(&mission, id).
+		Error; err != nil {
+		return mission, err
+	}
+
+	rewards := make([]*domain.Reward, 0, len(mission.Rewards))
+	for i := range mission.Rewards {
+		rewards = append(rewards, &mission.Rewards[i])
+	}
+
+	return mission, loadRewardables(h.db, rewards)
+}
+
+// Create writes a new mission along with its requirements and rewards.
+func (h *AdminMissionRepositoryMySQL) Create(mission *domain.Mission) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		relations := *mission
+		if err := tx.Omit(clause.Associations).Create(mission).Error; err != nil {
+			return err
+		}
+
+		if err := replaceMissionRequirements(tx, mission.ID, relations.MissionRequirements); err != nil {
+			return err
+		}
+
+		return replaceRewards(tx, mission.ID, domain.SourceableTypeMissions, relations.Rewards)
+	})
+}
+
+// Update replaces a mission along with its requirements and rewards. Kept
+// requirements are updated in place, so the progress on them is not lost.
+func (h *AdminMissionRepositoryMySQL) Update(mission *domain.Mission) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.Mission{}).Where("id = ?", mission.ID).Updates(map[string]any{
+			"mission":        mission.Mission,
+			"description":    mission.Description,
+			"status":         mission.Status,
+			"for_all":        mission.ForAll,
+			"coins":          mission.Coins,
+			"experience":     mission.Experience,
+			"frequency":      mission.Frequency,
+			"reset_hour":     mission.ResetHour,
+			"reset_weekday":  mission.ResetWeekday,
+			"reset_timezone": mission.ResetTimezone,
+		}).Error; err != nil {
+			return err
+		}
+
+		if err := replaceMissionRequirements(tx, mission.ID, mission.MissionRequirements); err != nil {
+			return err
+		}
+
+		return replaceRewards(tx, mission.ID, domain.SourceableTypeMissions, mission.Rewards)
+	})
+}
+
+// Delete removes the mission along with its requirements, rewards and
+// assignments.
+func (h *AdminMissionRepositoryMySQL) Delete(id uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&domain.Mission{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Where("mission_id = ?", id).Delete(&domain.MissionRequirement{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("mission_id = ?", id).Delete(&domain.UserMissionAssignment{}).Error; err != nil {
+			return err
+		}
+
+		return replaceRewards(tx, id, domain.SourceableTypeMissions, nil)
+	})
+}
+
+// SyncAssignments replaces the users the mission is assigned to.
+func (h *AdminMissionRepositoryMySQL) SyncAssignments(missionID uint, userIDs []uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		userIDs = uniqueIDs(userIDs)
+		if err := ensureAllExist(tx, &domain.User{}, userIDs, "Some of the given users could not be found."); err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("mission_id = ?", missionID).Delete(&domain.UserMissionAssignment{}).Error; err != nil {
+			return err
+		}
+
+		if len(userIDs) == 0 {
+			return nil
+		}
+
+		assignments := make([]domain.UserMissionAssignment, 0, len(userIDs))
+		for _, userID := range userIDs {
+			assignments = append(assignments, domain.UserMissionAssignment{
+				UserID:    userID,
+				MissionID: missionID,
+			})
+		}
+
+		return tx.Omit(clause.Associations).Create(&assignments).Error
+	})
+}
+
+func (h *AdminMissionRepositoryMySQL) FindRequirement(missionID uint, requirementID uint) (domain.MissionRequirement, error) {
+	var requirement domain.MissionRequirement
+	err := h.db.Where("mission_id = ?", missionID).First(&requirement, requirementID).Error
+
+	return requirement, err
+}
+
+// RequirementAchievers returns a page of the users that completed the
+// requirement, along with their total.
+func (h *AdminMissionRepositoryMySQL) RequirementAchievers(requirementID uint, offset int, limit int) ([]domain.User, int64, error) {
+	query := h.db.Model(&domain.User{}).
+		Joins("JOIN mission_progresses ON mission_progresses.user_id = users.id AND mission_progresses.deleted_at IS NULL").
+		Where("mission_progresses.mission_requirement_id = ? AND mission_progresses.completed = ?", requirementID, true)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var users []domain.User
+	err := query.Order("users.id").Offset(offset).Limit(limit).Find(&users).Error
+
+	return users, total, err
+}
+
+// replaceMissionRequirements updates the listed requirements of the mission,
+// creates the new ones and deletes the requirements left out.
+func replaceMissionRequirements(tx *gorm.DB, missionID uint, requirements []domain.MissionRequirement) error {
+	keep := make([]uint, 0, len(requirements))
+	for _, requirement := range requirements {
+		if requirement.ID != 0 {
+			keep = append(keep, requirement.ID)
+		}
+	}
+
+	remove := tx.Where("mission_id = ?", missionID)
+	if len(keep) > 0 {
+		remove = remove.Where("id NOT IN ?", keep)
+	}
+
+	if err := remove.Delete(&domain.MissionRequirement{}).Error; err != nil {
+		return err
+	}
+
+	for _, requirement := range requirements {
+		if requirement.ID == 0 {
+			requirement.MissionID = missionID
+			if err := tx.Omit(clause.Associations).Create(&requirement).Error; err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		result := tx.Model(&domain.MissionRequirement{}).
+			Where("id = ? AND mission_id = ?", requirement.ID, missionID).
+			Updates(map[string]any{
+				"task":        requirement.Task,
+				"key":         requirement.Key,
+				"goal":        requirement.Goal,
+				"description": requirement.Description,
+				"type":        requirement.Type,
+				"within_days": requirement.WithinDays,
+				"starts_at":   requirement.StartsAt,
+				"ends_at":     requirement.EndsAt,
+				"conditions":  requirement.Conditions,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The requirement %d does not belong to the mission.", requirement.ID))
+		}
+	}
+
+	return nil
+}
+
+// replaceRewards replaces the rewards given by the mission or level.
+func replaceRewards(tx *gorm.DB, sourceID uint, sourceType string, rewards []domain.Reward) error {
+	if err := tx.Unscoped().
+		Where("sourceable_id = ? AND sourceable_type = ?", sourceID, sourceType).
+		Delete(&domain.Reward{}).
+		Error; err != nil {
+		return err
+	}
+
+	if len(rewards) == 0 {
+		return nil
+	}
+
+	idsByType := make(map[string][]uint)
+	for i := range rewards {
+		rewards[i].ID = 0
+		rewards[i].SourceableID = sourceID
+		rewards[i].SourceableType = sourceType
+
+		if domain.IsRewardableEntity(rewards[i].RewardableType) {
+			idsByType[rewards[i].RewardableType] = append(idsByType[rewards[i].RewardableType], rewards[i].RewardableID)
+		}
+	}
+
+	for _, rewardableType := range domain.RewardableTypes {
+		model, ok := rewardableModels[rewardableType]
+		if !ok {
+			continue
+		}
+
+		message := fmt.Sprintf("Some of the given reward %s could not be found.", rewardableType)
+		if err := ensureAllExist(tx, model, uniqueIDs(idsByType[rewardableType]), message); err != nil {
+			return err
+		}
+	}
+
+	return tx.Create(&rewards).Error
+}
+
+// rewardableModels are the models of the rewardable types that point to an
+// entity.
+var rewardableModels = map[string]any{
+	domain.RewardableTypeTitles:    &domain.Title{},
+	domain.RewardableTypeBoosters:  &domain.Booster{},
+	domain.RewardableTypeCosmetics: &domain.Cosmetic{},
+	domain.RewardableTypeItems:     &domain.Item{},
+}
+
+// loadRewardables fills the rewarded entities of the given rewards.
+func loadRewardables(db *gorm.DB, rewards []*domain.Reward) error {
+	if err := loadRewardablesOf(db, rewards, domain.RewardableTypeTitles, func(title domain.Title) uint { return title.ID }); err != nil {
+		return err
+	}
+
+	if err := loadRewardablesOf(db, rewards, domain.RewardableTypeBoosters, func(booster domain.Booster) uint { return booster.ID }); err != nil {
+		return err
+	}
+
+	if err := loadRewardablesOf(db, rewards, domain.RewardableTypeCosmetics, func(cosmetic domain.Cosmetic) uint { return cosmetic.ID }); err != nil {
+		return err
+	}
+
+	return loadRewardablesOf(db, rewards, domain.RewardableTypeItems, func(item domain.Item) uint { return item.ID })
+}
+
+func loadRewardablesOf[T any](db *gorm.DB, rewards []*domain.Reward, rewardableType string, id func(T) uint) error {
+	var ids []uint
+	for _, reward := range rewards {
+		if reward.RewardableType == rewardableType {
+			ids = append(ids, reward.RewardableID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var entities []T
+	if err := db.Where("id IN ?", uniqueIDs(ids)).Find(&entities).Error; err != nil {
+		return err
+	}
+
+	entityMap := make(map[uint]*T, len(entities))
+	for i := range entities {
+		entityMap[id(entities[i])] = &entities[i]
+	}
+
+	for _, reward := range rewards {
+		if reward.RewardableType != rewardableType {
+			continue
+		}
+
+		if entity, ok := entityMap[reward.RewardableID]; ok {
+			reward.Rewardable = entity
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package db_admin
+
+import (
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+
+	"gorm.io/gorm"
+)
+
+type AdminMultiplierEventRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminMultiplierEventRepositoryMySQL(db *gorm.DB) ports_admin.AdminMultiplierEventRepository {
+	return &AdminMultiplierEventRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminMultiplierEventRepositoryMySQL) GetAll() ([]domain.MultiplierEvent, error) {
+	var events []domain.MultiplierEvent
+	err := h.db.Order("starts_at DESC").Find(&events).Error
+
+	return events, err
+}
+
+func (h *AdminMultiplierEventRepositoryMySQL) FindByID(id uint) (domain.MultiplierEvent, error) {
+	var event domain.MultiplierEvent
+	err := h.db.First(&event, id).Error
+
+	return event, err
+}
+
+func (h *AdminMultiplierEventRepositoryMySQL) Create(event *domain.MultiplierEvent) error {
+	return h.db.Create(event).Error
+}
+
+func (h *AdminMultiplierEventRepositoryMySQL) Update(event *domain.MultiplierEvent) error {
+	return h.db.Model(&domain.MultiplierEvent{}).Where("id = ?", event.ID).Updates(map[string]any{
+		"name":       event.Name,
+		"scope":      event.Scope,
+		"multiplier": event.Multiplier,
+		"action_key": event.ActionKey,
+		"starts_at":  event.StartsAt,
+		"ends_at":    event.EndsAt,
+	}).Error
+}
+
+func (h *AdminMultiplierEventRepositoryMySQL) Delete(id uint) error {
+	result := h.db.Delete(&domain.MultiplierEvent{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package db_admin
+
+import (
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+
+	"gorm.io/gorm"
+)
+
+type AdminPermissionRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminPermissionRepositoryMySQL(db *gorm.DB) ports_admin.AdminPermissionRepository {
+	return &AdminPermissionRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminPermissionRepositoryMySQL) GetAll() ([]domain.Permission, error) {
+	var permissions []domain.Permission
+	err := h.db.Order("scope").Find(&permissions).Error
+
+	return permissions, err
+}
+
+func (h *AdminPermissionRepositoryMySQL) FindByID(id uint) (domain.Permission, error) {
+	var permission domain.Permission
+	err := h.db.First(&permission, id).Error
+
+	return permission, err
+}
+
+func (h *AdminPermissionRepositoryMySQL) ExistsByScope(scope string, exceptID uint) (bool, error) {
+	var count int64
+	err := h.db.Unscoped().
+		Model(&domain.Permission{}).
+		Where("scope = ? AND id <> ?", scope, exceptID).
+		Count(&count).
+		Error
+
+	return count > 0, err
+}
+
+func (h *AdminPermissionRepositoryMySQL) Create(permission *domain.Permission) error {
+	return h.db.Create(permission).Error
+}
+
+func (h *AdminPermissionRepositoryMySQL) Save(permission *domain.Permission) error {
+	return h.db.Save(permission).Error
+}
+
+// Delete removes the permission from the database for good, as its scope is
+// unique and should be reusable, along with every grant of it.
+func (h *AdminPermissionRepositoryMySQL) Delete(id uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Delete(&domain.Permission{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Unscoped().Where("permission_id = ?", id).Delete(&domain.Permissionable{}).Error
+	})
+}
+
+func (h *AdminPermissionRepositoryMySQL) UserIDs(permissionID uint) ([]uint, error) {
+	var userIDs []uint
+	err := h.db.Model(&domain.Permissionable{}).
+		Where("permission_id = ? AND permissionable_type = ?", permissionID, usersMorphType).
+		Pluck("permissionable_id", &userIDs).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	var roleUserIDs []uint
+	err = h.db.Model(&domain.Roleable{}).
+		Where("roleable_type = ?", usersMorphType).
+		Where(
+			"role_id IN (?)",
+			h.db.Model(&domain.Permissionable{}).
+				Select("permissionable_id").
+				Where("permission_id = ? AND permissionable_type = ?", permissionID, rolesMorphType),
+		).
+		Pluck("roleable_id", &roleUserIDs).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return uniqueIDs(append(userIDs, roleUserIDs...)), nil
+}
+
+func (h *AdminPermissionRepositoryMySQL) CreateMissing(scopes []string) ([]domain.Permission, error) {
+	created := []domain.Permission{}
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		var existing []string
+		if err := tx.Unscoped().Model(&domain.Permission{}).Where("scope IN ?", scopes).Pluck("scope", &existing).Error; err != nil {
+			return err
+		}
+
+		stored := make(map[string]bool, len(existing))
+		for _, scope := range existing {
+			stored[scope] = true
+		}
+
+		for _, scope := range scopes {
+			if !stored[scope] {
+				created = append(created, domain.Permission{Scope: scope})
+			}
+		}
+
+		if len(created) == 0 {
+			return nil
+		}
+
+		return tx.Create(&created).Error
+	})
+
+	return created, err
+}
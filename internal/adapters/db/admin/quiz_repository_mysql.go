@@ -0,0 +1,208 @@
+package db_admin
+
+import (
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AdminQuizRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminQuizRepositoryMySQL(db *gorm.DB) ports_admin.AdminQuizRepository {
+	return &AdminQuizRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminQuizRepositoryMySQL) GetAll() ([]domain.Quiz, error) {
+	var quizzes []domain.Quiz
+	err := h.db.Order("opens_at DESC").Find(&quizzes).Error
+
+	return quizzes, err
+}
+
+func (h *AdminQuizRepositoryMySQL) FindByID(id uint) (domain.Quiz, error) {
+	var quiz domain.Quiz
+	err := h.db.
+		Preload("Questions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, id")
+		}).
+		Preload("Questions.Options", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, id")
+		}).
+		Preload("Rewards", func(db *gorm.DB) *gorm.DB {
+			return db.Order("min_score")
+		}).
+		Preload("Rewards.Rewards").
+		First(&quiz, id).
+		Error
+	if err != nil {
+		return quiz, err
+	}
+
+	var rewards []*domain.Reward
+	for i := range quiz.Rewards {
+		for j := range quiz.Rewards[i].Rewards {
+			rewards = append(rewards, &quiz.Rewards[i].Rewards[j])
+		}
+	}
+
+	return quiz, loadRewardables(h.db, rewards)
+}
+
+func (h *AdminQuizRepositoryMySQL) HasAttempts(id uint) (bool, error) {
+	var count int64
+	err := h.db.Model(&domain.QuizAttempt{}).Where("quiz_id = ?", id).Count(&count).Error
+
+	return count > 0, err
+}
+
+// Create writes the quiz along with its questions, options and reward tiers.
+func (h *AdminQuizRepositoryMySQL) Create(quiz *domain.Quiz) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		if err := ensureQuizGameExists(tx, quiz.GameID); err != nil {
+			return err
+		}
+
+		questions, tiers := quiz.Questions, quiz.Rewards
+		if err := tx.Omit(clause.Associations).Create(quiz).Error; err != nil {
+			return err
+		}
+
+		if err := replaceQuizQuestions(tx, quiz.ID, questions); err != nil {
+			return err
+		}
+
+		return replaceQuizRewards(tx, quiz.ID, tiers)
+	})
+}
+
+// Update writes the quiz and replaces its reward tiers, along with its
+// questions when asked to.
+func (h *AdminQuizRepositoryMySQL) Update(quiz *domain.Quiz, replaceQuestions bool) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		if err := ensureQuizGameExists(tx, quiz.GameID); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&domain.Quiz{}).Where("id = ?", quiz.ID).Updates(map[string]any{
+			"kind":        quiz.Kind,
+			"title":       quiz.Title,
+			"description": quiz.Description,
+			"game_id":     quiz.GameID,
+			"opens_at":    quiz.OpensAt,
+			"closes_at":   quiz.ClosesAt,
+		}).Error; err != nil {
+			return err
+		}
+
+		if replaceQuestions {
+			if err := replaceQuizQuestions(tx, quiz.ID, quiz.Questions); err != nil {
+				return err
+			}
+		}
+
+		return replaceQuizRewards(tx, quiz.ID, quiz.Rewards)
+	})
+}
+
+// Delete removes the quiz along with its questions and reward tiers. The
+// answers are kept for the record.
+func (h *AdminQuizRepositoryMySQL) Delete(id uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&domain.Quiz{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := replaceQuizQuestions(tx, id, nil); err != nil {
+			return err
+		}
+
+		return replaceQuizRewards(tx, id, nil)
+	})
+}
+
+func ensureQuizGameExists(tx *gorm.DB, gameID *uint) error {
+	if gameID == nil {
+		return nil
+	}
+
+	return ensureAllExist(tx, &domain.Game{}, []uint{*gameID}, "The given game could not be found.")
+}
+
+// replaceQuizQuestions deletes the questions and options of the quiz and
+// creates the given ones.
+func replaceQuizQuestions(tx *gorm.DB, quizID uint, questions []domain.QuizQuestion) error {
+	if err := tx.Where("question_id IN (?)", tx.Model(&domain.QuizQuestion{}).Select("id").Where("quiz_id = ?", quizID)).
+		Delete(&domain.QuizOption{}).
+		Error; err != nil {
+		return err
+	}
+
+	if err := tx.Where("quiz_id = ?", quizID).Delete(&domain.QuizQuestion{}).Error; err != nil {
+		return err
+	}
+
+	for _, question := range questions {
+		options := question.Options
+		question.ID = 0
+		question.QuizID = quizID
+		if err := tx.Omit(clause.Associations).Create(&question).Error; err != nil {
+			return err
+		}
+
+		for i := range options {
+			options[i].ID = 0
+			options[i].QuestionID = question.ID
+		}
+
+		if err := tx.Create(&options).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replaceQuizRewards deletes the reward tiers of the quiz along with their
+// rewards and creates the given ones.
+func replaceQuizRewards(tx *gorm.DB, quizID uint, tiers []domain.QuizReward) error {
+	var previous []uint
+	if err := tx.Model(&domain.QuizReward{}).Where("quiz_id = ?", quizID).Pluck("id", &previous).Error; err != nil {
+		return err
+	}
+
+	for _, id := range previous {
+		if err := replaceRewards(tx, id, domain.SourceableTypeQuizRewards, nil); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Where("quiz_id = ?", quizID).Delete(&domain.QuizReward{}).Error; err != nil {
+		return err
+	}
+
+	for _, tier := range tiers {
+		rewards := tier.Rewards
+		tier.ID = 0
+		tier.QuizID = quizID
+		if err := tx.Omit(clause.Associations).Create(&tier).Error; err != nil {
+			return err
+		}
+
+		if err := replaceRewards(tx, tier.ID, domain.SourceableTypeQuizRewards, rewards); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,255 @@
+package db_admin
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+
+	"gorm.io/gorm"
+)
+
+type AdminReferenceRepositoryMySQL[T any] struct {
+	db     *gorm.DB
+	usages []ports_admin.ReferenceUsage
+}
+
+func NewAdminReferenceRepositoryMySQL[T any](db *gorm.DB, usages ...ports_admin.ReferenceUsage) ports_admin.AdminReferenceRepository[T] {
+	return &AdminReferenceRepositoryMySQL[T]{
+		db:     db,
+		usages: usages,
+	}
+}
+
+func NewAdminCategoryRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.Category] {
+	return NewAdminReferenceRepositoryMySQL[domain.Category](db,
+		ports_admin.ReferenceUsage{Model: &domain.Categoriable{}, Column: "category_id", Owner: []string{"categoriable_id", "categoriable_type"}},
+	)
+}
+
+func NewAdminGenreRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.Genre] {
+	return NewAdminReferenceRepositoryMySQL[domain.Genre](db,
+		ports_admin.ReferenceUsage{Model: &domain.Genreable{}, Column: "genre_id", Owner: []string{"genreable_id", "genreable_type"}},
+	)
+}
+
+func NewAdminPlatformRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.Platform] {
+	return NewAdminReferenceRepositoryMySQL[domain.Platform](db,
+		ports_admin.ReferenceUsage{Model: &domain.Platformable{}, Column: "platform_id", Owner: []string{"platformable_id", "platformable_type"}},
+	)
+}
+
+func NewAdminTagRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.Tag] {
+	return NewAdminReferenceRepositoryMySQL[domain.Tag](db,
+		ports_admin.ReferenceUsage{Model: &domain.Taggable{}, Column: "tag_id", Owner: []string{"taggable_id", "taggable_type"}},
+	)
+}
+
+func NewAdminCrackerRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.Cracker] {
+	return NewAdminReferenceRepositoryMySQL[domain.Cracker](db,
+		ports_admin.ReferenceUsage{Model: &domain.Crack{}, Column: "cracker_id"},
+		ports_admin.ReferenceUsage{Model: &domain.CrackStatusChange{}, Column: "cracker_id"},
+	)
+}
+
+func NewAdminProtectionRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.Protection] {
+	return NewAdminReferenceRepositoryMySQL[domain.Protection](db,
+		ports_admin.ReferenceUsage{Model: &domain.Crack{}, Column: "protection_id"},
+		ports_admin.ReferenceUsage{Model: &domain.CrackStatusChange{}, Column: "protection_id"},
+	)
+}
+
+func NewAdminDeveloperRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.Developer] {
+	return NewAdminReferenceRepositoryMySQL[domain.Developer](db,
+		ports_admin.ReferenceUsage{Model: &domain.GameDeveloper{}, Column: "developer_id", Owner: []string{"game_id"}},
+		ports_admin.ReferenceUsage{Model: &domain.DLCDeveloper{}, Column: "developer_id", Owner: []string{"dlc_id"}},
+	)
+}
+
+func NewAdminPublisherRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.Publisher] {
+	return NewAdminReferenceRepositoryMySQL[domain.Publisher](db,
+		ports_admin.ReferenceUsage{Model: &domain.GamePublisher{}, Column: "publisher_id", Owner: []string{"game_id"}},
+		ports_admin.ReferenceUsage{Model: &domain.DLCPublisher{}, Column: "publisher_id", Owner: []string{"dlc_id"}},
+	)
+}
+
+func NewAdminStoreRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.Store] {
+	return NewAdminReferenceRepositoryMySQL[domain.Store](db,
+		ports_admin.ReferenceUsage{Model: &domain.GameStore{}, Column: "store_id"},
+		ports_admin.ReferenceUsage{Model: &domain.DLCStore{}, Column: "store_id"},
+	)
+}
+
+func NewAdminCriticRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.Critic] {
+	return NewAdminReferenceRepositoryMySQL[domain.Critic](db,
+		ports_admin.ReferenceUsage{Model: &domain.Criticable{}, Column: "critic_id", Owner: []string{"criticable_id", "criticable_type"}},
+	)
+}
+
+func NewAdminLanguageRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.Language] {
+	return NewAdminReferenceRepositoryMySQL[domain.Language](db,
+		ports_admin.ReferenceUsage{Model: &domain.GameLanguage{}, Column: "language_id", Owner: []string{"game_id"}},
+		ports_admin.ReferenceUsage{Model: &domain.DLCLanguage{}, Column: "language_id", Owner: []string{"dlc_id"}},
+	)
+}
+
+func NewAdminMediaTypeRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.MediaType] {
+	return NewAdminReferenceRepositoryMySQL[domain.MediaType](db,
+		ports_admin.ReferenceUsage{Model: &domain.Galleriable{}, Column: "media_type_id"},
+	)
+}
+
+func NewAdminRequirementTypeRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.RequirementType] {
+	return NewAdminReferenceRepositoryMySQL[domain.RequirementType](db,
+		ports_admin.ReferenceUsage{Model: &domain.Requirement{}, Column: "requirement_type_id"},
+	)
+}
+
+func NewAdminTorrentProviderRepositoryMySQL(db *gorm.DB) ports_admin.AdminReferenceRepository[domain.TorrentProvider] {
+	return NewAdminReferenceRepositoryMySQL[domain.TorrentProvider](db,
+		ports_admin.ReferenceUsage{Model: &domain.Torrent{}, Column: "torrent_provider_id"},
+	)
+}
+
+func (h *AdminReferenceRepositoryMySQL[T]) GetAll() ([]T, error) {
+	var entities []T
+	err := h.db.Model(new(T)).
+		Order("id ASC").
+		Find(&entities).
+		Error
+
+	return entities, err
+}
+
+func (h *AdminReferenceRepositoryMySQL[T]) FindByID(id uint) (T, error) {
+	var entity T
+	err := h.db.First(&entity, id).Error
+
+	return entity, err
+}
+
+// Exists checks the given column values against every record. Deleted
+// records are removed for good, so none of them holds the unique indexes.
+func (h *AdminReferenceRepositoryMySQL[T]) Exists(conditions map[string]any, exceptID uint) (bool, error) {
+	var count int64
+	err := h.db.Model(new(T)).
+		Where(conditions).
+		Where("id <> ?", exceptID).
+		Count(&count).
+		Error
+
+	return count > 0, err
+}
+
+// CountUsages counts the records pointing to the given entities, or to every
+// entity when no ID is given.
+func (h *AdminReferenceRepositoryMySQL[T]) CountUsages(ids ...uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64)
+
+	for _, usage := range h.usages {
+		var rows []struct {
+			ID    uint
+			Total int64
+		}
+
+		query := h.db.Model(usage.Model).
+			Select(usage.Column + " AS id, COUNT(*) AS total").
+			Group(usage.Column)
+
+		if len(ids) > 0 {
+			query = query.Where(usage.Column+" IN ?", ids)
+		}
+
+		if err := query.Scan(&rows).Error; err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			counts[row.ID] += row.Total
+		}
+	}
+
+	return counts, nil
+}
+
+func (h *AdminReferenceRepositoryMySQL[T]) Create(entity *T) error {
+	return h.db.Create(entity).Error
+}
+
+func (h *AdminReferenceRepositoryMySQL[T]) Save(entity *T) error {
+	return h.db.Save(entity).Error
+}
+
+// Delete removes the entity for good once nothing references it anymore, so
+// its unique values can be taken again. When a replacement is given, the
+// referencing records are moved to it first.
+func (h *AdminReferenceRepositoryMySQL[T]) Delete(id uint, reassignTo *uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		for _, usage := range h.usages {
+			if reassignTo != nil {
+				if err := dropDuplicateUsages(tx, usage, id, *reassignTo); err != nil {
+					return err
+				}
+
+				if err := tx.Unscoped().
+					Model(usage.Model).
+					Where(usage.Column+" = ?", id).
+					Update(usage.Column, *reassignTo).
+					Error; err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			var count int64
+			if err := tx.Unscoped().Model(usage.Model).Where(usage.Column+" = ?", id).Count(&count).Error; err != nil {
+				return err
+			}
+
+			if count > 0 {
+				return fmt.Errorf("%w by %d records", ports_admin.ErrReferenceInUse, count)
+			}
+		}
+
+		result := tx.Unscoped().Delete(new(T), id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	})
+}
+
+// dropDuplicateUsages removes the usages of the entity whose owner is already
+// linked to the replacement, so reassigning the rest leaves no duplicates.
+func dropDuplicateUsages(tx *gorm.DB, usage ports_admin.ReferenceUsage, id uint, reassignTo uint) error {
+	if len(usage.Owner) == 0 {
+		return nil
+	}
+
+	var owners []map[string]any
+	if err := tx.Unscoped().
+		Model(usage.Model).
+		Select(usage.Owner).
+		Where(usage.Column+" = ?", reassignTo).
+		Find(&owners).
+		Error; err != nil {
+		return err
+	}
+
+	for _, owner := range owners {
+		if err := tx.Unscoped().
+			Where(usage.Column+" = ?", id).
+			Where(owner).
+			Delete(usage.Model).
+			Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package db_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+
+	"gorm.io/gorm"
+)
+
+const rolesMorphType = "roles"
+
+type AdminRoleRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminRoleRepositoryMySQL(db *gorm.DB) ports_admin.AdminRoleRepository {
+	return &AdminRoleRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminRoleRepositoryMySQL) GetAll() ([]domain.Role, error) {
+	var roles []domain.Role
+	err := h.db.Preload("Permissions.Permission").Order("name").Find(&roles).Error
+
+	return roles, err
+}
+
+func (h *AdminRoleRepositoryMySQL) FindByID(id uint) (domain.Role, error) {
+	var role domain.Role
+	err := h.db.Preload("Permissions.Permission").First(&role, id).Error
+
+	return role, err
+}
+
+func (h *AdminRoleRepositoryMySQL) ExistsByName(name string, exceptID uint) (bool, error) {
+	var count int64
+	err := h.db.Model(&domain.Role{}).Where("name = ? AND id <> ?", name, exceptID).Count(&count).Error
+
+	return count > 0, err
+}
+
+func (h *AdminRoleRepositoryMySQL) Create(role *domain.Role, permissionIDs []uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Permissions").Create(role).Error; err != nil {
+			return err
+		}
+
+		return syncRolePermissions(tx, role, permissionIDs)
+	})
+}
+
+func (h *AdminRoleRepositoryMySQL) Update(role *domain.Role, permissionIDs []uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Permissions").Save(role).Error; err != nil {
+			return err
+		}
+
+		return syncRolePermissions(tx, role, permissionIDs)
+	})
+}
+
+// Delete removes the role along with its grants, so its users lose the
+// permissions it carried.
+func (h *AdminRoleRepositoryMySQL) Delete(id uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&domain.Role{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Unscoped().Where("role_id = ?", id).Delete(&domain.Roleable{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().
+			Where("permissionable_id = ? AND permissionable_type = ?", id, rolesMorphType).
+			Delete(&domain.Permissionable{}).
+			Error
+	})
+}
+
+func (h *AdminRoleRepositoryMySQL) UserIDs(roleID uint) ([]uint, error) {
+	var userIDs []uint
+	err := h.db.Model(&domain.Roleable{}).
+		Where("role_id = ? AND roleable_type = ?", roleID, usersMorphType).
+		Pluck("roleable_id", &userIDs).
+		Error
+
+	return userIDs, err
+}
+
+func syncRolePermissions(tx *gorm.DB, role *domain.Role, permissionIDs []uint) error {
+	if err := syncPermissionables(tx, role.ID, rolesMorphType, permissionIDs); err != nil {
+		return err
+	}
+
+	return tx.Where("permissionable_id = ? AND permissionable_type = ?", role.ID, rolesMorphType).
+		Preload("Permission").
+		Find(&role.Permissions).
+		Error
+}
+
+// syncPermissionables replaces the permissions granted to the given owner.
+func syncPermissionables(tx *gorm.DB, ownerID uint, ownerType string, permissionIDs []uint) error {
+	permissionIDs = uniqueIDs(permissionIDs)
+	if err := ensureAllExist(tx, &domain.Permission{}, permissionIDs, "Some of the given permissions could not be found."); err != nil {
+		return err
+	}
+
+	if err := tx.Unscoped().
+		Where("permissionable_id = ? AND permissionable_type = ?", ownerID, ownerType).
+		Delete(&domain.Permissionable{}).
+		Error; err != nil {
+		return err
+	}
+
+	if len(permissionIDs) == 0 {
+		return nil
+	}
+
+	permissionables := make([]domain.Permissionable, 0, len(permissionIDs))
+	for _, permissionID := range permissionIDs {
+		permissionables = append(permissionables, domain.Permissionable{
+			PermissionableID:   ownerID,
+			PermissionableType: ownerType,
+			PermissionID:       permissionID,
+		})
+	}
+
+	return tx.Omit("Permission").Create(&permissionables).Error
+}
+
+// syncRoleables replaces the roles given to the user.
+func syncRoleables(tx *gorm.DB, userID uint, roleIDs []uint) error {
+	roleIDs = uniqueIDs(roleIDs)
+	if err := ensureAllExist(tx, &domain.Role{}, roleIDs, "Some of the given roles could not be found."); err != nil {
+		return err
+	}
+
+	if err := tx.Unscoped().
+		Where("roleable_id = ? AND roleable_type = ?", userID, usersMorphType).
+		Delete(&domain.Roleable{}).
+		Error; err != nil {
+		return err
+	}
+
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	roleables := make([]domain.Roleable, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		roleables = append(roleables, domain.Roleable{
+			RoleableID:   userID,
+			RoleableType: usersMorphType,
+			RoleID:       roleID,
+		})
+	}
+
+	return tx.Omit("Role").Create(&roleables).Error
+}
+
+func ensureAllExist(tx *gorm.DB, model any, ids []uint, message string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var count int64
+	if err := tx.Model(model).Where("id IN ?", ids).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count != int64(len(ids)) {
+		return errors.NewHttpError(http.StatusUnprocessableEntity, message)
+	}
+
+	return nil
+}
+
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	return unique
+}
@@ -0,0 +1,70 @@
+package db_admin
+
+import (
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+
+	"gorm.io/gorm"
+)
+
+type AdminSchedulerRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminSchedulerRepositoryMySQL(db *gorm.DB) ports_admin.AdminSchedulerRepository {
+	return &AdminSchedulerRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminSchedulerRepositoryMySQL) GetJobs() ([]domain.ScheduledJob, error) {
+	var jobs []domain.ScheduledJob
+	err := h.db.Order("name ASC").Find(&jobs).Error
+
+	return jobs, err
+}
+
+func (h *AdminSchedulerRepositoryMySQL) FindJob(name string) (domain.ScheduledJob, error) {
+	var job domain.ScheduledJob
+	err := h.db.Where("name = ?", name).First(&job).Error
+
+	return job, err
+}
+
+// GetRuns returns a page of the runs matching the filters, latest first,
+// along with their total.
+func (h *AdminSchedulerRepositoryMySQL) GetRuns(filters ports_admin.ScheduledRunFilters, offset int, limit int) ([]domain.ScheduledRun, int64, error) {
+	query := h.db.Model(&domain.ScheduledRun{})
+
+	if filters.Job != "" {
+		query = query.Where("job_name = ?", filters.Job)
+	}
+
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var runs []domain.ScheduledRun
+	err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&runs).Error
+
+	return runs, total, err
+}
+
+func (h *AdminSchedulerRepositoryMySQL) HasPendingRun(jobName string) (bool, error) {
+	var count int64
+	err := h.db.Model(&domain.ScheduledRun{}).
+		Where("job_name = ? AND status = ?", jobName, domain.ScheduledRunPending).
+		Count(&count).
+		Error
+
+	return count > 0, err
+}
+
+func (h *AdminSchedulerRepositoryMySQL) CreateRun(run *domain.ScheduledRun) error {
+	return h.db.Create(run).Error
+}
@@ -0,0 +1,169 @@
+package db_admin
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AdminTitleRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminTitleRepositoryMySQL(db *gorm.DB) ports_admin.AdminTitleRepository {
+	return &AdminTitleRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminTitleRepositoryMySQL) GetAll() ([]domain.Title, error) {
+	var titles []domain.Title
+	err := h.db.Preload("TitleRequirements").Order("id DESC").Find(&titles).Error
+
+	return titles, err
+}
+
+func (h *AdminTitleRepositoryMySQL) FindByID(id uint) (domain.Title, error) {
+	var title domain.Title
+	err := h.db.Preload("TitleRequirements").First(&title, id).Error
+
+	return title, err
+}
+
+// Create writes a new title along with its requirements.
+func (h *AdminTitleRepositoryMySQL) Create(title *domain.Title) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		requirements := title.TitleRequirements
+		if err := tx.Omit(clause.Associations).Create(title).Error; err != nil {
+			return err
+		}
+
+		return replaceTitleRequirements(tx, title.ID, requirements)
+	})
+}
+
+// Update replaces a title along with its requirements. Kept requirements are
+// updated in place, so the progress on them is not lost.
+func (h *AdminTitleRepositoryMySQL) Update(title *domain.Title) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.Title{}).Where("id = ?", title.ID).Updates(map[string]any{
+			"title":       title.Title,
+			"description": title.Description,
+			"cost":        title.Cost,
+			"purchasable": title.Purchasable,
+			"status":      title.Status,
+		}).Error; err != nil {
+			return err
+		}
+
+		return replaceTitleRequirements(tx, title.ID, title.TitleRequirements)
+	})
+}
+
+// Delete removes the title along with its requirements and the rewards that
+// would give it. Users keep the titles they already earned.
+func (h *AdminTitleRepositoryMySQL) Delete(id uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&domain.Title{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Where("title_id = ?", id).Delete(&domain.TitleRequirement{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().
+			Where("rewardable_id = ? AND rewardable_type = ?", id, domain.RewardableTypeTitles).
+			Delete(&domain.Reward{}).
+			Error
+	})
+}
+
+func (h *AdminTitleRepositoryMySQL) FindRequirement(titleID uint, requirementID uint) (domain.TitleRequirement, error) {
+	var requirement domain.TitleRequirement
+	err := h.db.Where("title_id = ?", titleID).First(&requirement, requirementID).Error
+
+	return requirement, err
+}
+
+// RequirementAchievers returns a page of the users that completed the
+// requirement, along with their total.
+func (h *AdminTitleRepositoryMySQL) RequirementAchievers(requirementID uint, offset int, limit int) ([]domain.User, int64, error) {
+	query := h.db.Model(&domain.User{}).
+		Joins("JOIN title_progresses ON title_progresses.user_id = users.id AND title_progresses.deleted_at IS NULL").
+		Where("title_progresses.title_requirement_id = ? AND title_progresses.completed = ?", requirementID, true)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var users []domain.User
+	err := query.Order("users.id").Offset(offset).Limit(limit).Find(&users).Error
+
+	return users, total, err
+}
+
+// replaceTitleRequirements updates the listed requirements of the title,
+// creates the new ones and deletes the requirements left out.
+func replaceTitleRequirements(tx *gorm.DB, titleID uint, requirements []domain.TitleRequirement) error {
+	keep := make([]uint, 0, len(requirements))
+	for _, requirement := range requirements {
+		if requirement.ID != 0 {
+			keep = append(keep, requirement.ID)
+		}
+	}
+
+	remove := tx.Where("title_id = ?", titleID)
+	if len(keep) > 0 {
+		remove = remove.Where("id NOT IN ?", keep)
+	}
+
+	if err := remove.Delete(&domain.TitleRequirement{}).Error; err != nil {
+		return err
+	}
+
+	for _, requirement := range requirements {
+		if requirement.ID == 0 {
+			requirement.TitleID = titleID
+			if err := tx.Omit(clause.Associations).Create(&requirement).Error; err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		result := tx.Model(&domain.TitleRequirement{}).
+			Where("id = ? AND title_id = ?", requirement.ID, titleID).
+			Updates(map[string]any{
+				"task":        requirement.Task,
+				"key":         requirement.Key,
+				"goal":        requirement.Goal,
+				"description": requirement.Description,
+				"type":        requirement.Type,
+				"within_days": requirement.WithinDays,
+				"starts_at":   requirement.StartsAt,
+				"ends_at":     requirement.EndsAt,
+				"conditions":  requirement.Conditions,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The requirement %d does not belong to the title.", requirement.ID))
+		}
+	}
+
+	return nil
+}
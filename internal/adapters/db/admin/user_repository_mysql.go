@@ -0,0 +1,227 @@
+package db_admin
+
+import (
+	stdErrors "errors"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	usersMorphType             = "users"
+	adminUserTransactionsLimit = 50
+)
+
+type AdminUserRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAdminUserRepositoryMySQL(db *gorm.DB) ports_admin.AdminUserRepository {
+	return &AdminUserRepositoryMySQL{
+		db: db,
+	}
+}
+
+func (h *AdminUserRepositoryMySQL) GetAll(filters ports_admin.UserFilters, limit int) ([]domain.User, error) {
+	query := h.db.Model(&domain.User{})
+
+	if filters.Search != "" {
+		search := "%" + filters.Search + "%"
+		query = query.Where("name LIKE ? OR email LIKE ? OR nickname LIKE ?", search, search, search)
+	}
+
+	if filters.Blocked != nil {
+		query = query.Where("blocked = ?", *filters.Blocked)
+	}
+
+	if filters.RoleID != 0 {
+		query = query.Where(
+			"id IN (?)",
+			h.db.Model(&domain.Roleable{}).
+				Select("roleable_id").
+				Where("roleable_type = ? AND role_id = ?", usersMorphType, filters.RoleID),
+		)
+	}
+
+	var users []domain.User
+	err := query.Preload("Roles.Role").
+		Order("id DESC").
+		Limit(limit).
+		Find(&users).
+		Error
+
+	return users, err
+}
+
+func (h *AdminUserRepositoryMySQL) FindByID(id uint) (domain.User, error) {
+	var user domain.User
+	err := h.db.Preload("Profile").
+		Preload("Level").
+		Preload("Wallet").
+		Preload("Titles.Title").
+		Preload("Roles.Role").
+		Preload("Permissions.Permission").
+		Preload("Transactions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id DESC").Limit(adminUserTransactionsLimit)
+		}).
+		Preload("Transactions.TransactionType").
+		Preload("Missions.Mission").
+		Preload("MissionProgresses.MissionRequirement.Mission").
+		First(&user, id).
+		Error
+
+	return user, err
+}
+
+func (h *AdminUserRepositoryMySQL) Block(id uint, reason string, until *time.Time) error {
+	return h.updateUser(id, map[string]any{
+		"blocked":        true,
+		"blocked_reason": reason,
+		"blocked_until":  until,
+	})
+}
+
+func (h *AdminUserRepositoryMySQL) Unblock(id uint) error {
+	return h.updateUser(id, map[string]any{
+		"blocked":        false,
+		"blocked_reason": nil,
+		"blocked_until":  nil,
+	})
+}
+
+// AdjustWallet moves the wallet balance and records the movement as an user
+// transaction. Debits can not take the balance below zero.
+func (h *AdminUserRepositoryMySQL) AdjustWallet(id uint, amount int, description string) (domain.Transaction, error) {
+	transaction := domain.Transaction{
+		Amount:            uint(amount),
+		Description:       description,
+		UserID:            id,
+		TransactionTypeID: domain.AdditionTransactionTypeID,
+		TransactionType:   domain.TransactionType{ID: domain.AdditionTransactionTypeID, Type: domain.AdditionTransactionType},
+	}
+
+	if amount < 0 {
+		transaction.Amount = uint(-amount)
+		transaction.TransactionTypeID = domain.SubtractionTransactionTypeID
+		transaction.TransactionType = domain.TransactionType{ID: domain.SubtractionTransactionTypeID, Type: domain.SubtractionTransactionType}
+	}
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.Wallet{}).
+			Where("user_id = ? AND amount + ? >= 0", id, amount).
+			Update("amount", gorm.Expr("amount + ?", amount))
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			var wallet domain.Wallet
+			if err := tx.Where("user_id = ?", id).First(&wallet).Error; err != nil {
+				return err
+			}
+
+			return errors.NewHttpError(http.StatusUnprocessableEntity, "The wallet balance can not go below zero.")
+		}
+
+		return tx.Omit("TransactionType", "User").Create(&transaction).Error
+	})
+
+	return transaction, err
+}
+
+func (h *AdminUserRepositoryMySQL) GrantTitle(id uint, titleID uint) (domain.UserTitle, error) {
+	userTitle := domain.UserTitle{
+		UserID:  id,
+		TitleID: titleID,
+	}
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		var title domain.Title
+		if err := tx.First(&title, titleID).Error; err != nil {
+			if stdErrors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.NewHttpError(http.StatusUnprocessableEntity, "The title could not be found.")
+			}
+
+			return err
+		}
+
+		var owned int64
+		if err := tx.Model(&domain.UserTitle{}).Where("user_id = ? AND title_id = ?", id, titleID).Count(&owned).Error; err != nil {
+			return err
+		}
+
+		if owned > 0 {
+			return errors.NewHttpError(http.StatusConflict, "The user already has this title.")
+		}
+
+		if err := tx.Create(&userTitle).Error; err != nil {
+			return err
+		}
+
+		userTitle.Title = title
+
+		return nil
+	})
+
+	return userTitle, err
+}
+
+func (h *AdminUserRepositoryMySQL) RevokeSessions(id uint, at time.Time) error {
+	return h.updateUser(id, map[string]any{
+		"sessions_revoked_at": at,
+	})
+}
+
+// CreatePasswordReset stores a reset token for the user and revokes their
+// sessions, so the current password stops granting access right away.
+func (h *AdminUserRepositoryMySQL) CreatePasswordReset(id uint, reset *domain.PasswordReset) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.User{}).Where("id = ?", id).Update("sessions_revoked_at", reset.CreatedAt)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Create(reset).Error
+	})
+}
+
+func (h *AdminUserRepositoryMySQL) SyncRoles(id uint, roleIDs []uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&domain.User{}, id).Error; err != nil {
+			return err
+		}
+
+		return syncRoleables(tx, id, roleIDs)
+	})
+}
+
+func (h *AdminUserRepositoryMySQL) SyncPermissions(id uint, permissionIDs []uint) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&domain.User{}, id).Error; err != nil {
+			return err
+		}
+
+		return syncPermissionables(tx, id, usersMorphType, permissionIDs)
+	})
+}
+
+func (h *AdminUserRepositoryMySQL) updateUser(id uint, fields map[string]any) error {
+	result := h.db.Model(&domain.User{}).Where("id = ?", id).Updates(fields)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package db
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CheckInRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewCheckInRepositoryMySQL(db *gorm.DB) ports.CheckInRepository {
+	return &CheckInRepositoryMySQL{db: db}
+}
+
+// GetStreak returns the streak of the user, which is empty until the first
+// check-in.
+func (h *CheckInRepositoryMySQL) GetStreak(userID uint) (domain.CheckInStreak, error) {
+	streak := domain.CheckInStreak{UserID: userID}
+	err := h.db.Where("user_id = ?", userID).Limit(1).Find(&streak).Error
+
+	return streak, err
+}
+
+func (h *CheckInRepositoryMySQL) GetRecent(userID uint, limit int) ([]domain.CheckIn, error) {
+	var checkIns []domain.CheckIn
+	err := h.db.Where("user_id = ?", userID).Order("day DESC").Limit(limit).Find(&checkIns).Error
+
+	return checkIns, err
+}
+
+func (h *CheckInRepositoryMySQL) GetRewardTable() ([]domain.CheckInReward, error) {
+	var table []domain.CheckInReward
+	err := h.db.Preload("Rewards").Order("streak").Find(&table).Error
+
+	return table, err
+}
+
+// CheckIn records the check-in of the day along with the days the freezes
+// covered, so the same day can only be checked in once.
+func (h *CheckInRepositoryMySQL) CheckIn(userID uint, day time.Time, table []domain.CheckInReward) (domain.CheckInResult, error) {
+	var result domain.CheckInResult
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		streak, err := lockStreak(tx, userID)
+		if err != nil {
+			return err
+		}
+
+		frozenDays, ok := streak.CheckIn(day)
+		if !ok {
+			return errors.NewHttpError(http.StatusConflict, "You have already checked in today.")
+		}
+
+		if err := tx.Omit("User").Save(&streak).Error; err != nil {
+			return err
+		}
+
+		firstStreak := streak.Current - uint(len(frozenDays))
+		for i, frozenDay := range frozenDays {
+			frozen := domain.CheckIn{
+				UserID: userID,
+				Day:    frozenDay,
+				Streak: firstStreak + uint(i),
+				Frozen: true,
+			}
+
+			if err := tx.Omit("User").Create(&frozen).Error; err != nil {
+				return err
+			}
+		}
+
+		rewards := domain.CheckInRewardsFor(table, streak.Current)
+		checkIn := domain.CheckIn{
+			UserID: userID,
+			Day:    day,
+			Streak: streak.Current,
+		}
+
+		for _, reward := range rewards {
+			checkIn.Coins += reward.Coins
+			checkIn.Experience += reward.Experience
+		}
+
+		if err := tx.Omit("User").Create(&checkIn).Error; err != nil {
+			return err
+		}
+
+		result = domain.CheckInResult{
+			CheckIn:    checkIn,
+			Streak:     streak,
+			FrozenDays: frozenDays,
+			Rewards:    rewards,
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// BuyFreeze takes the cost of a streak freeze from the wallet of the user and
+// records the purchase on the ledger, as long as the user holds fewer freezes
+// than the limit.
+func (h *CheckInRepositoryMySQL) BuyFreeze(userID uint, cost uint, limit uint) (domain.CheckInStreak, domain.Transaction, error) {
+	streak := domain.CheckInStreak{UserID: userID}
+	transaction := domain.Transaction{
+		Amount:            cost,
+		Description:       fmt.Sprintf("Purchase of a streak freeze by %d coins.", cost),
+		UserID:            userID,
+		TransactionTypeID: domain.SubtractionTransactionTypeID,
+	}
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if streak, err = lockStreak(tx, userID); err != nil {
+			return err
+		}
+
+		if streak.Freezes >= limit {
+			return errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("You can not hold more than %d streak freezes.", limit))
+		}
+
+		result := tx.Model(&domain.Wallet{}).
+			Where("user_id = ? AND amount >= ?", userID, cost).
+			Update("amount", gorm.Expr("amount - ?", cost))
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return errors.NewHttpError(http.StatusUnprocessableEntity, "Insufficient funds to purchase the streak freeze!")
+		}
+
+		streak.Freezes++
+		if err := tx.Omit("User").Save(&streak).Error; err != nil {
+			return err
+		}
+
+		return tx.Omit("TransactionType", "User").Create(&transaction).Error
+	})
+
+	return streak, transaction, err
+}
+
+// lockStreak reads the streak of the user holding its row until the
+// transaction ends, so concurrent check-ins and freeze purchases apply one
+// after the other.
+func lockStreak(tx *gorm.DB, userID uint) (domain.CheckInStreak, error) {
+	streak := domain.CheckInStreak{UserID: userID}
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", userID).Limit(1).Find(&streak).Error
+
+	return streak, err
+}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+
+	"gorm.io/gorm"
+)
+
+type CrackRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewCrackRepositoryMySQL(db *gorm.DB) ports.CrackRepository {
+	return &CrackRepositoryMySQL{db: db}
+}
+
+func (h *CrackRepositoryMySQL) GetHistoryByGameSlug(slug string) ([]domain.CrackStatusChange, error) {
+	var changes []domain.CrackStatusChange
+	if err := h.db.Model(&domain.CrackStatusChange{}).
+		Preload("Cracker").
+		Preload("Protection").
+		Joins("JOIN games ON games.id = crack_status_changes.game_id").
+		Where("games.slug = ?", slug).
+		Order("crack_status_changes.created_at ASC").
+		Find(&changes).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func (h *CrackRepositoryMySQL) FindForStats() ([]domain.Crack, error) {
+	var cracks []domain.Crack
+	if err := h.db.Model(&domain.Crack{}).
+		Preload("Cracker").
+		Preload("Protection").
+		Find(&cracks).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return cracks, nil
+}
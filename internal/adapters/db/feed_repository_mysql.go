@@ -0,0 +1,105 @@
+package db
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type FeedRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewFeedRepositoryMySQL(db *gorm.DB) ports.FeedRepository {
+	return &FeedRepositoryMySQL{db: db}
+}
+
+func (h *FeedRepositoryMySQL) UpcomingReleases(filters ports.ReleaseFeedFilters) ([]domain.Game, error) {
+	var games []domain.Game
+
+	now := time.Now()
+
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	query := h.db.Model(&domain.Game{}).
+		Where("release_date >= ?", midnight)
+
+	if filters.Genre != "" {
+		query = query.Where("id IN (?)", h.db.Table("genreables").
+			Select("genreables.genreable_id").
+			Joins("JOIN genres ON genres.id = genreables.genre_id").
+			Where("genreables.genreable_type = ? AND genres.slug = ?", "games", filters.Genre))
+	}
+
+	if filters.Platform != "" {
+		query = query.Where("id IN (?)", h.db.Table("platformables").
+			Select("platformables.platformable_id").
+			Joins("JOIN platforms ON platforms.id = platformables.platform_id").
+			Where("platformables.platformable_type = ? AND platforms.slug = ?", "games", filters.Platform))
+	}
+
+	if filters.FollowedBy != 0 {
+		query = query.Where("id IN (?)", h.db.Model(&domain.GameFollow{}).Select("game_id").Where("user_id = ?", filters.FollowedBy))
+	}
+
+	err := query.Order("release_date ASC").Limit(200).Find(&games).Error
+
+	return games, err
+}
+
+func (h *FeedRepositoryMySQL) LatestGames(limit int) ([]domain.Game, error) {
+	var games []domain.Game
+	err := h.db.Model(&domain.Game{}).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&games).Error
+
+	return games, err
+}
+
+func (h *FeedRepositoryMySQL) LatestDLCs(limit int) ([]domain.DLC, error) {
+	var dlcs []domain.DLC
+	err := h.db.Model(&domain.DLC{}).
+		Preload("Game").
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&dlcs).Error
+
+	return dlcs, err
+}
+
+func (h *FeedRepositoryMySQL) LatestCrackChanges(limit int) ([]domain.CrackStatusChange, error) {
+	var changes []domain.CrackStatusChange
+	err := h.db.Model(&domain.CrackStatusChange{}).
+		Preload("Game").
+		Preload("Cracker").
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&changes).Error
+
+	return changes, err
+}
+
+func (h *FeedRepositoryMySQL) FindToken(token string) (*domain.FeedToken, error) {
+	var feedToken domain.FeedToken
+	if err := h.db.Where("token = ?", token).First(&feedToken).Error; err != nil {
+		return nil, err
+	}
+
+	return &feedToken, nil
+}
+
+func (h *FeedRepositoryMySQL) FindTokenForUser(userID uint) (*domain.FeedToken, error) {
+	var feedToken domain.FeedToken
+	if err := h.db.Where("user_id = ?", userID).First(&feedToken).Error; err != nil {
+		return nil, err
+	}
+
+	return &feedToken, nil
+}
+
+func (h *FeedRepositoryMySQL) SaveToken(token *domain.FeedToken) error {
+	return h.db.Save(token).Error
+}
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+
+	"gorm.io/gorm"
+)
+
+type GameFollowRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewGameFollowRepositoryMySQL(db *gorm.DB) ports.GameFollowRepository {
+	return &GameFollowRepositoryMySQL{db: db}
+}
+
+func (h *GameFollowRepositoryMySQL) GetAllForUser(userID uint) ([]domain.GameFollow, error) {
+	var follows []domain.GameFollow
+	if err := h.db.Model(&domain.GameFollow{}).
+		Preload("Game").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&follows).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return follows, nil
+}
+
+func (h *GameFollowRepositoryMySQL) FindByID(id uint) (*domain.GameFollow, error) {
+	var follow domain.GameFollow
+	if err := h.db.First(&follow, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &follow, nil
+}
+
+func (h *GameFollowRepositoryMySQL) FindForUser(gameID uint, userID uint) (*domain.GameFollow, error) {
+	var follow domain.GameFollow
+	if err := h.db.Model(&domain.GameFollow{}).
+		Where("game_id = ? AND user_id = ?", gameID, userID).
+		First(&follow).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &follow, nil
+}
+
+func (h *GameFollowRepositoryMySQL) FindByToken(token string) (*domain.GameFollow, error) {
+	var follow domain.GameFollow
+	if err := h.db.Model(&domain.GameFollow{}).
+		Where("token = ?", token).
+		First(&follow).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &follow, nil
+}
+
+func (h *GameFollowRepositoryMySQL) FindFollowersForEvent(gameID uint, event string) ([]domain.GameFollow, error) {
+	column, ok := domain.FollowEventColumn(event)
+	if !ok {
+		return nil, fmt.Errorf("unknown follow event: %s", event)
+	}
+
+	var follows []domain.GameFollow
+	if err := h.db.Model(&domain.GameFollow{}).
+		Preload("User").
+		Where("game_id = ?", gameID).
+		Where(column+" = ?", true).
+		Find(&follows).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return follows, nil
+}
+
+func (h *GameFollowRepositoryMySQL) GameExists(gameID uint) (bool, error) {
+	var count int64
+	if err := h.db.Model(&domain.Game{}).Where("id = ?", gameID).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (h *GameFollowRepositoryMySQL) Save(follow *domain.GameFollow) error {
+	return h.db.Save(follow).Error
+}
+
+func (h *GameFollowRepositoryMySQL) Delete(id uint) error {
+	return h.db.Unscoped().Delete(&domain.GameFollow{}, id).Error
+}
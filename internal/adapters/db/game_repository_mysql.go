@@ -137,7 +137,7 @@ func (h *GameRepositoryMySQL) FindGamesByCondition(condition string, limit *uint
 	return games, nil
 }
 
-func (h *GameRepositoryMySQL) FindBySlug(slug string, userID uint) (domain.Game, error) {
+func (h *GameRepositoryMySQL) FindBySlug(slug string, userID uint, region string) (domain.Game, error) {
 	var game domain.Game
 	if err := h.db.Preload("Categories.Category").
 		Preload("Genres.Genre").
@@ -153,10 +153,12 @@ func (h *GameRepositoryMySQL) FindBySlug(slug string, userID uint) (domain.Game,
 		Preload("Reviews.User.Profile").
 		Preload("Critics.Critic").
 		Preload("Stores.Store").
+		Preload("Stores.Prices", "region = ?", region).
 		Preload("Galleries.MediaType").
 		Preload("DLCs.Galleries.MediaType").
 		Preload("DLCs.Platforms.Platform").
 		Preload("DLCs.Stores.Store").
+		Preload("DLCs.Stores.Prices", "region = ?", region).
 		Preload("Comments", "parent_id IS NULL").
 		Preload("Comments.Hearts").
 		Preload("Comments.User").
@@ -205,7 +207,7 @@ func (h *GameRepositoryMySQL) ExistsForStore(storeID uint, appID uint) (bool, er
 	return count > 0, nil
 }
 
-func (h *GameRepositoryMySQL) Search(input string) ([]domain.Game, error) {
+func (h *GameRepositoryMySQL) Search(input string, filters ports.GameSearchFilters) ([]domain.Game, error) {
 	var games []domain.Game
 
 	likeInput := "%" + input + "%"
@@ -222,8 +224,28 @@ func (h *GameRepositoryMySQL) Search(input string) ([]domain.Game, error) {
 
 	columns := []string{"title", "description", "about", "short_description"}
 
+	searchQuery := h.db
 	for _, column := range columns {
-		query = query.Or(column+" LIKE ?", likeInput)
+		searchQuery = searchQuery.Or(column+" LIKE ?", likeInput)
+	}
+
+	query = query.Where(searchQuery)
+
+	if filters.MinPrice != nil || filters.MaxPrice != nil {
+		priceQuery := h.db.Table("game_stores").
+			Select("1").
+			Joins("JOIN store_prices ON store_prices.priceable_id = game_stores.id AND store_prices.priceable_type = ?", domain.PriceableTypeGameStores).
+			Where("game_stores.game_id = games.id AND game_stores.deleted_at IS NULL AND store_prices.region = ?", filters.Region)
+
+		if filters.MinPrice != nil {
+			priceQuery = priceQuery.Where("store_prices.final_price >= ?", *filters.MinPrice)
+		}
+
+		if filters.MaxPrice != nil {
+			priceQuery = priceQuery.Where("store_prices.final_price <= ?", *filters.MaxPrice)
+		}
+
+		query = query.Where("EXISTS (?)", priceQuery)
 	}
 
 	if err := query.Limit(100).Find(&games).Error; err != nil {
@@ -293,18 +315,22 @@ func (h *GameRepositoryMySQL) FindByClassification(classification string, filter
 	return games, nil
 }
 
-func (h *GameRepositoryMySQL) CalendarGames() ([]domain.Game, error) {
+func (h *GameRepositoryMySQL) CalendarGames(followedBy uint) ([]domain.Game, error) {
 	var games []domain.Game
 
 	now := time.Now()
 
 	oneMonthAgo := now.AddDate(0, -1, 0)
 
-	err := h.db.Model(&domain.Game{}).
+	query := h.db.Model(&domain.Game{}).
 		Preload("Crack").
-		Where("release_date >= ?", oneMonthAgo).
-		Limit(100).
-		Find(&games).Error
+		Where("release_date >= ?", oneMonthAgo)
+
+	if followedBy != 0 {
+		query = query.Where("id IN (?)", h.db.Model(&domain.GameFollow{}).Select("game_id").Where("user_id = ?", followedBy))
+	}
+
+	err := query.Limit(100).Find(&games).Error
 
 	return games, err
 }
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type LeaderboardRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewLeaderboardRepositoryMySQL(db *gorm.DB) ports.LeaderboardRepository {
+	return &LeaderboardRepositoryMySQL{db: db}
+}
+
+type leaderboardRow struct {
+	UserID uint
+	Score  float64
+}
+
+func (h *LeaderboardRepositoryMySQL) GetUsersByIDs(ids []uint) ([]domain.User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var users []domain.User
+	err := h.db.Preload("Profile").Where("id IN ?", ids).Find(&users).Error
+
+	return users, err
+}
+
+func (h *LeaderboardRepositoryMySQL) GetBlockedUserIDs(now time.Time) ([]uint, error) {
+	var ids []uint
+	err := h.db.Model(&domain.User{}).
+		Where("blocked = ? AND (blocked_until IS NULL OR blocked_until > ?)", true, now).
+		Pluck("id", &ids).
+		Error
+
+	return ids, err
+}
+
+// GetScores computes the scores of the period from the records the points
+// come from. Missions only keep their latest completion, so recurring missions
+// completed again count once, along with their experience.
+func (h *LeaderboardRepositoryMySQL) GetScores(period domain.LeaderboardPeriod) (map[uint]float64, error) {
+	if period.Board == domain.LeaderboardExperience && period.IsAllTime() {
+		return h.getTotalExperience()
+	}
+
+	var query *gorm.DB
+	switch period.Board {
+	case domain.LeaderboardExperience:
+		query = h.db.Model(&domain.UserMission{}).
+			Select("user_missions.user_id, SUM(missions.experience) AS score").
+			Joins("JOIN missions ON missions.id = user_missions.mission_id").
+			Where("user_missions.last_completed_at >= ? AND user_missions.last_completed_at < ?", period.Start, period.End).
+			Group("user_missions.user_id")
+	case domain.LeaderboardCoins:
+		query = h.db.Model(&domain.Transaction{}).
+			Select("user_id, SUM(amount) AS score").
+			Where("transaction_type_id = ?", domain.AdditionTransactionTypeID).
+			Group("user_id")
+
+		if !period.IsAllTime() {
+			query = query.Where("created_at >= ? AND created_at < ?", period.Start, period.End)
+		}
+	case domain.LeaderboardTitles:
+		query = h.db.Model(&domain.UserTitle{}).
+			Select("user_id, COUNT(*) AS score").
+			Group("user_id")
+
+		if !period.IsAllTime() {
+			query = query.Where("created_at >= ? AND created_at < ?", period.Start, period.End)
+		}
+	case domain.LeaderboardMissions:
+		start := period.Start
+		if period.IsAllTime() {
+			start = time.Unix(0, 0).UTC()
+		}
+
+		query = h.db.Model(&domain.UserMission{}).
+			Select("user_id, COUNT(*) AS score").
+			Where("last_completed_at >= ?", start).
+			Group("user_id")
+
+		if !period.IsAllTime() {
+			query = query.Where("last_completed_at < ?", period.End)
+		}
+	default:
+		return map[uint]float64{}, nil
+	}
+
+	var rows []leaderboardRow
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	return scoresFromRows(rows), nil
+}
+
+// getTotalExperience sums the experience needed to reach the level of each
+// user with the experience gained since.
+func (h *LeaderboardRepositoryMySQL) getTotalExperience() (map[uint]float64, error) {
+	var rows []leaderboardRow
+	if err := h.db.Model(&domain.User{}).
+		Select("users.id AS user_id, users.experience + COALESCE(SUM(levels.experience), 0) AS score").
+		Joins("JOIN levels AS current_levels ON current_levels.id = users.level_id").
+		Joins("LEFT JOIN levels ON levels.level <= current_levels.level AND levels.deleted_at IS NULL").
+		Group("users.id, users.experience").
+		Scan(&rows).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return scoresFromRows(rows), nil
+}
+
+func scoresFromRows(rows []leaderboardRow) map[uint]float64 {
+	scores := make(map[uint]float64, len(rows))
+	for _, row := range rows {
+		if row.Score > 0 {
+			scores[row.UserID] = row.Score
+		}
+	}
+
+	return scores
+}
@@ -0,0 +1,196 @@
+package db
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+
+	"gorm.io/gorm"
+)
+
+type LibraryRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewLibraryRepositoryMySQL(db *gorm.DB) ports.LibraryRepository {
+	return &LibraryRepositoryMySQL{db: db}
+}
+
+func (h *LibraryRepositoryMySQL) GetAllForUser(userID uint, status string, onlyPublic bool) ([]domain.Libraryable, error) {
+	var entries []domain.Libraryable
+
+	query := h.db.Model(&domain.Libraryable{}).
+		Where("libraryables.user_id = ?", userID)
+
+	if status != "" {
+		query = query.Where("libraryables.status = ?", status)
+	}
+
+	if onlyPublic {
+		query = query.Joins("JOIN profiles ON profiles.user_id = libraryables.user_id").
+			Where("profiles.share = ? AND libraryables.private = ?", true, false)
+	}
+
+	if err := query.Order("libraryables.updated_at DESC").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	if err := h.loadLibraryables(entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (h *LibraryRepositoryMySQL) FindByID(id uint) (*domain.Libraryable, error) {
+	var entry domain.Libraryable
+	if err := h.db.First(&entry, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func (h *LibraryRepositoryMySQL) FindForUser(libraryableID uint, libraryableType string, userID uint) (*domain.Libraryable, error) {
+	var entry domain.Libraryable
+
+	if err := h.db.Model(&domain.Libraryable{}).
+		Where("libraryable_id = ? AND libraryable_type = ? AND user_id = ?", libraryableID, libraryableType, userID).
+		First(&entry).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func (h *LibraryRepositoryMySQL) LibraryableExists(libraryableID uint, libraryableType string) (bool, error) {
+	var model any
+	switch libraryableType {
+	case domain.LibraryableTypeGames:
+		model = &domain.Game{}
+	case domain.LibraryableTypeDLCs:
+		model = &domain.DLC{}
+	default:
+		return false, nil
+	}
+
+	var count int64
+	if err := h.db.Model(model).Where("id = ?", libraryableID).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// HasTrackedAction tells whether the action was already tracked for the user
+// on the game or DLC, even if the library entry was removed since.
+func (h *LibraryRepositoryMySQL) HasTrackedAction(userID uint, actionKey string, libraryableID uint, libraryableType string) (bool, error) {
+	var count int64
+	if err := h.db.Model(&domain.UserAction{}).
+		Where("user_id = ? AND `key` = ? AND target_type = ? AND target_id = ?", userID, actionKey, libraryableType, libraryableID).
+		Count(&count).
+		Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (h *LibraryRepositoryMySQL) Save(libraryable *domain.Libraryable) error {
+	return h.db.Save(libraryable).Error
+}
+
+func (h *LibraryRepositoryMySQL) Delete(id uint) error {
+	return h.db.Unscoped().Delete(&domain.Libraryable{}, id).Error
+}
+
+func (h *LibraryRepositoryMySQL) GetStatsForUser(userID uint) (domain.LibraryStats, error) {
+	var stats domain.LibraryStats
+
+	var rows []struct {
+		Status      string
+		Total       uint
+		HoursPlayed uint
+	}
+
+	if err := h.db.Model(&domain.Libraryable{}).
+		Select("status, COUNT(*) AS total, COALESCE(SUM(hours_played), 0) AS hours_played").
+		Where("user_id = ?", userID).
+		Group("status").
+		Scan(&rows).
+		Error; err != nil {
+		return stats, err
+	}
+
+	for _, row := range rows {
+		stats.Total += row.Total
+		stats.HoursPlayed += row.HoursPlayed
+
+		switch row.Status {
+		case domain.LibraryWishlist:
+			stats.Wishlist = row.Total
+		case domain.LibraryPlaying:
+			stats.Playing = row.Total
+		case domain.LibraryCompleted:
+			stats.Completed = row.Total
+		case domain.LibraryDropped:
+			stats.Dropped = row.Total
+		case domain.LibraryBacklog:
+			stats.Backlog = row.Total
+		}
+	}
+
+	return stats, nil
+}
+
+func (h *LibraryRepositoryMySQL) loadLibraryables(entries []domain.Libraryable) error {
+	var gameIDs, dlcIDs []uint
+	for _, entry := range entries {
+		switch entry.LibraryableType {
+		case domain.LibraryableTypeGames:
+			gameIDs = append(gameIDs, entry.LibraryableID)
+		case domain.LibraryableTypeDLCs:
+			dlcIDs = append(dlcIDs, entry.LibraryableID)
+		}
+	}
+
+	gameMap := make(map[uint]domain.Game)
+	if len(gameIDs) > 0 {
+		var games []domain.Game
+		if err := h.db.Where("id IN (?)", gameIDs).Find(&games).Error; err != nil {
+			return err
+		}
+
+		for _, game := range games {
+			gameMap[game.ID] = game
+		}
+	}
+
+	dlcMap := make(map[uint]domain.DLC)
+	if len(dlcIDs) > 0 {
+		var dlcs []domain.DLC
+		if err := h.db.Where("id IN (?)", dlcIDs).Find(&dlcs).Error; err != nil {
+			return err
+		}
+
+		for _, dlc := range dlcs {
+			dlcMap[dlc.ID] = dlc
+		}
+	}
+
+	for i := range entries {
+		entry := &entries[i]
+		switch entry.LibraryableType {
+		case domain.LibraryableTypeGames:
+			if game, ok := gameMap[entry.LibraryableID]; ok {
+				entry.Libraryable = &game
+			}
+		case domain.LibraryableTypeDLCs:
+			if dlc, ok := dlcMap[entry.LibraryableID]; ok {
+				entry.Libraryable = &dlc
+			}
+		}
+	}
+
+	return nil
+}
@@ -3,7 +3,9 @@ package db
 import (
 	"fmt"
 	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
 	"gcstatus/internal/ports"
+	"net/http"
 	"time"
 
 	"gorm.io/gorm"
@@ -28,50 +30,28 @@ func (h *MissionRepositoryMySQL) GetAllForUser(userID uint) ([]*domain.Mission,
 
 	err := h.db.
 		Model(&domain.Mission{}).
-		Joins("LEFT JOIN user_missions ON user_missions.mission_id = missions.id AND user_missions.user_id = ?", userID).
-		Where("missions.for_all = ? OR user_missions.user_id = ?", true, userID).
+		Joins("LEFT JOIN user_mission_assignments ON user_mission_assignments.mission_id = missions.id AND user_mission_assignments.user_id = ? AND user_mission_assignments.deleted_at IS NULL", userID).
+		Where("missions.for_all = ? OR user_mission_assignments.user_id = ?", true, userID).
 		Where("missions.status NOT IN (?, ?)", domain.MissionUnavailable, domain.MissionCanceled).
 		Preload("MissionRequirements").
 		Preload("MissionRequirements.MissionProgress", "user_id = ?", userID).
 		Preload("UserMission", "user_id = ?", userID).
-		Preload("Rewards", "rewardable_type = ?", "titles").
+		Preload("Rewards").
 		Find(&missions).
 		Error
 	if err != nil {
 		return nil, err
 	}
 
-	var titleIDs []uint
+	var rewards []*domain.Reward
 	for _, mission := range missions {
-		for _, reward := range mission.Rewards {
-			if reward.RewardableType == domain.RewardableTypeTitles {
-				titleIDs = append(titleIDs, reward.RewardableID)
-			}
-		}
-	}
-
-	var titles []domain.Title
-	if len(titleIDs) > 0 {
-		err = h.db.Where("id IN (?)", titleIDs).Find(&titles).Error
-		if err != nil {
-			return nil, err
+		for i := range mission.Rewards {
+			rewards = append(rewards, &mission.Rewards[i])
 		}
 	}
 
-	titleMap := make(map[uint]domain.Title)
-	for _, title := range titles {
-		titleMap[title.ID] = title
-	}
-
-	for _, mission := range missions {
-		for i := range mission.Rewards {
-			reward := &mission.Rewards[i]
-			if reward.RewardableType == domain.RewardableTypeTitles {
-				if title, ok := titleMap[reward.RewardableID]; ok {
-					reward.Rewardable = &title
-				}
-			}
-		}
+	if err := loadRewardables(h.db, rewards); err != nil {
+		return nil, err
 	}
 
 	return missions, nil
@@ -99,7 +79,7 @@ func (h *MissionRepositoryMySQL) CompleteMission(userID uint, missionID uint) er
 	}
 
 	if userMission.Completed {
-		return fmt.Errorf("mission already completed by user")
+		return errors.NewHttpError(http.StatusConflict, "You have already completed this mission.")
 	}
 
 	var requirements []domain.MissionRequirement
@@ -118,11 +98,17 @@ func (h *MissionRepositoryMySQL) CompleteMission(userID uint, missionID uint) er
 		}
 	}
 
-	userMission.Completed = true
-	userMission.LastCompletedAt = time.Now()
+	// Only the request flipping the completed flag gets to complete the
+	// mission, so concurrent requests can not grant its rewards twice.
+	result := h.db.Model(&domain.UserMission{}).
+		Where("id = ? AND completed = ?", userMission.ID, false).
+		Updates(map[string]any{"completed": true, "last_completed_at": time.Now()})
+	if result.Error != nil {
+		return fmt.Errorf("error updating user mission completion: %w", result.Error)
+	}
 
-	if err := h.db.Save(&userMission).Error; err != nil {
-		return fmt.Errorf("error updating user mission completion: %w", err)
+	if result.RowsAffected == 0 {
+		return errors.NewHttpError(http.StatusConflict, "You have already completed this mission.")
 	}
 
 	return nil
@@ -0,0 +1,113 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type MultiplierRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewMultiplierRepositoryMySQL(db *gorm.DB) ports.MultiplierRepository {
+	return &MultiplierRepositoryMySQL{db: db}
+}
+
+// GetActive returns the multiplier events running at the given time and the
+// boosters the user is running.
+func (h *MultiplierRepositoryMySQL) GetActive(userID uint, at time.Time) ([]domain.MultiplierEvent, []domain.UserBooster, error) {
+	return loadActive(h.db, userID, at)
+}
+
+func (h *MultiplierRepositoryMySQL) GetPurchasableBoosters() ([]domain.Booster, error) {
+	var boosters []domain.Booster
+	err := h.db.Where("purchasable = ?", true).Order("cost ASC").Find(&boosters).Error
+
+	return boosters, err
+}
+
+// BuyBooster takes the cost of the booster from the wallet of the user,
+// records the purchase on the ledger and runs the booster for the user.
+func (h *MultiplierRepositoryMySQL) BuyBooster(userID uint, boosterID uint) (domain.UserBooster, domain.Transaction, error) {
+	var userBooster domain.UserBooster
+	var transaction domain.Transaction
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		var booster domain.Booster
+		if err := tx.Where("purchasable = ?", true).First(&booster, boosterID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return self_errors.NewHttpError(http.StatusNotFound, "The booster you are trying to buy does not exist.")
+			}
+
+			return err
+		}
+
+		result := tx.Model(&domain.Wallet{}).
+			Where("user_id = ? AND amount >= ?", userID, booster.Cost).
+			Update("amount", gorm.Expr("amount - ?", booster.Cost))
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return self_errors.NewHttpError(http.StatusUnprocessableEntity, "Insufficient funds to purchase the booster!")
+		}
+
+		transaction = domain.Transaction{
+			Amount:            booster.Cost,
+			Description:       fmt.Sprintf("Purchase of the booster %s by %d coins.", booster.Name, booster.Cost),
+			UserID:            userID,
+			TransactionTypeID: domain.SubtractionTransactionTypeID,
+		}
+
+		if err := tx.Omit("TransactionType", "User").Create(&transaction).Error; err != nil {
+			return err
+		}
+
+		var err error
+		userBooster, err = activateBooster(tx, userID, booster, booster.Duration())
+		if err != nil {
+			return err
+		}
+
+		userBooster.Booster = booster
+
+		return nil
+	})
+
+	return userBooster, transaction, err
+}
+
+// loadActive loads the multiplier events running at the given time and the
+// boosters the user is running.
+func loadActive(db *gorm.DB, userID uint, at time.Time) ([]domain.MultiplierEvent, []domain.UserBooster, error) {
+	var events []domain.MultiplierEvent
+	if err := db.Where("starts_at <= ? AND ends_at > ?", at, at).Order("ends_at ASC").Find(&events).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var boosters []domain.UserBooster
+	if err := db.Preload("Booster").Where("user_id = ? AND expires_at > ?", userID, at).Order("expires_at ASC").Find(&boosters).Error; err != nil {
+		return nil, nil, err
+	}
+
+	return events, boosters, nil
+}
+
+// loadMultipliers works out the multipliers of the user for the action from
+// the events and boosters active at the given time.
+func loadMultipliers(db *gorm.DB, userID uint, actionKey string, at time.Time) (domain.Multipliers, error) {
+	events, boosters, err := loadActive(db, userID, at)
+	if err != nil {
+		return domain.Multipliers{}, err
+	}
+
+	return domain.NewMultipliers(events, boosters, actionKey, at), nil
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"gcstatus/internal/domain"
 	"gcstatus/internal/ports"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -28,3 +29,10 @@ func (h *PasswordResetRepositoryMySQL) FindPasswordResetByToken(token string) (*
 func (repo *PasswordResetRepositoryMySQL) DeletePasswordResetByID(id uint) error {
 	return repo.db.Delete(&domain.PasswordReset{}, id).Error
 }
+
+// DeleteExpiredPasswordResets removes the password resets expired before the
+// given time, along with the ones already used, which were soft deleted.
+func (repo *PasswordResetRepositoryMySQL) DeleteExpiredPasswordResets(before time.Time) (int64, error) {
+	result := repo.db.Unscoped().Where("expires_at < ? OR deleted_at IS NOT NULL", before).Delete(&domain.PasswordReset{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+
+	"gorm.io/gorm"
+)
+
+type PriceAlertRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewPriceAlertRepositoryMySQL(db *gorm.DB) ports.PriceAlertRepository {
+	return &PriceAlertRepositoryMySQL{db: db}
+}
+
+func (h *PriceAlertRepositoryMySQL) GetAllForUser(userID uint) ([]domain.PriceAlert, error) {
+	var alerts []domain.PriceAlert
+	if err := h.db.Model(&domain.PriceAlert{}).
+		Preload("Game").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&alerts).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+func (h *PriceAlertRepositoryMySQL) FindByID(id uint) (*domain.PriceAlert, error) {
+	var alert domain.PriceAlert
+	if err := h.db.First(&alert, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &alert, nil
+}
+
+func (h *PriceAlertRepositoryMySQL) FindForUser(gameID uint, userID uint) (*domain.PriceAlert, error) {
+	var alert domain.PriceAlert
+	if err := h.db.Model(&domain.PriceAlert{}).
+		Where("game_id = ? AND user_id = ?", gameID, userID).
+		First(&alert).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &alert, nil
+}
+
+func (h *PriceAlertRepositoryMySQL) GameExists(gameID uint) (bool, error) {
+	var count int64
+	if err := h.db.Model(&domain.Game{}).Where("id = ?", gameID).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (h *PriceAlertRepositoryMySQL) Save(alert *domain.PriceAlert) error {
+	return h.db.Save(alert).Error
+}
+
+func (h *PriceAlertRepositoryMySQL) Delete(id uint) error {
+	return h.db.Unscoped().Delete(&domain.PriceAlert{}, id).Error
+}
@@ -45,3 +45,11 @@ func (h *ProfileRepositoryMySQL) UpdatePicture(profileID uint, path string) erro
 		return nil
 	})
 }
+
+func (h *ProfileRepositoryMySQL) UpdateRegion(profileID uint, region string) error {
+	if err := h.db.Model(&domain.Profile{}).Where("id = ?", profileID).Update("region", region).Error; err != nil {
+		return fmt.Errorf("failed to update region: %w", err)
+	}
+
+	return nil
+}
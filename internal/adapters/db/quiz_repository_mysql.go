@@ -0,0 +1,147 @@
+package db
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type QuizRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewQuizRepositoryMySQL(db *gorm.DB) ports.QuizRepository {
+	return &QuizRepositoryMySQL{db: db}
+}
+
+// GetOpen returns the quizzes and polls taking answers at the given time,
+// those closing first on top, optionally only the ones about a game.
+func (h *QuizRepositoryMySQL) GetOpen(gameID *uint, at time.Time) ([]domain.Quiz, error) {
+	query := h.db.Preload("Game").Where("opens_at <= ? AND closes_at > ?", at, at)
+	if gameID != nil {
+		query = query.Where("game_id = ?", *gameID)
+	}
+
+	var quizzes []domain.Quiz
+	err := query.Order("closes_at").Find(&quizzes).Error
+
+	return quizzes, err
+}
+
+// FindByID returns the quiz along with its questions and options in order
+// and its reward tiers.
+func (h *QuizRepositoryMySQL) FindByID(id uint) (domain.Quiz, error) {
+	var quiz domain.Quiz
+	err := h.db.
+		Preload("Game").
+		Preload("Questions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, id")
+		}).
+		Preload("Questions.Options", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, id")
+		}).
+		Preload("Rewards", func(db *gorm.DB) *gorm.DB {
+			return db.Order("min_score")
+		}).
+		Preload("Rewards.Rewards").
+		First(&quiz, id).
+		Error
+	if err != nil {
+		return quiz, err
+	}
+
+	var rewards []*domain.Reward
+	for i := range quiz.Rewards {
+		for j := range quiz.Rewards[i].Rewards {
+			rewards = append(rewards, &quiz.Rewards[i].Rewards[j])
+		}
+	}
+
+	return quiz, loadRewardables(h.db, rewards)
+}
+
+// GetAttempts returns the answers of the user to the given quizzes.
+func (h *QuizRepositoryMySQL) GetAttempts(userID uint, quizIDs []uint) ([]domain.QuizAttempt, error) {
+	var attempts []domain.QuizAttempt
+	if len(quizIDs) == 0 {
+		return attempts, nil
+	}
+
+	err := h.db.Preload("Answers").Where("user_id = ? AND quiz_id IN ?", userID, quizIDs).Find(&attempts).Error
+
+	return attempts, err
+}
+
+// CreateAttempt records the answers of the user, which can only be given
+// once per quiz.
+func (h *QuizRepositoryMySQL) CreateAttempt(attempt *domain.QuizAttempt) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		answers := attempt.Answers
+		result := tx.Omit(clause.Associations).Clauses(clause.OnConflict{DoNothing: true}).Create(attempt)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return errors.NewHttpError(http.StatusConflict, "You have already answered this quiz.")
+		}
+
+		for i := range answers {
+			answers[i].AttemptID = attempt.ID
+		}
+
+		if err := tx.Create(&answers).Error; err != nil {
+			return err
+		}
+
+		attempt.Answers = answers
+
+		return nil
+	})
+}
+
+// GetResults counts the votes of every option of the quiz, along with how
+// many users answered it and their average score.
+func (h *QuizRepositoryMySQL) GetResults(quiz domain.Quiz) (domain.QuizResults, error) {
+	results := domain.QuizResults{Quiz: quiz, Votes: make(map[uint]uint)}
+
+	var summary struct {
+		Attempts     uint
+		AverageScore float64
+	}
+
+	if err := h.db.Model(&domain.QuizAttempt{}).
+		Select("COUNT(*) AS attempts, COALESCE(AVG(score), 0) AS average_score").
+		Where("quiz_id = ?", quiz.ID).
+		Scan(&summary).
+		Error; err != nil {
+		return results, err
+	}
+
+	var votes []struct {
+		OptionID uint
+		Votes    uint
+	}
+
+	if err := h.db.Model(&domain.QuizAnswer{}).
+		Select("option_id, COUNT(*) AS votes").
+		Where("quiz_id = ?", quiz.ID).
+		Group("option_id").
+		Scan(&votes).
+		Error; err != nil {
+		return results, err
+	}
+
+	results.Attempts = summary.Attempts
+	results.AverageScore = summary.AverageScore
+	for _, vote := range votes {
+		results.Votes[vote.OptionID] = vote.Votes
+	}
+
+	return results, nil
+}
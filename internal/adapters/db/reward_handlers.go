@@ -0,0 +1,329 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"gcstatus/internal/domain"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// rewardHandler gives a single reward to the user of the grant within its
+// transaction, collecting what it gave and the transactions and
+// notifications it produced.
+type rewardHandler func(tx *gorm.DB, granted *domain.GrantedRewards, reward domain.Reward) error
+
+// rewardHandlers is the registry of the handlers by rewardable type.
+var rewardHandlers map[string]rewardHandler
+
+func init() {
+	rewardHandlers = map[string]rewardHandler{
+		domain.RewardableTypeCoins:      grantCoins,
+		domain.RewardableTypeExperience: grantExperience,
+		domain.RewardableTypeTitles:     grantTitle,
+		domain.RewardableTypeBoosters:   grantBooster,
+		domain.RewardableTypeCosmetics:  grantCosmetic,
+		domain.RewardableTypeItems:      grantItem,
+	}
+}
+
+func grantReward(tx *gorm.DB, granted *domain.GrantedRewards, reward domain.Reward) error {
+	handler, ok := rewardHandlers[reward.RewardableType]
+	if !ok {
+		return fmt.Errorf("no handler for rewards of type %q", reward.RewardableType)
+	}
+
+	return handler(tx, granted, reward)
+}
+
+func grantCoins(tx *gorm.DB, granted *domain.GrantedRewards, reward domain.Reward) error {
+	description := fmt.Sprintf("Received coins from %s.", granted.Grant.Reason)
+	if reward.Multiplier > 1 {
+		description = fmt.Sprintf("Received coins from %s (%s multiplier).", granted.Grant.Reason, domain.FormatMultiplier(reward.Multiplier))
+	}
+
+	return creditCoins(tx, granted, reward.Quantity(), description)
+}
+
+// creditCoins adds the coins to the wallet of the user and records them on
+// the ledger with the given description.
+func creditCoins(tx *gorm.DB, granted *domain.GrantedRewards, coins uint, description string) error {
+	if coins == 0 {
+		return nil
+	}
+
+	result := tx.Model(&domain.Wallet{}).
+		Where("user_id = ?", granted.Grant.UserID).
+		Update("amount", gorm.Expr("amount + ?", coins))
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("wallet of user %d not found", granted.Grant.UserID)
+	}
+
+	granted.Coins += coins
+	granted.Transactions = append(granted.Transactions, domain.Transaction{
+		Amount:            coins,
+		Description:       description,
+		UserID:            granted.Grant.UserID,
+		TransactionTypeID: domain.AdditionTransactionTypeID,
+	})
+
+	return nil
+}
+
+// grantExperience adds the experience to the user, leveling up as many times
+// as it reaches. Every level reached gives its coins and rewards, the latter
+// once the user is saved so they see the new level. The user row stays locked
+// until the grant ends, so concurrent grants add up instead of overwriting
+// each other.
+func grantExperience(tx *gorm.DB, granted *domain.GrantedRewards, reward domain.Reward) error {
+	var user domain.User
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&user, granted.Grant.UserID).Error; err != nil {
+		return err
+	}
+
+	var levels []domain.Level
+	if err := tx.Preload("Rewards").Order("level ASC").Find(&levels).Error; err != nil {
+		return err
+	}
+
+	user.Experience += reward.Quantity()
+	granted.Experience += reward.Quantity()
+
+	var levelRewards []domain.Reward
+	for _, nextLevel := range nextLevels(levels, user.LevelID) {
+		if user.Experience < nextLevel.Experience {
+			break
+		}
+
+		user.LevelID = nextLevel.ID
+		user.Experience -= nextLevel.Experience
+
+		if err := creditCoins(tx, granted, nextLevel.Coins, fmt.Sprintf("Received coins from level up to Level %d.", nextLevel.Level)); err != nil {
+			return err
+		}
+
+		granted.Notifications = append(granted.Notifications, newRewardNotification(
+			user.ID,
+			"NewLevelNotification",
+			fmt.Sprintf("Congratulations! You've reached level %d!", nextLevel.Level),
+			"/profile/?section=levels",
+			"FaMedal",
+		))
+
+		levelRewards = append(levelRewards, nextLevel.Rewards...)
+	}
+
+	if err := tx.Model(&domain.User{}).Where("id = ?", user.ID).Updates(map[string]any{
+		"level_id":   user.LevelID,
+		"experience": user.Experience,
+	}).Error; err != nil {
+		return err
+	}
+
+	for _, levelReward := range levelRewards {
+		if err := grantReward(tx, granted, levelReward); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nextLevels are the levels following the current one of the user, in order,
+// as long as there is no gap between them.
+func nextLevels(levels []domain.Level, currentLevelID uint) []domain.Level {
+	var next []domain.Level
+	var current *domain.Level
+
+	for i, level := range levels {
+		switch {
+		case level.ID == currentLevelID:
+			current = &levels[i]
+		case current != nil && level.Level == current.Level+uint(len(next))+1:
+			next = append(next, level)
+		}
+	}
+
+	return next
+}
+
+// grantTitle gives the title unless the user already holds it.
+func grantTitle(tx *gorm.DB, granted *domain.GrantedRewards, reward domain.Reward) error {
+	var title domain.Title
+	if err := tx.First(&title, reward.RewardableID).Error; err != nil {
+		return err
+	}
+
+	var count int64
+	if err := tx.Model(&domain.UserTitle{}).
+		Where("user_id = ? AND title_id = ?", granted.Grant.UserID, title.ID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	if err := awardTitle(tx, granted.Grant.UserID, title.ID); err != nil {
+		return err
+	}
+
+	granted.TitleIDs = append(granted.TitleIDs, title.ID)
+	granted.Notifications = append(granted.Notifications, newRewardNotification(
+		granted.Grant.UserID,
+		"NewTitleNotification",
+		fmt.Sprintf("Congratulations! You've unlocked the title: %s", title.Title),
+		"/profile/?section=titles",
+		"FaMedal",
+	))
+
+	return nil
+}
+
+// grantBooster starts the booster for the user. Giving a booster the user is
+// already running extends it instead.
+func grantBooster(tx *gorm.DB, granted *domain.GrantedRewards, reward domain.Reward) error {
+	var booster domain.Booster
+	if err := tx.First(&booster, reward.RewardableID).Error; err != nil {
+		return err
+	}
+
+	if _, err := activateBooster(tx, granted.Grant.UserID, booster, booster.Duration()*time.Duration(reward.Quantity())); err != nil {
+		return err
+	}
+
+	granted.Notifications = append(granted.Notifications, newRewardNotification(
+		granted.Grant.UserID,
+		"NewBoosterNotification",
+		fmt.Sprintf("You have received the booster: %s", booster.Name),
+		"/profile/?section=boosters",
+		"FaRocket",
+	))
+
+	return nil
+}
+
+// activateBooster runs the booster for the user for the given duration, on
+// top of what is left of it if the user is already running it.
+func activateBooster(tx *gorm.DB, userID uint, booster domain.Booster, duration time.Duration) (domain.UserBooster, error) {
+	now := time.Now()
+
+	userBooster := domain.UserBooster{
+		UserID:     userID,
+		BoosterID:  booster.ID,
+		Scope:      booster.Scope,
+		Multiplier: booster.Multiplier,
+		ExpiresAt:  now,
+	}
+
+	if err := tx.Where("user_id = ? AND booster_id = ? AND expires_at > ?", userID, booster.ID, now).
+		Limit(1).
+		Find(&userBooster).Error; err != nil {
+		return domain.UserBooster{}, err
+	}
+
+	userBooster.ExpiresAt = userBooster.ExpiresAt.Add(duration)
+	if err := tx.Omit("User", "Booster").Save(&userBooster).Error; err != nil {
+		return domain.UserBooster{}, err
+	}
+
+	return userBooster, nil
+}
+
+// grantCosmetic adds the cosmetic to the profile of the user unless it is
+// already there.
+func grantCosmetic(tx *gorm.DB, granted *domain.GrantedRewards, reward domain.Reward) error {
+	var cosmetic domain.Cosmetic
+	if err := tx.First(&cosmetic, reward.RewardableID).Error; err != nil {
+		return err
+	}
+
+	var count int64
+	if err := tx.Model(&domain.UserCosmetic{}).
+		Where("user_id = ? AND cosmetic_id = ?", granted.Grant.UserID, cosmetic.ID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	userCosmetic := domain.UserCosmetic{
+		UserID:     granted.Grant.UserID,
+		CosmeticID: cosmetic.ID,
+	}
+
+	if err := tx.Omit("User", "Cosmetic").Create(&userCosmetic).Error; err != nil {
+		return err
+	}
+
+	granted.Notifications = append(granted.Notifications, newRewardNotification(
+		granted.Grant.UserID,
+		"NewCosmeticNotification",
+		fmt.Sprintf("You have unlocked the cosmetic: %s", cosmetic.Name),
+		"/profile/?section=cosmetics",
+		"FaPalette",
+	))
+
+	return nil
+}
+
+// grantItem stacks the items in the inventory of the user.
+func grantItem(tx *gorm.DB, granted *domain.GrantedRewards, reward domain.Reward) error {
+	var item domain.Item
+	if err := tx.First(&item, reward.RewardableID).Error; err != nil {
+		return err
+	}
+
+	userItem := domain.UserItem{
+		UserID: granted.Grant.UserID,
+		ItemID: item.ID,
+	}
+
+	if err := tx.Where("user_id = ? AND item_id = ?", userItem.UserID, userItem.ItemID).
+		Limit(1).
+		Find(&userItem).Error; err != nil {
+		return err
+	}
+
+	userItem.Quantity += reward.Quantity()
+	if err := tx.Omit("User", "Item").Save(&userItem).Error; err != nil {
+		return err
+	}
+
+	granted.Notifications = append(granted.Notifications, newRewardNotification(
+		granted.Grant.UserID,
+		"NewItemNotification",
+		fmt.Sprintf("You have received %dx %s", reward.Quantity(), item.Name),
+		"/profile/?section=inventory",
+		"FaBoxOpen",
+	))
+
+	return nil
+}
+
+func newRewardNotification(userID uint, notificationType string, title string, actionUrl string, icon string) domain.Notification {
+	dataJson, err := json.Marshal(&domain.NotificationData{
+		Title:     title,
+		ActionUrl: actionUrl,
+		Icon:      icon,
+	})
+	if err != nil {
+		log.Printf("Failed to marshal notification content: %+v", err)
+	}
+
+	return domain.Notification{
+		Type:   notificationType,
+		Data:   string(dataJson),
+		UserID: userID,
+	}
+}
@@ -0,0 +1,164 @@
+package db
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+	"gcstatus/pkg/leaderboard"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RewardRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewRewardRepositoryMySQL(db *gorm.DB) ports.RewardRepository {
+	return &RewardRepositoryMySQL{db: db}
+}
+
+// GrantRewards gives the rewards to the user in a single transaction, along
+// with the transactions and notifications they produce. The coins and
+// experience are multiplied by the events and boosters active for the action
+// of the grant. Rewards already granted under the same key are not given
+// again.
+func (h *RewardRepositoryMySQL) GrantRewards(grant domain.RewardGrant, rewards []domain.Reward) (domain.GrantedRewards, error) {
+	granted := domain.GrantedRewards{Grant: grant}
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Omit("User").Clauses(clause.OnConflict{DoNothing: true}).Create(&granted.Grant)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			granted.Duplicate = true
+			return nil
+		}
+
+		if hasAmountRewards(rewards) {
+			multipliers, err := loadMultipliers(tx, grant.UserID, grant.ActionKey, time.Now())
+			if err != nil {
+				return err
+			}
+
+			granted.Multipliers = multipliers
+			rewards = multipliers.Apply(rewards)
+		}
+
+		for _, reward := range rewards {
+			if err := grantReward(tx, &granted, reward); err != nil {
+				return err
+			}
+		}
+
+		return saveGranted(tx, &granted)
+	})
+	if err != nil {
+		return domain.GrantedRewards{}, err
+	}
+
+	recordGranted(granted)
+
+	return granted, nil
+}
+
+// hasAmountRewards tells whether any of the rewards is coins or experience,
+// the only ones multipliers apply to.
+func hasAmountRewards(rewards []domain.Reward) bool {
+	for _, reward := range rewards {
+		if !domain.IsRewardableEntity(reward.RewardableType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadRewardables fills the rewarded entities of the given rewards.
+func loadRewardables(db *gorm.DB, rewards []*domain.Reward) error {
+	if err := loadRewardablesOf(db, rewards, domain.RewardableTypeTitles, func(title domain.Title) uint { return title.ID }); err != nil {
+		return err
+	}
+
+	if err := loadRewardablesOf(db, rewards, domain.RewardableTypeBoosters, func(booster domain.Booster) uint { return booster.ID }); err != nil {
+		return err
+	}
+
+	if err := loadRewardablesOf(db, rewards, domain.RewardableTypeCosmetics, func(cosmetic domain.Cosmetic) uint { return cosmetic.ID }); err != nil {
+		return err
+	}
+
+	return loadRewardablesOf(db, rewards, domain.RewardableTypeItems, func(item domain.Item) uint { return item.ID })
+}
+
+func loadRewardablesOf[T any](db *gorm.DB, rewards []*domain.Reward, rewardableType string, id func(T) uint) error {
+	var ids []uint
+	seen := make(map[uint]bool)
+	for _, reward := range rewards {
+		if reward.RewardableType == rewardableType && !seen[reward.RewardableID] {
+			seen[reward.RewardableID] = true
+			ids = append(ids, reward.RewardableID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var entities []T
+	if err := db.Where("id IN (?)", ids).Find(&entities).Error; err != nil {
+		return err
+	}
+
+	entityMap := make(map[uint]*T, len(entities))
+	for i := range entities {
+		entityMap[id(entities[i])] = &entities[i]
+	}
+
+	for _, reward := range rewards {
+		if reward.RewardableType != rewardableType {
+			continue
+		}
+
+		if entity, ok := entityMap[reward.RewardableID]; ok {
+			reward.Rewardable = entity
+		}
+	}
+
+	return nil
+}
+
+// saveGranted stores the transactions and notifications produced by the
+// rewards of the grant.
+func saveGranted(tx *gorm.DB, granted *domain.GrantedRewards) error {
+	if len(granted.Transactions) > 0 {
+		if err := tx.Omit("TransactionType", "User").Create(&granted.Transactions).Error; err != nil {
+			return err
+		}
+	}
+
+	if len(granted.Notifications) > 0 {
+		if err := tx.Omit("User").Create(&granted.Notifications).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordGranted moves the leaderboards by what the grant gave, once it is
+// committed.
+func recordGranted(granted domain.GrantedRewards) {
+	if granted.Duplicate {
+		return
+	}
+
+	now := time.Now()
+	userID := granted.Grant.UserID
+
+	leaderboard.Record(userID, domain.LeaderboardExperience, float64(granted.Experience), now)
+	leaderboard.Record(userID, domain.LeaderboardCoins, float64(granted.Coins), now)
+	leaderboard.Record(userID, domain.LeaderboardTitles, float64(len(granted.TitleIDs)), now)
+}
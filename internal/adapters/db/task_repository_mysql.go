@@ -2,10 +2,13 @@ package db
 
 import (
 	"errors"
+	"fmt"
 	"gcstatus/internal/domain"
 	"gcstatus/internal/ports"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type TaskRepositoryMySQL struct {
@@ -72,6 +75,124 @@ func (r *TaskRepositoryMySQL) UpdateMissionProgress(progress *domain.MissionProg
 	return r.db.Save(progress).Error
 }
 
+func (r *TaskRepositoryMySQL) RecordAction(action *domain.UserAction) error {
+	return r.db.Omit("User").Create(action).Error
+}
+
+func (r *TaskRepositoryMySQL) GetUserActions(userID uint, actionKey string, since *time.Time, until *time.Time) ([]domain.UserAction, error) {
+	query := r.db.Where("user_id = ? AND `key` = ?", userID, actionKey)
+
+	if since != nil {
+		query = query.Where("occurred_at >= ?", *since)
+	}
+
+	if until != nil {
+		query = query.Where("occurred_at < ?", *until)
+	}
+
+	var actions []domain.UserAction
+	err := query.Order("occurred_at").Find(&actions).Error
+
+	return actions, err
+}
+
+// GetActionPolicy returns the enabled policy of the action key, or nil when
+// its actions are not limited.
+func (r *TaskRepositoryMySQL) GetActionPolicy(actionKey string) (*domain.ActionPolicy, error) {
+	var policies []domain.ActionPolicy
+	if err := r.db.Where("action_key = ? AND enabled = ?", actionKey, true).Limit(1).Find(&policies).Error; err != nil {
+		return nil, err
+	}
+
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	return &policies[0], nil
+}
+
+// DecideAction weighs the action against the policy and records the decision
+// in one transaction. The row of the user is locked first, so the parallel
+// actions of a user are decided one after the other and can not all pass the
+// same limit.
+func (r *TaskRepositoryMySQL) DecideAction(action domain.UserAction, policy domain.ActionPolicy) (domain.ActionDecision, error) {
+	var decision domain.ActionDecision
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var user domain.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&user, action.UserID).Error; err != nil {
+			return err
+		}
+
+		var counted []domain.ActionDecision
+		if err := tx.
+			Where("user_id = ? AND action_key = ? AND occurred_at >= ? AND weight > 0", action.UserID, action.Key, policy.Since(action.OccurredAt)).
+			Order("occurred_at").
+			Find(&counted).
+			Error; err != nil {
+			return err
+		}
+
+		targetSeen := false
+		if policy.OncePerTarget && action.TargetType != nil && action.TargetID != nil {
+			var count int64
+			if err := tx.Model(&domain.ActionDecision{}).
+				Where("user_id = ? AND action_key = ? AND target_type = ? AND target_id = ? AND weight > 0", action.UserID, action.Key, *action.TargetType, *action.TargetID).
+				Count(&count).
+				Error; err != nil {
+				return err
+			}
+
+			targetSeen = count > 0
+		}
+
+		decision = policy.Decide(action, counted, targetSeen)
+
+		return tx.Omit("User").Create(&decision).Error
+	})
+
+	return decision, err
+}
+
+// GetTargetAttributes returns the slugs of the genres, categories, tags and
+// platforms of a game, which requirement conditions can match on.
+func (r *TaskRepositoryMySQL) GetTargetAttributes(targetType string, targetID uint) (map[string][]string, error) {
+	attributes := make(map[string][]string)
+	if targetType != domain.ActionTargetGames {
+		return attributes, nil
+	}
+
+	morphs := []struct {
+		table string
+		pivot string
+		morph string
+		key   string
+	}{
+		{"genres", "genreables", "genreable", "genre_id"},
+		{"categories", "categoriables", "categoriable", "category_id"},
+		{"tags", "taggables", "taggable", "tag_id"},
+		{"platforms", "platformables", "platformable", "platform_id"},
+	}
+
+	for _, m := range morphs {
+		var slugs []string
+		err := r.db.Table(m.table).
+			Joins(fmt.Sprintf("JOIN %s ON %s.%s = %s.id", m.pivot, m.pivot, m.key, m.table)).
+			Where(fmt.Sprintf("%s.%s_type = ? AND %s.%s_id = ? AND %s.deleted_at IS NULL", m.pivot, m.morph, m.pivot, m.morph, m.pivot), targetType, targetID).
+			Pluck(m.table+".slug", &slugs).
+			Error
+		if err != nil {
+			return nil, err
+		}
+
+		if len(slugs) > 0 {
+			attributes[m.table] = slugs
+		}
+	}
+
+	return attributes, nil
+}
+
 func (r *TaskRepositoryMySQL) UserHasTitle(userID uint, titleID uint) (bool, error) {
 	var count int64
 	err := r.db.Model(&domain.UserTitle{}).
@@ -85,8 +206,14 @@ func (r *TaskRepositoryMySQL) UserHasTitle(userID uint, titleID uint) (bool, err
 }
 
 func (r *TaskRepositoryMySQL) AwardTitleToUser(userID uint, titleID uint) error {
-	tx := r.db.Begin()
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return awardTitle(tx, userID, titleID)
+	})
+}
 
+// awardTitle gives the title to the user and completes the progress of all
+// of its requirements.
+func awardTitle(tx *gorm.DB, userID uint, titleID uint) error {
 	userTitle := domain.UserTitle{
 		UserID:  userID,
 		TitleID: titleID,
@@ -94,13 +221,11 @@ func (r *TaskRepositoryMySQL) AwardTitleToUser(userID uint, titleID uint) error
 	}
 
 	if err := tx.Create(&userTitle).Error; err != nil {
-		tx.Rollback()
 		return err
 	}
 
 	var requirements []domain.TitleRequirement
 	if err := tx.Where("title_id = ?", titleID).Find(&requirements).Error; err != nil {
-		tx.Rollback()
 		return err
 	}
 
@@ -109,7 +234,6 @@ func (r *TaskRepositoryMySQL) AwardTitleToUser(userID uint, titleID uint) error
 
 		err := tx.Where("user_id = ? AND title_requirement_id = ?", userID, requirement.ID).First(&progress).Error
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-			tx.Rollback()
 			return err
 		}
 
@@ -122,18 +246,16 @@ func (r *TaskRepositoryMySQL) AwardTitleToUser(userID uint, titleID uint) error
 			}
 
 			if err := tx.Create(&progress).Error; err != nil {
-				tx.Rollback()
 				return err
 			}
 		} else if !progress.Completed {
 			progress.Progress = requirement.Goal
 			progress.Completed = true
 			if err := tx.Save(&progress).Error; err != nil {
-				tx.Rollback()
 				return err
 			}
 		}
 	}
 
-	return tx.Commit().Error
+	return nil
 }
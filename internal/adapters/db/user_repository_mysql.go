@@ -1,11 +1,10 @@
 package db
 
 import (
-	"encoding/json"
 	"fmt"
 	"gcstatus/internal/domain"
 	"gcstatus/internal/ports"
-	"log"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -74,6 +73,12 @@ func (repo *UserRepositoryMySQL) FindUserByEmailOrNickname(emailOrNickname strin
 	return &user, err
 }
 
+func (repo *UserRepositoryMySQL) FindUserByNickname(nickname string) (*domain.User, error) {
+	var user domain.User
+	err := repo.db.Where("nickname = ?", nickname).First(&user).Error
+	return &user, err
+}
+
 func (repo *UserRepositoryMySQL) FindUserByEmailForAdmin(email string) (*domain.User, error) {
 	var user domain.User
 	err := repo.db.Model(&domain.User{}).
@@ -108,6 +113,10 @@ func (repo *UserRepositoryMySQL) UpdateUserBasics(userID uint, request ports.Upd
 		"birthdate": request.Birthdate,
 	}
 
+	if request.Timezone != nil {
+		updateFields["timezone"] = *request.Timezone
+	}
+
 	if err := repo.db.Model(&domain.User{}).Where("id = ?", userID).Updates(updateFields).Error; err != nil {
 		return fmt.Errorf("failed to update user basic informations: %+s", err.Error())
 	}
@@ -115,144 +124,34 @@ func (repo *UserRepositoryMySQL) UpdateUserBasics(userID uint, request ports.Upd
 	return nil
 }
 
-func (h *UserRepositoryMySQL) AddExperience(
-	userID uint,
-	experienceGained uint,
-	awardTitleToUserFunc func(userID uint, titleID uint) error,
-) error {
-	var user domain.User
-	if err := h.db.Preload("Level").Preload("Wallet").First(&user, userID).Error; err != nil {
-		return err
-	}
-
-	var levels []domain.Level
-	if err := h.db.Preload("Rewards").Order("level ASC").Find(&levels).Error; err != nil {
-		return err
+// AddExperience gives the experience to the user, multiplied by the events
+// and boosters active, leveling up along with the coins and rewards of every
+// level reached. It comes from no action, so only the events not limited to
+// one apply.
+func (h *UserRepositoryMySQL) AddExperience(userID uint, experienceGained uint) error {
+	granted := domain.GrantedRewards{
+		Grant: domain.RewardGrant{UserID: userID, Reason: "a level up"},
 	}
 
-	user.Experience += experienceGained
-
-	for {
-		var currentLevel *domain.Level
-		for _, level := range levels {
-			if level.ID == user.LevelID {
-				currentLevel = &level
-				break
-			}
-		}
-
-		var nextLevel *domain.Level
-		if currentLevel != nil {
-			for _, level := range levels {
-				if level.Level == currentLevel.Level+1 {
-					nextLevel = &level
-					break
-				}
-			}
-		}
-
-		if nextLevel == nil {
-			break
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		multipliers, err := loadMultipliers(tx, userID, "", time.Now())
+		if err != nil {
+			return err
 		}
 
-		if user.Experience >= nextLevel.Experience {
-			user.Level = *nextLevel
-			user.LevelID = nextLevel.ID
-			user.Experience -= nextLevel.Experience
-			user.Wallet.Amount += int(nextLevel.Coins)
-
-			h.createTransactionForLevelUp(*nextLevel, user.ID)
-			h.createNotificationForLevelUp(*nextLevel, user.ID)
-
-			for _, reward := range nextLevel.Rewards {
-				if reward.RewardableType == "titles" {
-					if err := awardTitleToUserFunc(user.ID, reward.RewardableID); err != nil {
-						return fmt.Errorf("error awarding title: %w", err)
-					}
-
-					h.createNotificationForRewardTitle(reward, user.ID)
-				}
-			}
-
-			if err := h.db.Model(&user).Updates(map[string]any{
-				"LevelID":    user.LevelID,
-				"Experience": user.Experience,
-			}).Error; err != nil {
-				return fmt.Errorf("error updating user level in the database: %w", err)
-			}
-
-			if err := h.db.Model(&user.Wallet).Updates(map[string]any{
-				"Amount": user.Wallet.Amount,
-			}).Error; err != nil {
-				return fmt.Errorf("failed to update coins for user wallet: %w", err)
-			}
-		} else {
-			break
+		granted.Multipliers = multipliers
+		reward := domain.Reward{RewardableType: domain.RewardableTypeExperience, Amount: experienceGained}.Boosted(multipliers.Experience)
+		if err := grantExperience(tx, &granted, reward); err != nil {
+			return err
 		}
-	}
-
-	if err := h.db.Save(&user).Error; err != nil {
-		return fmt.Errorf("error saving user at the end: %w", err)
-	}
 
-	return nil
-}
-
-func (h *UserRepositoryMySQL) createTransactionForLevelUp(nextLevel domain.Level, userID uint) {
-	transaction := &domain.Transaction{
-		Amount:            nextLevel.Coins,
-		Description:       fmt.Sprintf("Received coins from level up to Level %d.", nextLevel.Level),
-		UserID:            userID,
-		TransactionTypeID: domain.AdditionTransactionTypeID,
-	}
-
-	if err := h.db.Create(&transaction).Error; err != nil {
-		log.Printf("Failed to save the transaction of level up: %+v", err)
-	}
-}
-
-func (h *UserRepositoryMySQL) createNotificationForLevelUp(nextLevel domain.Level, userID uint) {
-	notificationContent := &domain.NotificationData{
-		Title:     fmt.Sprintf("Congratulations! You've reached level %d!", nextLevel.Level),
-		ActionUrl: "/profile/?section=levels",
-		Icon:      "FaMedal",
-	}
-
-	dataJson, err := json.Marshal(notificationContent)
-	if err != nil {
-		log.Printf("Failed to marshal notification content: %+v", err)
-	}
-
-	notification := &domain.Notification{
-		Type:   "NewLevelNotification",
-		Data:   string(dataJson),
-		UserID: userID,
-	}
-
-	if err := h.db.Create(&notification).Error; err != nil {
-		log.Printf("Failed to save the title award notification: %+v", err)
-	}
-}
-
-func (h *UserRepositoryMySQL) createNotificationForRewardTitle(reward domain.Reward, userID uint) {
-	notificationContent := &domain.NotificationData{
-		Title:     fmt.Sprintf("Congratulations! You've unlocked the title: %d", reward.RewardableID),
-		ActionUrl: "/profile/?section=titles",
-		Icon:      "FaMedal",
-	}
-
-	dataJson, err := json.Marshal(notificationContent)
+		return saveGranted(tx, &granted)
+	})
 	if err != nil {
-		log.Printf("Failed to marshal notification content: %+v", err)
+		return fmt.Errorf("error adding experience to user: %w", err)
 	}
 
-	notification := &domain.Notification{
-		Type:   "NewTitleNotification",
-		Data:   string(dataJson),
-		UserID: userID,
-	}
+	recordGranted(granted)
 
-	if err := h.db.Create(&notification).Error; err != nil {
-		log.Printf("Failed to save the title award notification: %+v", err)
-	}
+	return nil
 }
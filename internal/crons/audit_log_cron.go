@@ -0,0 +1,34 @@
+package crons
+
+import (
+	"fmt"
+	"gcstatus/config"
+	db_admin "gcstatus/internal/adapters/db/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"log"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PruneAuditLogs removes the audit logs older than the configured retention.
+func PruneAuditLogs(db *gorm.DB) error {
+	env := config.LoadConfig()
+
+	retentionDays, err := strconv.Atoi(env.AuditRetention)
+	if err != nil {
+		return fmt.Errorf("invalid AUDIT_LOG_RETENTION_DAYS value %q, keeping the audit logs", env.AuditRetention)
+	}
+
+	service := usecases_admin.NewAdminAuditLogService(db_admin.NewAdminAuditLogRepositoryMySQL(db))
+
+	pruned, err := service.Prune(retentionDays, time.Now())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("pruned %d audit logs older than %d days", pruned, retentionDays)
+
+	return nil
+}
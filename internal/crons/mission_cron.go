@@ -1,85 +1,233 @@
 package crons
 
 import (
+	"errors"
 	"gcstatus/internal/domain"
 	"log"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
-func ResetMissions(db *gorm.DB) {
+const (
+	missionResetBatchSize  = 500
+	missionResetStaleAfter = 30 * time.Minute
+)
+
+func ResetMissions(db *gorm.DB) error {
 	log.Printf("start running cron...")
 
-	now := time.Now()
+	if err := ResetMissionsAt(db, time.Now()); err != nil {
+		return err
+	}
+
+	log.Printf("cron runned successfully!")
 
-	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return nil
+}
 
+// ResetMissionsAt resets the recurring missions whose latest scheduled reset
+// has not run yet. Missions without a reset timezone are reset on the clock of
+// each user, so they are handled per timezone of the users. Resets missed
+// while the server was down are caught up on the next run, and each reset is
+// claimed through its run record, so several replicas can run it at once.
+func ResetMissionsAt(db *gorm.DB, now time.Time) error {
 	var missions []domain.Mission
+	if err := db.Where("status NOT IN (?, ?) AND frequency IN ?",
+		domain.MissionCanceled,
+		domain.MissionUnavailable,
+		[]string{domain.DailyMission, domain.WeeklyMission, domain.MonthlyMission},
+	).Find(&missions).Error; err != nil {
+		return err
+	}
 
-	db.Where("reset_time < ? AND status NOT IN (?,?) AND frequency != ?", now, domain.MissionCanceled, domain.MissionUnavailable, domain.OneTimeMission).Find(&missions)
+	var userTimezones []string
+	if err := db.Model(&domain.User{}).Distinct().Pluck("timezone", &userTimezones).Error; err != nil {
+		return err
+	}
 
+	var errs []error
 	for _, mission := range missions {
-		if mission.ForAll {
-			resetAllUserProgressForMission(db, mission)
-		} else {
-			resetSpecificUserProgressForMission(db, mission)
+		timezones := userTimezones
+		if mission.ResetTimezone != nil {
+			timezones = []string{*mission.ResetTimezone}
 		}
 
-		switch mission.Frequency {
-		case "daily":
-			mission.ResetTime = midnight.Add(24 * time.Hour)
-		case "weekly":
-			mission.ResetTime = midnight.AddDate(0, 0, 7)
-		case "monthly":
-			mission.ResetTime = midnight.AddDate(0, 1, 0)
+		for _, timezone := range timezones {
+			if err := resetMissionForTimezone(db, mission, timezone, now); err != nil {
+				errs = append(errs, err)
+			}
 		}
 
-		db.Save(&mission)
+		loc := time.UTC
+		if mission.ResetTimezone != nil {
+			loc = (&domain.User{Timezone: *mission.ResetTimezone}).Location()
+		}
+
+		if next, ok := mission.NextReset(now, loc); ok {
+			if err := db.Model(&domain.Mission{}).Where("id = ?", mission.ID).Update("reset_time", next).Error; err != nil {
+				errs = append(errs, err)
+			}
+		}
 	}
 
-	log.Printf("cron runned successfully!")
+	return errors.Join(errs...)
 }
 
-func resetAllUserProgressForMission(db *gorm.DB, mission domain.Mission) {
-	var users []domain.User
+func resetMissionForTimezone(db *gorm.DB, mission domain.Mission, timezone string, now time.Time) error {
+	periodStart, ok := mission.LastReset(now, (&domain.User{Timezone: timezone}).Location())
+	if !ok {
+		return nil
+	}
+
+	run, err := claimMissionReset(db, mission.ID, timezone, periodStart.UTC(), now)
+	if err != nil || run == nil {
+		return err
+	}
+
+	progresses, resetErr := resetMissionProgress(db, mission, timezone, run.PeriodStart)
 
-	db.Find(&users)
+	updates := map[string]any{
+		"status":      domain.MissionResetSucceeded,
+		"progresses":  progresses,
+		"finished_at": time.Now(),
+	}
 
-	for _, user := range users {
-		resetProgressForUser(db, user.ID, mission)
+	if resetErr != nil {
+		updates["status"] = domain.MissionResetFailed
+		updates["error"] = resetErr.Error()
 	}
+
+	if err := db.Model(&domain.MissionResetRun{}).Where("id = ?", run.ID).Updates(updates).Error; err != nil {
+		return errors.Join(resetErr, err)
+	}
+
+	return resetErr
 }
 
-func resetSpecificUserProgressForMission(db *gorm.DB, mission domain.Mission) {
-	var userMissions []domain.UserMission
+// claimMissionReset creates the run of the reset, telling through a nil run
+// that another replica already did it or is doing it. Failed runs and runs
+// left behind by a stopped replica are claimed again.
+func claimMissionReset(db *gorm.DB, missionID uint, timezone string, periodStart time.Time, now time.Time) (*domain.MissionResetRun, error) {
+	run := domain.MissionResetRun{
+		MissionID:   missionID,
+		Timezone:    timezone,
+		PeriodStart: periodStart,
+		Status:      domain.MissionResetRunning,
+		StartedAt:   now,
+	}
 
-	db.Where("mission_id = ?", mission.ID).Find(&userMissions)
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Omit(clause.Associations).Create(&run)
+	if result.Error != nil {
+		return nil, result.Error
+	}
 
-	for _, userMission := range userMissions {
-		resetProgressForUser(db, userMission.UserID, mission)
+	if result.RowsAffected == 1 {
+		return &run, nil
 	}
+
+	period := db.Model(&domain.MissionResetRun{}).
+		Where("mission_id = ? AND timezone = ? AND period_start = ?", missionID, timezone, periodStart).
+		Session(&gorm.Session{})
+
+	result = period.Where("status = ? OR (status = ? AND started_at < ?)", domain.MissionResetFailed, domain.MissionResetRunning, now.Add(-missionResetStaleAfter)).
+		Updates(map[string]any{
+			"status":      domain.MissionResetRunning,
+			"started_at":  now,
+			"finished_at": nil,
+			"error":       nil,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	if err := period.First(&run).Error; err != nil {
+		return nil, err
+	}
+
+	return &run, nil
 }
 
-func resetProgressForUser(db *gorm.DB, userID uint, mission domain.Mission) {
-	var missionProgress []domain.MissionProgress
+// resetMissionProgress resets, in batches, the progress of the mission made
+// before the period started by the users of the timezone. Progress already
+// reset for the period is left alone, so an interrupted reset can be resumed.
+func resetMissionProgress(db *gorm.DB, mission domain.Mission, timezone string, periodStart time.Time) (int64, error) {
+	var total int64
+
+	for {
+		var ids []uint
+		query := db.Model(&domain.MissionProgress{}).
+			Joins("JOIN mission_requirements ON mission_requirements.id = mission_progresses.mission_requirement_id").
+			Where("mission_requirements.mission_id = ? AND mission_progresses.created_at < ?", mission.ID, periodStart).
+			Where("mission_progresses.reset_at IS NULL OR mission_progresses.reset_at < ?", periodStart)
+
+		if err := scopeMissionUsers(db, query, "mission_progresses.user_id", mission, timezone).
+			Limit(missionResetBatchSize).
+			Pluck("mission_progresses.id", &ids).
+			Error; err != nil {
+			return total, err
+		}
 
-	db.Where("mission_requirement_id IN (?) AND user_id = ?", db.Table("mission_requirements").Select("id").Where("mission_id = ?", mission.ID), userID).Find(&missionProgress)
+		if len(ids) == 0 {
+			break
+		}
 
-	if len(missionProgress) == 0 {
-		return
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			result := tx.Model(&domain.MissionProgress{}).Where("id IN ?", ids).Updates(map[string]any{
+				"progress":  0,
+				"completed": false,
+				"reset_at":  periodStart,
+			})
+			total += result.RowsAffected
+
+			return result.Error
+		}); err != nil {
+			return total, err
+		}
 	}
 
-	for _, progress := range missionProgress {
-		progress.Progress = 0
-		progress.Completed = false
-		db.Save(&progress)
+	for {
+		var ids []uint
+		query := db.Model(&domain.UserMission{}).
+			Where("mission_id = ? AND completed = ? AND last_completed_at < ?", mission.ID, true, periodStart)
+
+		if err := scopeMissionUsers(db, query, "user_id", mission, timezone).
+			Limit(missionResetBatchSize).
+			Pluck("id", &ids).
+			Error; err != nil {
+			return total, err
+		}
+
+		if len(ids) == 0 {
+			break
+		}
+
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			return tx.Model(&domain.UserMission{}).Where("id IN ?", ids).Update("completed", false).Error
+		}); err != nil {
+			return total, err
+		}
 	}
 
-	var userMission domain.UserMission
-	if err := db.Where("mission_id = ? AND user_id = ?", mission.ID, userID).First(&userMission).Error; err == nil {
-		userMission.Completed = false
-		userMission.LastCompletedAt = time.Time{}
-		db.Save(&userMission)
+	return total, nil
+}
+
+// scopeMissionUsers limits the query to the users the mission is reset for:
+// the users of the timezone, unless the mission resets on a fixed one, among
+// the users it is assigned to.
+func scopeMissionUsers(db *gorm.DB, query *gorm.DB, column string, mission domain.Mission, timezone string) *gorm.DB {
+	if mission.ResetTimezone == nil {
+		query = query.Where(column+" IN (?)", db.Model(&domain.User{}).Select("id").Where("timezone = ?", timezone))
 	}
+
+	if !mission.ForAll {
+		query = query.Where(column+" IN (?)", db.Model(&domain.UserMissionAssignment{}).Select("user_id").Where("mission_id = ?", mission.ID))
+	}
+
+	return query
 }
@@ -0,0 +1,24 @@
+package crons
+
+import (
+	"gcstatus/internal/adapters/db"
+	"gcstatus/internal/usecases"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PrunePasswordResets removes the password resets that already expired.
+func PrunePasswordResets(conn *gorm.DB) error {
+	service := usecases.NewPasswordResetService(db.NewPasswordResetRepositoryMySQL(conn))
+
+	pruned, err := service.PruneExpired(time.Now())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("pruned %d expired password resets", pruned)
+
+	return nil
+}
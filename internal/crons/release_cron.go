@@ -0,0 +1,40 @@
+package crons
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/jobs"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func NotifyFollowedReleases(db *gorm.DB) error {
+	log.Printf("start running release cron...")
+
+	now := time.Now()
+
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var games []domain.Game
+
+	if err := db.Where("release_date >= ? AND release_date < ? AND id IN (?)",
+		midnight,
+		midnight.Add(24*time.Hour),
+		db.Model(&domain.GameFollow{}).Select("game_id").Where("notify_release = ?", true),
+	).Find(&games).Error; err != nil {
+		return err
+	}
+
+	for _, game := range games {
+		title := fmt.Sprintf("%s is out today!", game.Title)
+		if err := jobs.EnqueueGameFollowEvent(game.ID, domain.FollowReleaseEvent, title, fmt.Sprintf("/games/%s", game.Slug)); err != nil {
+			log.Printf("Failed to enqueue release of game %d: %+v", game.ID, err)
+		}
+	}
+
+	log.Printf("release cron runned successfully!")
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ActionDecisionAllowed        = "allowed"
+	ActionDecisionDiminished     = "diminished"
+	ActionDecisionCooldown       = "cooldown"
+	ActionDecisionDailyLimit     = "daily_limit"
+	ActionDecisionRepeatedTarget = "repeated_target"
+)
+
+// ActionPolicy limits how much the actions of a key advance the title and
+// mission requirements, so they can not be farmed. Every limit is off when
+// left empty. Days are UTC days.
+//
+// Past DiminishAfter actions in a day, every action is worth DiminishingFactor
+// times the one before it. The worth adds up over the day and the progress
+// only moves when it reaches a whole step, so a factor of 0.5 gives at most
+// one more step however many actions follow.
+type ActionPolicy struct {
+	gorm.Model
+	ID                uint    `gorm:"primaryKey"`
+	ActionKey         string  `gorm:"size:100;not null;uniqueIndex" validate:"required"`
+	CooldownSeconds   uint    `gorm:"not null;default:0"`
+	MaxPerDay         uint    `gorm:"not null;default:0"`
+	OncePerTarget     bool    `gorm:"not null;default:false"`
+	DiminishAfter     uint    `gorm:"not null;default:0"`
+	DiminishingFactor float64 `gorm:"not null;default:0" validate:"gte=0,lt=1"`
+	Enabled           bool    `gorm:"not null"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// ActionDecision records what a policy decided about an action, for audit.
+// The weight is what the action was worth, zero when it was refused, and the
+// increment is the progress it made.
+type ActionDecision struct {
+	gorm.Model
+	ID         uint      `gorm:"primaryKey"`
+	UserID     uint      `gorm:"not null;index:idx_action_decisions_user_key_time,priority:1"`
+	ActionKey  string    `gorm:"size:100;not null;index:idx_action_decisions_user_key_time,priority:2"`
+	PolicyID   uint      `gorm:"not null;index"`
+	TargetType *string   `gorm:"size:100"`
+	TargetID   *uint     `gorm:"default:null"`
+	Outcome    string    `gorm:"size:32;not null;index"`
+	Weight     float64   `gorm:"not null;default:0"`
+	Increment  int       `gorm:"not null;default:0"`
+	OccurredAt time.Time `gorm:"not null;index:idx_action_decisions_user_key_time,priority:3"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	User       User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+func (p *ActionPolicy) ValidateActionPolicy() error {
+	Init()
+
+	if err := validate.Struct(p); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
+
+// Since is how far back the decisions of the user matter to the policy: the
+// start of the day of the action, or the cooldown before it when longer.
+func (p ActionPolicy) Since(at time.Time) time.Time {
+	since := ActionDay(at)
+	if cooldownStart := at.Add(-p.cooldown()); cooldownStart.Before(since) {
+		since = cooldownStart
+	}
+
+	return since
+}
+
+// Decide weighs the action against the decisions counted for the user since
+// Since, and whether an action on the same target was already counted.
+func (p ActionPolicy) Decide(action UserAction, counted []ActionDecision, targetSeen bool) ActionDecision {
+	decision := ActionDecision{
+		UserID:     action.UserID,
+		ActionKey:  action.Key,
+		PolicyID:   p.ID,
+		TargetType: action.TargetType,
+		TargetID:   action.TargetID,
+		OccurredAt: action.OccurredAt,
+	}
+
+	if p.OncePerTarget && action.TargetID != nil && targetSeen {
+		decision.Outcome = ActionDecisionRepeatedTarget
+		return decision
+	}
+
+	day := ActionDay(action.OccurredAt)
+	var today uint
+	var credit float64
+
+	for _, previous := range counted {
+		if p.cooldown() > 0 && absDuration(action.OccurredAt.Sub(previous.OccurredAt)) < p.cooldown() {
+			decision.Outcome = ActionDecisionCooldown
+			return decision
+		}
+
+		if !previous.OccurredAt.Before(day) {
+			today++
+			credit += previous.Weight
+		}
+	}
+
+	if p.MaxPerDay > 0 && today >= p.MaxPerDay {
+		decision.Outcome = ActionDecisionDailyLimit
+		return decision
+	}
+
+	decision.Outcome = ActionDecisionAllowed
+	decision.Weight = float64(max(action.Increment, 1))
+
+	if p.DiminishingFactor > 0 && today >= p.DiminishAfter {
+		decision.Outcome = ActionDecisionDiminished
+		decision.Weight *= math.Pow(p.DiminishingFactor, float64(today-p.DiminishAfter+1))
+	}
+
+	decision.Increment = wholeSteps(credit+decision.Weight) - wholeSteps(credit)
+
+	return decision
+}
+
+// Allowed tells whether the action makes progress.
+func (d ActionDecision) Allowed() bool {
+	return d.Increment > 0
+}
+
+// ActionDay is the UTC day of the given time, as its midnight.
+func ActionDay(at time.Time) time.Time {
+	year, month, day := at.UTC().Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func (p ActionPolicy) cooldown() time.Duration {
+	return time.Duration(p.CooldownSeconds) * time.Second
+}
+
+// wholeSteps is how many whole steps of progress the credit is worth, leaving
+// room for the rounding of the factors.
+func wholeSteps(credit float64) int {
+	return int(math.Floor(credit + 1e-9))
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+
+	return d
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"reflect"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AuditLog records an admin mutation: who did it, on which target and what
+// changed.
+type AuditLog struct {
+	gorm.Model
+	ID         uint      `gorm:"primaryKey"`
+	UserID     *uint     `gorm:"index"`
+	User       *User     `gorm:"foreignKey:UserID"`
+	Action     string    `gorm:"size:255;not null;index" validate:"required"`
+	TargetType string    `gorm:"size:100;index:idx_audit_logs_target"`
+	TargetID   *uint     `gorm:"index:idx_audit_logs_target"`
+	Changes    *string   `gorm:"type:text"`
+	Status     int       `gorm:"not null"`
+	IP         string    `gorm:"size:45"`
+	UserAgent  string    `gorm:"size:512"`
+	CreatedAt  time.Time `gorm:"index"`
+	UpdatedAt  time.Time
+}
+
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+func (a *AuditLog) ValidateAuditLog() error {
+	Init()
+
+	if err := validate.Struct(a); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
+
+// DiffAuditSnapshots compares the target fields before and after a mutation,
+// keeping only the ones that changed. A nil snapshot means the target did not
+// exist at that point.
+func DiffAuditSnapshots(before, after map[string]any) map[string]AuditChange {
+	changes := make(map[string]AuditChange)
+
+	for field, value := range after {
+		previous, existed := before[field]
+		if !existed || !reflect.DeepEqual(previous, value) {
+			changes[field] = AuditChange{Before: previous, After: value}
+		}
+	}
+
+	for field, previous := range before {
+		if _, exists := after[field]; !exists {
+			changes[field] = AuditChange{Before: previous}
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	BoosterScopeExperience = "experience"
+	BoosterScopeCoins      = "coins"
+)
+
+var BoosterScopes = []string{BoosterScopeExperience, BoosterScopeCoins}
+
+// Booster is a multiplier of the experience or coins earned, active for a
+// while once a user gets it. Purchasable boosters can be bought with coins
+// besides being given as rewards.
+type Booster struct {
+	gorm.Model
+	ID              uint    `gorm:"primaryKey"`
+	Name            string  `gorm:"not null" validate:"required"`
+	Scope           string  `gorm:"not null" validate:"required,oneof=experience coins"`
+	Multiplier      float64 `gorm:"not null;default:1" validate:"required,gt=1"`
+	DurationMinutes uint    `gorm:"not null" validate:"required"`
+	Cost            uint    `gorm:"not null;default:0" validate:"required_if=Purchasable true"`
+	Purchasable     bool    `gorm:"not null;default:false"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// UserBooster is a booster held by a user until it expires. The scope and
+// multiplier are kept as they were when it was given.
+type UserBooster struct {
+	gorm.Model
+	ID         uint      `gorm:"primaryKey"`
+	UserID     uint      `gorm:"not null;index"`
+	BoosterID  uint      `gorm:"not null"`
+	Scope      string    `gorm:"not null"`
+	Multiplier float64   `gorm:"not null"`
+	ExpiresAt  time.Time `gorm:"not null;index"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	User       User    `gorm:"foreignKey:UserID;references:ID"`
+	Booster    Booster `gorm:"foreignKey:BoosterID;references:ID"`
+}
+
+func (b *Booster) ValidateBooster() error {
+	Init()
+
+	err := validate.Struct(b)
+	if err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
+
+// Duration is how long the booster lasts once given.
+func (b Booster) Duration() time.Duration {
+	return time.Duration(b.DurationMinutes) * time.Minute
+}
+
+// IsActive tells whether the booster is still running at the given time.
+func (ub UserBooster) IsActive(at time.Time) bool {
+	return ub.ExpiresAt.After(at)
+}
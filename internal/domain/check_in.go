@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	CheckInRequirementKey = "daily_check_in"
+)
+
+// CheckInStreak is the running streak of daily check-ins of a user. Days are
+// the calendar days of the user's timezone, kept as midnight UTC.
+type CheckInStreak struct {
+	gorm.Model
+	ID            uint       `gorm:"primaryKey"`
+	UserID        uint       `gorm:"not null;uniqueIndex"`
+	Current       uint       `gorm:"not null;default:0"`
+	Longest       uint       `gorm:"not null;default:0"`
+	Freezes       uint       `gorm:"not null;default:0"`
+	LastCheckInOn *time.Time `gorm:"type:date"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	User          User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+// CheckIn is a day of the streak of a user, either checked in or covered by
+// a streak freeze. Coins and experience are the rewards it gave.
+type CheckIn struct {
+	gorm.Model
+	ID         uint      `gorm:"primaryKey"`
+	UserID     uint      `gorm:"not null;uniqueIndex:idx_check_ins_user_day,priority:1"`
+	Day        time.Time `gorm:"type:date;not null;uniqueIndex:idx_check_ins_user_day,priority:2"`
+	Streak     uint      `gorm:"not null"`
+	Frozen     bool      `gorm:"not null;default:false"`
+	Coins      uint      `gorm:"not null;default:0"`
+	Experience uint      `gorm:"not null;default:0"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	User       User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+// CheckInReward is a row of the check-in reward table. Tiers are given on
+// every check-in from their streak on, until a longer tier is reached, while
+// milestones are only given on the day their streak is reached.
+type CheckInReward struct {
+	gorm.Model
+	ID         uint `gorm:"primaryKey"`
+	Streak     uint `gorm:"not null" validate:"required"`
+	Milestone  bool `gorm:"not null;default:false"`
+	Coins      uint `gorm:"not null;default:0"`
+	Experience uint `gorm:"not null;default:0"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Rewards    []Reward `gorm:"polymorphic:Sourceable;"`
+}
+
+// CheckInResult is a check-in along with the streak it leaves, the missed
+// days the freezes covered and the rewards it gave.
+type CheckInResult struct {
+	CheckIn    CheckIn
+	Streak     CheckInStreak
+	FrozenDays []time.Time
+	Rewards    []CheckInReward
+}
+
+func (r *CheckInReward) ValidateCheckInReward() error {
+	Init()
+
+	if err := validate.Struct(r); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
+
+// CheckInDay is the calendar day of the given time in the location, kept as
+// midnight UTC.
+func CheckInDay(at time.Time, loc *time.Location) time.Time {
+	year, month, day := at.In(loc).Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// HasCheckedIn tells whether the user already checked in on the given day.
+func (s *CheckInStreak) HasCheckedIn(day time.Time) bool {
+	return s.LastCheckInOn != nil && !day.After(s.lastDay())
+}
+
+// lastDay is the last day checked in as midnight UTC, whatever the location
+// the date was read with.
+func (s *CheckInStreak) lastDay() time.Time {
+	return CheckInDay(*s.LastCheckInOn, s.LastCheckInOn.Location())
+}
+
+// CheckIn moves the streak to the given day. Missed days are covered by
+// freezes when there are enough of them, counting for the streak, otherwise
+// the streak starts over and the freezes are kept. It returns the covered
+// days, and false when the day was already checked in.
+func (s *CheckInStreak) CheckIn(day time.Time) ([]time.Time, bool) {
+	if s.HasCheckedIn(day) {
+		return nil, false
+	}
+
+	var frozenDays []time.Time
+	if s.LastCheckInOn == nil {
+		s.Current = 1
+	} else {
+		last := s.lastDay()
+		missed := uint(day.Sub(last).Hours()/24) - 1
+
+		switch {
+		case missed == 0:
+			s.Current++
+		case missed <= s.Freezes:
+			for i := uint(1); i <= missed; i++ {
+				frozenDays = append(frozenDays, last.AddDate(0, 0, int(i)))
+			}
+
+			s.Freezes -= missed
+			s.Current += missed + 1
+		default:
+			s.Current = 1
+		}
+	}
+
+	if s.Current > s.Longest {
+		s.Longest = s.Current
+	}
+
+	s.LastCheckInOn = &day
+
+	return frozenDays, true
+}
+
+// CheckInRewardsFor picks the rewards of the table given on the day the
+// streak reaches the given length: the longest tier reached and the
+// milestones of that exact length.
+func CheckInRewardsFor(table []CheckInReward, streak uint) []CheckInReward {
+	var rewards []CheckInReward
+	var tier *CheckInReward
+
+	for i, reward := range table {
+		if reward.Milestone {
+			if reward.Streak == streak {
+				rewards = append(rewards, reward)
+			}
+
+			continue
+		}
+
+		if reward.Streak <= streak && (tier == nil || reward.Streak > tier.Streak) {
+			tier = &table[i]
+		}
+	}
+
+	if tier != nil {
+		rewards = append([]CheckInReward{*tier}, rewards...)
+	}
+
+	return rewards
+}
+
+// RewardsToGrant are the coins, experience and rewards the check-in gives.
+func (r CheckInResult) RewardsToGrant() []Reward {
+	rewards := AmountRewards(r.CheckIn.Coins, r.CheckIn.Experience)
+	for _, reward := range r.Rewards {
+		rewards = append(rewards, reward.Rewards...)
+	}
+
+	return rewards
+}
+
+// RewardGrantKey identifies the rewards of the check-in, given once per day.
+func (r CheckInResult) RewardGrantKey() string {
+	return "check_ins:" + r.CheckIn.Day.Format("2006-01-02")
+}
@@ -6,6 +6,10 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	CommentGameRequirementKey = "comment_game"
+)
+
 type Commentable struct {
 	gorm.Model
 	ID              uint   `gorm:"primaryKey"`
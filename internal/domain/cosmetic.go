@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Cosmetic is a profile decoration, such as a frame or a badge, shown in the
+// given slot of the profile.
+type Cosmetic struct {
+	gorm.Model
+	ID        uint   `gorm:"primaryKey"`
+	Name      string `gorm:"not null" validate:"required"`
+	Slot      string `gorm:"not null" validate:"required"`
+	Asset     string `gorm:"not null" validate:"required"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type UserCosmetic struct {
+	gorm.Model
+	ID         uint `gorm:"primaryKey"`
+	UserID     uint `gorm:"not null;uniqueIndex:idx_user_cosmetics_user_cosmetic,priority:1"`
+	CosmeticID uint `gorm:"not null;uniqueIndex:idx_user_cosmetics_user_cosmetic,priority:2"`
+	Enabled    bool `gorm:"not null;default:false"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	User       User     `gorm:"foreignKey:UserID;references:ID"`
+	Cosmetic   Cosmetic `gorm:"foreignKey:CosmeticID;references:ID"`
+}
+
+func (c *Cosmetic) ValidateCosmetic() error {
+	Init()
+
+	err := validate.Struct(c)
+	if err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
@@ -10,6 +10,8 @@ const (
 	CrackedStatus   = "cracked"
 	UncrackedStatus = "uncracked"
 	CrackedSameDay  = "cracked-oneday"
+
+	CrackedSameDayThreshold = 1
 )
 
 type Crack struct {
@@ -17,11 +19,12 @@ type Crack struct {
 	ID           uint   `gorm:"primaryKey"`
 	Status       string `gorm:"size:255;not null;type:enum('cracked','uncracked','cracked-oneday');default:uncracked" validate:"required"`
 	CrackedAt    *time.Time
+	DaysToCrack  *uint
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
-	CrackerID    uint       `gorm:"constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+	CrackerID    *uint      `gorm:"constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
 	Cracker      Cracker    `gorm:"foreignKey:CrackerID;references:ID;"`
-	ProtectionID uint       `gorm:"constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+	ProtectionID *uint      `gorm:"constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
 	Protection   Protection `gorm:"foreignKey:ProtectionID;references:ID;"`
 	GameID       uint       `gorm:"constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
 	Game         Game       `gorm:"foreignKey:GameID;references:ID;"`
@@ -37,3 +40,23 @@ func (c *Crack) ValidateCrack() error {
 
 	return nil
 }
+
+// DeriveCrackStatus computes the crack status and the days it took to crack a
+// game released at the given date. Games cracked within a day of their release
+// are flagged as cracked-oneday.
+func DeriveCrackStatus(releaseDate time.Time, crackedAt *time.Time) (string, *uint) {
+	if crackedAt == nil {
+		return UncrackedStatus, nil
+	}
+
+	var days uint
+	if crackedAt.After(releaseDate) {
+		days = uint(crackedAt.Sub(releaseDate).Hours() / 24)
+	}
+
+	if days <= CrackedSameDayThreshold {
+		return CrackedSameDay, &days
+	}
+
+	return CrackedStatus, &days
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"math"
+	"sort"
+)
+
+type CrackStats struct {
+	Total               uint
+	Cracked             uint
+	Uncracked           uint
+	UncrackedPercentage float64
+	MedianDaysToCrack   *float64
+}
+
+// SummarizeCracks aggregates the given cracks. Cracks without a known
+// days-to-crack value are counted but left out of the median.
+func SummarizeCracks(cracks []Crack) CrackStats {
+	stats := CrackStats{Total: uint(len(cracks))}
+
+	var days []uint
+	for _, crack := range cracks {
+		if crack.Status == UncrackedStatus {
+			stats.Uncracked++
+			continue
+		}
+
+		stats.Cracked++
+		if crack.DaysToCrack != nil {
+			days = append(days, *crack.DaysToCrack)
+		}
+	}
+
+	if stats.Total > 0 {
+		stats.UncrackedPercentage = math.Round(float64(stats.Uncracked)/float64(stats.Total)*10000) / 100
+	}
+
+	if len(days) > 0 {
+		sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
+
+		middle := len(days) / 2
+		median := float64(days[middle])
+		if len(days)%2 == 0 {
+			median = float64(days[middle-1]+days[middle]) / 2
+		}
+
+		stats.MedianDaysToCrack = &median
+	}
+
+	return stats
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type CrackStatusChange struct {
+	gorm.Model
+	ID             uint   `gorm:"primaryKey"`
+	Status         string `gorm:"size:255;not null" validate:"required"`
+	PreviousStatus string `gorm:"size:255"`
+	CrackedAt      *time.Time
+	DaysToCrack    *uint
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	CrackID        uint       `gorm:"index;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+	Crack          Crack      `gorm:"foreignKey:CrackID;references:ID;"`
+	GameID         uint       `gorm:"index;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+	Game           Game       `gorm:"foreignKey:GameID;references:ID;"`
+	CrackerID      *uint      `gorm:"constraint:OnDelete:SET NULL,OnUpdate:CASCADE;"`
+	Cracker        Cracker    `gorm:"foreignKey:CrackerID;references:ID;"`
+	ProtectionID   *uint      `gorm:"constraint:OnDelete:SET NULL,OnUpdate:CASCADE;"`
+	Protection     Protection `gorm:"foreignKey:ProtectionID;references:ID;"`
+}
+
+func (c *CrackStatusChange) ValidateCrackStatusChange() error {
+	Init()
+
+	err := validate.Struct(c)
+	if err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
+
+// CrackStatusHeadline describes the new crack status of a game. An empty
+// previous status means it is the first crack record of the game.
+func CrackStatusHeadline(gameTitle string, status string, previousStatus string, daysToCrack *uint) string {
+	switch {
+	case status == UncrackedStatus && previousStatus == "":
+		return fmt.Sprintf("%s is not cracked yet!", gameTitle)
+	case status == UncrackedStatus:
+		return fmt.Sprintf("%s is uncracked again!", gameTitle)
+	case status == CrackedSameDay:
+		return fmt.Sprintf("%s was cracked on its release day!", gameTitle)
+	case daysToCrack != nil:
+		return fmt.Sprintf("%s was cracked after %d days!", gameTitle, *daysToCrack)
+	default:
+		return fmt.Sprintf("%s was cracked!", gameTitle)
+	}
+}
@@ -8,16 +8,25 @@ import (
 
 type DLCStore struct {
 	gorm.Model
-	ID        uint   `gorm:"primaryKey"`
-	Price     uint   `gorm:"not null" validate:"required"`
-	URL       string `gorm:"size:255;not null" validate:"required"`
-	DLCID     uint   `gorm:"constraint:OnDelete:SET NULL,OnUpdate:CASCADE;"`
-	DLC       DLC    `gorm:"foreignKey:DLCID;references:ID"`
-	StoreID   uint   `gorm:"constraint:OnDelete:SET NULL,OnUpdate:CASCADE;"`
-	Store     Store  `gorm:"foreignKey:StoreID;references:ID"`
-	StorDLCID string `gorm:"not null;" validate:"required"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID           uint         `gorm:"primaryKey"`
+	Price        uint         `gorm:"not null" validate:"required"`
+	URL          string       `gorm:"size:255;not null" validate:"required"`
+	DLCID        uint         `gorm:"constraint:OnDelete:SET NULL,OnUpdate:CASCADE;"`
+	DLC          DLC          `gorm:"foreignKey:DLCID;references:ID"`
+	StoreID      uint         `gorm:"constraint:OnDelete:SET NULL,OnUpdate:CASCADE;"`
+	Store        Store        `gorm:"foreignKey:StoreID;references:ID"`
+	StorDLCID    string       `gorm:"not null;" validate:"required"`
+	InitialPrice uint         `gorm:"not null;default:0"`
+	LowestPrice  uint         `gorm:"not null;default:0"`
+	Prices       []StorePrice `gorm:"polymorphic:Priceable;"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Discount returns the current discount percentage of the listing, based on
+// the initial price reported by the store.
+func (ds *DLCStore) Discount() uint {
+	return CalculateDiscount(ds.InitialPrice, ds.Price)
 }
 
 func (ds *DLCStore) ValidateDLCStore() error {
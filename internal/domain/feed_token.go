@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type FeedToken struct {
+	gorm.Model
+	ID        uint   `gorm:"primaryKey"`
+	Token     string `gorm:"size:64;not null;uniqueIndex" validate:"required"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	UserID    uint `gorm:"uniqueIndex;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+	User      User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+func (f *FeedToken) ValidateFeedToken() error {
+	Init()
+
+	if err := validate.Struct(f); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
@@ -62,3 +62,52 @@ func (g *Game) ValidateGame() error {
 
 	return nil
 }
+
+// ValidateGameAggregate validates a game written as a whole by admins along
+// with its nested relations. Back references to the game and the referenced
+// records are skipped, as they are only checked through their foreign keys.
+func (g *Game) ValidateGameAggregate() error {
+	Init()
+
+	if err := validate.StructExcept(g, "Crack", "Support"); err != nil {
+		return FormatValidationError(err)
+	}
+
+	if g.Support != nil && g.Support.Email != nil && *g.Support.Email != "" {
+		if err := validate.StructExcept(g.Support, "Game"); err != nil {
+			return FormatValidationError(err)
+		}
+	}
+
+	for i := range g.Requirements {
+		if err := validate.StructExcept(&g.Requirements[i], "Game"); err != nil {
+			return FormatValidationError(err)
+		}
+	}
+
+	for i := range g.Languages {
+		if err := validate.StructExcept(&g.Languages[i], "Game", "Language"); err != nil {
+			return FormatValidationError(err)
+		}
+	}
+
+	for i := range g.Stores {
+		if err := validate.StructExcept(&g.Stores[i], "Game", "Store", "Price"); err != nil {
+			return FormatValidationError(err)
+		}
+	}
+
+	for i := range g.Galleries {
+		if err := validate.StructExcept(&g.Galleries[i], "MediaType"); err != nil {
+			return FormatValidationError(err)
+		}
+	}
+
+	for i := range g.DLCs {
+		if err := validate.StructExcept(&g.DLCs[i], "Game"); err != nil {
+			return FormatValidationError(err)
+		}
+	}
+
+	return nil
+}
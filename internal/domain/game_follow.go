@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	FollowReleaseEvent = "release"
+	FollowCrackEvent   = "crack"
+	FollowPriceEvent   = "price"
+	FollowDLCEvent     = "dlc"
+)
+
+var followEventColumns = map[string]string{
+	FollowReleaseEvent: "notify_release",
+	FollowCrackEvent:   "notify_crack",
+	FollowPriceEvent:   "notify_price",
+	FollowDLCEvent:     "notify_dlc",
+}
+
+type GameFollow struct {
+	gorm.Model
+	ID            uint   `gorm:"primaryKey"`
+	NotifyRelease bool   `gorm:"not null" validate:"boolean"`
+	NotifyCrack   bool   `gorm:"not null" validate:"boolean"`
+	NotifyPrice   bool   `gorm:"not null" validate:"boolean"`
+	NotifyDLC     bool   `gorm:"not null" validate:"boolean"`
+	Token         string `gorm:"size:64;not null;uniqueIndex" validate:"required"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	GameID        uint `gorm:"uniqueIndex:idx_game_follows_game_user;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+	Game          Game `gorm:"foreignKey:GameID;references:ID"`
+	UserID        uint `gorm:"uniqueIndex:idx_game_follows_game_user;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+	User          User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+func (gf *GameFollow) ValidateGameFollow() error {
+	Init()
+
+	if err := validate.Struct(gf); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
+
+// FollowEventColumn returns the preference column of the given follow event.
+func FollowEventColumn(event string) (string, bool) {
+	column, ok := followEventColumns[event]
+	return column, ok
+}
+
+func (gf *GameFollow) Notifies(event string) bool {
+	switch event {
+	case FollowReleaseEvent:
+		return gf.NotifyRelease
+	case FollowCrackEvent:
+		return gf.NotifyCrack
+	case FollowPriceEvent:
+		return gf.NotifyPrice
+	case FollowDLCEvent:
+		return gf.NotifyDLC
+	}
+
+	return false
+}
+
+// Mute disables the notifications of the given event, or every event when
+// none is given.
+func (gf *GameFollow) Mute(event string) {
+	if event == "" || event == FollowReleaseEvent {
+		gf.NotifyRelease = false
+	}
+	if event == "" || event == FollowCrackEvent {
+		gf.NotifyCrack = false
+	}
+	if event == "" || event == FollowPriceEvent {
+		gf.NotifyPrice = false
+	}
+	if event == "" || event == FollowDLCEvent {
+		gf.NotifyDLC = false
+	}
+}
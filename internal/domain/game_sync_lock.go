@@ -0,0 +1,256 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Game fields and relations kept in sync with the store catalogs. Locking one
+// of them keeps admin edits from being overwritten by the next re-sync.
+const (
+	SyncFieldTitle            = "title"
+	SyncFieldAbout            = "about"
+	SyncFieldDescription      = "description"
+	SyncFieldShortDescription = "short_description"
+	SyncFieldCover            = "cover"
+	SyncFieldFree             = "free"
+	SyncFieldReleaseDate      = "release_date"
+	SyncFieldAge              = "age"
+	SyncFieldWebsite          = "website"
+	SyncFieldLegal            = "legal"
+	SyncFieldSupport          = "support"
+	SyncFieldGalleries        = "galleries"
+	SyncFieldGenres           = "genres"
+	SyncFieldCategories       = "categories"
+	SyncFieldPublishers       = "publishers"
+	SyncFieldDevelopers       = "developers"
+	SyncFieldLanguages        = "languages"
+	SyncFieldRequirements     = "requirements"
+	SyncFieldDLCs             = "dlcs"
+)
+
+var SyncLockableFields = []string{
+	SyncFieldTitle,
+	SyncFieldAbout,
+	SyncFieldDescription,
+	SyncFieldShortDescription,
+	SyncFieldCover,
+	SyncFieldFree,
+	SyncFieldReleaseDate,
+	SyncFieldAge,
+	SyncFieldWebsite,
+	SyncFieldLegal,
+	SyncFieldSupport,
+	SyncFieldGalleries,
+	SyncFieldGenres,
+	SyncFieldCategories,
+	SyncFieldPublishers,
+	SyncFieldDevelopers,
+	SyncFieldLanguages,
+	SyncFieldRequirements,
+	SyncFieldDLCs,
+}
+
+type GameSyncLock struct {
+	gorm.Model
+	ID        uint   `gorm:"primaryKey"`
+	Field     string `gorm:"size:50;not null;uniqueIndex:idx_game_sync_locks_game_field" validate:"required,sync_field"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	GameID    uint `gorm:"uniqueIndex:idx_game_sync_locks_game_field;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+	Game      Game `gorm:"foreignKey:GameID;references:ID"`
+}
+
+func (gsl *GameSyncLock) ValidateGameSyncLock() error {
+	Init()
+
+	if err := validate.Struct(gsl); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
+
+func IsSyncLockableField(field string) bool {
+	for _, lockable := range SyncLockableFields {
+		if lockable == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LockedSyncFields indexes the given locks by field.
+func LockedSyncFields(locks []GameSyncLock) map[string]bool {
+	locked := make(map[string]bool, len(locks))
+	for _, lock := range locks {
+		locked[lock.Field] = true
+	}
+
+	return locked
+}
+
+// ChangedSyncFields lists the sync fields an admin edit changes on a game, so
+// they can be locked against the next re-sync. Relations are compared by the
+// records they point to, regardless of their order.
+func ChangedSyncFields(before *Game, after *Game) []string {
+	changed := map[string]bool{
+		SyncFieldTitle:            before.Title != after.Title,
+		SyncFieldAbout:            before.About != after.About,
+		SyncFieldDescription:      before.Description != after.Description,
+		SyncFieldShortDescription: before.ShortDescription != after.ShortDescription,
+		SyncFieldCover:            before.Cover != after.Cover,
+		SyncFieldFree:             before.Free != after.Free,
+		SyncFieldReleaseDate:      !before.ReleaseDate.Equal(after.ReleaseDate),
+		SyncFieldAge:              before.Age != after.Age,
+		SyncFieldWebsite:          !sameStringPointer(before.Website, after.Website),
+		SyncFieldLegal:            !sameStringPointer(before.Legal, after.Legal),
+		SyncFieldSupport:          !sameKeys(supportKeys(before.Support), supportKeys(after.Support)),
+		SyncFieldGalleries:        !sameKeys(galleryKeys(before.Galleries), galleryKeys(after.Galleries)),
+		SyncFieldGenres:           !sameKeys(genreKeys(before.Genres), genreKeys(after.Genres)),
+		SyncFieldCategories:       !sameKeys(categoryKeys(before.Categories), categoryKeys(after.Categories)),
+		SyncFieldPublishers:       !sameKeys(publisherKeys(before.Publishers), publisherKeys(after.Publishers)),
+		SyncFieldDevelopers:       !sameKeys(developerKeys(before.Developers), developerKeys(after.Developers)),
+		SyncFieldLanguages:        !sameKeys(languageKeys(before.Languages), languageKeys(after.Languages)),
+		SyncFieldRequirements:     !sameKeys(requirementKeys(before.Requirements), requirementKeys(after.Requirements)),
+		SyncFieldDLCs:             !sameKeys(dlcKeys(before.DLCs), dlcKeys(after.DLCs)),
+	}
+
+	fields := make([]string, 0, len(changed))
+	for _, field := range SyncLockableFields {
+		if changed[field] {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+func sameStringPointer(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func sameKeys(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sort.Strings(a)
+	sort.Strings(b)
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func supportKeys(support *GameSupport) []string {
+	if support == nil {
+		return nil
+	}
+
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+
+		return *s
+	}
+
+	return []string{value(support.URL), value(support.Email), value(support.Contact)}
+}
+
+func galleryKeys(galleries []Galleriable) []string {
+	keys := make([]string, 0, len(galleries))
+	for _, gallery := range galleries {
+		keys = append(keys, fmt.Sprintf("%d:%s", gallery.MediaTypeID, gallery.Path))
+	}
+
+	return keys
+}
+
+func genreKeys(genres []Genreable) []string {
+	keys := make([]string, 0, len(genres))
+	for _, genre := range genres {
+		keys = append(keys, fmt.Sprint(genre.GenreID))
+	}
+
+	return keys
+}
+
+func categoryKeys(categories []Categoriable) []string {
+	keys := make([]string, 0, len(categories))
+	for _, category := range categories {
+		keys = append(keys, fmt.Sprint(category.CategoryID))
+	}
+
+	return keys
+}
+
+func publisherKeys(publishers []GamePublisher) []string {
+	keys := make([]string, 0, len(publishers))
+	for _, publisher := range publishers {
+		keys = append(keys, fmt.Sprint(publisher.PublisherID))
+	}
+
+	return keys
+}
+
+func developerKeys(developers []GameDeveloper) []string {
+	keys := make([]string, 0, len(developers))
+	for _, developer := range developers {
+		keys = append(keys, fmt.Sprint(developer.DeveloperID))
+	}
+
+	return keys
+}
+
+func languageKeys(languages []GameLanguage) []string {
+	keys := make([]string, 0, len(languages))
+	for _, language := range languages {
+		keys = append(keys, fmt.Sprintf("%d:%t:%t:%t", language.LanguageID, language.Menu, language.Dubs, language.Subtitles))
+	}
+
+	return keys
+}
+
+func requirementKeys(requirements []Requirement) []string {
+	keys := make([]string, 0, len(requirements))
+	for _, r := range requirements {
+		obs := ""
+		if r.OBS != nil {
+			obs = *r.OBS
+		}
+
+		keys = append(keys, fmt.Sprintf("%s:%s:%s:%s:%s:%s:%s:%s:%s:%s",
+			r.RequirementType.Potential, r.RequirementType.OS, r.OS, r.DX, r.CPU, r.RAM, r.GPU, r.ROM, r.Network, obs))
+	}
+
+	return keys
+}
+
+func dlcKeys(dlcs []DLC) []string {
+	keys := make([]string, 0, len(dlcs))
+	for _, dlc := range dlcs {
+		legal := ""
+		if dlc.Legal != nil {
+			legal = *dlc.Legal
+		}
+
+		keys = append(keys, fmt.Sprintf("%s:%s:%s:%s:%s:%t:%d:%s",
+			dlc.Name, dlc.Cover, dlc.About, dlc.Description, dlc.ShortDescription, dlc.Free, dlc.ReleaseDate.Unix(), legal))
+	}
+
+	return keys
+}
@@ -6,6 +6,10 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	HeartGameRequirementKey = "heart_game"
+)
+
 type Heartable struct {
 	gorm.Model
 	ID            uint   `gorm:"primaryKey"`
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ImportCheckpoint keeps the progress of a catalog import, so an interrupted
+// run resumes after the last app it fully processed. The apps that failed
+// are kept apart to be retried by the next run.
+type ImportCheckpoint struct {
+	gorm.Model
+	ID           uint    `gorm:"primaryKey"`
+	Source       string  `gorm:"size:50;not null;uniqueIndex" validate:"required"`
+	LastAppID    uint    `gorm:"not null"`
+	FailedAppIDs *string `gorm:"type:text"`
+	Created      uint    `gorm:"not null"`
+	Updated      uint    `gorm:"not null"`
+	Skipped      uint    `gorm:"not null"`
+	Failed       uint    `gorm:"not null"`
+	FinishedAt   *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (ic *ImportCheckpoint) ValidateImportCheckpoint() error {
+	Init()
+
+	if err := validate.Struct(ic); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
+
+// FailedIDs decodes the apps left to retry.
+func (ic *ImportCheckpoint) FailedIDs() []uint {
+	var ids []uint
+	if ic.FailedAppIDs == nil {
+		return ids
+	}
+
+	_ = json.Unmarshal([]byte(*ic.FailedAppIDs), &ids)
+
+	return ids
+}
+
+// SetFailedIDs encodes the apps left to retry in ascending order, leaving
+// them empty when there are none.
+func (ic *ImportCheckpoint) SetFailedIDs(ids []uint) {
+	if len(ids) == 0 {
+		ic.FailedAppIDs = nil
+		return
+	}
+
+	sorted := append([]uint(nil), ids...)
+	sort.Slice(sorted, func(a, b int) bool { return sorted[a] < sorted[b] })
+
+	encoded, err := json.Marshal(sorted)
+	if err != nil {
+		return
+	}
+
+	value := string(encoded)
+	ic.FailedAppIDs = &value
+}
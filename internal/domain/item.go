@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Item is something users keep in their inventory, stacked by quantity.
+type Item struct {
+	gorm.Model
+	ID          uint   `gorm:"primaryKey"`
+	Name        string `gorm:"not null" validate:"required"`
+	Description string `gorm:"not null" validate:"required"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type UserItem struct {
+	gorm.Model
+	ID        uint `gorm:"primaryKey"`
+	UserID    uint `gorm:"not null;uniqueIndex:idx_user_items_user_item,priority:1"`
+	ItemID    uint `gorm:"not null;uniqueIndex:idx_user_items_user_item,priority:2"`
+	Quantity  uint `gorm:"not null;default:0"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	User      User `gorm:"foreignKey:UserID;references:ID"`
+	Item      Item `gorm:"foreignKey:ItemID;references:ID"`
+}
+
+func (i *Item) ValidateItem() error {
+	Init()
+
+	err := validate.Struct(i)
+	if err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	JobStatusPending   = "pending"
+	JobStatusRunning   = "running"
+	JobStatusSucceeded = "succeeded"
+	JobStatusFailed    = "failed"
+	JobStatusCancelled = "cancelled"
+)
+
+// Job types handled by the background workers.
+const (
+	JobTypeCatalogImportItem = "catalog:import-item"
+	JobTypeCatalogSyncGame   = "catalog:sync-game"
+	JobTypeSendEmail         = "email:send"
+	JobTypeGameFollowEvent   = "notification:game-follow"
+)
+
+const DefaultJobMaxAttempts = 3
+
+type Job struct {
+	gorm.Model
+	ID          uint       `gorm:"primaryKey"`
+	Type        string     `gorm:"size:100;not null;index" validate:"required"`
+	Payload     string     `gorm:"type:text;not null"`
+	Status      string     `gorm:"size:20;not null;index:idx_jobs_status_available_at" validate:"required,oneof=pending running succeeded failed cancelled"`
+	Attempts    uint       `gorm:"not null;default:0"`
+	MaxAttempts uint       `gorm:"not null;default:3" validate:"required"`
+	Error       *string    `gorm:"type:text"`
+	AvailableAt time.Time  `gorm:"not null;index:idx_jobs_status_available_at"`
+	StartedAt   *time.Time `gorm:"null"`
+	LockedUntil *time.Time `gorm:"null;index"`
+	FinishedAt  *time.Time `gorm:"null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (j *Job) ValidateJob() error {
+	Init()
+
+	if err := validate.Struct(j); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
+
+// IsRetryable tells whether an admin can send the job back to the queue.
+func (j *Job) IsRetryable() bool {
+	return j.Status == JobStatusFailed || j.Status == JobStatusCancelled
+}
+
+// IsCancellable tells whether the job has not finished yet.
+func (j *Job) IsCancellable() bool {
+	return j.Status == JobStatusPending || j.Status == JobStatusRunning
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	LeaderboardExperience = "experience"
+	LeaderboardCoins      = "coins"
+	LeaderboardTitles     = "titles"
+	LeaderboardMissions   = "missions"
+
+	LeaderboardAllTime = "all-time"
+	LeaderboardMonthly = "monthly"
+	LeaderboardWeekly  = "weekly"
+)
+
+var (
+	LeaderboardBoards  = []string{LeaderboardExperience, LeaderboardCoins, LeaderboardTitles, LeaderboardMissions}
+	LeaderboardWindows = []string{LeaderboardAllTime, LeaderboardMonthly, LeaderboardWeekly}
+)
+
+// LeaderboardScore is the place of a user on a leaderboard. Ranks start at 1.
+type LeaderboardScore struct {
+	UserID uint
+	Score  float64
+	Rank   int64
+}
+
+// LeaderboardEntry is a ranked user of a leaderboard. Ranks leave out the
+// blocked users.
+type LeaderboardEntry struct {
+	Rank  int64
+	Score float64
+	User  User
+}
+
+// LeaderboardPeriod is the window of a leaderboard holding the given time.
+// Windows follow UTC, weeks starting on Monday. The all-time window has no
+// bounds, so its start and end are zero.
+type LeaderboardPeriod struct {
+	Board  string
+	Window string
+	Start  time.Time
+	End    time.Time
+}
+
+func IsLeaderboard(board string, window string) bool {
+	return slices.Contains(LeaderboardBoards, board) && slices.Contains(LeaderboardWindows, window)
+}
+
+func NewLeaderboardPeriod(board string, window string, at time.Time) LeaderboardPeriod {
+	period := LeaderboardPeriod{Board: board, Window: window}
+	at = at.UTC()
+
+	switch window {
+	case LeaderboardMonthly:
+		period.Start = time.Date(at.Year(), at.Month(), 1, 0, 0, 0, 0, time.UTC)
+		period.End = period.Start.AddDate(0, 1, 0)
+	case LeaderboardWeekly:
+		day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+		period.Start = day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+		period.End = period.Start.AddDate(0, 0, 7)
+	}
+
+	return period
+}
+
+func (p LeaderboardPeriod) IsAllTime() bool {
+	return p.Start.IsZero()
+}
+
+// Key is the Redis key of the sorted set holding the period, such as
+// "leaderboard:coins:weekly:2026-W42".
+func (p LeaderboardPeriod) Key() string {
+	switch p.Window {
+	case LeaderboardMonthly:
+		return fmt.Sprintf("leaderboard:%s:%s:%s", p.Board, p.Window, p.Start.Format("2006-01"))
+	case LeaderboardWeekly:
+		year, week := p.Start.ISOWeek()
+		return fmt.Sprintf("leaderboard:%s:%s:%d-W%02d", p.Board, p.Window, year, week)
+	}
+
+	return fmt.Sprintf("leaderboard:%s:%s", p.Board, p.Window)
+}
+
+// ExpiresAt tells when the period can be dropped, keeping it for one more
+// period once it ends, so the previous board can still be looked up.
+func (p LeaderboardPeriod) ExpiresAt() *time.Time {
+	if p.IsAllTime() {
+		return nil
+	}
+
+	expiresAt := p.End.Add(p.End.Sub(p.Start))
+
+	return &expiresAt
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	LibraryWishlist  = "wishlist"
+	LibraryPlaying   = "playing"
+	LibraryCompleted = "completed"
+	LibraryDropped   = "dropped"
+	LibraryBacklog   = "backlog"
+
+	LibraryableTypeGames = "games"
+	LibraryableTypeDLCs  = "dlcs"
+
+	AddToLibraryRequirementKey = "add_to_library"
+	CompleteGameRequirementKey = "complete_game"
+)
+
+type Libraryable struct {
+	gorm.Model
+	ID              uint       `gorm:"primaryKey"`
+	Status          string     `gorm:"size:255;not null;type:enum('wishlist','playing','completed','dropped','backlog');default:wishlist" validate:"required"`
+	HoursPlayed     *uint      `gorm:"default:null"`
+	CompletedAt     *time.Time `gorm:"default:null"`
+	Private         bool       `gorm:"not null;default:false" validate:"boolean"`
+	LibraryableID   uint       `gorm:"index"`
+	LibraryableType string     `gorm:"index"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	UserID          uint `gorm:"constraint:OnDelete:SET NULL,OnUpdate:CASCADE;"`
+	User            User `gorm:"foreignKey:UserID"`
+
+	Libraryable any `gorm:"-"`
+}
+
+type LibraryStats struct {
+	Total       uint `json:"total"`
+	Wishlist    uint `json:"wishlist"`
+	Playing     uint `json:"playing"`
+	Completed   uint `json:"completed"`
+	Dropped     uint `json:"dropped"`
+	Backlog     uint `json:"backlog"`
+	HoursPlayed uint `json:"hours_played"`
+}
+
+func IsValidLibraryStatus(status string) bool {
+	switch status {
+	case LibraryWishlist, LibraryPlaying, LibraryCompleted, LibraryDropped, LibraryBacklog:
+		return true
+	}
+
+	return false
+}
+
+func (l *Libraryable) ValidateLibraryable() error {
+	Init()
+
+	if err := validate.Struct(l); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
@@ -16,6 +17,11 @@ const (
 	MonthlyMission     = "monthly"
 )
 
+var (
+	MissionStatuses    = []string{MissionAvailable, MissionUnavailable, MissionCanceled}
+	MissionFrequencies = []string{OneTimeMission, DailyMission, WeeklyMission, MonthlyMission}
+)
+
 type Mission struct {
 	gorm.Model
 	ID                  uint      `gorm:"primaryKey"`
@@ -27,11 +33,15 @@ type Mission struct {
 	Experience          uint      `gorm:"not null" validate:"required,numeric"`
 	Frequency           string    `gorm:"not null;default:one-time" validate:"required"`
 	ResetTime           time.Time `gorm:"not null;autoCreateTime"`
+	ResetHour           uint      `gorm:"not null;default:0" validate:"lte=23"`
+	ResetWeekday        uint      `gorm:"not null;default:1" validate:"lte=6"`
+	ResetTimezone       *string   `gorm:"size:64"`
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
-	MissionRequirements []MissionRequirement `gorm:"foreignKey:MissionID"`
-	Rewards             []Reward             `gorm:"polymorphic:Sourceable;"`
-	UserMission         []UserMission        `json:"user" gorm:"foreignKey:MissionID"`
+	MissionRequirements []MissionRequirement    `gorm:"foreignKey:MissionID"`
+	Rewards             []Reward                `gorm:"polymorphic:Sourceable;"`
+	UserMission         []UserMission           `json:"user" gorm:"foreignKey:MissionID"`
+	Assignments         []UserMissionAssignment `gorm:"foreignKey:MissionID"`
 }
 
 func (t *Mission) ValidateMission() error {
@@ -42,5 +52,67 @@ func (t *Mission) ValidateMission() error {
 		return FormatValidationError(err)
 	}
 
+	if t.ResetTimezone != nil {
+		if _, err := time.LoadLocation(*t.ResetTimezone); err != nil {
+			return fmt.Errorf("the reset timezone %q is not valid", *t.ResetTimezone)
+		}
+	}
+
 	return nil
 }
+
+// IsRecurring tells whether the progress of the mission is reset on a
+// schedule.
+func (t *Mission) IsRecurring() bool {
+	return t.Frequency == DailyMission || t.Frequency == WeeklyMission || t.Frequency == MonthlyMission
+}
+
+// LastReset is the latest scheduled reset of the mission at or before now, on
+// the clock of the given location. Daily missions reset every day at the reset
+// hour, weekly ones on the reset weekday and monthly ones on the first day of
+// the month.
+func (t *Mission) LastReset(now time.Time, loc *time.Location) (time.Time, bool) {
+	if !t.IsRecurring() {
+		return time.Time{}, false
+	}
+
+	local := now.In(loc)
+	reset := time.Date(local.Year(), local.Month(), local.Day(), int(t.ResetHour), 0, 0, 0, loc)
+
+	switch t.Frequency {
+	case WeeklyMission:
+		reset = reset.AddDate(0, 0, -((int(reset.Weekday()) - int(t.ResetWeekday) + 7) % 7))
+		if reset.After(local) {
+			reset = reset.AddDate(0, 0, -7)
+		}
+	case MonthlyMission:
+		reset = time.Date(local.Year(), local.Month(), 1, int(t.ResetHour), 0, 0, 0, loc)
+		if reset.After(local) {
+			reset = reset.AddDate(0, -1, 0)
+		}
+	default:
+		if reset.After(local) {
+			reset = reset.AddDate(0, 0, -1)
+		}
+	}
+
+	return reset, true
+}
+
+// NextReset is the first scheduled reset of the mission after now, on the
+// clock of the given location.
+func (t *Mission) NextReset(now time.Time, loc *time.Location) (time.Time, bool) {
+	last, ok := t.LastReset(now, loc)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	switch t.Frequency {
+	case WeeklyMission:
+		return last.AddDate(0, 0, 7), true
+	case MonthlyMission:
+		return last.AddDate(0, 1, 0), true
+	default:
+		return last.AddDate(0, 0, 1), true
+	}
+}
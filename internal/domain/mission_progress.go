@@ -8,9 +8,10 @@ import (
 
 type MissionProgress struct {
 	gorm.Model
-	ID                   uint `gorm:"primaryKey" json:"id"`
-	Progress             int  `json:"progress"`
-	Completed            bool `json:"completed"`
+	ID                   uint       `gorm:"primaryKey" json:"id"`
+	Progress             int        `json:"progress"`
+	Completed            bool       `json:"completed"`
+	ResetAt              *time.Time `json:"reset_at" gorm:"default:null"`
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 	UserID               uint                `json:"user_id"`
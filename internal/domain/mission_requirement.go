@@ -13,6 +13,7 @@ type MissionRequirement struct {
 	Key             string `gorm:"not null" validate:"required"`
 	Goal            int    `gorm:"not null" validate:"required,numeric"`
 	Description     string `gorm:"not null" validate:"required"`
+	TaskRule        `gorm:"embedded"`
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	MissionProgress MissionProgress `json:"progress" gorm:"foreignKey:MissionRequirementID"`
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	MissionResetRunning   = "running"
+	MissionResetSucceeded = "succeeded"
+	MissionResetFailed    = "failed"
+)
+
+// MissionResetRun records the reset of a mission for the users of a timezone
+// at one of its scheduled resets. The unique period lets a single replica claim
+// each reset.
+type MissionResetRun struct {
+	gorm.Model
+	ID          uint       `gorm:"primaryKey"`
+	MissionID   uint       `gorm:"not null;uniqueIndex:idx_mission_reset_runs_period"`
+	Timezone    string     `gorm:"size:64;not null;uniqueIndex:idx_mission_reset_runs_period" validate:"required"`
+	PeriodStart time.Time  `gorm:"not null;uniqueIndex:idx_mission_reset_runs_period"`
+	Status      string     `gorm:"size:20;not null;index" validate:"required,oneof=running succeeded failed"`
+	Progresses  int64      `gorm:"not null;default:0"`
+	Error       *string    `gorm:"type:text"`
+	StartedAt   time.Time  `gorm:"not null"`
+	FinishedAt  *time.Time `gorm:"null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Mission     Mission `gorm:"foreignKey:MissionID;references:ID"`
+}
+
+func (r *MissionResetRun) ValidateMissionResetRun() error {
+	Init()
+
+	if err := validate.Struct(r); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
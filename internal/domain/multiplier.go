@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// Multipliers are what the experience and coins a user earns are multiplied
+// by, along with the events and boosters they come from. Events and boosters
+// of the same scope do not stack: the best event is multiplied by the best
+// booster, so a double XP weekend and a double XP booster give four times.
+type Multipliers struct {
+	Experience float64
+	Coins      float64
+	Events     []MultiplierEvent
+	Boosters   []UserBooster
+}
+
+// NewMultipliers keeps the events and boosters active at the given time,
+// leaving out the events of other actions, and works out the multiplier of
+// each scope.
+func NewMultipliers(events []MultiplierEvent, boosters []UserBooster, actionKey string, at time.Time) Multipliers {
+	multipliers := Multipliers{Experience: 1, Coins: 1}
+	bestEvents := map[string]float64{}
+	bestBoosters := map[string]float64{}
+
+	for _, event := range events {
+		if !event.IsActive(at) || !event.AppliesTo(actionKey) {
+			continue
+		}
+
+		multipliers.Events = append(multipliers.Events, event)
+		bestEvents[event.Scope] = math.Max(bestEvents[event.Scope], event.Multiplier)
+	}
+
+	for _, booster := range boosters {
+		if !booster.IsActive(at) {
+			continue
+		}
+
+		multipliers.Boosters = append(multipliers.Boosters, booster)
+		bestBoosters[booster.Scope] = math.Max(bestBoosters[booster.Scope], booster.Multiplier)
+	}
+
+	multiplierOf := func(scope string) float64 {
+		return math.Max(bestEvents[scope], 1) * math.Max(bestBoosters[scope], 1)
+	}
+
+	multipliers.Experience = multiplierOf(BoosterScopeExperience)
+	multipliers.Coins = multiplierOf(BoosterScopeCoins)
+
+	return multipliers
+}
+
+// For is the multiplier of the scope, 1 when nothing multiplies it.
+func (m Multipliers) For(scope string) float64 {
+	switch scope {
+	case BoosterScopeExperience:
+		return math.Max(m.Experience, 1)
+	case BoosterScopeCoins:
+		return math.Max(m.Coins, 1)
+	default:
+		return 1
+	}
+}
+
+// Apply multiplies the coins and experience rewards, leaving the others as
+// they are.
+func (m Multipliers) Apply(rewards []Reward) []Reward {
+	applied := make([]Reward, len(rewards))
+	for i, reward := range rewards {
+		applied[i] = reward.Boosted(m.For(reward.RewardableType))
+	}
+
+	return applied
+}
+
+// FormatMultiplier writes the multiplier as shown to users, e.g. "x1.5".
+func FormatMultiplier(multiplier float64) string {
+	return "x" + strconv.FormatFloat(multiplier, 'f', -1, 64)
+}
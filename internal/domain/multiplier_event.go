@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const CompleteMissionActionKey = "complete_mission"
+
+// MultiplierActionKeys are the actions giving coins or experience, so they
+// are the only keys a multiplier event can be limited to.
+var MultiplierActionKeys = []string{
+	CompleteMissionActionKey,
+	CheckInRequirementKey,
+	AnswerQuizActionKey,
+}
+
+// MultiplierEvent is a global multiplier of the experience or coins earned
+// between its start and end, e.g. a double XP weekend. An event with an
+// action key only multiplies what that action gives, while one without it
+// multiplies everything.
+type MultiplierEvent struct {
+	gorm.Model
+	ID         uint      `gorm:"primaryKey"`
+	Name       string    `gorm:"not null" validate:"required"`
+	Scope      string    `gorm:"not null" validate:"required,oneof=experience coins"`
+	Multiplier float64   `gorm:"not null;default:1" validate:"required,gt=1"`
+	ActionKey  string    `gorm:"size:64;not null;default:''" validate:"omitempty,multiplier_action"`
+	StartsAt   time.Time `gorm:"not null;index" validate:"required"`
+	EndsAt     time.Time `gorm:"not null;index" validate:"required,gtfield=StartsAt"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (e *MultiplierEvent) ValidateMultiplierEvent() error {
+	Init()
+
+	if err := validate.Struct(e); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
+
+func IsMultiplierActionKey(key string) bool {
+	for _, action := range MultiplierActionKeys {
+		if action == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsActive tells whether the event is running at the given time.
+func (e MultiplierEvent) IsActive(at time.Time) bool {
+	return !at.Before(e.StartsAt) && at.Before(e.EndsAt)
+}
+
+// AppliesTo tells whether the event multiplies what the action gives.
+func (e MultiplierEvent) AppliesTo(actionKey string) bool {
+	return e.ActionKey == "" || e.ActionKey == actionKey
+}
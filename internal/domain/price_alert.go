@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type PriceAlert struct {
+	gorm.Model
+	ID                uint  `gorm:"primaryKey"`
+	TargetPrice       *uint `gorm:"default:null"`
+	AnyDiscount       bool  `gorm:"not null;default:false" validate:"boolean"`
+	Active            bool  `gorm:"not null;default:true" validate:"boolean"`
+	LastNotifiedPrice *uint `gorm:"default:null"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	GameID            uint `gorm:"constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+	Game              Game `gorm:"foreignKey:GameID;references:ID"`
+	UserID            uint `gorm:"constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+	User              User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+// ShouldTrigger reports whether the alert must notify its user about the given
+// price. An alert is never triggered twice for the same price.
+func (pa *PriceAlert) ShouldTrigger(initialPrice uint, price uint) bool {
+	if !pa.Active {
+		return false
+	}
+
+	if pa.LastNotifiedPrice != nil && *pa.LastNotifiedPrice == price {
+		return false
+	}
+
+	if pa.TargetPrice != nil && price <= *pa.TargetPrice {
+		return true
+	}
+
+	return pa.AnyDiscount && CalculateDiscount(initialPrice, price) > 0
+}
+
+func (pa *PriceAlert) ValidatePriceAlert() error {
+	Init()
+
+	if err := validate.Struct(pa); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
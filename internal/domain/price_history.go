@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	PriceableTypeGameStores = "game_stores"
+	PriceableTypeDLCStores  = "dlc_stores"
+)
+
+type PriceHistory struct {
+	gorm.Model
+	ID            uint   `gorm:"primaryKey"`
+	Price         uint   `gorm:"not null"`
+	InitialPrice  uint   `gorm:"not null"`
+	Discount      uint   `gorm:"not null;default:0"`
+	Currency      string `gorm:"size:10"`
+	PriceableID   uint   `gorm:"index"`
+	PriceableType string `gorm:"index"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// CalculateDiscount returns the discount percentage between the initial and
+// the final price of a store listing.
+func CalculateDiscount(initialPrice uint, price uint) uint {
+	if initialPrice == 0 || price >= initialPrice {
+		return 0
+	}
+
+	return (initialPrice - price) * 100 / initialPrice
+}
+
+func (ph *PriceHistory) ValidatePriceHistory() error {
+	Init()
+
+	if err := validate.Struct(ph); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
@@ -22,6 +22,7 @@ type Profile struct {
 	Youtube   string
 	Twitch    string
 	Github    string
+	Region    string `gorm:"size:5"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	UserID    uint `gorm:"unique;constraint:OnDelete:SET NULL,OnUpdate:CASCADE;"`
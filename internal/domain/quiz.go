@@ -0,0 +1,257 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	QuizKindQuiz = "quiz"
+	QuizKindPoll = "poll"
+
+	AnswerQuizActionKey = "answer_quiz"
+)
+
+// Quiz is a set of questions open to answers between its open and close
+// times. The options of a quiz have correct answers the users are scored on,
+// while a poll only gathers votes. Both may be about a game.
+type Quiz struct {
+	gorm.Model
+	ID          uint      `gorm:"primaryKey"`
+	Kind        string    `gorm:"size:16;not null;index" validate:"required,oneof=quiz poll"`
+	Title       string    `gorm:"not null" validate:"required"`
+	Description string    `gorm:"type:text"`
+	GameID      *uint     `gorm:"index"`
+	OpensAt     time.Time `gorm:"not null;index" validate:"required"`
+	ClosesAt    time.Time `gorm:"not null;index" validate:"required,gtfield=OpensAt"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Game        *Game          `gorm:"foreignKey:GameID;references:ID"`
+	Questions   []QuizQuestion `gorm:"foreignKey:QuizID;references:ID"`
+	Rewards     []QuizReward   `gorm:"foreignKey:QuizID;references:ID"`
+}
+
+type QuizQuestion struct {
+	gorm.Model
+	ID        uint   `gorm:"primaryKey"`
+	QuizID    uint   `gorm:"not null;index"`
+	Question  string `gorm:"type:text;not null" validate:"required"`
+	Position  uint   `gorm:"not null;default:0"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Options   []QuizOption `gorm:"foreignKey:QuestionID;references:ID" validate:"min=2,dive"`
+}
+
+type QuizOption struct {
+	gorm.Model
+	ID         uint   `gorm:"primaryKey"`
+	QuestionID uint   `gorm:"not null;index"`
+	Text       string `gorm:"not null" validate:"required"`
+	Correct    bool   `gorm:"not null;default:false"`
+	Position   uint   `gorm:"not null;default:0"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// QuizReward is a tier of the rewards of a quiz, given to every user whose
+// score reaches its percentage. Users get the rewards of all the tiers they
+// reach, so a perfect score also gives the rewards of the lower tiers. Polls
+// have a single tier at zero, given for taking part.
+type QuizReward struct {
+	gorm.Model
+	ID         uint `gorm:"primaryKey"`
+	QuizID     uint `gorm:"not null;index"`
+	MinScore   uint `gorm:"not null;default:0" validate:"max=100"`
+	Coins      uint `gorm:"not null;default:0"`
+	Experience uint `gorm:"not null;default:0"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Rewards    []Reward `gorm:"polymorphic:Sourceable;"`
+}
+
+// QuizAttempt is the answers of a user to a quiz, which can only be given
+// once. Correct is how many questions were answered right and Score is their
+// percentage, both zero on polls.
+type QuizAttempt struct {
+	gorm.Model
+	ID        uint `gorm:"primaryKey"`
+	QuizID    uint `gorm:"not null;uniqueIndex:idx_quiz_attempts_quiz_user,priority:1"`
+	UserID    uint `gorm:"not null;uniqueIndex:idx_quiz_attempts_quiz_user,priority:2"`
+	Correct   uint `gorm:"not null;default:0"`
+	Score     uint `gorm:"not null;default:0"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	User      User         `gorm:"foreignKey:UserID;references:ID"`
+	Answers   []QuizAnswer `gorm:"foreignKey:AttemptID;references:ID"`
+}
+
+type QuizAnswer struct {
+	gorm.Model
+	ID         uint `gorm:"primaryKey"`
+	AttemptID  uint `gorm:"not null;index"`
+	QuizID     uint `gorm:"not null;index"`
+	QuestionID uint `gorm:"not null"`
+	OptionID   uint `gorm:"not null;index"`
+	Correct    bool `gorm:"not null;default:false"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// QuizResults are the votes of every option of a quiz, along with how many
+// users answered it and their average score.
+type QuizResults struct {
+	Quiz         Quiz
+	Attempts     uint
+	AverageScore float64
+	Votes        map[uint]uint
+}
+
+func (q *Quiz) ValidateQuiz() error {
+	Init()
+
+	if err := validate.Struct(q); err != nil {
+		return FormatValidationError(err)
+	}
+
+	if len(q.Questions) == 0 {
+		return fmt.Errorf("The %s must have at least one question.", q.Kind)
+	}
+
+	for i, question := range q.Questions {
+		if err := validate.Struct(question); err != nil {
+			return fmt.Errorf("The question %d is not valid: %s", i+1, FormatValidationError(err))
+		}
+
+		var correct int
+		for _, option := range question.Options {
+			if option.Correct {
+				correct++
+			}
+		}
+
+		if q.IsPoll() && correct > 0 {
+			return fmt.Errorf("The question %d of a poll can not have correct options.", i+1)
+		}
+
+		if !q.IsPoll() && correct != 1 {
+			return fmt.Errorf("The question %d must have exactly one correct option.", i+1)
+		}
+	}
+
+	for _, reward := range q.Rewards {
+		if err := validate.Struct(reward); err != nil {
+			return FormatValidationError(err)
+		}
+
+		if q.IsPoll() && reward.MinScore > 0 {
+			return errors.New("The rewards of a poll can not ask for a score.")
+		}
+	}
+
+	return nil
+}
+
+func (q Quiz) IsPoll() bool {
+	return q.Kind == QuizKindPoll
+}
+
+// IsOpen tells whether the quiz takes answers at the given time.
+func (q Quiz) IsOpen(at time.Time) bool {
+	return !at.Before(q.OpensAt) && at.Before(q.ClosesAt)
+}
+
+func (q Quiz) IsClosed(at time.Time) bool {
+	return !at.Before(q.ClosesAt)
+}
+
+// Grade checks the answers of the user, one option for every question of the
+// quiz, and scores them. Options are given by question ID.
+func (q Quiz) Grade(userID uint, choices map[uint]uint) (QuizAttempt, error) {
+	attempt := QuizAttempt{QuizID: q.ID, UserID: userID}
+
+	if len(choices) != len(q.Questions) {
+		return attempt, fmt.Errorf("Every question of the %s must be answered once.", q.Kind)
+	}
+
+	for _, question := range q.Questions {
+		optionID, ok := choices[question.ID]
+		if !ok {
+			return attempt, fmt.Errorf("The question %d was not answered.", question.ID)
+		}
+
+		option, ok := question.Option(optionID)
+		if !ok {
+			return attempt, fmt.Errorf("The option %d is not an answer to the question %d.", optionID, question.ID)
+		}
+
+		attempt.Answers = append(attempt.Answers, QuizAnswer{
+			QuizID:     q.ID,
+			QuestionID: question.ID,
+			OptionID:   option.ID,
+			Correct:    option.Correct,
+		})
+
+		if option.Correct {
+			attempt.Correct++
+		}
+	}
+
+	if !q.IsPoll() {
+		attempt.Score = attempt.Correct * 100 / uint(len(q.Questions))
+	}
+
+	return attempt, nil
+}
+
+func (q QuizQuestion) Option(id uint) (QuizOption, bool) {
+	for _, option := range q.Options {
+		if option.ID == id {
+			return option, true
+		}
+	}
+
+	return QuizOption{}, false
+}
+
+// RewardsFor are the tiers of the quiz the score reaches.
+func (q Quiz) RewardsFor(score uint) []QuizReward {
+	var tiers []QuizReward
+	for _, tier := range q.Rewards {
+		if score >= tier.MinScore {
+			tiers = append(tiers, tier)
+		}
+	}
+
+	return tiers
+}
+
+// RewardsToGrant are the coins, experience and rewards of the tiers reached
+// by the attempt.
+func (q Quiz) RewardsToGrant(attempt QuizAttempt) []Reward {
+	var rewards []Reward
+	for _, tier := range q.RewardsFor(attempt.Score) {
+		rewards = append(rewards, AmountRewards(tier.Coins, tier.Experience)...)
+		rewards = append(rewards, tier.Rewards...)
+	}
+
+	return rewards
+}
+
+// RewardGrantKey identifies the rewards of the quiz, given once per user.
+func (q Quiz) RewardGrantKey() string {
+	return fmt.Sprintf("quizzes:%d", q.ID)
+}
+
+// Share is the percentage of the users that picked the option, rounded to one
+// decimal.
+func (r QuizResults) Share(optionID uint) float64 {
+	if r.Attempts == 0 {
+		return 0
+	}
+
+	return math.Round(float64(r.Votes[optionID])*1000/float64(r.Attempts)) / 10
+}
@@ -1,23 +1,49 @@
 package domain
 
 import (
+	"math"
 	"time"
 
 	"gorm.io/gorm"
 )
 
 const (
-	RewardableTypeTitles = "titles"
+	RewardableTypeCoins      = "coins"
+	RewardableTypeExperience = "experience"
+	RewardableTypeTitles     = "titles"
+	RewardableTypeBoosters   = "boosters"
+	RewardableTypeCosmetics  = "cosmetics"
+	RewardableTypeItems      = "items"
+
+	SourceableTypeMissions       = "missions"
+	SourceableTypeLevels         = "levels"
+	SourceableTypeCheckInRewards = "check_in_rewards"
+	SourceableTypeQuizRewards    = "quiz_rewards"
 )
 
+// RewardableTypes are the rewards that can be given by missions, levels,
+// check-ins and quizzes.
+var RewardableTypes = []string{
+	RewardableTypeCoins,
+	RewardableTypeExperience,
+	RewardableTypeTitles,
+	RewardableTypeBoosters,
+	RewardableTypeCosmetics,
+	RewardableTypeItems,
+}
+
 type Reward struct {
 	gorm.Model
 	ID             uint   `gorm:"primaryKey"`
 	SourceableID   uint   `gorm:"not null" validate:"required"`
 	SourceableType string `gorm:"not null" validate:"required"`
 
-	RewardableID   uint   `gorm:"not null" validate:"required"`
+	RewardableID   uint   `gorm:"not null;default:0" validate:"required_unless=RewardableType coins RewardableType experience"`
 	RewardableType string `gorm:"not null" validate:"required"`
+	Amount         uint   `gorm:"not null;default:1"`
+
+	// Multiplier is what the amount was multiplied by when it was given.
+	Multiplier float64 `gorm:"-" json:"-"`
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -26,6 +52,60 @@ type Reward struct {
 	Sourceable any `gorm:"-"`
 }
 
+func IsRewardableType(rewardableType string) bool {
+	for _, rewardable := range RewardableTypes {
+		if rewardable == rewardableType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsRewardableEntity tells whether the rewards of the type point to a
+// rewarded entity. Coins and experience are plain amounts.
+func IsRewardableEntity(rewardableType string) bool {
+	return rewardableType != RewardableTypeCoins && rewardableType != RewardableTypeExperience
+}
+
+// AmountRewards are the coins and experience given along with the rewards of
+// a source, leaving out the empty ones.
+func AmountRewards(coins uint, experience uint) []Reward {
+	var rewards []Reward
+	if coins > 0 {
+		rewards = append(rewards, Reward{RewardableType: RewardableTypeCoins, Amount: coins})
+	}
+
+	if experience > 0 {
+		rewards = append(rewards, Reward{RewardableType: RewardableTypeExperience, Amount: experience})
+	}
+
+	return rewards
+}
+
+// Quantity is how many of the rewarded entity or amount is given, at least
+// one.
+func (r Reward) Quantity() uint {
+	if r.Amount == 0 {
+		return 1
+	}
+
+	return r.Amount
+}
+
+// Boosted is the reward with its amount multiplied and rounded, as long as
+// the multiplier raises it.
+func (r Reward) Boosted(multiplier float64) Reward {
+	if multiplier <= 1 {
+		return r
+	}
+
+	r.Amount = uint(math.Round(float64(r.Quantity()) * multiplier))
+	r.Multiplier = multiplier
+
+	return r
+}
+
 func (r *Reward) ValidateReward() error {
 	Init()
 
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RewardGrant records a set of rewards given to a user under a key, so the
+// same rewards are never given twice, e.g. when a message is delivered again.
+// The action key is the action the rewards come from, which multiplier events
+// can be limited to.
+type RewardGrant struct {
+	gorm.Model
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"not null;uniqueIndex:idx_reward_grants_user_key,priority:1"`
+	Key       string `gorm:"size:191;not null;uniqueIndex:idx_reward_grants_user_key,priority:2"`
+	Reason    string `gorm:"not null"`
+	ActionKey string `gorm:"size:64;not null;default:''"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	User      User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+// GrantedRewards is what a grant gave to the user, along with the
+// multipliers applied and the transactions and notifications it produced. Duplicate is set when the
+// grant was already given before, in which case nothing else is.
+type GrantedRewards struct {
+	Grant         RewardGrant
+	Duplicate     bool
+	Multipliers   Multipliers
+	Coins         uint
+	Experience    uint
+	TitleIDs      []uint
+	Transactions  []Transaction
+	Notifications []Notification
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ScheduledRunPending   = "pending"
+	ScheduledRunRunning   = "running"
+	ScheduledRunSucceeded = "succeeded"
+	ScheduledRunFailed    = "failed"
+
+	ScheduledTriggerSchedule = "schedule"
+	ScheduledTriggerManual   = "manual"
+)
+
+// Jobs run by the scheduler.
+const (
+	ScheduledJobResetMissions       = "missions:reset"
+	ScheduledJobNotifyReleases      = "releases:notify"
+	ScheduledJobPruneAuditLogs      = "audit-logs:prune"
+	ScheduledJobPrunePasswordResets = "password-resets:prune"
+	ScheduledJobRefreshSteamPrices  = "steam:refresh-prices"
+	ScheduledJobResyncCatalogGames  = "catalog:resync-games"
+	ScheduledJobRebuildLeaderboards = "leaderboards:rebuild"
+	SchedulerLeaderLease            = "scheduler:leader"
+	schedulerJobLeasePrefix         = "scheduler:job:"
+)
+
+// ScheduledJob is a job registered on the scheduler along with its schedule.
+// Its next run is claimed with a conditional update, so a scheduled run is
+// never started twice.
+type ScheduledJob struct {
+	gorm.Model
+	ID        uint       `gorm:"primaryKey"`
+	Name      string     `gorm:"size:100;not null;unique" validate:"required"`
+	Schedule  string     `gorm:"size:100;not null" validate:"required"`
+	NextRunAt *time.Time `gorm:"null"`
+	LastRunAt *time.Time `gorm:"null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ScheduledRun is one execution of a scheduled job, whether it was due or
+// triggered by an admin.
+type ScheduledRun struct {
+	gorm.Model
+	ID          uint       `gorm:"primaryKey"`
+	JobName     string     `gorm:"size:100;not null;index:idx_scheduled_runs_job_status" validate:"required"`
+	Trigger     string     `gorm:"size:20;not null" validate:"required,oneof=schedule manual"`
+	Status      string     `gorm:"size:20;not null;index:idx_scheduled_runs_job_status" validate:"required,oneof=pending running succeeded failed"`
+	Owner       *string    `gorm:"size:255"`
+	TriggeredBy *uint      `gorm:"null"`
+	Error       *string    `gorm:"type:text"`
+	StartedAt   *time.Time `gorm:"null"`
+	FinishedAt  *time.Time `gorm:"null"`
+	DurationMs  *int64     `gorm:"null"`
+	CreatedAt   time.Time  `gorm:"index"`
+	UpdatedAt   time.Time
+}
+
+// SchedulerLease is a lock held by a scheduler process until it expires. The
+// leader lease elects the process running the due jobs, while job leases keep
+// a job from running twice at the same time.
+type SchedulerLease struct {
+	gorm.Model
+	ID        uint      `gorm:"primaryKey"`
+	Name      string    `gorm:"size:150;not null;unique" validate:"required"`
+	Owner     string    `gorm:"size:255;not null" validate:"required"`
+	ExpiresAt time.Time `gorm:"not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (sr *ScheduledRun) ValidateScheduledRun() error {
+	Init()
+
+	if err := validate.Struct(sr); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
+
+// SchedulerJobLease is the name of the lease guarding the runs of a job.
+func SchedulerJobLease(jobName string) string {
+	return schedulerJobLeasePrefix + jobName
+}
@@ -8,6 +8,7 @@ import (
 
 const (
 	SteamStoreID = 1
+	GOGStoreID   = 2
 )
 
 type Store struct {
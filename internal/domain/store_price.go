@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type StorePrice struct {
+	gorm.Model
+	ID            uint   `gorm:"primaryKey"`
+	Region        string `gorm:"size:5;not null;uniqueIndex:idx_store_prices_priceable_region" validate:"required"`
+	Currency      string `gorm:"size:10;not null" validate:"required"`
+	InitialPrice  uint   `gorm:"not null"`
+	FinalPrice    uint   `gorm:"not null"`
+	Discount      uint   `gorm:"not null;default:0"`
+	PriceableID   uint   `gorm:"uniqueIndex:idx_store_prices_priceable_region"`
+	PriceableType string `gorm:"size:50;uniqueIndex:idx_store_prices_priceable_region"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (sp *StorePrice) ValidateStorePrice() error {
+	Init()
+
+	if err := validate.Struct(sp); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
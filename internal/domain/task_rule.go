@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	// RequirementCounter sums the increments of the matching actions.
+	RequirementCounter = "counter"
+	// RequirementDistinct counts the different targets of the matching actions.
+	RequirementDistinct = "distinct"
+	// RequirementStreak counts the longest run of consecutive days with a
+	// matching action.
+	RequirementStreak = "streak"
+)
+
+var RequirementTypes = []string{RequirementCounter, RequirementDistinct, RequirementStreak}
+
+// TaskRule is how a mission or title requirement evaluates the stream of
+// actions of its key. Conditions hold the attribute values an action must
+// have, such as {"genres": ["rpg"]}, where any of the listed values matches.
+type TaskRule struct {
+	Type       string     `gorm:"size:20;not null;default:counter"`
+	WithinDays *uint      `gorm:"default:null"`
+	StartsAt   *time.Time `gorm:"default:null"`
+	EndsAt     *time.Time `gorm:"default:null"`
+	Conditions *string    `gorm:"type:text"`
+}
+
+// ValidateTaskRule checks the rule can be evaluated.
+func (r TaskRule) ValidateTaskRule() error {
+	if r.Type != "" && !isRequirementType(r.Type) {
+		return fmt.Errorf("the requirement type %q is not valid", r.Type)
+	}
+
+	if r.WithinDays != nil && *r.WithinDays == 0 {
+		return fmt.Errorf("the requirement window must have at least one day")
+	}
+
+	if r.StartsAt != nil && r.EndsAt != nil && !r.EndsAt.After(*r.StartsAt) {
+		return fmt.Errorf("the requirement must end after it starts")
+	}
+
+	if r.Conditions != nil {
+		var conditions map[string][]string
+		if err := json.Unmarshal([]byte(*r.Conditions), &conditions); err != nil {
+			return fmt.Errorf("the requirement conditions must map attributes to lists of values")
+		}
+	}
+
+	return nil
+}
+
+// ConditionValues decodes the conditions of the rule.
+func (r TaskRule) ConditionValues() map[string][]string {
+	conditions := make(map[string][]string)
+	if r.Conditions == nil {
+		return conditions
+	}
+
+	_ = json.Unmarshal([]byte(*r.Conditions), &conditions)
+
+	return conditions
+}
+
+// SetConditionValues encodes the conditions of the rule, leaving them empty
+// when there are none.
+func (r *TaskRule) SetConditionValues(conditions map[string][]string) {
+	if len(conditions) == 0 {
+		r.Conditions = nil
+		return
+	}
+
+	encoded, err := json.Marshal(conditions)
+	if err != nil {
+		return
+	}
+
+	value := string(encoded)
+	r.Conditions = &value
+}
+
+// IsPlainCounter tells whether the rule only adds up increments, which keeps
+// the running total of the progress instead of reading the action stream.
+func (r TaskRule) IsPlainCounter() bool {
+	return (r.Type == "" || r.Type == RequirementCounter) &&
+		r.WithinDays == nil &&
+		r.StartsAt == nil &&
+		r.EndsAt == nil &&
+		r.Conditions == nil
+}
+
+// Window is the period of the actions the rule counts, starting no earlier
+// than the given reset of the progress.
+func (r TaskRule) Window(now time.Time, resetAt *time.Time) (*time.Time, *time.Time) {
+	var since *time.Time
+
+	if r.WithinDays != nil {
+		start := now.AddDate(0, 0, -int(*r.WithinDays))
+		since = &start
+	}
+
+	for _, start := range []*time.Time{r.StartsAt, resetAt} {
+		if start != nil && (since == nil || start.After(*since)) {
+			since = start
+		}
+	}
+
+	return since, r.EndsAt
+}
+
+// Accepts tells whether the action counts for the rule.
+func (r TaskRule) Accepts(action UserAction, now time.Time) bool {
+	if r.WithinDays != nil && action.OccurredAt.Before(now.AddDate(0, 0, -int(*r.WithinDays))) {
+		return false
+	}
+
+	if r.StartsAt != nil && action.OccurredAt.Before(*r.StartsAt) {
+		return false
+	}
+
+	if r.EndsAt != nil && !action.OccurredAt.Before(*r.EndsAt) {
+		return false
+	}
+
+	if r.Conditions == nil {
+		return true
+	}
+
+	var conditions map[string][]string
+	if err := json.Unmarshal([]byte(*r.Conditions), &conditions); err != nil {
+		return false
+	}
+
+	attributes := action.AttributeValues()
+	for attribute, expected := range conditions {
+		if !anyShared(attributes[attribute], expected) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Progress evaluates the rule over the given actions.
+func (r TaskRule) Progress(actions []UserAction, now time.Time) int {
+	var accepted []UserAction
+	for _, action := range actions {
+		if r.Accepts(action, now) {
+			accepted = append(accepted, action)
+		}
+	}
+
+	switch r.Type {
+	case RequirementDistinct:
+		targets := make(map[string]bool)
+		for _, action := range accepted {
+			if action.TargetType != nil && action.TargetID != nil {
+				targets[fmt.Sprintf("%s:%d", *action.TargetType, *action.TargetID)] = true
+			}
+		}
+
+		return len(targets)
+	case RequirementStreak:
+		return longestDailyStreak(accepted)
+	default:
+		progress := 0
+		for _, action := range accepted {
+			progress += action.Increment
+		}
+
+		return progress
+	}
+}
+
+func longestDailyStreak(actions []UserAction) int {
+	days := make(map[time.Time]bool, len(actions))
+	for _, action := range actions {
+		year, month, day := action.OccurredAt.Date()
+		days[time.Date(year, month, day, 0, 0, 0, 0, time.UTC)] = true
+	}
+
+	longest := 0
+	for day := range days {
+		if days[day.AddDate(0, 0, -1)] {
+			continue
+		}
+
+		length := 1
+		for days[day.AddDate(0, 0, length)] {
+			length++
+		}
+
+		if length > longest {
+			longest = length
+		}
+	}
+
+	return longest
+}
+
+func anyShared(values []string, expected []string) bool {
+	for _, value := range values {
+		for _, candidate := range expected {
+			if value == candidate {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isRequirementType(requirementType string) bool {
+	for _, candidate := range RequirementTypes {
+		if candidate == requirementType {
+			return true
+		}
+	}
+
+	return false
+}
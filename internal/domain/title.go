@@ -12,6 +12,8 @@ const (
 	TitleCanceled    = "canceled"
 )
 
+var TitleStatuses = []string{TitleAvailable, TitleUnavailable, TitleCanceled}
+
 type Title struct {
 	gorm.Model
 	ID                uint   `gorm:"primaryKey"`
@@ -0,0 +1,23 @@
+package domain
+
+// TrackedActionKeys are the user actions whose progress is tracked, so they
+// are the only keys mission and title requirements can count.
+var TrackedActionKeys = []string{
+	ProfilePictureTitleRequirementKey,
+	AddToLibraryRequirementKey,
+	CompleteGameRequirementKey,
+	HeartGameRequirementKey,
+	CommentGameRequirementKey,
+	LoginRequirementKey,
+	CheckInRequirementKey,
+}
+
+func IsTrackedActionKey(key string) bool {
+	for _, tracked := range TrackedActionKeys {
+		if tracked == key {
+			return true
+		}
+	}
+
+	return false
+}
@@ -6,14 +6,22 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	LoginRequirementKey = "login"
+)
+
 type User struct {
 	gorm.Model
-	ID                uint      `gorm:"primaryKey"`
-	Name              string    `gorm:"size:100;not null" validate:"required"`
-	Email             string    `gorm:"unique;not null" validate:"required,email"`
-	Nickname          string    `gorm:"unique;not null" validate:"required"`
-	Experience        uint      `gorm:"not null; default:0"`
-	Blocked           bool      `gorm:"not null; default:false"`
+	ID                uint    `gorm:"primaryKey"`
+	Name              string  `gorm:"size:100;not null" validate:"required"`
+	Email             string  `gorm:"unique;not null" validate:"required,email"`
+	Nickname          string  `gorm:"unique;not null" validate:"required"`
+	Experience        uint    `gorm:"not null; default:0"`
+	Blocked           bool    `gorm:"not null; default:false"`
+	BlockedReason     *string `gorm:"size:255"`
+	BlockedUntil      *time.Time
+	SessionsRevokedAt *time.Time
+	Timezone          string    `gorm:"size:64;not null;default:UTC"`
 	Birthdate         time.Time `gorm:"not null" validate:"required"`
 	Password          string    `gorm:"not null" validate:"required,min=8"`
 	CreatedAt         time.Time
@@ -44,3 +52,31 @@ func (u *User) ValidateUser() error {
 
 	return nil
 }
+
+// Location is the timezone of the user, falling back to UTC when it is
+// missing or unknown.
+func (u *User) Location() *time.Location {
+	if u.Timezone == "" {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// IsBlocked tells whether the user is blocked at the given time. Blocks with
+// an expiry stop applying once it is reached.
+func (u *User) IsBlocked(now time.Time) bool {
+	return u.Blocked && (u.BlockedUntil == nil || now.Before(*u.BlockedUntil))
+}
+
+// SessionRevoked tells whether a session issued at the given unix time was
+// revoked by a forced logout. Tokens carry their issue time in whole seconds,
+// so a session issued in the second of the revocation is kept.
+func (u *User) SessionRevoked(issuedAt int64) bool {
+	return u.SessionsRevokedAt != nil && issuedAt < u.SessionsRevokedAt.Unix()
+}
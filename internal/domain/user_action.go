@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ActionTargetGames = "games"
+)
+
+// UserAction is one entry of the stream of tracked user actions, which mission
+// and title requirements are evaluated from.
+type UserAction struct {
+	gorm.Model
+	ID         uint      `gorm:"primaryKey"`
+	UserID     uint      `gorm:"not null;index:idx_user_actions_user_key_time,priority:1" validate:"required"`
+	Key        string    `gorm:"size:100;not null;index:idx_user_actions_user_key_time,priority:2" validate:"required"`
+	TargetType *string   `gorm:"size:100"`
+	TargetID   *uint     `gorm:"default:null"`
+	Attributes *string   `gorm:"type:text"`
+	Increment  int       `gorm:"not null;default:1"`
+	OccurredAt time.Time `gorm:"not null;index:idx_user_actions_user_key_time,priority:3"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	User       User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+func (ua *UserAction) ValidateUserAction() error {
+	Init()
+
+	if err := validate.Struct(ua); err != nil {
+		return FormatValidationError(err)
+	}
+
+	return nil
+}
+
+// AttributeValues decodes the attributes of the action, such as the genres of
+// the game it targets.
+func (ua *UserAction) AttributeValues() map[string][]string {
+	attributes := make(map[string][]string)
+	if ua.Attributes == nil {
+		return attributes
+	}
+
+	_ = json.Unmarshal([]byte(*ua.Attributes), &attributes)
+
+	return attributes
+}
+
+// SetAttributeValues encodes the attributes of the action, leaving them empty
+// when there are none.
+func (ua *UserAction) SetAttributeValues(attributes map[string][]string) {
+	if len(attributes) == 0 {
+		ua.Attributes = nil
+		return
+	}
+
+	encoded, err := json.Marshal(attributes)
+	if err != nil {
+		return
+	}
+
+	value := string(encoded)
+	ua.Attributes = &value
+}
@@ -25,6 +25,18 @@ func Init() {
 	}); err != nil {
 		fmt.Printf("Error registering validation enum_os: %v\n", err)
 	}
+
+	if err := validate.RegisterValidation("sync_field", func(fl validator.FieldLevel) bool {
+		return IsSyncLockableField(fl.Field().String())
+	}); err != nil {
+		fmt.Printf("Error registering validation sync_field: %v\n", err)
+	}
+
+	if err := validate.RegisterValidation("multiplier_action", func(fl validator.FieldLevel) bool {
+		return IsMultiplierActionKey(fl.Field().String())
+	}); err != nil {
+		fmt.Printf("Error registering validation multiplier_action: %v\n", err)
+	}
 }
 
 func FormatValidationError(err error) error {
@@ -33,7 +45,7 @@ func FormatValidationError(err error) error {
 	for _, err := range err.(validator.ValidationErrors) {
 		fieldName := err.Field()
 		switch err.Tag() {
-		case "required":
+		case "required", "required_unless", "required_if":
 			errorMessages = append(errorMessages, fmt.Sprintf("%s is a required field", fieldName))
 		case "email":
 			errorMessages = append(errorMessages, fmt.Sprintf("%s must be a valid email address", fieldName))
@@ -41,6 +53,10 @@ func FormatValidationError(err error) error {
 			errorMessages = append(errorMessages, fmt.Sprintf("%s must be one of 'minimum', 'recommended', or 'maximum'", fieldName))
 		case "enum_os":
 			errorMessages = append(errorMessages, fmt.Sprintf("%s must be one of 'windows', 'mac', or 'linux'", fieldName))
+		case "sync_field":
+			errorMessages = append(errorMessages, fmt.Sprintf("%s must be a lockable sync field", fieldName))
+		case "multiplier_action":
+			errorMessages = append(errorMessages, fmt.Sprintf("%s must be an action giving coins or experience", fieldName))
 		default:
 			errorMessages = append(errorMessages, fmt.Sprintf("%s is not valid", fieldName))
 		}
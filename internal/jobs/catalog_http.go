@@ -0,0 +1,109 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultCatalogThrottleDelay  = 30 * time.Second
+	defaultCatalogMaxRetries     = 5
+	catalogThrottleBackoffFactor = 2
+)
+
+var ErrCatalogThrottled = errors.New("the store kept throttling the requests")
+
+// CatalogStatusError is returned when a store answers with an unexpected status.
+type CatalogStatusError struct {
+	StatusCode int
+	URL        string
+}
+
+func (e *CatalogStatusError) Error() string {
+	return fmt.Sprintf("store answered %d for %s", e.StatusCode, e.URL)
+}
+
+// CatalogHTTPClient fetches JSON documents from a store API. Every request
+// goes through a shared rate limiter, and throttled responses (429/503) are
+// retried with an exponential backoff that honors the Retry-After header.
+type CatalogHTTPClient struct {
+	HTTPClient    *http.Client
+	MaxRetries    int
+	ThrottleDelay time.Duration
+	limiter       *RateLimiter
+}
+
+func NewCatalogHTTPClient(httpClient *http.Client, requestDelay time.Duration) CatalogHTTPClient {
+	return CatalogHTTPClient{
+		HTTPClient:    httpClient,
+		MaxRetries:    defaultCatalogMaxRetries,
+		ThrottleDelay: defaultCatalogThrottleDelay,
+		limiter:       NewRateLimiter(requestDelay),
+	}
+}
+
+func (c *CatalogHTTPClient) getJSON(ctx context.Context, url string, out any) error {
+	delay := c.ThrottleDelay
+
+	for attempt := 0; attempt <= c.MaxRetries; attempt++ {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+			wait := retryAfter(resp, delay)
+			closeBody(resp)
+
+			log.Printf("Store throttled %s, backing off for %s", url, wait)
+			c.limiter.Pause(wait)
+			delay *= catalogThrottleBackoffFactor
+			continue
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			closeBody(resp)
+			return &CatalogStatusError{StatusCode: resp.StatusCode, URL: url}
+		}
+
+		err = json.NewDecoder(resp.Body).Decode(out)
+		closeBody(resp)
+
+		return err
+	}
+
+	return ErrCatalogThrottled
+}
+
+func retryAfter(resp *http.Response, fallback time.Duration) time.Duration {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(resp.Header.Get("Retry-After")); err == nil {
+		return time.Until(at)
+	}
+
+	return fallback
+}
+
+func closeBody(resp *http.Response) {
+	if closeErr := resp.Body.Close(); closeErr != nil {
+		log.Printf("Error closing response body: %v", closeErr)
+	}
+}
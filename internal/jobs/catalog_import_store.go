@@ -0,0 +1,441 @@
+package jobs
+
+import (
+	"errors"
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+	"log"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	catalogGamesMorphsType = "games"
+	catalogDLCsMorphsType  = "dlcs"
+)
+
+// catalogMatchWindow is how far apart the release dates of the same game on
+// two stores may be for them to be matched.
+const catalogMatchWindow = 366 * 24 * time.Hour
+
+type catalogImportStoreMySQL struct {
+	db *gorm.DB
+}
+
+func NewCatalogImportStore(db *gorm.DB) CatalogImportStore {
+	return &catalogImportStoreMySQL{db: db}
+}
+
+func (s *catalogImportStoreMySQL) LoadCheckpoint(source string) (*domain.ImportCheckpoint, error) {
+	var checkpoint domain.ImportCheckpoint
+	if err := s.db.Where("source = ?", source).First(&checkpoint).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &domain.ImportCheckpoint{Source: source}, nil
+		}
+
+		return nil, err
+	}
+
+	return &checkpoint, nil
+}
+
+func (s *catalogImportStoreMySQL) SaveCheckpoint(checkpoint *domain.ImportCheckpoint) error {
+	return s.db.Save(checkpoint).Error
+}
+
+// UpsertGame writes a catalog game. Listings already imported re-sync their
+// game when they are its primary listing (the first store it was imported
+// from) and only refresh their prices otherwise. New listings are attached to
+// the same game imported from another store when it matches, and create a new
+// game with all its relations when it does not.
+func (s *catalogImportStoreMySQL) UpsertGame(item *CatalogGame) (ImportOutcome, uint, error) {
+	var gameStore domain.GameStore
+	err := s.db.Where("store_id = ? AND store_game_id = ?", item.Listing.StoreID, item.Listing.ExternalID).First(&gameStore).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return ImportSkipped, 0, err
+	}
+
+	if err == nil {
+		primary, err := s.isPrimaryListing(&gameStore)
+		if err != nil {
+			return ImportSkipped, 0, err
+		}
+
+		if primary {
+			if err := syncCatalogGame(s.db, gameStore.GameID, item); err != nil {
+				return ImportSkipped, 0, err
+			}
+		}
+
+		RefreshGameStorePrice(s.db, &gameStore, item.Listing.Price)
+
+		return ImportUpdated, gameStore.GameID, nil
+	}
+
+	match, err := s.findMatchingGame(item)
+	if err != nil {
+		return ImportSkipped, 0, err
+	}
+
+	if match != nil {
+		listing, err := s.createGameListing(s.db, match.ID, item.Listing)
+		if err != nil {
+			return ImportSkipped, 0, err
+		}
+
+		mapCatalogRegionalPrices(s.db, listing, item.Listing.Price)
+
+		return ImportUpdated, match.ID, nil
+	}
+
+	slug, err := s.availableSlug(item)
+	if err != nil {
+		return ImportSkipped, 0, err
+	}
+
+	game := catalogGameToDomain(item)
+	game.Slug = slug
+
+	var listing *domain.GameStore
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&game).Error; err != nil {
+			return err
+		}
+
+		plan := PlanCatalogSync(game, item, nil)
+		if err := applyCatalogSyncPlan(tx, game.ID, plan); err != nil {
+			return err
+		}
+
+		listing, err = s.createGameListing(tx, game.ID, item.Listing)
+
+		return err
+	}); err != nil {
+		return ImportSkipped, 0, err
+	}
+
+	mapCatalogRegionalPrices(s.db, listing, item.Listing.Price)
+
+	return ImportCreated, game.ID, nil
+}
+
+// UpsertDLC writes a catalog DLC of the given game. Like games, DLCs already
+// sold on another store are matched by name and get a new listing. Games with
+// a locked DLC list keep the DLCs picked and edited by admins, so only the
+// listing prices of their DLCs are refreshed.
+func (s *catalogImportStoreMySQL) UpsertDLC(item *CatalogDLC, gameID uint) (ImportOutcome, error) {
+	var locks int64
+	if err := s.db.Model(&domain.GameSyncLock{}).Where("game_id = ? AND field = ?", gameID, domain.SyncFieldDLCs).Count(&locks).Error; err != nil {
+		return ImportSkipped, err
+	}
+
+	var dlcStore domain.DLCStore
+	err := s.db.Where("store_id = ? AND stor_dlc_id = ?", item.Listing.StoreID, item.Listing.ExternalID).First(&dlcStore).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return ImportSkipped, err
+	}
+
+	if err == nil && locks > 0 {
+		RefreshDLCStorePrice(s.db, &dlcStore, item.Listing.Price)
+
+		return ImportSkipped, nil
+	}
+
+	if err == nil {
+		fresh := catalogDLCToDomain(item, gameID)
+		if err := s.db.Model(&domain.DLC{}).Where("id = ?", dlcStore.DLCID).Updates(map[string]any{
+			"name":              fresh.Name,
+			"about":             fresh.About,
+			"description":       fresh.Description,
+			"short_description": fresh.ShortDescription,
+			"cover":             fresh.Cover,
+			"free":              fresh.Free,
+			"release_date":      fresh.ReleaseDate,
+			"legal":             fresh.Legal,
+		}).Error; err != nil {
+			return ImportSkipped, err
+		}
+
+		RefreshDLCStorePrice(s.db, &dlcStore, item.Listing.Price)
+
+		return ImportUpdated, nil
+	}
+
+	var match domain.DLC
+	err = s.db.Where("game_id = ? AND name = ?", gameID, item.Name).First(&match).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return ImportSkipped, err
+	}
+
+	if err == nil {
+		if err := s.createDLCListing(s.db, match.ID, item.Listing); err != nil {
+			return ImportSkipped, err
+		}
+
+		return ImportUpdated, nil
+	}
+
+	if locks > 0 {
+		return ImportSkipped, nil
+	}
+
+	dlc := catalogDLCToDomain(item, gameID)
+	if err := s.db.Create(&dlc).Error; err != nil {
+		return ImportSkipped, err
+	}
+
+	if err := s.createDLCListing(s.db, dlc.ID, item.Listing); err != nil {
+		return ImportSkipped, err
+	}
+
+	mapCatalogDLCRelations(s.db, dlc.ID, item)
+
+	return ImportCreated, nil
+}
+
+func (s *catalogImportStoreMySQL) isPrimaryListing(gameStore *domain.GameStore) (bool, error) {
+	var older int64
+	if err := s.db.Model(&domain.GameStore{}).
+		Where("game_id = ? AND id < ?", gameStore.GameID, gameStore.ID).
+		Count(&older).
+		Error; err != nil {
+		return false, err
+	}
+
+	return older == 0, nil
+}
+
+// findMatchingGame looks for the game already imported from another store
+// that the catalog game is a listing of.
+func (s *catalogImportStoreMySQL) findMatchingGame(item *CatalogGame) (*domain.Game, error) {
+	var game domain.Game
+	err := s.db.Preload("Stores").Where("slug = ?", utils.Slugify(item.Title)).First(&game).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if !MatchCatalogGame(game, item) {
+		return nil, nil
+	}
+
+	return &game, nil
+}
+
+// availableSlug picks a free slug for a new game, disambiguating games that
+// share their title with another one by their release year and, when still
+// taken, by their store listing.
+func (s *catalogImportStoreMySQL) availableSlug(item *CatalogGame) (string, error) {
+	base := utils.Slugify(item.Title)
+
+	candidates := []string{base}
+	if !item.ReleaseDate.IsZero() {
+		candidates = append(candidates, fmt.Sprintf("%s-%d", base, item.ReleaseDate.Year()))
+	}
+	candidates = append(candidates, fmt.Sprintf("%s-%s-%s", base, item.Source, item.Listing.ExternalID))
+
+	for _, candidate := range candidates {
+		var taken int64
+		if err := s.db.Unscoped().Model(&domain.Game{}).Where("slug = ?", candidate).Count(&taken).Error; err != nil {
+			return "", err
+		}
+
+		if taken == 0 {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("no slug available for %s game %s", item.Source, item.Listing.ExternalID)
+}
+
+func (s *catalogImportStoreMySQL) createGameListing(db *gorm.DB, gameID uint, listing CatalogListing) (*domain.GameStore, error) {
+	gameStore := domain.GameStore{
+		Price:        listing.Price.Final,
+		InitialPrice: initialPriceOf(listing.Price.Initial, listing.Price.Final),
+		LowestPrice:  listing.Price.Final,
+		URL:          listing.URL,
+		GameID:       gameID,
+		StoreID:      listing.StoreID,
+		StoreGameID:  listing.ExternalID,
+	}
+
+	if err := db.Create(&gameStore).Error; err != nil {
+		return nil, err
+	}
+
+	recordPriceHistory(db, gameStore.ID, domain.PriceableTypeGameStores, listing.Price.Currency, gameStore.InitialPrice, gameStore.Price)
+
+	return &gameStore, nil
+}
+
+func (s *catalogImportStoreMySQL) createDLCListing(db *gorm.DB, dlcID uint, listing CatalogListing) error {
+	dlcStore := domain.DLCStore{
+		Price:        listing.Price.Final,
+		InitialPrice: initialPriceOf(listing.Price.Initial, listing.Price.Final),
+		LowestPrice:  listing.Price.Final,
+		URL:          listing.URL,
+		DLCID:        dlcID,
+		StoreID:      listing.StoreID,
+		StorDLCID:    listing.ExternalID,
+	}
+
+	if err := db.Create(&dlcStore).Error; err != nil {
+		return err
+	}
+
+	recordPriceHistory(db, dlcStore.ID, domain.PriceableTypeDLCStores, listing.Price.Currency, dlcStore.InitialPrice, dlcStore.Price)
+
+	if dlcStore.StoreID == domain.SteamStoreID {
+		MapSteamRegionalPrices(listing.Price, dlcStore.ID, domain.PriceableTypeDLCStores, dlcStore.StorDLCID, db)
+	}
+
+	return nil
+}
+
+// mapCatalogRegionalPrices stores the regional prices of a new game listing.
+// Only Steam listings are priced per region.
+func mapCatalogRegionalPrices(db *gorm.DB, gameStore *domain.GameStore, price CatalogPrice) {
+	if gameStore.StoreID == domain.SteamStoreID {
+		MapSteamRegionalPrices(price, gameStore.ID, domain.PriceableTypeGameStores, gameStore.StoreGameID, db)
+	}
+}
+
+// MatchCatalogGame tells whether the catalog game is another store listing of
+// the stored game, which must be loaded with its stores. Titles must share the
+// same slug, the stored game can not already be listed on the same store, and
+// release dates, when both known, must be close to each other.
+func MatchCatalogGame(game domain.Game, item *CatalogGame) bool {
+	if game.Slug != utils.Slugify(item.Title) {
+		return false
+	}
+
+	for _, store := range game.Stores {
+		if store.StoreID == item.Listing.StoreID {
+			return false
+		}
+	}
+
+	if game.ReleaseDate.IsZero() || item.ReleaseDate.IsZero() {
+		return true
+	}
+
+	return math.Abs(float64(game.ReleaseDate.Sub(item.ReleaseDate))) <= float64(catalogMatchWindow)
+}
+
+func catalogGameToDomain(item *CatalogGame) domain.Game {
+	return domain.Game{
+		Slug:             utils.Slugify(item.Title),
+		Title:            item.Title,
+		About:            item.About,
+		ShortDescription: item.ShortDescription,
+		Description:      item.Description,
+		Cover:            item.Cover,
+		Free:             item.Free,
+		ReleaseDate:      item.ReleaseDate,
+		Age:              item.Age,
+		Website:          item.Website,
+		Legal:            item.Legal,
+	}
+}
+
+func catalogDLCToDomain(item *CatalogDLC, gameID uint) domain.DLC {
+	return domain.DLC{
+		Name:             item.Name,
+		About:            item.About,
+		ShortDescription: item.ShortDescription,
+		Description:      item.Description,
+		Cover:            item.Cover,
+		Free:             item.Free,
+		ReleaseDate:      item.ReleaseDate,
+		Legal:            item.Legal,
+		GameID:           gameID,
+	}
+}
+
+// mapCatalogDLCRelations associates a newly created DLC with its galleries,
+// genres, categories, companies and languages.
+func mapCatalogDLCRelations(db *gorm.DB, dlcID uint, item *CatalogDLC) {
+	for _, media := range item.Media {
+		if err := db.Create(&domain.Galleriable{
+			S3:              false,
+			Path:            media.Path,
+			MediaTypeID:     media.MediaTypeID,
+			GalleriableID:   dlcID,
+			GalleriableType: catalogDLCsMorphsType,
+		}).Error; err != nil {
+			log.Printf("Failed to create a gallery for dlc %d: %+v", dlcID, err)
+		}
+	}
+
+	for _, name := range item.Genres {
+		genre := domain.Genre{Name: name, Slug: utils.Slugify(name)}
+		if err := db.Where("slug = ?", genre.Slug).FirstOrCreate(&genre).Error; err != nil {
+			log.Printf("Database error while fetching or creating genre: %v", err)
+			continue
+		}
+
+		if err := db.Create(&domain.Genreable{GenreableID: dlcID, GenreableType: catalogDLCsMorphsType, GenreID: genre.ID}).Error; err != nil {
+			log.Printf("Failed to associate genre %s to dlc %v", name, dlcID)
+		}
+	}
+
+	for _, name := range item.Categories {
+		category := domain.Category{Name: name, Slug: utils.Slugify(name)}
+		if err := db.Where("slug = ?", category.Slug).FirstOrCreate(&category).Error; err != nil {
+			log.Printf("Database error while fetching or creating category: %v", err)
+			continue
+		}
+
+		if err := db.Create(&domain.Categoriable{CategoriableID: dlcID, CategoriableType: catalogDLCsMorphsType, CategoryID: category.ID}).Error; err != nil {
+			log.Printf("Failed to associate category %s to dlc %v", name, dlcID)
+		}
+	}
+
+	for _, name := range item.Developers {
+		developer := domain.Developer{Name: name, Slug: utils.Slugify(name), Acting: true}
+		if err := db.Where("slug = ?", developer.Slug).FirstOrCreate(&developer).Error; err != nil {
+			log.Printf("Database error while fetching or creating developer: %v", err)
+			continue
+		}
+
+		if err := db.Create(&domain.DLCDeveloper{DLCID: dlcID, DeveloperID: developer.ID}).Error; err != nil {
+			log.Printf("Failed to associate developer %s to dlc %v", name, dlcID)
+		}
+	}
+
+	for _, name := range item.Publishers {
+		publisher := domain.Publisher{Name: name, Slug: utils.Slugify(name), Acting: true}
+		if err := db.Where("slug = ?", publisher.Slug).FirstOrCreate(&publisher).Error; err != nil {
+			log.Printf("Database error while fetching or creating publisher: %v", err)
+			continue
+		}
+
+		if err := db.Create(&domain.DLCPublisher{DLCID: dlcID, PublisherID: publisher.ID}).Error; err != nil {
+			log.Printf("Failed to associate publisher %s to dlc %v", name, dlcID)
+		}
+	}
+
+	for _, catalogLanguage := range item.Languages {
+		var language domain.Language
+		if err := db.Where("name = ?", catalogLanguage.Name).FirstOrCreate(&language, domain.Language{Name: catalogLanguage.Name}).Error; err != nil {
+			log.Printf("Failed while fetching or creating language %s: %v", catalogLanguage.Name, err)
+			continue
+		}
+
+		if err := db.Create(&domain.DLCLanguage{
+			DLCID:      dlcID,
+			LanguageID: language.ID,
+			Menu:       true,
+			Dubs:       catalogLanguage.Dubs,
+			Subtitles:  true,
+		}).Error; err != nil {
+			log.Printf("Failed to associate language %s with dlc %v: %v", catalogLanguage.Name, dlcID, err)
+		}
+	}
+}
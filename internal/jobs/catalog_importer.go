@@ -0,0 +1,279 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gcstatus/internal/domain"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+type ImportOutcome int
+
+const (
+	ImportSkipped ImportOutcome = iota
+	ImportCreated
+	ImportUpdated
+)
+
+type ImportSummary struct {
+	Created uint
+	Updated uint
+	Skipped uint
+	Failed  uint
+}
+
+func (s *ImportSummary) record(outcome ImportOutcome, err error) {
+	switch {
+	case err != nil:
+		s.Failed++
+	case outcome == ImportCreated:
+		s.Created++
+	case outcome == ImportUpdated:
+		s.Updated++
+	default:
+		s.Skipped++
+	}
+}
+
+func (s ImportSummary) String() string {
+	return fmt.Sprintf("created: %d, updated: %d, skipped: %d, failed: %d", s.Created, s.Updated, s.Skipped, s.Failed)
+}
+
+// CatalogImportStore persists what the catalog importer fetches. Games and
+// DLCs are upserted by their store listing, so reruns never duplicate them.
+type CatalogImportStore interface {
+	LoadCheckpoint(source string) (*domain.ImportCheckpoint, error)
+	SaveCheckpoint(checkpoint *domain.ImportCheckpoint) error
+	UpsertGame(item *CatalogGame) (ImportOutcome, uint, error)
+	UpsertDLC(item *CatalogDLC, gameID uint) (ImportOutcome, error)
+}
+
+type CatalogImporter struct {
+	source  CatalogSource
+	store   CatalogImportStore
+	workers int
+
+	// DLCAdded, when set, is called for every new DLC of an already imported
+	// game, so its followers can be notified.
+	DLCAdded func(gameID uint, dlc *CatalogDLC)
+}
+
+func NewCatalogImporter(source CatalogSource, store CatalogImportStore, workers int) *CatalogImporter {
+	if workers < 1 {
+		workers = 1
+	}
+
+	return &CatalogImporter{
+		source:  source,
+		store:   store,
+		workers: workers,
+	}
+}
+
+type catalogImportResult struct {
+	index   int
+	outcome ImportOutcome
+	err     error
+}
+
+// Run imports every item of the source catalog that comes after the stored
+// checkpoint, retrying the items that failed on earlier runs. Items are
+// processed in id order by a bounded worker pool, and the checkpoint only
+// moves past items whose predecessors are all done, so a crashed or cancelled
+// run resumes without leaving gaps behind. Failed items are kept on the
+// checkpoint until an import of theirs goes through.
+func (i *CatalogImporter) Run(ctx context.Context) (ImportSummary, error) {
+	checkpoint, err := i.store.LoadCheckpoint(i.source.Name())
+	if err != nil {
+		return ImportSummary{}, err
+	}
+
+	// A finished checkpoint starts a new incremental run from where it stopped.
+	if checkpoint.FinishedAt != nil {
+		checkpoint.FinishedAt = nil
+		checkpoint.Created, checkpoint.Updated, checkpoint.Skipped, checkpoint.Failed = 0, 0, 0, 0
+	}
+
+	summary := ImportSummary{
+		Created: checkpoint.Created,
+		Updated: checkpoint.Updated,
+		Skipped: checkpoint.Skipped,
+		Failed:  checkpoint.Failed,
+	}
+
+	entries, err := i.source.List(ctx)
+	if err != nil {
+		return summary, err
+	}
+
+	pending := pendingCatalogEntries(entries, checkpoint.LastAppID, checkpoint.FailedIDs())
+
+	failed := make(map[uint]bool)
+	for _, entry := range pending {
+		if entry.ID <= checkpoint.LastAppID {
+			failed[entry.ID] = true
+		}
+	}
+
+	indexes := make(chan int)
+	results := make(chan catalogImportResult)
+
+	var wg sync.WaitGroup
+	for w := 0; w < i.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				outcome, err := i.ImportItem(ctx, pending[index].ID)
+				results <- catalogImportResult{index: index, outcome: outcome, err: err}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(indexes)
+		for index := range pending {
+			select {
+			case <-ctx.Done():
+				return
+			case indexes <- index:
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	done := make([]bool, len(pending))
+	watermark := 0
+
+	for result := range results {
+		// Items interrupted by the cancellation are retried on the next run.
+		if result.err != nil && ctx.Err() != nil {
+			continue
+		}
+
+		id := pending[result.index].ID
+		if result.err != nil {
+			log.Printf("Failed to import %s item %d: %+v", i.source.Name(), id, result.err)
+			failed[id] = true
+		} else {
+			delete(failed, id)
+		}
+
+		summary.record(result.outcome, result.err)
+		done[result.index] = true
+
+		advanced := false
+		for watermark < len(done) && done[watermark] {
+			watermark++
+			advanced = true
+		}
+
+		// Retried items come before the checkpoint, which never moves back.
+		if advanced && pending[watermark-1].ID > checkpoint.LastAppID {
+			checkpoint.LastAppID = pending[watermark-1].ID
+		}
+
+		if advanced || result.err == nil {
+			i.saveCheckpoint(checkpoint, summary, failed)
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		i.saveCheckpoint(checkpoint, summary, failed)
+		return summary, err
+	}
+
+	now := time.Now()
+	checkpoint.FinishedAt = &now
+	i.saveCheckpoint(checkpoint, summary, failed)
+
+	return summary, nil
+}
+
+// ImportItem fetches and upserts a single catalog item together with its
+// DLCs, re-syncing items that were already imported. Items the store does not
+// serve anymore and items that are not games are skipped.
+func (i *CatalogImporter) ImportItem(ctx context.Context, id uint) (ImportOutcome, error) {
+	item, err := i.source.Fetch(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrCatalogItemUnavailable) || errors.Is(err, ErrCatalogItemSkipped) {
+			return ImportSkipped, nil
+		}
+
+		return ImportSkipped, err
+	}
+
+	outcome, gameID, err := i.store.UpsertGame(item)
+	if err != nil {
+		return outcome, err
+	}
+
+	for index := range item.DLCs {
+		dlc := &item.DLCs[index]
+
+		dlcOutcome, err := i.store.UpsertDLC(dlc, gameID)
+		if err != nil {
+			log.Printf("Failed to import %s dlc %s: %+v", i.source.Name(), dlc.Listing.ExternalID, err)
+			continue
+		}
+
+		if outcome == ImportUpdated && dlcOutcome == ImportCreated && i.DLCAdded != nil {
+			i.DLCAdded(gameID, dlc)
+		}
+	}
+
+	return outcome, nil
+}
+
+func (i *CatalogImporter) saveCheckpoint(checkpoint *domain.ImportCheckpoint, summary ImportSummary, failed map[uint]bool) {
+	ids := make([]uint, 0, len(failed))
+	for id := range failed {
+		ids = append(ids, id)
+	}
+
+	checkpoint.SetFailedIDs(ids)
+	checkpoint.Created = summary.Created
+	checkpoint.Updated = summary.Updated
+	checkpoint.Skipped = summary.Skipped
+	checkpoint.Failed = summary.Failed
+
+	if err := i.store.SaveCheckpoint(checkpoint); err != nil {
+		log.Printf("Failed to save the %s import checkpoint: %+v", checkpoint.Source, err)
+	}
+}
+
+// pendingCatalogEntries sorts the catalog by id, dropping nameless entries,
+// duplicates and everything up to the checkpoint but the items to retry.
+func pendingCatalogEntries(entries []CatalogEntry, lastID uint, retryIDs []uint) []CatalogEntry {
+	sort.Slice(entries, func(a, b int) bool {
+		return entries[a].ID < entries[b].ID
+	})
+
+	retry := make(map[uint]bool, len(retryIDs))
+	for _, id := range retryIDs {
+		retry[id] = true
+	}
+
+	pending := make([]CatalogEntry, 0, len(entries))
+	for _, entry := range entries {
+		if (entry.ID <= lastID && !retry[entry.ID]) || entry.Name == "" {
+			continue
+		}
+
+		if len(pending) > 0 && pending[len(pending)-1].ID == entry.ID {
+			continue
+		}
+
+		pending = append(pending, entry)
+	}
+
+	return pending
+}
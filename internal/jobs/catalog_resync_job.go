@@ -0,0 +1,110 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"gcstatus/internal/domain"
+	"log"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// The scheduled re-sync only covers the games whose store pages change the
+// most: the recently released ones and the most viewed ones.
+const (
+	recentlyReleasedWindow = 30 * 24 * time.Hour
+	popularGamesWindow     = 7 * 24 * time.Hour
+	popularGamesLimit      = 50
+)
+
+// ResyncCatalogGamesJob re-syncs the recently released and most viewed games
+// with their store listings.
+func ResyncCatalogGamesJob(db *gorm.DB) {
+	log.Println("Starting catalog re-sync job...")
+
+	now := time.Now()
+
+	var recentIDs []uint
+	if err := db.Model(&domain.Game{}).
+		Where("release_date BETWEEN ? AND ?", now.Add(-recentlyReleasedWindow), now).
+		Pluck("id", &recentIDs).
+		Error; err != nil {
+		log.Printf("Failed to fetch recently released games: %+v", err)
+		return
+	}
+
+	var popularIDs []uint
+	if err := db.Model(&domain.Viewable{}).
+		Where("viewable_type = ? AND created_at >= ?", "games", now.Add(-popularGamesWindow)).
+		Group("viewable_id").
+		Order("COUNT(*) DESC").
+		Limit(popularGamesLimit).
+		Pluck("viewable_id", &popularIDs).
+		Error; err != nil {
+		log.Printf("Failed to fetch most viewed games: %+v", err)
+		return
+	}
+
+	summary := SyncCatalogGames(context.Background(), db, append(recentIDs, popularIDs...))
+
+	log.Printf("Catalog re-sync job completed (%s).", summary)
+}
+
+// SyncCatalogGames re-syncs every store listing of the given games that comes
+// from a catalog source. Listings of stores without a source are ignored.
+func SyncCatalogGames(ctx context.Context, db *gorm.DB, gameIDs []uint) ImportSummary {
+	var summary ImportSummary
+	if len(gameIDs) == 0 {
+		return summary
+	}
+
+	var gameStores []domain.GameStore
+	if err := db.Where("game_id IN ?", gameIDs).Order("id ASC").Find(&gameStores).Error; err != nil {
+		log.Printf("Failed to fetch game stores to re-sync: %+v", err)
+		return summary
+	}
+
+	importers := make(map[uint]*CatalogImporter)
+	synced := make(map[string]bool, len(gameStores))
+
+	for _, gameStore := range gameStores {
+		key := fmt.Sprintf("%d:%s", gameStore.StoreID, gameStore.StoreGameID)
+		if synced[key] {
+			continue
+		}
+		synced[key] = true
+
+		importer, ok := importers[gameStore.StoreID]
+		if !ok {
+			source, found := CatalogSourceForStore(gameStore.StoreID)
+			if !found {
+				continue
+			}
+
+			importer = newCatalogImporter(db, source)
+			importers[gameStore.StoreID] = importer
+		}
+
+		id, err := strconv.ParseUint(gameStore.StoreGameID, 10, 32)
+		if err != nil {
+			log.Printf("Invalid store id %q of game %d: %+v", gameStore.StoreGameID, gameStore.GameID, err)
+			summary.record(ImportSkipped, err)
+			continue
+		}
+
+		outcome, err := importer.ImportItem(ctx, uint(id))
+		if err != nil {
+			log.Printf("Failed to re-sync store listing %s: %+v", key, err)
+		}
+
+		summary.record(outcome, err)
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return summary
+}
@@ -0,0 +1,162 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gcstatus/internal/domain"
+	"sort"
+	"time"
+)
+
+var (
+	ErrCatalogItemUnavailable = errors.New("catalog item unavailable")
+	ErrCatalogItemSkipped     = errors.New("catalog item is not a game")
+)
+
+// CatalogSource is a store catalog the importer can pull games from. Sources
+// turn their own payloads into the normalized CatalogGame aggregate, so the
+// mapper that writes games, DLCs, store listings and relations is shared.
+type CatalogSource interface {
+	// Name identifies the source on the import checkpoints.
+	Name() string
+	// StoreID is the store the listings of the source belong to.
+	StoreID() uint
+	// List returns every item of the catalog, in any order.
+	List(ctx context.Context) ([]CatalogEntry, error)
+	// Fetch builds the aggregate of a single item. Items the store does not
+	// serve anymore fail with ErrCatalogItemUnavailable and items that are not
+	// games fail with ErrCatalogItemSkipped.
+	Fetch(ctx context.Context, id uint) (*CatalogGame, error)
+}
+
+type CatalogEntry struct {
+	ID   uint
+	Name string
+}
+
+type CatalogPrice struct {
+	Currency string
+	Initial  uint
+	Final    uint
+}
+
+// CatalogListing is the store listing of a game or DLC.
+type CatalogListing struct {
+	StoreID    uint
+	ExternalID string
+	URL        string
+	Price      CatalogPrice
+}
+
+type CatalogMedia struct {
+	Path        string
+	MediaTypeID uint
+}
+
+// CatalogLanguage is a supported language of a catalog item, Dubs telling
+// whether it has full audio support.
+type CatalogLanguage struct {
+	Name string
+	Dubs bool
+}
+
+// CatalogRequirement is a requirement of a catalog item for one potential
+// (minimum or recommended) on one operating system.
+type CatalogRequirement struct {
+	Potential   string
+	OS          string
+	Requirement domain.Requirement
+}
+
+type CatalogSupport struct {
+	URL   string
+	Email string
+}
+
+// CatalogGame is the normalized aggregate of a catalog game. Relations left
+// nil are not provided by the source and are never touched by the mapper,
+// while empty relations mean the game has none.
+type CatalogGame struct {
+	Source           string
+	Listing          CatalogListing
+	Title            string
+	About            string
+	Description      string
+	ShortDescription string
+	Cover            string
+	Free             bool
+	ReleaseDate      time.Time
+	Age              int
+	Website          *string
+	Legal            *string
+	Support          *CatalogSupport
+	Media            []CatalogMedia
+	Genres           []string
+	Categories       []string
+	Developers       []string
+	Publishers       []string
+	Languages        []CatalogLanguage
+	Requirements     []CatalogRequirement
+	DLCs             []CatalogDLC
+	// ListedDLCs holds the external id of every DLC listed by the store,
+	// including the ones that could not be fetched.
+	ListedDLCs []string
+}
+
+type CatalogDLC struct {
+	Listing          CatalogListing
+	Name             string
+	About            string
+	Description      string
+	ShortDescription string
+	Cover            string
+	Free             bool
+	ReleaseDate      time.Time
+	Legal            *string
+	Media            []CatalogMedia
+	Genres           []string
+	Categories       []string
+	Developers       []string
+	Publishers       []string
+	Languages        []CatalogLanguage
+}
+
+var catalogSources = map[string]func() CatalogSource{
+	SteamImportSource: func() CatalogSource { return NewDefaultSteamCatalogSource() },
+	GOGImportSource:   func() CatalogSource { return NewGOGCatalogSource(DefaultGOGClient, defaultGOGCountry) },
+}
+
+// NewCatalogSource returns the source registered under the given name.
+func NewCatalogSource(name string) (CatalogSource, error) {
+	newSource, ok := catalogSources[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown catalog source %q", name)
+	}
+
+	return newSource(), nil
+}
+
+// CatalogSourceForStore returns the source importing the listings of the
+// given store, if any.
+func CatalogSourceForStore(storeID uint) (CatalogSource, bool) {
+	for _, name := range CatalogSourceNames() {
+		source, _ := NewCatalogSource(name)
+		if source.StoreID() == storeID {
+			return source, true
+		}
+	}
+
+	return nil, false
+}
+
+func CatalogSourceNames() []string {
+	names := make([]string, 0, len(catalogSources))
+	for name := range catalogSources {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,618 @@
+package jobs
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+// CatalogSyncPlan holds the changes needed to bring a game back in sync with
+// its catalog aggregate. Relations are diffed by their natural keys: galleries
+// by path, genres, categories, developers and publishers by slug, languages by
+// name, requirements by potential and operating system and DLCs by their
+// external id on the store.
+type CatalogSyncPlan struct {
+	Fields             map[string]any
+	Support            *domain.GameSupport
+	AddGalleries       []domain.Galleriable
+	RemoveGalleries    []uint
+	AddGenres          []string
+	RemoveGenres       []uint
+	AddCategories      []string
+	RemoveCategories   []uint
+	AddDevelopers      []string
+	RemoveDevelopers   []uint
+	AddPublishers      []string
+	RemovePublishers   []uint
+	AddLanguages       []CatalogLanguage
+	UpdateLanguages    map[uint]bool
+	RemoveLanguages    []uint
+	AddRequirements    []CatalogRequirement
+	UpdateRequirements map[uint]domain.Requirement
+	RemoveRequirements []uint
+	RemoveDLCs         []uint
+}
+
+func (p CatalogSyncPlan) Empty() bool {
+	return len(p.Fields) == 0 &&
+		p.Support == nil &&
+		len(p.AddGalleries) == 0 && len(p.RemoveGalleries) == 0 &&
+		len(p.AddGenres) == 0 && len(p.RemoveGenres) == 0 &&
+		len(p.AddCategories) == 0 && len(p.RemoveCategories) == 0 &&
+		len(p.AddDevelopers) == 0 && len(p.RemoveDevelopers) == 0 &&
+		len(p.AddPublishers) == 0 && len(p.RemovePublishers) == 0 &&
+		len(p.AddLanguages) == 0 && len(p.UpdateLanguages) == 0 && len(p.RemoveLanguages) == 0 &&
+		len(p.AddRequirements) == 0 && len(p.UpdateRequirements) == 0 && len(p.RemoveRequirements) == 0 &&
+		len(p.RemoveDLCs) == 0
+}
+
+// PlanCatalogSync diffs the catalog aggregate against the stored game, which
+// must be loaded with the relations listed in catalogSyncPreloads. Locked
+// fields and relations are left untouched, and so are relations the source
+// does not provide, galleries uploaded to S3 and DLCs listed on other stores.
+func PlanCatalogSync(game domain.Game, item *CatalogGame, locked map[string]bool) CatalogSyncPlan {
+	plan := CatalogSyncPlan{
+		Fields:             planCatalogFields(game, catalogGameToDomain(item), locked),
+		UpdateLanguages:    make(map[uint]bool),
+		UpdateRequirements: make(map[uint]domain.Requirement),
+	}
+
+	if item.Support != nil && !locked[domain.SyncFieldSupport] {
+		plan.Support = planCatalogSupport(game.Support, item.Support)
+	}
+
+	if item.Media != nil && !locked[domain.SyncFieldGalleries] {
+		planCatalogGalleries(&plan, game, item.Media)
+	}
+
+	if item.Genres != nil && !locked[domain.SyncFieldGenres] {
+		current := make(map[uint]string, len(game.Genres))
+		for _, genreable := range game.Genres {
+			current[genreable.ID] = genreable.Genre.Slug
+		}
+
+		plan.AddGenres, plan.RemoveGenres = diffSlugs(current, item.Genres)
+	}
+
+	if item.Categories != nil && !locked[domain.SyncFieldCategories] {
+		current := make(map[uint]string, len(game.Categories))
+		for _, categoriable := range game.Categories {
+			current[categoriable.ID] = categoriable.Category.Slug
+		}
+
+		plan.AddCategories, plan.RemoveCategories = diffSlugs(current, item.Categories)
+	}
+
+	if item.Developers != nil && !locked[domain.SyncFieldDevelopers] {
+		current := make(map[uint]string, len(game.Developers))
+		for _, gameDeveloper := range game.Developers {
+			current[gameDeveloper.ID] = gameDeveloper.Developer.Slug
+		}
+
+		plan.AddDevelopers, plan.RemoveDevelopers = diffSlugs(current, item.Developers)
+	}
+
+	if item.Publishers != nil && !locked[domain.SyncFieldPublishers] {
+		current := make(map[uint]string, len(game.Publishers))
+		for _, gamePublisher := range game.Publishers {
+			current[gamePublisher.ID] = gamePublisher.Publisher.Slug
+		}
+
+		plan.AddPublishers, plan.RemovePublishers = diffSlugs(current, item.Publishers)
+	}
+
+	if item.Languages != nil && !locked[domain.SyncFieldLanguages] {
+		planCatalogLanguages(&plan, game, item.Languages)
+	}
+
+	if item.Requirements != nil && !locked[domain.SyncFieldRequirements] {
+		planCatalogRequirements(&plan, game, item.Requirements)
+	}
+
+	if item.ListedDLCs != nil && !locked[domain.SyncFieldDLCs] {
+		planCatalogDLCs(&plan, game, item)
+	}
+
+	return plan
+}
+
+func planCatalogFields(game domain.Game, fresh domain.Game, locked map[string]bool) map[string]any {
+	fields := make(map[string]any)
+
+	set := func(field string, changed bool, value any) {
+		if changed && !locked[field] {
+			fields[field] = value
+		}
+	}
+
+	set(domain.SyncFieldTitle, game.Title != fresh.Title, fresh.Title)
+	set(domain.SyncFieldAbout, game.About != fresh.About, fresh.About)
+	set(domain.SyncFieldDescription, game.Description != fresh.Description, fresh.Description)
+	set(domain.SyncFieldShortDescription, game.ShortDescription != fresh.ShortDescription, fresh.ShortDescription)
+	set(domain.SyncFieldCover, game.Cover != fresh.Cover, fresh.Cover)
+	set(domain.SyncFieldFree, game.Free != fresh.Free, fresh.Free)
+	set(domain.SyncFieldReleaseDate, !game.ReleaseDate.Equal(fresh.ReleaseDate), fresh.ReleaseDate)
+	set(domain.SyncFieldAge, game.Age != fresh.Age, fresh.Age)
+	set(domain.SyncFieldWebsite, !equalStringPointers(game.Website, fresh.Website), fresh.Website)
+	set(domain.SyncFieldLegal, !equalStringPointers(game.Legal, fresh.Legal), fresh.Legal)
+
+	return fields
+}
+
+func planCatalogSupport(current *domain.GameSupport, fresh *CatalogSupport) *domain.GameSupport {
+	url, email := fresh.URL, fresh.Email
+
+	if current == nil {
+		return &domain.GameSupport{URL: &url, Email: &email}
+	}
+
+	if equalStringPointers(current.URL, &url) && equalStringPointers(current.Email, &email) {
+		return nil
+	}
+
+	support := *current
+	support.URL = &url
+	support.Email = &email
+
+	return &support
+}
+
+func planCatalogGalleries(plan *CatalogSyncPlan, game domain.Game, media []CatalogMedia) {
+	desired := make(map[string]uint)
+	var order []string
+
+	for _, m := range media {
+		if _, ok := desired[m.Path]; !ok && m.Path != "" {
+			desired[m.Path] = m.MediaTypeID
+			order = append(order, m.Path)
+		}
+	}
+
+	stored := make(map[string]bool)
+	for _, gallery := range game.Galleries {
+		if gallery.S3 {
+			continue
+		}
+
+		if _, ok := desired[gallery.Path]; !ok || stored[gallery.Path] {
+			plan.RemoveGalleries = append(plan.RemoveGalleries, gallery.ID)
+			continue
+		}
+
+		stored[gallery.Path] = true
+	}
+
+	for _, path := range order {
+		if stored[path] {
+			continue
+		}
+
+		plan.AddGalleries = append(plan.AddGalleries, domain.Galleriable{
+			S3:              false,
+			Path:            path,
+			MediaTypeID:     desired[path],
+			GalleriableID:   game.ID,
+			GalleriableType: catalogGamesMorphsType,
+		})
+	}
+}
+
+func planCatalogLanguages(plan *CatalogSyncPlan, game domain.Game, languages []CatalogLanguage) {
+	desired := make(map[string]CatalogLanguage)
+	var order []string
+
+	for _, language := range languages {
+		if _, ok := desired[language.Name]; !ok {
+			order = append(order, language.Name)
+		}
+		desired[language.Name] = language
+	}
+
+	stored := make(map[string]bool)
+	for _, gameLanguage := range game.Languages {
+		language, ok := desired[gameLanguage.Language.Name]
+		if !ok || stored[gameLanguage.Language.Name] {
+			plan.RemoveLanguages = append(plan.RemoveLanguages, gameLanguage.ID)
+			continue
+		}
+
+		stored[gameLanguage.Language.Name] = true
+
+		if gameLanguage.Dubs != language.Dubs {
+			plan.UpdateLanguages[gameLanguage.ID] = language.Dubs
+		}
+	}
+
+	for _, name := range order {
+		if !stored[name] {
+			plan.AddLanguages = append(plan.AddLanguages, desired[name])
+		}
+	}
+}
+
+func planCatalogRequirements(plan *CatalogSyncPlan, game domain.Game, requirements []CatalogRequirement) {
+	key := func(potential, os string) string {
+		return potential + "/" + os
+	}
+
+	desired := make(map[string]CatalogRequirement)
+	var order []string
+
+	for _, requirement := range requirements {
+		k := key(requirement.Potential, requirement.OS)
+		if _, ok := desired[k]; !ok {
+			order = append(order, k)
+		}
+		desired[k] = requirement
+	}
+
+	stored := make(map[string]bool)
+	for _, requirement := range game.Requirements {
+		k := key(requirement.RequirementType.Potential, requirement.RequirementType.OS)
+
+		fresh, ok := desired[k]
+		if !ok || stored[k] {
+			plan.RemoveRequirements = append(plan.RemoveRequirements, requirement.ID)
+			continue
+		}
+
+		stored[k] = true
+
+		if !equalRequirements(requirement, fresh.Requirement) {
+			plan.UpdateRequirements[requirement.ID] = fresh.Requirement
+		}
+	}
+
+	for _, k := range order {
+		if !stored[k] {
+			plan.AddRequirements = append(plan.AddRequirements, desired[k])
+		}
+	}
+}
+
+func planCatalogDLCs(plan *CatalogSyncPlan, game domain.Game, item *CatalogGame) {
+	listed := make(map[string]bool, len(item.ListedDLCs))
+	for _, externalID := range item.ListedDLCs {
+		listed[externalID] = true
+	}
+
+	for _, dlc := range game.DLCs {
+		for _, store := range dlc.Stores {
+			if store.StoreID == item.Listing.StoreID && !listed[store.StorDLCID] {
+				plan.RemoveDLCs = append(plan.RemoveDLCs, dlc.ID)
+				break
+			}
+		}
+	}
+}
+
+// diffSlugs compares the stored associations, indexed by their id, against the
+// desired names. It returns the names to associate and the association ids to
+// drop, duplicates included.
+func diffSlugs(current map[uint]string, names []string) ([]string, []uint) {
+	desired := make(map[string]bool, len(names))
+	var added []string
+
+	for _, name := range names {
+		slug := utils.Slugify(name)
+		if slug == "" || desired[slug] {
+			continue
+		}
+
+		desired[slug] = true
+		added = append(added, name)
+	}
+
+	var removed []uint
+	stored := make(map[string]bool, len(current))
+
+	for _, id := range sortedIDs(current) {
+		slug := current[id]
+		if !desired[slug] || stored[slug] {
+			removed = append(removed, id)
+			continue
+		}
+
+		stored[slug] = true
+	}
+
+	kept := added[:0]
+	for _, name := range added {
+		if !stored[utils.Slugify(name)] {
+			kept = append(kept, name)
+		}
+	}
+
+	return kept, removed
+}
+
+func sortedIDs(current map[uint]string) []uint {
+	ids := make([]uint, 0, len(current))
+	for id := range current {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(a, b int) bool {
+		return ids[a] < ids[b]
+	})
+
+	return ids
+}
+
+func equalStringPointers(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func equalRequirements(stored, fresh domain.Requirement) bool {
+	return stored.OS == fresh.OS &&
+		stored.DX == fresh.DX &&
+		stored.CPU == fresh.CPU &&
+		stored.RAM == fresh.RAM &&
+		stored.GPU == fresh.GPU &&
+		stored.ROM == fresh.ROM &&
+		equalStringPointers(stored.OBS, fresh.OBS)
+}
+
+var catalogSyncPreloads = []string{
+	"Support",
+	"Galleries",
+	"Genres.Genre",
+	"Categories.Category",
+	"Developers.Developer",
+	"Publishers.Publisher",
+	"Languages.Language",
+	"Requirements.RequirementType",
+	"DLCs.Stores",
+}
+
+// syncCatalogGame diffs and applies the catalog aggregate of an imported game
+// within a single transaction, so a failing change leaves the game untouched.
+func syncCatalogGame(db *gorm.DB, gameID uint, item *CatalogGame) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		query := tx
+		for _, preload := range catalogSyncPreloads {
+			query = query.Preload(preload)
+		}
+
+		var game domain.Game
+		if err := query.First(&game, gameID).Error; err != nil {
+			return err
+		}
+
+		var locks []domain.GameSyncLock
+		if err := tx.Where("game_id = ?", gameID).Find(&locks).Error; err != nil {
+			return err
+		}
+
+		plan := PlanCatalogSync(game, item, domain.LockedSyncFields(locks))
+		if plan.Empty() {
+			return nil
+		}
+
+		return applyCatalogSyncPlan(tx, gameID, plan)
+	})
+}
+
+func applyCatalogSyncPlan(tx *gorm.DB, gameID uint, plan CatalogSyncPlan) error {
+	if len(plan.Fields) > 0 {
+		if err := tx.Model(&domain.Game{}).Where("id = ?", gameID).Updates(plan.Fields).Error; err != nil {
+			return err
+		}
+	}
+
+	if plan.Support != nil {
+		plan.Support.GameID = gameID
+		if err := tx.Save(plan.Support).Error; err != nil {
+			return err
+		}
+	}
+
+	if len(plan.RemoveGalleries) > 0 {
+		if err := tx.Delete(&domain.Galleriable{}, plan.RemoveGalleries).Error; err != nil {
+			return err
+		}
+	}
+
+	for i := range plan.AddGalleries {
+		if err := tx.Create(&plan.AddGalleries[i]).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := syncCatalogGenres(tx, gameID, plan); err != nil {
+		return err
+	}
+
+	if err := syncCatalogCategories(tx, gameID, plan); err != nil {
+		return err
+	}
+
+	if err := syncCatalogCompanies(tx, gameID, plan); err != nil {
+		return err
+	}
+
+	if err := syncCatalogLanguages(tx, gameID, plan); err != nil {
+		return err
+	}
+
+	if err := syncCatalogRequirements(tx, gameID, plan); err != nil {
+		return err
+	}
+
+	if len(plan.RemoveDLCs) > 0 {
+		if err := tx.Delete(&domain.DLC{}, plan.RemoveDLCs).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func syncCatalogGenres(tx *gorm.DB, gameID uint, plan CatalogSyncPlan) error {
+	if len(plan.RemoveGenres) > 0 {
+		if err := tx.Delete(&domain.Genreable{}, plan.RemoveGenres).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, name := range plan.AddGenres {
+		genre := domain.Genre{Name: name, Slug: utils.Slugify(name)}
+		if err := tx.Where("slug = ?", genre.Slug).FirstOrCreate(&genre).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(&domain.Genreable{
+			GenreableID:   gameID,
+			GenreableType: catalogGamesMorphsType,
+			GenreID:       genre.ID,
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func syncCatalogCategories(tx *gorm.DB, gameID uint, plan CatalogSyncPlan) error {
+	if len(plan.RemoveCategories) > 0 {
+		if err := tx.Delete(&domain.Categoriable{}, plan.RemoveCategories).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, name := range plan.AddCategories {
+		category := domain.Category{Name: name, Slug: utils.Slugify(name)}
+		if err := tx.Where("slug = ?", category.Slug).FirstOrCreate(&category).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(&domain.Categoriable{
+			CategoriableID:   gameID,
+			CategoriableType: catalogGamesMorphsType,
+			CategoryID:       category.ID,
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func syncCatalogCompanies(tx *gorm.DB, gameID uint, plan CatalogSyncPlan) error {
+	if len(plan.RemoveDevelopers) > 0 {
+		if err := tx.Delete(&domain.GameDeveloper{}, plan.RemoveDevelopers).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, name := range plan.AddDevelopers {
+		developer := domain.Developer{Name: name, Slug: utils.Slugify(name), Acting: true}
+		if err := tx.Where("slug = ?", developer.Slug).FirstOrCreate(&developer).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(&domain.GameDeveloper{GameID: gameID, DeveloperID: developer.ID}).Error; err != nil {
+			return err
+		}
+	}
+
+	if len(plan.RemovePublishers) > 0 {
+		if err := tx.Delete(&domain.GamePublisher{}, plan.RemovePublishers).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, name := range plan.AddPublishers {
+		publisher := domain.Publisher{Name: name, Slug: utils.Slugify(name), Acting: true}
+		if err := tx.Where("slug = ?", publisher.Slug).FirstOrCreate(&publisher).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(&domain.GamePublisher{GameID: gameID, PublisherID: publisher.ID}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func syncCatalogLanguages(tx *gorm.DB, gameID uint, plan CatalogSyncPlan) error {
+	if len(plan.RemoveLanguages) > 0 {
+		if err := tx.Delete(&domain.GameLanguage{}, plan.RemoveLanguages).Error; err != nil {
+			return err
+		}
+	}
+
+	for id, dubs := range plan.UpdateLanguages {
+		if err := tx.Model(&domain.GameLanguage{}).Where("id = ?", id).Update("dubs", dubs).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, catalogLanguage := range plan.AddLanguages {
+		var language domain.Language
+		if err := tx.Where("name = ?", catalogLanguage.Name).FirstOrCreate(&language, domain.Language{Name: catalogLanguage.Name}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(&domain.GameLanguage{
+			GameID:     gameID,
+			LanguageID: language.ID,
+			Menu:       true,
+			Dubs:       catalogLanguage.Dubs,
+			Subtitles:  true,
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func syncCatalogRequirements(tx *gorm.DB, gameID uint, plan CatalogSyncPlan) error {
+	if len(plan.RemoveRequirements) > 0 {
+		if err := tx.Delete(&domain.Requirement{}, plan.RemoveRequirements).Error; err != nil {
+			return err
+		}
+	}
+
+	for id, requirement := range plan.UpdateRequirements {
+		if err := tx.Model(&domain.Requirement{}).Where("id = ?", id).Updates(map[string]any{
+			"os":  requirement.OS,
+			"dx":  requirement.DX,
+			"cpu": requirement.CPU,
+			"ram": requirement.RAM,
+			"gpu": requirement.GPU,
+			"rom": requirement.ROM,
+			"obs": requirement.OBS,
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, catalogRequirement := range plan.AddRequirements {
+		var requirementType domain.RequirementType
+		if err := tx.Where("potential = ? AND os = ?", catalogRequirement.Potential, catalogRequirement.OS).FirstOrCreate(&requirementType, domain.RequirementType{
+			Potential: catalogRequirement.Potential,
+			OS:        catalogRequirement.OS,
+		}).Error; err != nil {
+			return err
+		}
+
+		requirement := catalogRequirement.Requirement
+		requirement.GameID = gameID
+		requirement.RequirementTypeID = requirementType.ID
+
+		if err := tx.Create(&requirement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package jobs
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/pkg/worker"
+)
+
+// EnqueueGameFollowEvent queues a job notifying the followers of the game
+// that opted in the given event.
+func EnqueueGameFollowEvent(gameID uint, event string, title string, actionURL string) error {
+	_, err := worker.Dispatch(domain.JobTypeGameFollowEvent, map[string]any{
+		"game_id":    gameID,
+		"event":      event,
+		"title":      title,
+		"action_url": actionURL,
+	})
+
+	return err
+}
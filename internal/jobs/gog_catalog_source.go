@@ -0,0 +1,257 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"gcstatus/internal/domain"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	GOGImportSource   = "gog"
+	defaultGOGCountry = "US"
+	gogReleaseLayout  = "2006-01-02T15:04:05-0700"
+	gogScreenshotSize = "ggvgl_2x"
+)
+
+// GOGTaxonomy holds what only the catalog listing knows about a product.
+type GOGTaxonomy struct {
+	Genres     []string
+	Developers []string
+	Publishers []string
+}
+
+// GOGCatalogSource imports the GOG catalog. Genres, developers and publishers
+// are only served by the catalog listing, so they are cached by List and left
+// untouched when a product is fetched without being listed first.
+type GOGCatalogSource struct {
+	client  *GOGClient
+	country string
+
+	mu         sync.RWMutex
+	taxonomies map[uint]GOGTaxonomy
+}
+
+func NewGOGCatalogSource(client *GOGClient, country string) *GOGCatalogSource {
+	return &GOGCatalogSource{
+		client:     client,
+		country:    country,
+		taxonomies: make(map[uint]GOGTaxonomy),
+	}
+}
+
+func (s *GOGCatalogSource) Name() string {
+	return GOGImportSource
+}
+
+func (s *GOGCatalogSource) StoreID() uint {
+	return domain.GOGStoreID
+}
+
+func (s *GOGCatalogSource) List(ctx context.Context) ([]CatalogEntry, error) {
+	var entries []CatalogEntry
+
+	for page, pages := 1, 1; page <= pages; page++ {
+		catalogPage, err := s.client.CatalogPage(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+
+		pages = catalogPage.Pages
+
+		for _, product := range catalogPage.Products {
+			id, err := strconv.ParseUint(product.ID, 10, 32)
+			if err != nil || id == 0 {
+				continue
+			}
+
+			genres := make([]string, 0, len(product.Genres))
+			for _, genre := range product.Genres {
+				genres = append(genres, genre.Name)
+			}
+
+			s.mu.Lock()
+			s.taxonomies[uint(id)] = GOGTaxonomy{
+				Genres:     genres,
+				Developers: nonNilStrings(product.Developers),
+				Publishers: nonNilStrings(product.Publishers),
+			}
+			s.mu.Unlock()
+
+			entries = append(entries, CatalogEntry{ID: uint(id), Name: product.Title})
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *GOGCatalogSource) Fetch(ctx context.Context, id uint) (*CatalogGame, error) {
+	product, err := s.client.Product(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.GameType != "game" {
+		return nil, fmt.Errorf("%w: gog product %d is a %q", ErrCatalogItemSkipped, id, product.GameType)
+	}
+
+	s.mu.RLock()
+	taxonomy, listed := s.taxonomies[id]
+	s.mu.RUnlock()
+
+	var taxonomyRef *GOGTaxonomy
+	if listed {
+		taxonomyRef = &taxonomy
+	}
+
+	item := NormalizeGOGProduct(product, s.price(ctx, id), taxonomyRef)
+
+	for i := range product.ExpandedDLCs {
+		dlc := &product.ExpandedDLCs[i]
+		item.DLCs = append(item.DLCs, NormalizeGOGDLC(dlc, s.price(ctx, uint(dlc.ID))))
+	}
+
+	return item, nil
+}
+
+// price fetches the price of a product, a product without a price being
+// imported as if it had none.
+func (s *GOGCatalogSource) price(ctx context.Context, id uint) CatalogPrice {
+	prices, err := s.client.Prices(ctx, id, s.country)
+	if err != nil {
+		log.Printf("Failed to fetch prices for gog product ID %d: %+v", id, err)
+		return CatalogPrice{}
+	}
+
+	return prices.Price()
+}
+
+// NormalizeGOGProduct turns a GOG product into a catalog aggregate, without
+// its DLCs. Relations GOG does not serve (categories, requirements) are left
+// nil, as are the genres and companies when the taxonomy is unknown.
+func NormalizeGOGProduct(product *GOGProduct, price CatalogPrice, taxonomy *GOGTaxonomy) *CatalogGame {
+	listedDLCs := make([]string, 0, len(product.ExpandedDLCs))
+	for _, dlc := range product.ExpandedDLCs {
+		listedDLCs = append(listedDLCs, strconv.Itoa(dlc.ID))
+	}
+
+	item := &CatalogGame{
+		Source:           GOGImportSource,
+		Listing:          gogListing(product, price),
+		Title:            product.Title,
+		About:            product.Description.Full,
+		Description:      product.Description.Full,
+		ShortDescription: product.Description.Lead,
+		Cover:            gogURL(product.Images.Background),
+		Free:             price.Final == 0 && price.Currency != "",
+		ReleaseDate:      parseGOGReleaseDate(product.ReleaseDate),
+		Media:            gogMedia(product),
+		Languages:        gogLanguages(product.Languages),
+		ListedDLCs:       listedDLCs,
+	}
+
+	if product.Links.Support != "" {
+		item.Support = &CatalogSupport{URL: product.Links.Support}
+	}
+
+	if taxonomy != nil {
+		item.Genres = taxonomy.Genres
+		item.Developers = taxonomy.Developers
+		item.Publishers = taxonomy.Publishers
+	}
+
+	return item
+}
+
+func NormalizeGOGDLC(product *GOGProduct, price CatalogPrice) CatalogDLC {
+	return CatalogDLC{
+		Listing:          gogListing(product, price),
+		Name:             product.Title,
+		About:            product.Description.Full,
+		Description:      product.Description.Full,
+		ShortDescription: product.Description.Lead,
+		Cover:            gogURL(product.Images.Background),
+		Free:             price.Final == 0 && price.Currency != "",
+		ReleaseDate:      parseGOGReleaseDate(product.ReleaseDate),
+		Media:            gogMedia(product),
+		Languages:        gogLanguages(product.Languages),
+	}
+}
+
+func gogListing(product *GOGProduct, price CatalogPrice) CatalogListing {
+	return CatalogListing{
+		StoreID:    domain.GOGStoreID,
+		ExternalID: strconv.Itoa(product.ID),
+		URL:        product.Links.ProductCard,
+		Price:      price,
+	}
+}
+
+func gogMedia(product *GOGProduct) []CatalogMedia {
+	media := make([]CatalogMedia, 0, len(product.Screenshots)+len(product.Videos))
+
+	for _, screenshot := range product.Screenshots {
+		if len(screenshot.FormattedImages) == 0 {
+			continue
+		}
+
+		path := screenshot.FormattedImages[0].ImageURL
+		for _, image := range screenshot.FormattedImages {
+			if image.FormatterName == gogScreenshotSize {
+				path = image.ImageURL
+				break
+			}
+		}
+
+		media = append(media, CatalogMedia{Path: gogURL(path), MediaTypeID: domain.PhotoTypeID})
+	}
+
+	for _, video := range product.Videos {
+		media = append(media, CatalogMedia{Path: video.VideoURL, MediaTypeID: domain.VideoTypeID})
+	}
+
+	return media
+}
+
+// gogLanguages lists the languages of a product by name. GOG does not tell
+// which of them are dubbed.
+func gogLanguages(languages map[string]string) []CatalogLanguage {
+	result := make([]CatalogLanguage, 0, len(languages))
+	for _, name := range languages {
+		result = append(result, CatalogLanguage{Name: name})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// gogURL completes the protocol-relative URLs served by GOG.
+func gogURL(path string) string {
+	if strings.HasPrefix(path, "//") {
+		return "https:" + path
+	}
+
+	return path
+}
+
+func parseGOGReleaseDate(date string) time.Time {
+	if date == "" {
+		return time.Time{}
+	}
+
+	releaseDate, err := time.Parse(gogReleaseLayout, date)
+	if err != nil {
+		log.Printf("Failed to parse release date: %v", err)
+		return time.Time{}
+	}
+
+	return releaseDate
+}
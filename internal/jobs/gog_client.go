@@ -0,0 +1,161 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	gogAPIBaseURL          = "https://api.gog.com"
+	gogCatalogBaseURL      = "https://catalog.gog.com"
+	gogCatalogPageSize     = 48
+	defaultGOGRequestDelay = 500 * time.Millisecond
+)
+
+// GOGClient talks to the GOG catalog and product APIs.
+type GOGClient struct {
+	CatalogHTTPClient
+	APIBaseURL     string
+	CatalogBaseURL string
+}
+
+func NewGOGClient(httpClient *http.Client, apiBaseURL string, catalogBaseURL string, requestDelay time.Duration) *GOGClient {
+	return &GOGClient{
+		CatalogHTTPClient: NewCatalogHTTPClient(httpClient, requestDelay),
+		APIBaseURL:        apiBaseURL,
+		CatalogBaseURL:    catalogBaseURL,
+	}
+}
+
+var DefaultGOGClient = NewGOGClient(http.DefaultClient, gogAPIBaseURL, gogCatalogBaseURL, defaultGOGRequestDelay)
+
+type GOGCatalogPage struct {
+	Pages    int                 `json:"pages"`
+	Products []GOGCatalogProduct `json:"products"`
+}
+
+type GOGCatalogProduct struct {
+	ID          string   `json:"id"`
+	Title       string   `json:"title"`
+	Slug        string   `json:"slug"`
+	Developers  []string `json:"developers"`
+	Publishers  []string `json:"publishers"`
+	ReleaseDate string   `json:"releaseDate"`
+	Genres      []struct {
+		Name string `json:"name"`
+		Slug string `json:"slug"`
+	} `json:"genres"`
+}
+
+type GOGProduct struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	Slug        string `json:"slug"`
+	GameType    string `json:"game_type"`
+	ReleaseDate string `json:"release_date"`
+	Links       struct {
+		ProductCard string `json:"product_card"`
+		Support     string `json:"support"`
+	} `json:"links"`
+	Images struct {
+		Background string `json:"background"`
+		Logo       string `json:"logo"`
+	} `json:"images"`
+	Description struct {
+		Lead string `json:"lead"`
+		Full string `json:"full"`
+	} `json:"description"`
+	Screenshots []struct {
+		FormattedImages []struct {
+			FormatterName string `json:"formatter_name"`
+			ImageURL      string `json:"image_url"`
+		} `json:"formatted_images"`
+	} `json:"screenshots"`
+	Videos []struct {
+		VideoURL string `json:"video_url"`
+	} `json:"videos"`
+	Languages    map[string]string `json:"languages"`
+	ExpandedDLCs []GOGProduct      `json:"expanded_dlcs"`
+}
+
+type GOGPrices struct {
+	Embedded struct {
+		Prices []struct {
+			Currency struct {
+				Code string `json:"code"`
+			} `json:"currency"`
+			BasePrice  string `json:"basePrice"`
+			FinalPrice string `json:"finalPrice"`
+		} `json:"prices"`
+	} `json:"_embedded"`
+}
+
+// Price returns the first price of the list, GOG prices being formatted as
+// the amount in cents followed by the currency code ("1999 USD").
+func (p *GOGPrices) Price() CatalogPrice {
+	if len(p.Embedded.Prices) == 0 {
+		return CatalogPrice{}
+	}
+
+	price := p.Embedded.Prices[0]
+
+	return CatalogPrice{
+		Currency: price.Currency.Code,
+		Initial:  parseGOGAmount(price.BasePrice),
+		Final:    parseGOGAmount(price.FinalPrice),
+	}
+}
+
+func (c *GOGClient) CatalogPage(ctx context.Context, page int) (*GOGCatalogPage, error) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(gogCatalogPageSize))
+	query.Set("page", strconv.Itoa(page))
+	query.Set("order", "asc:title")
+	query.Set("productType", "in:game")
+
+	var catalogPage GOGCatalogPage
+	if err := c.getJSON(ctx, c.CatalogBaseURL+"/v1/catalog?"+query.Encode(), &catalogPage); err != nil {
+		return nil, err
+	}
+
+	return &catalogPage, nil
+}
+
+func (c *GOGClient) Product(ctx context.Context, productID uint) (*GOGProduct, error) {
+	var product GOGProduct
+	err := c.getJSON(ctx, fmt.Sprintf("%s/products/%d?expand=description,screenshots,videos,expanded_dlcs&locale=en-US", c.APIBaseURL, productID), &product)
+	if err != nil {
+		var statusErr *CatalogStatusError
+		if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: no data for gog product ID %d", ErrCatalogItemUnavailable, productID)
+		}
+
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+func (c *GOGClient) Prices(ctx context.Context, productID uint, country string) (*GOGPrices, error) {
+	var prices GOGPrices
+	if err := c.getJSON(ctx, fmt.Sprintf("%s/products/%d/prices?countryCode=%s", c.APIBaseURL, productID, country), &prices); err != nil {
+		return nil, err
+	}
+
+	return &prices, nil
+}
+
+func parseGOGAmount(amount string) uint {
+	value, err := strconv.ParseUint(strings.TrimSpace(strings.Split(strings.TrimSpace(amount), " ")[0]), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return uint(value)
+}
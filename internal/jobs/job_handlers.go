@@ -0,0 +1,66 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/pkg/worker"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+type CatalogImportItemPayload struct {
+	Source string `json:"source"`
+	ID     uint   `json:"id"`
+}
+
+type CatalogSyncGamePayload struct {
+	GameID uint `json:"game_id"`
+}
+
+// RegisterJobHandlers registers the catalog jobs on the worker pool.
+func RegisterJobHandlers(pool *worker.Pool, db *gorm.DB) {
+	pool.Register(domain.JobTypeCatalogImportItem, func(ctx context.Context, payload []byte) error {
+		var importPayload CatalogImportItemPayload
+		if err := json.Unmarshal(payload, &importPayload); err != nil {
+			return fmt.Errorf("invalid catalog import payload: %+v", err)
+		}
+
+		return ImportCatalogItem(ctx, db, importPayload.Source, importPayload.ID)
+	})
+
+	pool.Register(domain.JobTypeCatalogSyncGame, func(ctx context.Context, payload []byte) error {
+		var syncPayload CatalogSyncGamePayload
+		if err := json.Unmarshal(payload, &syncPayload); err != nil {
+			return fmt.Errorf("invalid catalog sync payload: %+v", err)
+		}
+
+		summary := SyncCatalogGames(ctx, db, []uint{syncPayload.GameID})
+		if summary.Failed > 0 {
+			return fmt.Errorf("failed to re-sync game %d (%s)", syncPayload.GameID, summary)
+		}
+
+		return ctx.Err()
+	})
+}
+
+// ImportCatalogItem imports a single item of the given catalog source.
+func ImportCatalogItem(ctx context.Context, db *gorm.DB, sourceName string, id uint) error {
+	source, err := NewCatalogSource(sourceName)
+	if err != nil {
+		return err
+	}
+
+	outcome, err := newCatalogImporter(db, source).ImportItem(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to import %s item %d: %w", sourceName, id, err)
+	}
+
+	if outcome == ImportSkipped {
+		log.Printf("The %s item %d was skipped, it is not an available game.", sourceName, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,274 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gcstatus/config"
+	"gcstatus/internal/domain"
+	"gcstatus/pkg/sqs"
+	"log"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RefreshSteamPricesJob fetches the current Steam price of every game and DLC
+// store listing, keeps the price history and notifies the users price alerts.
+func RefreshSteamPricesJob(db *gorm.DB) {
+	log.Println("Starting steam prices refresh job...")
+
+	var gameStores []domain.GameStore
+	if err := db.Where("store_id = ?", domain.SteamStoreID).Find(&gameStores).Error; err != nil {
+		log.Printf("Failed to fetch steam game stores: %+v", err)
+		return
+	}
+
+	for i := range gameStores {
+		appDetails, err := fetchSteamListingDetails(gameStores[i].StoreGameID)
+		if err != nil {
+			log.Printf("Failed to fetch steam prices for game store %d: %+v", gameStores[i].ID, err)
+			continue
+		}
+
+		RefreshGameStorePrice(db, &gameStores[i], CatalogPrice(appDetails.Data.PriceOverview))
+
+		time.Sleep(delayBetweenRequests)
+	}
+
+	var dlcStores []domain.DLCStore
+	if err := db.Where("store_id = ?", domain.SteamStoreID).Find(&dlcStores).Error; err != nil {
+		log.Printf("Failed to fetch steam dlc stores: %+v", err)
+		return
+	}
+
+	for i := range dlcStores {
+		appDetails, err := fetchSteamListingDetails(dlcStores[i].StorDLCID)
+		if err != nil {
+			log.Printf("Failed to fetch steam prices for dlc store %d: %+v", dlcStores[i].ID, err)
+			continue
+		}
+
+		RefreshDLCStorePrice(db, &dlcStores[i], CatalogPrice(appDetails.Data.PriceOverview))
+
+		time.Sleep(delayBetweenRequests)
+	}
+
+	log.Println("Steam prices refresh job completed.")
+}
+
+// RefreshGameStorePrice updates the given game store listing and, on Steam,
+// its regional prices with the fetched price overview. When the price changes, a new price history is recorded, the
+// game sale condition is synced with the discount and, on price drops, the game price alerts are evaluated.
+func RefreshGameStorePrice(db *gorm.DB, gameStore *domain.GameStore, priceOverview CatalogPrice) {
+	// Listings without a price overview (free or unavailable apps) have nothing to track.
+	if priceOverview.Initial == 0 && priceOverview.Final == 0 {
+		return
+	}
+
+	previousPrice := gameStore.Price
+	firstRefresh := gameStore.InitialPrice == 0
+
+	gameStore.Price = priceOverview.Final
+	gameStore.InitialPrice = initialPriceOf(priceOverview.Initial, priceOverview.Final)
+	gameStore.LowestPrice = lowestPriceOf(gameStore.LowestPrice, priceOverview.Final)
+
+	if err := db.Save(gameStore).Error; err != nil {
+		log.Printf("Failed to update prices for game store %d: %+v", gameStore.ID, err)
+		return
+	}
+
+	if gameStore.StoreID == domain.SteamStoreID {
+		MapSteamRegionalPrices(priceOverview, gameStore.ID, domain.PriceableTypeGameStores, gameStore.StoreGameID, db)
+	}
+
+	if !firstRefresh && previousPrice == gameStore.Price {
+		return
+	}
+
+	recordPriceHistory(db, gameStore.ID, domain.PriceableTypeGameStores, priceOverview.Currency, gameStore.InitialPrice, gameStore.Price)
+	syncGameSaleCondition(db, gameStore)
+
+	if gameStore.Price < previousPrice || firstRefresh {
+		handleGamePriceDrop(db, gameStore)
+	}
+}
+
+// RefreshDLCStorePrice updates the given DLC store listing and, on Steam, its
+// regional prices with the fetched price overview, recording a new price history when the price changes.
+func RefreshDLCStorePrice(db *gorm.DB, dlcStore *domain.DLCStore, priceOverview CatalogPrice) {
+	// Listings without a price overview (free or unavailable apps) have nothing to track.
+	if priceOverview.Initial == 0 && priceOverview.Final == 0 {
+		return
+	}
+
+	previousPrice := dlcStore.Price
+	firstRefresh := dlcStore.InitialPrice == 0
+
+	dlcStore.Price = priceOverview.Final
+	dlcStore.InitialPrice = initialPriceOf(priceOverview.Initial, priceOverview.Final)
+	dlcStore.LowestPrice = lowestPriceOf(dlcStore.LowestPrice, priceOverview.Final)
+
+	if err := db.Save(dlcStore).Error; err != nil {
+		log.Printf("Failed to update prices for dlc store %d: %+v", dlcStore.ID, err)
+		return
+	}
+
+	if dlcStore.StoreID == domain.SteamStoreID {
+		MapSteamRegionalPrices(priceOverview, dlcStore.ID, domain.PriceableTypeDLCStores, dlcStore.StorDLCID, db)
+	}
+
+	if !firstRefresh && previousPrice == dlcStore.Price {
+		return
+	}
+
+	recordPriceHistory(db, dlcStore.ID, domain.PriceableTypeDLCStores, priceOverview.Currency, dlcStore.InitialPrice, dlcStore.Price)
+}
+
+func handleGamePriceDrop(db *gorm.DB, gameStore *domain.GameStore) {
+	var game domain.Game
+	if err := db.First(&game, gameStore.GameID).Error; err != nil {
+		log.Printf("Failed to fetch game %d for price drop: %+v", gameStore.GameID, err)
+		return
+	}
+
+	var store domain.Store
+	if err := db.First(&store, gameStore.StoreID).Error; err != nil {
+		log.Printf("Failed to fetch store %d for price alerts: %+v", gameStore.StoreID, err)
+	}
+
+	title := fmt.Sprintf("%s dropped to %.2f on %s!", game.Title, float64(gameStore.Price)/100, store.Name)
+	if err := EnqueueGameFollowEvent(game.ID, domain.FollowPriceEvent, title, fmt.Sprintf("/games/%s", game.Slug)); err != nil {
+		log.Printf("Failed to enqueue price drop for followers of game %d: %+v", game.ID, err)
+	}
+
+	var alerts []domain.PriceAlert
+	if err := db.Where("game_id = ? AND active = ?", game.ID, true).Find(&alerts).Error; err != nil {
+		log.Printf("Failed to fetch price alerts for game %d: %+v", game.ID, err)
+		return
+	}
+
+	for i := range alerts {
+		alert := &alerts[i]
+		if !alert.ShouldTrigger(gameStore.InitialPrice, gameStore.Price) {
+			continue
+		}
+
+		if err := enqueuePriceAlert(alert, &game, gameStore, store.Name); err != nil {
+			log.Printf("Failed to enqueue price alert %d: %+v", alert.ID, err)
+			continue
+		}
+
+		price := gameStore.Price
+		alert.LastNotifiedPrice = &price
+
+		if err := db.Model(alert).Update("last_notified_price", price).Error; err != nil {
+			log.Printf("Failed to update price alert %d: %+v", alert.ID, err)
+		}
+	}
+}
+
+// syncGameSaleCondition puts a commom game on sale once the listing discount
+// reaches the sale threshold, and takes it back to commom when none of its
+// listings reach it anymore.
+func syncGameSaleCondition(db *gorm.DB, gameStore *domain.GameStore) {
+	threshold, _ := strconv.Atoi(config.LoadConfig().SaleThreshold)
+	if threshold <= 0 {
+		return
+	}
+
+	var game domain.Game
+	if err := db.Select("id", "condition").First(&game, gameStore.GameID).Error; err != nil {
+		log.Printf("Failed to fetch game %d for sale condition: %+v", gameStore.GameID, err)
+		return
+	}
+
+	onSale := gameStore.Discount() >= uint(threshold)
+
+	switch {
+	case onSale && game.Condition == domain.CommomCondition:
+		if err := db.Model(&game).Update("condition", domain.SaleCondition).Error; err != nil {
+			log.Printf("Failed to flip game %d into sale condition: %+v", game.ID, err)
+		}
+	case !onSale && game.Condition == domain.SaleCondition:
+		var listings []domain.GameStore
+		if err := db.Where("game_id = ? AND id <> ?", game.ID, gameStore.ID).Find(&listings).Error; err != nil {
+			log.Printf("Failed to fetch game %d listings for sale condition: %+v", game.ID, err)
+			return
+		}
+
+		for i := range listings {
+			if listings[i].Discount() >= uint(threshold) {
+				return
+			}
+		}
+
+		if err := db.Model(&game).Update("condition", domain.CommomCondition).Error; err != nil {
+			log.Printf("Failed to flip game %d back into commom condition: %+v", game.ID, err)
+		}
+	}
+}
+
+func enqueuePriceAlert(alert *domain.PriceAlert, game *domain.Game, gameStore *domain.GameStore, storeName string) error {
+	priceAlertMessage := map[string]any{
+		"type": "PriceAlertTriggered",
+		"body": map[string]any{
+			"user_id":       alert.UserID,
+			"game_title":    game.Title,
+			"game_slug":     game.Slug,
+			"store":         storeName,
+			"url":           gameStore.URL,
+			"price":         gameStore.Price,
+			"initial_price": gameStore.InitialPrice,
+			"discount":      gameStore.Discount(),
+		},
+	}
+
+	priceAlertMessageBody, err := json.Marshal(priceAlertMessage)
+	if err != nil {
+		return fmt.Errorf("failed to serialize price alert message to JSON: %+v", err)
+	}
+
+	return sqs.GlobalSQSClient.SendMessage(context.Background(), sqs.GetAwsQueue(), string(priceAlertMessageBody))
+}
+
+func recordPriceHistory(db *gorm.DB, priceableID uint, priceableType string, currency string, initialPrice uint, price uint) {
+	history := domain.PriceHistory{
+		Price:         price,
+		InitialPrice:  initialPrice,
+		Discount:      domain.CalculateDiscount(initialPrice, price),
+		Currency:      currency,
+		PriceableID:   priceableID,
+		PriceableType: priceableType,
+	}
+
+	if err := db.Create(&history).Error; err != nil {
+		log.Printf("Failed to record price history for %s %d: %+v", priceableType, priceableID, err)
+	}
+}
+
+func fetchSteamListingDetails(storeAppID string) (*SteamAppDetails, error) {
+	appID, err := strconv.Atoi(storeAppID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid steam app id %q: %+v", storeAppID, err)
+	}
+
+	return FetchSteamAppDetails(appID)
+}
+
+func initialPriceOf(initial uint, final uint) uint {
+	if initial == 0 {
+		return final
+	}
+
+	return initial
+}
+
+func lowestPriceOf(lowest uint, price uint) uint {
+	if lowest == 0 || price < lowest {
+		return price
+	}
+
+	return lowest
+}
@@ -1,10 +1,10 @@
 package jobs
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
-	"net/http"
+	"gcstatus/internal/utils"
 	"strconv"
 )
 
@@ -58,6 +58,7 @@ type Requirements struct {
 type SteamAppDetails struct {
 	Success bool `json:"success"`
 	Data    struct {
+		Type               string       `json:"type"`
 		Name               string       `json:"name"`
 		Background         string       `json:"background_raw"`
 		HeaderImage        string       `json:"header_image"`
@@ -106,46 +107,25 @@ type SteamAppDetails struct {
 }
 
 func FetchSteamAppList() ([]SteamApp, error) {
-	resp, err := http.Get("https://api.steampowered.com/ISteamApps/GetAppList/v2/")
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		if closeErr := resp.Body.Close(); closeErr != nil {
-			log.Printf("Error closing response body: %v", closeErr)
-		}
-	}()
-
-	var appListResponse SteamAppListResponse
-	if err := json.NewDecoder(resp.Body).Decode(&appListResponse); err != nil {
-		return nil, err
-	}
-
-	return appListResponse.Applist.Apps, nil
+	return DefaultSteamClient.AppList(context.Background())
 }
 
 func FetchSteamAppDetails(appID int) (*SteamAppDetails, error) {
-	url := fmt.Sprintf("https://store.steampowered.com/api/appdetails?appids=%d&cc=en&l=en", appID)
-	resp, err := http.Get(url)
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		if closeErr := resp.Body.Close(); closeErr != nil {
-			log.Printf("Error closing response body: %v", closeErr)
-		}
-	}()
+	return FetchSteamAppDetailsForRegion(appID, utils.ResolveRegion(utils.SteamRegions()))
+}
 
-	var appDetailsMap map[string]SteamAppDetails
-	err = json.NewDecoder(resp.Body).Decode(&appDetailsMap)
-	if err != nil {
-		return nil, err
-	}
+// FetchSteamPriceOverview fetches only the price overview of the given app on
+// the given region (country code).
+func FetchSteamPriceOverview(appID int, region string) (*SteamAppDetails, error) {
+	return DefaultSteamClient.PriceOverview(context.Background(), appID, region)
+}
 
-	appDetails, ok := appDetailsMap[fmt.Sprintf("%d", appID)]
-	if !ok || !appDetails.Success {
-		return nil, fmt.Errorf("no data for app ID %d", appID)
-	}
+func FetchSteamAppDetailsForRegion(appID int, region string) (*SteamAppDetails, error) {
+	return DefaultSteamClient.AppDetails(context.Background(), appID, region)
+}
 
-	return &appDetails, nil
+// IsGame tells whether the app is a base game, as the app list also carries
+// DLCs, soundtracks, tools, videos and so on.
+func (d *SteamAppDetails) IsGame() bool {
+	return d.Data.Type == "game"
 }
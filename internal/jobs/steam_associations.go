@@ -1,260 +1,78 @@
 package jobs
 
 import (
-	"fmt"
 	"gcstatus/internal/domain"
 	"gcstatus/internal/utils"
 	"log"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
 )
 
-const (
-	steamGamesAssociationsMorphsType = "games"
-	steamDlcsAssociationsMorphsType  = "dlcs"
-)
+const delayBetweenRegionRequests = 2 * time.Second
 
-func MapSteamGalleries(screenshots []struct {
-	Path string `json:"path_full"`
-}, videos []struct {
-	MP4 struct {
-		Max string `json:"max"`
-	} `json:"mp4"`
-}, associateID uint, morphs string, db *gorm.DB) {
-	if len(screenshots) > 0 {
-		for _, screenshot := range screenshots {
-			gallery := domain.Galleriable{
-				S3:              false,
-				Path:            screenshot.Path,
-				MediaTypeID:     domain.PhotoTypeID,
-				GalleriableID:   associateID,
-				GalleriableType: morphs,
-			}
+// MapSteamRegionalPrices stores the price of a Steam listing on every configured
+// region. The given price overview was already fetched for the default region,
+// so only the remaining regions are requested to Steam.
+func MapSteamRegionalPrices(defaultPriceOverview CatalogPrice, priceableID uint, priceableType string, appID string, db *gorm.DB) {
+	regions := utils.SteamRegions()
 
-			if err := db.Model(&domain.Galleriable{}).Create(&gallery).Error; err != nil {
-				log.Printf("Failed to create an image on database for associateID: %v", associateID)
-			}
-		}
-	}
+	for i, region := range regions {
+		priceOverview := defaultPriceOverview
 
-	if len(videos) > 0 {
-		for _, video := range videos {
-			gallery := domain.Galleriable{
-				S3:              false,
-				Path:            video.MP4.Max,
-				MediaTypeID:     domain.VideoTypeID,
-				GalleriableID:   associateID,
-				GalleriableType: morphs,
+		if i > 0 {
+			id, err := strconv.Atoi(appID)
+			if err != nil {
+				log.Printf("Invalid steam app id %q for regional prices: %+v", appID, err)
+				return
 			}
 
-			if err := db.Model(&domain.Galleriable{}).Create(&gallery).Error; err != nil {
-				log.Printf("Failed to create a video on database for associateID: %v", associateID)
-			}
-		}
-	}
-}
-
-func MapSteamGamePrices(priceOverview struct {
-	Currency string `json:"currency"`
-	Initial  uint   `json:"initial"`
-	Final    uint   `json:"final"`
-}, gameID uint, appID string, db *gorm.DB) {
-	gameStore := domain.GameStore{
-		Price:       priceOverview.Final,
-		URL:         fmt.Sprintf("https://store.steampowered.com/app/%s", appID),
-		GameID:      gameID,
-		StoreID:     domain.SteamStoreID,
-		StoreGameID: appID,
-	}
-
-	if err := db.Model(&domain.GameStore{}).Create(&gameStore).Error; err != nil {
-		log.Printf("Failed to create a store for gameID: %v", gameID)
-	}
-}
-
-func MapSteamDLCPrices(priceOverview struct {
-	Currency string `json:"currency"`
-	Initial  uint   `json:"initial"`
-	Final    uint   `json:"final"`
-}, dlcID uint, appID string, db *gorm.DB) {
-	dlcStore := domain.DLCStore{
-		Price:     priceOverview.Final,
-		URL:       fmt.Sprintf("https://store.steampowered.com/app/%s", appID),
-		DLCID:     dlcID,
-		StoreID:   domain.SteamStoreID,
-		StorDLCID: appID,
-	}
-
-	if err := db.Model(&domain.DLCStore{}).Create(&dlcStore).Error; err != nil {
-		log.Printf("Failed to create a store for dlcID: %v", dlcID)
-	}
-}
-
-func MapSteamGenresAndCategories(
-	genres []struct {
-		Name string `json:"description"`
-	},
-	categories []struct {
-		Name string `json:"description"`
-	},
-	associateID uint,
-	morphs string,
-	db *gorm.DB,
-) {
-	if len(genres) > 0 {
-		for _, genre := range genres {
-			slug := utils.Slugify(genre.Name)
-
-			dbGenre := domain.Genre{
-				Name: genre.Name,
-				Slug: slug,
-			}
-
-			err := db.Model(&domain.Genre{}).Where("slug = ?", slug).FirstOrCreate(&dbGenre).Error
-			if err != nil && err != gorm.ErrRecordNotFound {
-				log.Printf("Database error while fetching or creating genre: %v", err)
+			appDetails, err := FetchSteamPriceOverview(id, region)
+			if err != nil {
+				log.Printf("Failed to fetch %s prices for app ID %s: %+v", region, appID, err)
 				continue
 			}
 
-			genreable := domain.Genreable{
-				GenreableID:   associateID,
-				GenreableType: morphs,
-				GenreID:       dbGenre.ID,
-			}
-			if err := db.Create(&genreable).Error; err != nil {
-				log.Printf("Failed to associate genre %s to game %v", genre.Name, associateID)
-			}
-		}
-	}
+			priceOverview = CatalogPrice(appDetails.Data.PriceOverview)
 
-	if len(categories) > 0 {
-		for _, category := range categories {
-			slug := utils.Slugify(category.Name)
-
-			dbCategory := domain.Category{
-				Name: category.Name,
-				Slug: slug,
-			}
-
-			err := db.Model(&domain.Category{}).Where("slug = ?", slug).FirstOrCreate(&dbCategory).Error
-			if err != nil && err != gorm.ErrRecordNotFound {
-				log.Printf("Database error while fetching or creating category: %v", err)
-				continue
-			}
-
-			categoriable := domain.Categoriable{
-				CategoriableID:   associateID,
-				CategoriableType: morphs,
-				CategoryID:       dbCategory.ID,
-			}
-			if err := db.Create(&categoriable).Error; err != nil {
-				log.Printf("Failed to associate category %s to game %v", category.Name, associateID)
-			}
+			time.Sleep(delayBetweenRegionRequests)
 		}
-	}
-}
-
-func MapSteamPublishersAndDevelopers(
-	developers []string,
-	publishers []string,
-	associateID uint,
-	morphs string,
-	db *gorm.DB,
-) {
-	if len(developers) > 0 {
-		for _, developer := range developers {
-			slug := utils.Slugify(developer)
-
-			dbDeveloper := domain.Developer{
-				Name:   developer,
-				Slug:   slug,
-				Acting: true,
-			}
 
-			err := db.Model(&domain.Developer{}).Where("slug = ?", slug).FirstOrCreate(&dbDeveloper).Error
-			if err != nil && err != gorm.ErrRecordNotFound {
-				log.Printf("Database error while fetching or creating developer: %v", err)
-				continue
-			}
-
-			if morphs == steamGamesAssociationsMorphsType {
-				gameDeveloper := domain.GameDeveloper{
-					GameID:      associateID,
-					DeveloperID: dbDeveloper.ID,
-				}
-				if err := db.Create(&gameDeveloper).Error; err != nil {
-					log.Printf("Failed to associate developer %s to game %v", developer, associateID)
-				}
-			} else if morphs == steamDlcsAssociationsMorphsType {
-				dlcDeveloper := domain.DLCDeveloper{
-					DLCID:       associateID,
-					DeveloperID: dbDeveloper.ID,
-				}
-				if err := db.Create(&dlcDeveloper).Error; err != nil {
-					log.Printf("Failed to associate developer %s to dlc %v", developer, associateID)
-				}
-			}
+		if priceOverview.Initial == 0 && priceOverview.Final == 0 {
+			continue
 		}
-	}
-
-	if len(publishers) > 0 {
-		for _, publisher := range publishers {
-			slug := utils.Slugify(publisher)
-
-			dbPublisher := domain.Publisher{
-				Name:   publisher,
-				Slug:   slug,
-				Acting: true,
-			}
 
-			err := db.Model(&domain.Publisher{}).Where("slug = ?", slug).FirstOrCreate(&dbPublisher).Error
-			if err != nil && err != gorm.ErrRecordNotFound {
-				log.Printf("Database error while fetching or creating publisher: %v", err)
-				continue
-			}
-
-			if morphs == steamGamesAssociationsMorphsType {
-				gamePublisher := domain.GamePublisher{
-					GameID:      associateID,
-					PublisherID: dbPublisher.ID,
-				}
-				if err := db.Create(&gamePublisher).Error; err != nil {
-					log.Printf("Failed to associate publisher %s to game %v", publisher, associateID)
-				}
-			} else if morphs == steamDlcsAssociationsMorphsType {
-				dlcPublisher := domain.DLCPublisher{
-					DLCID:       associateID,
-					PublisherID: dbPublisher.ID,
-				}
-				if err := db.Create(&dlcPublisher).Error; err != nil {
-					log.Printf("Failed to associate publisher %s to dlc %v", publisher, associateID)
-				}
-			}
+		storePrice := domain.StorePrice{
+			Region:        region,
+			PriceableID:   priceableID,
+			PriceableType: priceableType,
 		}
-	}
-}
 
-func MapSteamSupport(support struct {
-	URL   string `json:"url"`
-	Email string `json:"email"`
-}, gameID uint, db *gorm.DB) {
-	dbSupport := domain.GameSupport{
-		URL:    &support.URL,
-		Email:  &support.Email,
-		GameID: gameID,
-	}
-
-	if err := db.Model(&domain.GameSupport{}).Create(&dbSupport).Error; err != nil {
-		log.Fatalf("Failed to create a new support for game %v.", gameID)
+		if err := db.Where(storePrice).
+			Assign(domain.StorePrice{
+				Currency:     priceOverview.Currency,
+				InitialPrice: initialPriceOf(priceOverview.Initial, priceOverview.Final),
+				FinalPrice:   priceOverview.Final,
+				Discount:     domain.CalculateDiscount(initialPriceOf(priceOverview.Initial, priceOverview.Final), priceOverview.Final),
+			}).
+			FirstOrCreate(&storePrice).
+			Error; err != nil {
+			log.Printf("Failed to save %s prices for %s %d: %+v", region, priceableType, priceableID, err)
+		}
 	}
 }
 
-func MapSteamSupportedLanguages(supportedLanguages string, associateID uint, morphs string, db *gorm.DB) {
+// ParseSteamLanguages parses the supported languages of a Steam app. Languages
+// flagged with a "<strong>*</strong>" have full audio support.
+func ParseSteamLanguages(supportedLanguages string) []CatalogLanguage {
 	langEntries := strings.Split(supportedLanguages, ",")
 	audioSupportRegex := regexp.MustCompile(`(.*?)<strong>\*</strong>?`)
 
+	languages := make([]CatalogLanguage, 0, len(langEntries))
 	for _, entry := range langEntries {
 		entry = strings.TrimSpace(entry)
 		entry = strings.ReplaceAll(entry, "<br>", "")
@@ -272,43 +90,22 @@ func MapSteamSupportedLanguages(supportedLanguages string, associateID uint, mor
 			continue
 		}
 
-		var language domain.Language
-		if err := db.Where("name = ?", langName).FirstOrCreate(&language, domain.Language{Name: langName}).Error; err != nil {
-			log.Printf("Failed while fetching or creating language %s: %v", langName, err)
-			continue
-		}
-
-		if morphs == steamGamesAssociationsMorphsType {
-			if err := db.Create(&domain.GameLanguage{
-				GameID:     associateID,
-				LanguageID: language.ID,
-				Menu:       true,
-				Dubs:       hasAudio,
-				Subtitles:  true,
-			}).Error; err != nil {
-				log.Printf("Failed to associate language %s with game %v: %v", langName, associateID, err)
-			}
-		} else if morphs == steamDlcsAssociationsMorphsType {
-			if err := db.Create(&domain.DLCLanguage{
-				DLCID:      associateID,
-				LanguageID: language.ID,
-				Menu:       true,
-				Dubs:       hasAudio,
-				Subtitles:  true,
-			}).Error; err != nil {
-				log.Printf("Failed to associate language %s with dlc %v: %v", langName, associateID, err)
-			}
-		}
+		languages = append(languages, CatalogLanguage{Name: langName, Dubs: hasAudio})
 	}
+
+	return languages
 }
 
-func MapSteamRequirements(requirements map[string]any, associateID uint, db *gorm.DB) {
+// ParseSteamRequirements parses the minimum and recommended requirements of a
+// Steam app on every operating system.
+func ParseSteamRequirements(requirements map[string]any) []CatalogRequirement {
 	requirementTypes := map[string]string{
 		"pc_requirements":    "windows",
 		"mac_requirements":   "mac",
 		"linux_requirements": "linux",
 	}
 
+	parsed := make([]CatalogRequirement, 0)
 	for reqType, osType := range requirementTypes {
 		reqMap, ok := requirements[reqType].(map[string]string)
 		if !ok {
@@ -317,35 +114,37 @@ func MapSteamRequirements(requirements map[string]any, associateID uint, db *gor
 		}
 
 		for potential, html := range reqMap {
-			if isEmptyRequirement(html) {
-				log.Printf("Skipping creation for empty requirement type %s and os type %s.", potential, osType)
+			if html == "" || isEmptyRequirement(html) {
 				continue
 			}
 
 			os, dx, cpu, ram, gpu, storage, obs := extractRequirements(html)
 
-			var reqType domain.RequirementType
-			db.Where("potential = ? AND os = ?", potential, osType).FirstOrCreate(&reqType, domain.RequirementType{
+			parsed = append(parsed, CatalogRequirement{
 				Potential: potential,
 				OS:        osType,
+				Requirement: domain.Requirement{
+					OS:      os,
+					DX:      dx,
+					CPU:     cpu,
+					RAM:     ram,
+					GPU:     gpu,
+					ROM:     storage,
+					OBS:     obs,
+					Network: "N/A",
+				},
 			})
-
-			if err := db.Create(&domain.Requirement{
-				GameID:            associateID,
-				RequirementTypeID: reqType.ID,
-				OS:                os,
-				DX:                dx,
-				CPU:               cpu,
-				RAM:               ram,
-				GPU:               gpu,
-				ROM:               storage,
-				OBS:               obs,
-				Network:           "N/A",
-			}).Error; err != nil {
-				log.Printf("Failed to create requirement for game %s", err.Error())
-			}
 		}
 	}
+
+	sort.Slice(parsed, func(a, b int) bool {
+		if parsed[a].OS != parsed[b].OS {
+			return parsed[a].OS < parsed[b].OS
+		}
+		return parsed[a].Potential < parsed[b].Potential
+	})
+
+	return parsed
 }
 
 func isEmptyRequirement(html string) bool {
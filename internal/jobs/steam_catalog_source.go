@@ -0,0 +1,218 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+	"log"
+	"strconv"
+	"time"
+)
+
+const SteamImportSource = "steam"
+
+// SteamCatalogSource imports the Steam catalog through the Steam store API.
+type SteamCatalogSource struct {
+	client *SteamClient
+	region string
+}
+
+func NewSteamCatalogSource(client *SteamClient, region string) *SteamCatalogSource {
+	return &SteamCatalogSource{client: client, region: region}
+}
+
+func NewDefaultSteamCatalogSource() *SteamCatalogSource {
+	return NewSteamCatalogSource(DefaultSteamClient, utils.ResolveRegion(utils.SteamRegions()))
+}
+
+func (s *SteamCatalogSource) Name() string {
+	return SteamImportSource
+}
+
+func (s *SteamCatalogSource) StoreID() uint {
+	return domain.SteamStoreID
+}
+
+func (s *SteamCatalogSource) List(ctx context.Context) ([]CatalogEntry, error) {
+	apps, err := s.client.AppList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]CatalogEntry, 0, len(apps))
+	for _, app := range apps {
+		if app.AppID <= 0 {
+			continue
+		}
+
+		entries = append(entries, CatalogEntry{ID: uint(app.AppID), Name: app.Name})
+	}
+
+	return entries, nil
+}
+
+// Fetch builds the aggregate of a Steam game and of its DLCs. DLCs that fail
+// to be fetched are left out of the aggregate but kept on its DLC list.
+func (s *SteamCatalogSource) Fetch(ctx context.Context, id uint) (*CatalogGame, error) {
+	details, err := s.client.AppDetails(ctx, int(id), s.region)
+	if err != nil {
+		return nil, err
+	}
+
+	if !details.IsGame() {
+		return nil, fmt.Errorf("%w: steam app %d is a %q", ErrCatalogItemSkipped, id, details.Data.Type)
+	}
+
+	item := NormalizeSteamApp(int(id), details)
+
+	for _, dlcID := range details.Data.DLC {
+		dlcDetails, err := s.client.AppDetails(ctx, dlcID, s.region)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			log.Printf("Failed to fetch details for dlc app ID %d: %+v", dlcID, err)
+			continue
+		}
+
+		item.DLCs = append(item.DLCs, NormalizeSteamDLC(dlcID, dlcDetails))
+	}
+
+	return item, nil
+}
+
+// NormalizeSteamApp turns the details of a Steam game into a catalog
+// aggregate, without its DLCs.
+func NormalizeSteamApp(appID int, details *SteamAppDetails) *CatalogGame {
+	data := details.Data
+	age, _ := strconv.Atoi(string(data.Age))
+	website, legal := data.Website, data.Legal
+
+	listedDLCs := make([]string, 0, len(data.DLC))
+	for _, dlcID := range data.DLC {
+		listedDLCs = append(listedDLCs, strconv.Itoa(dlcID))
+	}
+
+	return &CatalogGame{
+		Source:           SteamImportSource,
+		Listing:          steamListing(appID, details),
+		Title:            data.Name,
+		About:            data.AboutTheGame,
+		Description:      data.Description,
+		ShortDescription: data.ShortDescription,
+		Cover:            data.Background,
+		Free:             data.IsFree,
+		ReleaseDate:      parseSteamReleaseDate(details),
+		Age:              age,
+		Website:          &website,
+		Legal:            &legal,
+		Support:          &CatalogSupport{URL: data.Support.URL, Email: data.Support.Email},
+		Media:            steamMedia(details),
+		Genres:           steamDescriptions(data.Genres),
+		Categories:       steamDescriptions(data.Categories),
+		Developers:       nonNilStrings(data.Developers),
+		Publishers:       nonNilStrings(data.Publishers),
+		Languages:        ParseSteamLanguages(data.SupportedLanguages),
+		Requirements:     ParseSteamRequirements(steamRequirements(details)),
+		ListedDLCs:       listedDLCs,
+	}
+}
+
+func NormalizeSteamDLC(appID int, details *SteamAppDetails) CatalogDLC {
+	data := details.Data
+	legal := data.Legal
+
+	return CatalogDLC{
+		Listing:          steamListing(appID, details),
+		Name:             data.Name,
+		About:            data.AboutTheGame,
+		Description:      data.Description,
+		ShortDescription: data.ShortDescription,
+		Cover:            data.HeaderImage,
+		Free:             data.IsFree,
+		ReleaseDate:      parseSteamReleaseDate(details),
+		Legal:            &legal,
+		Media:            steamMedia(details),
+		Genres:           steamDescriptions(data.Genres),
+		Categories:       steamDescriptions(data.Categories),
+		Developers:       nonNilStrings(data.Developers),
+		Publishers:       nonNilStrings(data.Publishers),
+		Languages:        ParseSteamLanguages(data.SupportedLanguages),
+	}
+}
+
+func steamListing(appID int, details *SteamAppDetails) CatalogListing {
+	return CatalogListing{
+		StoreID:    domain.SteamStoreID,
+		ExternalID: strconv.Itoa(appID),
+		URL:        fmt.Sprintf("https://store.steampowered.com/app/%d", appID),
+		Price:      CatalogPrice(details.Data.PriceOverview),
+	}
+}
+
+func steamMedia(details *SteamAppDetails) []CatalogMedia {
+	media := make([]CatalogMedia, 0, len(details.Data.Screenshots)+len(details.Data.Movies))
+
+	for _, screenshot := range details.Data.Screenshots {
+		media = append(media, CatalogMedia{Path: screenshot.Path, MediaTypeID: domain.PhotoTypeID})
+	}
+
+	for _, movie := range details.Data.Movies {
+		media = append(media, CatalogMedia{Path: movie.MP4.Max, MediaTypeID: domain.VideoTypeID})
+	}
+
+	return media
+}
+
+func steamDescriptions(entries []struct {
+	Name string `json:"description"`
+}) []string {
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name)
+	}
+
+	return names
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
+
+func parseSteamReleaseDate(appDetails *SteamAppDetails) time.Time {
+	layout := "2 Jan, 2006"
+	if appDetails.Data.ReleaseDate.Soon {
+		layout = "January 2006"
+	}
+
+	releaseDate, err := time.Parse(layout, appDetails.Data.ReleaseDate.Date)
+	if err != nil {
+		log.Printf("Failed to parse release date: %v", err)
+		return time.Time{}
+	}
+
+	return releaseDate
+}
+
+func steamRequirements(appDetails *SteamAppDetails) map[string]any {
+	return map[string]any{
+		"pc_requirements": map[string]string{
+			"minimum":     appDetails.Data.PCRequirements.Minimum,
+			"recommended": appDetails.Data.PCRequirements.Recommended,
+		},
+		"linux_requirements": map[string]string{
+			"minimum":     appDetails.Data.LinuxRequirements.Minimum,
+			"recommended": appDetails.Data.LinuxRequirements.Recommended,
+		},
+		"mac_requirements": map[string]string{
+			"minimum":     appDetails.Data.MacRequirements.Minimum,
+			"recommended": appDetails.Data.MacRequirements.Recommended,
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	steamStoreBaseURL        = "https://store.steampowered.com"
+	steamAPIBaseURL          = "https://api.steampowered.com"
+	defaultSteamRequestDelay = 1500 * time.Millisecond
+)
+
+// SteamClient talks to the Steam store and web APIs.
+type SteamClient struct {
+	CatalogHTTPClient
+	StoreBaseURL string
+	APIBaseURL   string
+}
+
+func NewSteamClient(httpClient *http.Client, storeBaseURL string, apiBaseURL string, requestDelay time.Duration) *SteamClient {
+	return &SteamClient{
+		CatalogHTTPClient: NewCatalogHTTPClient(httpClient, requestDelay),
+		StoreBaseURL:      storeBaseURL,
+		APIBaseURL:        apiBaseURL,
+	}
+}
+
+var DefaultSteamClient = NewSteamClient(http.DefaultClient, steamStoreBaseURL, steamAPIBaseURL, defaultSteamRequestDelay)
+
+func (c *SteamClient) AppList(ctx context.Context) ([]SteamApp, error) {
+	var appListResponse SteamAppListResponse
+	if err := c.getJSON(ctx, c.APIBaseURL+"/ISteamApps/GetAppList/v2/", &appListResponse); err != nil {
+		return nil, err
+	}
+
+	return appListResponse.Applist.Apps, nil
+}
+
+func (c *SteamClient) AppDetails(ctx context.Context, appID int, region string) (*SteamAppDetails, error) {
+	return c.appDetails(ctx, fmt.Sprintf("%s/api/appdetails?appids=%d&cc=%s&l=en", c.StoreBaseURL, appID, region), appID)
+}
+
+// PriceOverview fetches only the price overview of the given app on the
+// given region (country code).
+func (c *SteamClient) PriceOverview(ctx context.Context, appID int, region string) (*SteamAppDetails, error) {
+	return c.appDetails(ctx, fmt.Sprintf("%s/api/appdetails?appids=%d&cc=%s&filters=price_overview", c.StoreBaseURL, appID, region), appID)
+}
+
+func (c *SteamClient) appDetails(ctx context.Context, url string, appID int) (*SteamAppDetails, error) {
+	var appDetailsMap map[string]SteamAppDetails
+	if err := c.getJSON(ctx, url, &appDetailsMap); err != nil {
+		return nil, err
+	}
+
+	appDetails, ok := appDetailsMap[strconv.Itoa(appID)]
+	if !ok || !appDetails.Success {
+		return nil, fmt.Errorf("%w: no data for steam app ID %d", ErrCatalogItemUnavailable, appID)
+	}
+
+	return &appDetails, nil
+}
@@ -0,0 +1,157 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"gcstatus/internal/domain"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	auditBeforeKey     = "audit_before"
+	auditUserAgentSize = 512
+)
+
+type AuditServiceInterface interface {
+	Record(auditLog *domain.AuditLog) error
+}
+
+// auditResponseWriter keeps a copy of the response body, so the audit log can
+// record the state of the target after the mutation.
+type auditResponseWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *auditResponseWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *auditResponseWriter) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+	return w.ResponseWriter.WriteString(data)
+}
+
+// SetAuditBefore keeps the state of the target before an admin mutation, so
+// the audit log records what the mutation changed. The snapshot should have
+// the same shape as the resource the handler responds with.
+func SetAuditBefore(c *gin.Context, before any) {
+	c.Set(auditBeforeKey, before)
+}
+
+// AuditMiddleware records every mutation made through the routes it guards,
+// along with the authenticated actor, the target and its changes.
+func AuditMiddleware(auditService AuditServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		writer := &auditResponseWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = writer
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			return
+		}
+
+		auditLog := domain.AuditLog{
+			Action:     c.Request.Method + " " + route,
+			TargetType: auditTargetType(route),
+			Status:     writer.Status(),
+			IP:         c.ClientIP(),
+			UserAgent:  c.Request.UserAgent(),
+		}
+
+		if len(auditLog.UserAgent) > auditUserAgentSize {
+			auditLog.UserAgent = auditLog.UserAgent[:auditUserAgentSize]
+		}
+
+		if value, exists := c.Get("user_id"); exists {
+			if user, ok := value.(*domain.User); ok && user != nil {
+				auditLog.UserID = &user.ID
+			}
+		}
+
+		var after map[string]any
+		if auditLog.Status < http.StatusMultipleChoices {
+			after = auditResponseData(writer.body.Bytes())
+		}
+
+		auditLog.TargetID = auditTargetID(c, after)
+
+		value, _ := c.Get(auditBeforeKey)
+		if before := auditSnapshot(value); before != nil || after != nil {
+			if changes, err := json.Marshal(domain.DiffAuditSnapshots(before, after)); err == nil {
+				encoded := string(changes)
+				auditLog.Changes = &encoded
+			}
+		}
+
+		if err := auditService.Record(&auditLog); err != nil {
+			log.Printf("failed to record the audit log of %s: %+v", auditLog.Action, err)
+		}
+	}
+}
+
+// auditTargetType is the admin resource of the route, such as "games" for
+// "/admin/games/:id/crack".
+func auditTargetType(route string) string {
+	segments := strings.Split(strings.Trim(route, "/"), "/")
+	if len(segments) > 1 && segments[0] == "admin" {
+		return segments[1]
+	}
+
+	return segments[0]
+}
+
+func auditTargetID(c *gin.Context, after map[string]any) *uint {
+	if id, err := strconv.ParseUint(c.Param("id"), 10, 32); err == nil {
+		targetID := uint(id)
+		return &targetID
+	}
+
+	if id, ok := after["id"].(float64); ok && id > 0 {
+		targetID := uint(id)
+		return &targetID
+	}
+
+	return nil
+}
+
+func auditResponseData(body []byte) map[string]any {
+	var response struct {
+		Data map[string]any `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil
+	}
+
+	return response.Data
+}
+
+// auditSnapshot brings a snapshot to the same JSON shape as the response data.
+func auditSnapshot(value any) map[string]any {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	var snapshot map[string]any
+	if err := json.Unmarshal(encoded, &snapshot); err != nil {
+		return nil
+	}
+
+	return snapshot
+}
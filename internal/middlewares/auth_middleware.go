@@ -16,6 +16,8 @@ func JWTAuthMiddleware(userService *usecases.UserService) gin.HandlerFunc {
 		if err != nil {
 			if err.Error() == "user is blocked" {
 				api.RespondWithError(c, http.StatusForbidden, "Your account is blocked. Please, contact support.")
+			} else if err.Error() == "session was revoked" {
+				api.RespondWithError(c, http.StatusUnauthorized, "Your session has expired. Please, log in again.")
 			} else {
 				api.RespondWithError(c, http.StatusInternalServerError, err.Error())
 			}
@@ -4,40 +4,82 @@ import (
 	"gcstatus/internal/adapters/api"
 	"gcstatus/internal/domain"
 	"gcstatus/internal/utils"
+	"gcstatus/pkg/cache"
 	"net/http"
+	"sort"
+	"sync"
 
 	"github.com/gin-gonic/gin"
 )
 
+// SuperuserScope is cached in place of the user scopes when one of their roles
+// is the superuser role, which grants every scope.
+const SuperuserScope = "*"
+
 type UserServiceInterface interface {
+	GetUserByID(userID uint) (*domain.User, error)
 	GetUserByIDForAdmin(userID uint) (*domain.User, error)
 }
 
-func NewPermissionMiddleware(userService UserServiceInterface) func(requiredScopes ...string) gin.HandlerFunc {
+// ScopeCatalog collects every scope required through the permission
+// middleware, so the permissions admins can grant match the registered routes.
+type ScopeCatalog struct {
+	mu     sync.RWMutex
+	scopes map[string]struct{}
+}
+
+func NewScopeCatalog() *ScopeCatalog {
+	return &ScopeCatalog{
+		scopes: make(map[string]struct{}),
+	}
+}
+
+func (c *ScopeCatalog) Add(scopes ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, scope := range scopes {
+		c.scopes[scope] = struct{}{}
+	}
+}
+
+func (c *ScopeCatalog) Scopes() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	scopes := make([]string, 0, len(c.scopes))
+	for scope := range c.scopes {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+
+	return scopes
+}
+
+func NewPermissionMiddleware(userService UserServiceInterface, catalog *ScopeCatalog, superuserRole string) func(requiredScopes ...string) gin.HandlerFunc {
 	return func(requiredScopes ...string) gin.HandlerFunc {
+		catalog.Add(requiredScopes...)
+
 		return func(c *gin.Context) {
-			user, err := utils.Auth(c, userService.GetUserByIDForAdmin)
+			user, err := utils.Auth(c, userService.GetUserByID)
 			if err != nil {
 				api.RespondWithError(c, http.StatusUnauthorized, err.Error())
 				c.Abort()
 				return
 			}
 
-			hasFullAccess := false
-			for _, role := range user.Roles {
-				if role.Role.Name == "Technology" {
-					hasFullAccess = true
-					break
-				}
+			userPermissions, err := userScopes(user.ID, userService, superuserRole)
+			if err != nil {
+				api.RespondWithError(c, http.StatusInternalServerError, "Failed to check your permissions.")
+				c.Abort()
+				return
 			}
 
-			if hasFullAccess {
+			if userHasPermission(userPermissions, SuperuserScope) {
 				c.Next()
 				return
 			}
 
-			userPermissions := collectPermissions(user)
-
 			for _, requiredScope := range requiredScopes {
 				if !userHasPermission(userPermissions, requiredScope) {
 					api.RespondWithError(c, http.StatusForbidden, "insufficient permissions")
@@ -51,7 +93,31 @@ func NewPermissionMiddleware(userService UserServiceInterface) func(requiredScop
 	}
 }
 
-func collectPermissions(user *domain.User) map[string]bool {
+// userScopes resolves the scopes granted to the user, directly or through
+// their roles, and caches them until an admin changes the user access.
+func userScopes(userID uint, userService UserServiceInterface, superuserRole string) (map[string]bool, error) {
+	if scopes, found := cache.GlobalCache.GetUserScopesFromCache(userID); found {
+		return scopesSet(scopes), nil
+	}
+
+	user, err := userService.GetUserByIDForAdmin(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := collectPermissions(user, superuserRole)
+	cache.GlobalCache.SetUserScopesInCache(userID, scopes)
+
+	return scopesSet(scopes), nil
+}
+
+func collectPermissions(user *domain.User, superuserRole string) []string {
+	for _, roleable := range user.Roles {
+		if roleable.Role.Name == superuserRole {
+			return []string{SuperuserScope}
+		}
+	}
+
 	permissions := make(map[string]bool)
 
 	for _, userPerm := range user.Permissions {
@@ -64,7 +130,21 @@ func collectPermissions(user *domain.User) map[string]bool {
 		}
 	}
 
-	return permissions
+	scopes := make([]string, 0, len(permissions))
+	for scope := range permissions {
+		scopes = append(scopes, scope)
+	}
+
+	return scopes
+}
+
+func scopesSet(scopes []string) map[string]bool {
+	set := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		set[scope] = true
+	}
+
+	return set
 }
 
 func userHasPermission(userPermissions map[string]bool, requiredScope string) bool {
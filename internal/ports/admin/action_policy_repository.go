@@ -0,0 +1,38 @@
+package ports_admin
+
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
+
+// ActionPolicyRequest is the policy of an action key written by admins. The
+// limits left empty are off.
+type ActionPolicyRequest struct {
+	ActionKey         string  `json:"action_key" binding:"required"`
+	CooldownSeconds   uint    `json:"cooldown_seconds"`
+	MaxPerDay         uint    `json:"max_per_day"`
+	OncePerTarget     bool    `json:"once_per_target"`
+	DiminishAfter     uint    `json:"diminish_after"`
+	DiminishingFactor float64 `json:"diminishing_factor"`
+	Enabled           *bool   `json:"enabled"`
+}
+
+type ActionDecisionFilters struct {
+	UserID    uint       `form:"user_id"`
+	ActionKey string     `form:"action_key"`
+	Outcome   string     `form:"outcome"`
+	From      *time.Time `form:"from" time_format:"2006-01-02"`
+	To        *time.Time `form:"to" time_format:"2006-01-02"`
+	Page      int        `form:"page"`
+	PerPage   int        `form:"per_page"`
+}
+
+type AdminActionPolicyRepository interface {
+	GetAll() ([]domain.ActionPolicy, error)
+	FindByID(id uint) (domain.ActionPolicy, error)
+	ExistsByActionKey(actionKey string, exceptID uint) (bool, error)
+	Create(policy *domain.ActionPolicy) error
+	Update(policy *domain.ActionPolicy) error
+	Delete(id uint) error
+	GetDecisions(filters ActionDecisionFilters, offset int, limit int) ([]domain.ActionDecision, int64, error)
+}
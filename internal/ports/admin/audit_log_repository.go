@@ -0,0 +1,23 @@
+package ports_admin
+
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
+
+type AuditLogFilters struct {
+	UserID     uint       `form:"user_id"`
+	Action     string     `form:"action"`
+	TargetType string     `form:"target_type"`
+	TargetID   uint       `form:"target_id"`
+	From       *time.Time `form:"from" time_format:"2006-01-02"`
+	To         *time.Time `form:"to" time_format:"2006-01-02"`
+	Page       int        `form:"page"`
+	PerPage    int        `form:"per_page"`
+}
+
+type AdminAuditLogRepository interface {
+	Create(auditLog *domain.AuditLog) error
+	GetAll(filters AuditLogFilters, offset int, limit int) ([]domain.AuditLog, int64, error)
+	DeleteOlderThan(before time.Time) (int64, error)
+}
@@ -0,0 +1,22 @@
+package ports_admin
+
+import "gcstatus/internal/domain"
+
+// BoosterRequest is a booster written by admins. Purchasable boosters need a
+// cost, while the others can only be given as rewards.
+type BoosterRequest struct {
+	Name            string  `json:"name" binding:"required"`
+	Scope           string  `json:"scope" binding:"required"`
+	Multiplier      float64 `json:"multiplier" binding:"required"`
+	DurationMinutes uint    `json:"duration_minutes" binding:"required"`
+	Cost            uint    `json:"cost"`
+	Purchasable     bool    `json:"purchasable"`
+}
+
+type AdminBoosterRepository interface {
+	GetAll() ([]domain.Booster, error)
+	FindByID(id uint) (domain.Booster, error)
+	Create(booster *domain.Booster) error
+	Update(booster *domain.Booster) error
+	Delete(id uint) error
+}
@@ -0,0 +1,22 @@
+package ports_admin
+
+import "gcstatus/internal/domain"
+
+// CheckInRewardRequest is the whole row of the check-in reward table written
+// by admins. Its rewards are replaced by the given ones.
+type CheckInRewardRequest struct {
+	Streak     uint            `json:"streak" binding:"required"`
+	Milestone  bool            `json:"milestone"`
+	Coins      uint            `json:"coins"`
+	Experience uint            `json:"experience"`
+	Rewards    []RewardRequest `json:"rewards" binding:"dive"`
+}
+
+type AdminCheckInRewardRepository interface {
+	GetAll() ([]domain.CheckInReward, error)
+	FindByID(id uint) (domain.CheckInReward, error)
+	ExistsByStreak(streak uint, milestone bool, exceptID uint) (bool, error)
+	Create(reward *domain.CheckInReward) error
+	Update(reward *domain.CheckInReward) error
+	Delete(id uint) error
+}
@@ -1,8 +1,113 @@
 package ports_admin
 
-import "gcstatus/internal/domain"
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
+
+// UpdateCrackRequest records the crack of a game. The cracker and the
+// protection are only required once the game is cracked.
+type UpdateCrackRequest struct {
+	CrackedAt    *time.Time `json:"cracked_at"`
+	CrackerID    *uint      `json:"cracker_id" binding:"required_with=CrackedAt"`
+	ProtectionID *uint      `json:"protection_id" binding:"required_with=CrackedAt"`
+}
+
+type UpdateSyncLocksRequest struct {
+	Fields []string `json:"fields"`
+}
+
+type GameLanguageRequest struct {
+	LanguageID uint `json:"language_id" binding:"required"`
+	Menu       bool `json:"menu"`
+	Dubs       bool `json:"dubs"`
+	Subtitles  bool `json:"subtitles"`
+}
+
+type GameRequirementRequest struct {
+	Potential string  `json:"potential" binding:"required"`
+	System    string  `json:"system" binding:"required"`
+	OS        string  `json:"os"`
+	DX        string  `json:"dx"`
+	CPU       string  `json:"cpu"`
+	RAM       string  `json:"ram"`
+	GPU       string  `json:"gpu"`
+	ROM       string  `json:"rom"`
+	OBS       *string `json:"obs"`
+	Network   string  `json:"network"`
+}
+
+type GameStoreRequest struct {
+	StoreID     uint   `json:"store_id" binding:"required"`
+	StoreGameID string `json:"store_game_id" binding:"required"`
+	URL         string `json:"url" binding:"required"`
+	Price       uint   `json:"price"`
+}
+
+type GameSupportRequest struct {
+	URL     *string `json:"url"`
+	Email   *string `json:"email"`
+	Contact *string `json:"contact"`
+}
+
+type GameGalleryRequest struct {
+	Path        string `json:"path" binding:"required"`
+	MediaTypeID uint   `json:"media_type_id" binding:"required"`
+	S3          bool   `json:"s3"`
+}
+
+// GameDLCRequest updates the DLC with the given ID, or creates a new one
+// when the ID is missing.
+type GameDLCRequest struct {
+	ID               *uint     `json:"id"`
+	Name             string    `json:"name"`
+	Cover            string    `json:"cover"`
+	About            string    `json:"about"`
+	Description      string    `json:"description"`
+	ShortDescription string    `json:"short_description"`
+	Free             bool      `json:"free"`
+	Legal            *string   `json:"legal"`
+	ReleaseDate      time.Time `json:"release_date"`
+}
+
+// UpsertGameRequest is the whole game aggregate written by admins. Every
+// relation is replaced by the given one, except the crack, which is only
+// updated when present.
+type UpsertGameRequest struct {
+	Title            string                   `json:"title" binding:"required"`
+	Age              int                      `json:"age"`
+	Condition        string                   `json:"condition"`
+	Cover            string                   `json:"cover"`
+	About            string                   `json:"about"`
+	Description      string                   `json:"description"`
+	ShortDescription string                   `json:"short_description"`
+	Free             bool                     `json:"free"`
+	GreatRelease     bool                     `json:"great_release"`
+	Legal            *string                  `json:"legal"`
+	Website          *string                  `json:"website"`
+	ReleaseDate      time.Time                `json:"release_date"`
+	CategoryIDs      []uint                   `json:"categories"`
+	GenreIDs         []uint                   `json:"genres"`
+	TagIDs           []uint                   `json:"tags"`
+	PlatformIDs      []uint                   `json:"platforms"`
+	DeveloperIDs     []uint                   `json:"developers"`
+	PublisherIDs     []uint                   `json:"publishers"`
+	Languages        []GameLanguageRequest    `json:"languages" binding:"dive"`
+	Requirements     []GameRequirementRequest `json:"requirements" binding:"dive"`
+	Stores           []GameStoreRequest       `json:"stores" binding:"dive"`
+	Galleries        []GameGalleryRequest     `json:"galleries" binding:"dive"`
+	DLCs             []GameDLCRequest         `json:"dlcs" binding:"dive"`
+	Support          *GameSupportRequest      `json:"support"`
+	Crack            *UpdateCrackRequest      `json:"crack"`
+}
 
 type AdminGameRepository interface {
 	GetAll() ([]domain.Game, error)
 	FindByID(id uint) (domain.Game, error)
+	UpdateCrack(gameID uint, request UpdateCrackRequest) (*domain.Crack, *domain.CrackStatusChange, error)
+	GetSyncLocks(gameID uint) ([]domain.GameSyncLock, error)
+	UpdateSyncLocks(gameID uint, fields []string) ([]domain.GameSyncLock, error)
+	Create(game *domain.Game, crack *UpdateCrackRequest) (*domain.CrackStatusChange, error)
+	Update(game *domain.Game, crack *UpdateCrackRequest) (*domain.CrackStatusChange, error)
+	Delete(id uint) error
 }
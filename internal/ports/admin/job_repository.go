@@ -0,0 +1,15 @@
+package ports_admin
+
+import "gcstatus/internal/domain"
+
+type JobFilters struct {
+	Status string `form:"status"`
+	Type   string `form:"type"`
+}
+
+type AdminJobRepository interface {
+	GetAll(filters JobFilters, limit int) ([]domain.Job, error)
+	FindByID(id uint) (domain.Job, error)
+	Retry(job *domain.Job) error
+	Cancel(job *domain.Job) error
+}
@@ -0,0 +1,21 @@
+package ports_admin
+
+import "gcstatus/internal/domain"
+
+// LevelRequest is the whole level written by admins. Its rewards are replaced
+// by the given ones.
+type LevelRequest struct {
+	Level      uint            `json:"level" binding:"required"`
+	Experience uint            `json:"experience" binding:"required"`
+	Coins      uint            `json:"coins" binding:"required"`
+	Rewards    []RewardRequest `json:"rewards" binding:"dive"`
+}
+
+type AdminLevelRepository interface {
+	GetAll() ([]domain.Level, error)
+	FindByID(id uint) (domain.Level, error)
+	ExistsByLevel(level uint, exceptID uint) (bool, error)
+	Create(level *domain.Level) error
+	Update(level *domain.Level) error
+	Delete(id uint) error
+}
@@ -0,0 +1,69 @@
+package ports_admin
+
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
+
+// TaskRequirementRequest updates the mission or title requirement with the
+// given ID, or creates a new one when the ID is missing. The type, window and
+// conditions say how the actions of the key are evaluated, defaulting to a
+// plain counter.
+type TaskRequirementRequest struct {
+	ID          *uint               `json:"id"`
+	Task        string              `json:"task" binding:"required"`
+	Key         string              `json:"key" binding:"required"`
+	Goal        int                 `json:"goal" binding:"required,min=1"`
+	Description string              `json:"description" binding:"required"`
+	Type        string              `json:"type"`
+	WithinDays  *uint               `json:"within_days"`
+	StartsAt    *time.Time          `json:"starts_at"`
+	EndsAt      *time.Time          `json:"ends_at"`
+	Conditions  map[string][]string `json:"conditions"`
+}
+
+// RewardRequest is a reward given by a mission, level or check-in. Coins and
+// experience are given by amount, the other types point to the rewarded
+// entity, given amount times.
+type RewardRequest struct {
+	RewardableType string `json:"rewardable_type" binding:"required"`
+	RewardableID   uint   `json:"rewardable_id"`
+	Amount         uint   `json:"amount"`
+}
+
+// MissionRequest is the whole mission written by admins. Its requirements and
+// rewards are replaced by the given ones.
+type MissionRequest struct {
+	Mission       string                   `json:"mission" binding:"required"`
+	Description   string                   `json:"description" binding:"required"`
+	Status        string                   `json:"status"`
+	ForAll        bool                     `json:"for_all"`
+	Coins         uint                     `json:"coins" binding:"required"`
+	Experience    uint                     `json:"experience" binding:"required"`
+	Frequency     string                   `json:"frequency"`
+	ResetHour     uint                     `json:"reset_hour" binding:"max=23"`
+	ResetWeekday  *uint                    `json:"reset_weekday" binding:"omitempty,max=6"`
+	ResetTimezone *string                  `json:"reset_timezone"`
+	Requirements  []TaskRequirementRequest `json:"requirements" binding:"dive"`
+	Rewards       []RewardRequest          `json:"rewards" binding:"dive"`
+}
+
+type AssignMissionRequest struct {
+	UserIDs []uint `json:"user_ids"`
+}
+
+type PageRequest struct {
+	Page    int `form:"page"`
+	PerPage int `form:"per_page"`
+}
+
+type AdminMissionRepository interface {
+	GetAll() ([]domain.Mission, error)
+	FindByID(id uint) (domain.Mission, error)
+	Create(mission *domain.Mission) error
+	Update(mission *domain.Mission) error
+	Delete(id uint) error
+	SyncAssignments(missionID uint, userIDs []uint) error
+	FindRequirement(missionID uint, requirementID uint) (domain.MissionRequirement, error)
+	RequirementAchievers(requirementID uint, offset int, limit int) ([]domain.User, int64, error)
+}
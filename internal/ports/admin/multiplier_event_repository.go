@@ -0,0 +1,25 @@
+package ports_admin
+
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
+
+// MultiplierEventRequest is a multiplier event written by admins. Leaving the
+// action key out makes the event multiply everything of its scope.
+type MultiplierEventRequest struct {
+	Name       string    `json:"name" binding:"required"`
+	Scope      string    `json:"scope" binding:"required"`
+	Multiplier float64   `json:"multiplier" binding:"required"`
+	ActionKey  string    `json:"action_key"`
+	StartsAt   time.Time `json:"starts_at" binding:"required"`
+	EndsAt     time.Time `json:"ends_at" binding:"required"`
+}
+
+type AdminMultiplierEventRepository interface {
+	GetAll() ([]domain.MultiplierEvent, error)
+	FindByID(id uint) (domain.MultiplierEvent, error)
+	Create(event *domain.MultiplierEvent) error
+	Update(event *domain.MultiplierEvent) error
+	Delete(id uint) error
+}
@@ -0,0 +1,31 @@
+package ports_admin
+
+import "gcstatus/internal/domain"
+
+type PermissionRequest struct {
+	Scope string `json:"scope" binding:"required"`
+}
+
+// PermissionCatalogEntry pairs a scope with its stored permission. Scopes
+// required by the admin routes but not stored yet have no permission, while
+// stored permissions no route requires anymore are not in the routes.
+type PermissionCatalogEntry struct {
+	Scope      string
+	InRoutes   bool
+	Permission *domain.Permission
+}
+
+type AdminPermissionRepository interface {
+	GetAll() ([]domain.Permission, error)
+	FindByID(id uint) (domain.Permission, error)
+	ExistsByScope(scope string, exceptID uint) (bool, error)
+	Create(permission *domain.Permission) error
+	Save(permission *domain.Permission) error
+	Delete(id uint) error
+	// UserIDs returns the users granted the permission, directly or through
+	// one of their roles.
+	UserIDs(permissionID uint) ([]uint, error)
+	// CreateMissing creates a permission for every scope not stored yet and
+	// returns the created ones.
+	CreateMissing(scopes []string) ([]domain.Permission, error)
+}
@@ -0,0 +1,46 @@
+package ports_admin
+
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
+
+type QuizOptionRequest struct {
+	Text    string `json:"text" binding:"required"`
+	Correct bool   `json:"correct"`
+}
+
+type QuizQuestionRequest struct {
+	Question string              `json:"question" binding:"required"`
+	Options  []QuizOptionRequest `json:"options" binding:"required,min=2,dive"`
+}
+
+type QuizRewardRequest struct {
+	MinScore   uint            `json:"min_score" binding:"max=100"`
+	Coins      uint            `json:"coins"`
+	Experience uint            `json:"experience"`
+	Rewards    []RewardRequest `json:"rewards" binding:"dive"`
+}
+
+// QuizRequest is the whole quiz or poll written by admins. Its reward tiers
+// are replaced by the given ones, and so are its questions when given, as
+// long as nobody answered it yet.
+type QuizRequest struct {
+	Kind        string                `json:"kind" binding:"required,oneof=quiz poll"`
+	Title       string                `json:"title" binding:"required"`
+	Description string                `json:"description"`
+	GameID      *uint                 `json:"game_id"`
+	OpensAt     time.Time             `json:"opens_at" binding:"required"`
+	ClosesAt    time.Time             `json:"closes_at" binding:"required"`
+	Questions   []QuizQuestionRequest `json:"questions" binding:"omitempty,dive"`
+	Rewards     []QuizRewardRequest   `json:"rewards" binding:"dive"`
+}
+
+type AdminQuizRepository interface {
+	GetAll() ([]domain.Quiz, error)
+	FindByID(id uint) (domain.Quiz, error)
+	HasAttempts(id uint) (bool, error)
+	Create(quiz *domain.Quiz) error
+	Update(quiz *domain.Quiz, replaceQuestions bool) error
+	Delete(id uint) error
+}
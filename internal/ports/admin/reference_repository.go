@@ -0,0 +1,40 @@
+package ports_admin
+
+import "errors"
+
+// ErrReferenceInUse is returned when deleting a reference entity that is
+// still referenced by other records and no replacement was given.
+var ErrReferenceInUse = errors.New("the record is still referenced")
+
+// ReferenceRequest is the payload of every catalog reference entity. Each
+// entity only reads the fields it declares, and is validated by its domain
+// validator afterwards.
+type ReferenceRequest struct {
+	Name      string `json:"name"`
+	Slug      string `json:"-"`
+	URL       string `json:"url"`
+	Logo      string `json:"logo"`
+	ISO       string `json:"iso"`
+	Acting    bool   `json:"acting"`
+	Potential string `json:"potential"`
+	OS        string `json:"os"`
+}
+
+// ReferenceUsage is a column of another model pointing to a reference entity.
+// Owner lists the columns of the record the usage links the entity to, when a
+// record can only be linked once to each entity.
+type ReferenceUsage struct {
+	Model  any
+	Column string
+	Owner  []string
+}
+
+type AdminReferenceRepository[T any] interface {
+	GetAll() ([]T, error)
+	FindByID(id uint) (T, error)
+	Exists(conditions map[string]any, exceptID uint) (bool, error)
+	CountUsages(ids ...uint) (map[uint]int64, error)
+	Create(entity *T) error
+	Save(entity *T) error
+	Delete(id uint, reassignTo *uint) error
+}
@@ -0,0 +1,18 @@
+package ports_admin
+
+import "gcstatus/internal/domain"
+
+type RoleRequest struct {
+	Name          string `json:"name" binding:"required"`
+	PermissionIDs []uint `json:"permission_ids"`
+}
+
+type AdminRoleRepository interface {
+	GetAll() ([]domain.Role, error)
+	FindByID(id uint) (domain.Role, error)
+	ExistsByName(name string, exceptID uint) (bool, error)
+	Create(role *domain.Role, permissionIDs []uint) error
+	Update(role *domain.Role, permissionIDs []uint) error
+	Delete(id uint) error
+	UserIDs(roleID uint) ([]uint, error)
+}
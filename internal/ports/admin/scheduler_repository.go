@@ -0,0 +1,18 @@
+package ports_admin
+
+import "gcstatus/internal/domain"
+
+type ScheduledRunFilters struct {
+	Job     string `form:"job"`
+	Status  string `form:"status"`
+	Page    int    `form:"page"`
+	PerPage int    `form:"per_page"`
+}
+
+type AdminSchedulerRepository interface {
+	GetJobs() ([]domain.ScheduledJob, error)
+	FindJob(name string) (domain.ScheduledJob, error)
+	GetRuns(filters ScheduledRunFilters, offset int, limit int) ([]domain.ScheduledRun, int64, error)
+	HasPendingRun(jobName string) (bool, error)
+	CreateRun(run *domain.ScheduledRun) error
+}
@@ -0,0 +1,24 @@
+package ports_admin
+
+import "gcstatus/internal/domain"
+
+// TitleRequest is the whole title written by admins. Its requirements are
+// replaced by the given ones.
+type TitleRequest struct {
+	Title        string                   `json:"title" binding:"required"`
+	Description  string                   `json:"description" binding:"required"`
+	Cost         *int                     `json:"cost" binding:"omitempty,min=0"`
+	Purchasable  bool                     `json:"purchasable"`
+	Status       string                   `json:"status"`
+	Requirements []TaskRequirementRequest `json:"requirements" binding:"dive"`
+}
+
+type AdminTitleRepository interface {
+	GetAll() ([]domain.Title, error)
+	FindByID(id uint) (domain.Title, error)
+	Create(title *domain.Title) error
+	Update(title *domain.Title) error
+	Delete(id uint) error
+	FindRequirement(titleID uint, requirementID uint) (domain.TitleRequirement, error)
+	RequirementAchievers(requirementID uint, offset int, limit int) ([]domain.User, int64, error)
+}
@@ -0,0 +1,50 @@
+package ports_admin
+
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
+
+type UserFilters struct {
+	Search  string `form:"search"`
+	Blocked *bool  `form:"blocked"`
+	RoleID  uint   `form:"role_id"`
+}
+
+// BlockUserRequest blocks an user, permanently when no expiry is given.
+type BlockUserRequest struct {
+	Reason string     `json:"reason" binding:"required"`
+	Until  *time.Time `json:"until"`
+}
+
+// AdjustWalletRequest credits positive amounts to the user wallet and debits
+// negative ones.
+type AdjustWalletRequest struct {
+	Amount      int    `json:"amount" binding:"required"`
+	Description string `json:"description" binding:"required"`
+}
+
+type GrantTitleRequest struct {
+	TitleID uint `json:"title_id" binding:"required"`
+}
+
+type SyncUserRolesRequest struct {
+	RoleIDs []uint `json:"role_ids"`
+}
+
+type SyncUserPermissionsRequest struct {
+	PermissionIDs []uint `json:"permission_ids"`
+}
+
+type AdminUserRepository interface {
+	GetAll(filters UserFilters, limit int) ([]domain.User, error)
+	FindByID(id uint) (domain.User, error)
+	Block(id uint, reason string, until *time.Time) error
+	Unblock(id uint) error
+	AdjustWallet(id uint, amount int, description string) (domain.Transaction, error)
+	GrantTitle(id uint, titleID uint) (domain.UserTitle, error)
+	RevokeSessions(id uint, at time.Time) error
+	CreatePasswordReset(id uint, reset *domain.PasswordReset) error
+	SyncRoles(id uint, roleIDs []uint) error
+	SyncPermissions(id uint, permissionIDs []uint) error
+}
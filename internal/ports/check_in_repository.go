@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
+
+type CheckInRepository interface {
+	GetStreak(userID uint) (domain.CheckInStreak, error)
+	GetRecent(userID uint, limit int) ([]domain.CheckIn, error)
+	GetRewardTable() ([]domain.CheckInReward, error)
+	CheckIn(userID uint, day time.Time, table []domain.CheckInReward) (domain.CheckInResult, error)
+	BuyFreeze(userID uint, cost uint, limit uint) (domain.CheckInStreak, domain.Transaction, error)
+}
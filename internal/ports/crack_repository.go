@@ -0,0 +1,15 @@
+package ports
+
+import "gcstatus/internal/domain"
+
+type CrackGroupStats struct {
+	ID    uint
+	Name  string
+	Slug  string
+	Stats domain.CrackStats
+}
+
+type CrackRepository interface {
+	GetHistoryByGameSlug(slug string) ([]domain.CrackStatusChange, error)
+	FindForStats() ([]domain.Crack, error)
+}
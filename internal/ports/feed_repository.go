@@ -0,0 +1,19 @@
+package ports
+
+import "gcstatus/internal/domain"
+
+type ReleaseFeedFilters struct {
+	Genre      string
+	Platform   string
+	FollowedBy uint
+}
+
+type FeedRepository interface {
+	UpcomingReleases(filters ReleaseFeedFilters) ([]domain.Game, error)
+	LatestGames(limit int) ([]domain.Game, error)
+	LatestDLCs(limit int) ([]domain.DLC, error)
+	LatestCrackChanges(limit int) ([]domain.CrackStatusChange, error)
+	FindToken(token string) (*domain.FeedToken, error)
+	FindTokenForUser(userID uint) (*domain.FeedToken, error)
+	SaveToken(token *domain.FeedToken) error
+}
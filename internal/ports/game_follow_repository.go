@@ -0,0 +1,22 @@
+package ports
+
+import "gcstatus/internal/domain"
+
+type UpsertGameFollowRequest struct {
+	GameID        uint  `json:"game_id" binding:"required"`
+	NotifyRelease *bool `json:"notify_release,omitempty"`
+	NotifyCrack   *bool `json:"notify_crack,omitempty"`
+	NotifyPrice   *bool `json:"notify_price,omitempty"`
+	NotifyDLC     *bool `json:"notify_dlc,omitempty"`
+}
+
+type GameFollowRepository interface {
+	GetAllForUser(userID uint) ([]domain.GameFollow, error)
+	FindByID(id uint) (*domain.GameFollow, error)
+	FindForUser(gameID uint, userID uint) (*domain.GameFollow, error)
+	FindByToken(token string) (*domain.GameFollow, error)
+	FindFollowersForEvent(gameID uint, event string) ([]domain.GameFollow, error)
+	GameExists(gameID uint) (bool, error)
+	Save(follow *domain.GameFollow) error
+	Delete(id uint) error
+}
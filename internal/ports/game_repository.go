@@ -2,12 +2,18 @@ package ports
 
 import "gcstatus/internal/domain"
 
+type GameSearchFilters struct {
+	Region   string
+	MinPrice *uint
+	MaxPrice *uint
+}
+
 type GameRepository interface {
-	FindBySlug(slug string, userID uint) (domain.Game, error)
+	FindBySlug(slug string, userID uint, region string) (domain.Game, error)
 	FindGamesByCondition(condition string, limit *uint) ([]domain.Game, error)
 	FindByClassification(classification string, filterable string) ([]domain.Game, error)
 	HomeGames() ([]domain.Game, []domain.Game, []domain.Game, *domain.Game, []domain.Game, error)
 	ExistsForStore(storeID uint, appID uint) (bool, error)
-	Search(input string) ([]domain.Game, error)
-	CalendarGames() ([]domain.Game, error)
+	Search(input string, filters GameSearchFilters) ([]domain.Game, error)
+	CalendarGames(followedBy uint) ([]domain.Game, error)
 }
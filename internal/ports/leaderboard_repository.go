@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
+
+type LeaderboardQuery struct {
+	Window  string `form:"window"`
+	Page    int    `form:"page"`
+	PerPage int    `form:"per_page"`
+	Radius  int    `form:"radius"`
+}
+
+// LeaderboardStore keeps the ranked scores of the leaderboards.
+type LeaderboardStore interface {
+	Increment(key string, userID uint, points float64, expiresAt *time.Time) error
+	Count(key string) (int64, error)
+	Range(key string, start int64, stop int64) ([]domain.LeaderboardScore, error)
+	Ranks(key string, userIDs []uint) ([]domain.LeaderboardScore, error)
+	Replace(key string, scores map[uint]float64, expiresAt *time.Time) error
+}
+
+// LeaderboardRepository reads the users shown on the leaderboards and the
+// scores the leaderboards are rebuilt from.
+type LeaderboardRepository interface {
+	GetUsersByIDs(ids []uint) ([]domain.User, error)
+	GetBlockedUserIDs(now time.Time) ([]uint, error)
+	GetScores(period domain.LeaderboardPeriod) (map[uint]float64, error)
+}
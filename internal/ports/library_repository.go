@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"gcstatus/internal/domain"
+)
+
+type UpsertLibraryRequest struct {
+	LibraryableID   uint    `json:"libraryable_id" binding:"required"`
+	LibraryableType string  `json:"libraryable_type" binding:"required"`
+	Status          string  `json:"status" binding:"required"`
+	HoursPlayed     *uint   `json:"hours_played,omitempty"`
+	CompletedAt     *string `json:"completed_at,omitempty"`
+	Private         *bool   `json:"private,omitempty"`
+}
+
+type LibraryRepository interface {
+	GetAllForUser(userID uint, status string, onlyPublic bool) ([]domain.Libraryable, error)
+	FindByID(id uint) (*domain.Libraryable, error)
+	FindForUser(libraryableID uint, libraryableType string, userID uint) (*domain.Libraryable, error)
+	LibraryableExists(libraryableID uint, libraryableType string) (bool, error)
+	HasTrackedAction(userID uint, actionKey string, libraryableID uint, libraryableType string) (bool, error)
+	Save(libraryable *domain.Libraryable) error
+	Delete(id uint) error
+	GetStatsForUser(userID uint) (domain.LibraryStats, error)
+}
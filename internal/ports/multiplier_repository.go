@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
+
+type MultiplierRepository interface {
+	GetActive(userID uint, at time.Time) ([]domain.MultiplierEvent, []domain.UserBooster, error)
+	GetPurchasableBoosters() ([]domain.Booster, error)
+	BuyBooster(userID uint, boosterID uint) (domain.UserBooster, domain.Transaction, error)
+}
@@ -1,9 +1,13 @@
 package ports
 
-import "gcstatus/internal/domain"
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
 
 type PasswordResetRepository interface {
 	CreatePasswordReset(passwordReset *domain.PasswordReset) error
 	FindPasswordResetByToken(token string) (*domain.PasswordReset, error)
 	DeletePasswordResetByID(id uint) error
+	DeleteExpiredPasswordResets(before time.Time) (int64, error)
 }
@@ -0,0 +1,18 @@
+package ports
+
+import "gcstatus/internal/domain"
+
+type UpsertPriceAlertRequest struct {
+	GameID      uint  `json:"game_id" binding:"required"`
+	TargetPrice *uint `json:"target_price,omitempty"`
+	AnyDiscount bool  `json:"any_discount"`
+}
+
+type PriceAlertRepository interface {
+	GetAllForUser(userID uint) ([]domain.PriceAlert, error)
+	FindByID(id uint) (*domain.PriceAlert, error)
+	FindForUser(gameID uint, userID uint) (*domain.PriceAlert, error)
+	GameExists(gameID uint) (bool, error)
+	Save(alert *domain.PriceAlert) error
+	Delete(id uint) error
+}
@@ -11,7 +11,12 @@ type UpdateSocialsRequest struct {
 	Instagram *string `json:"instagram,omitempty"`
 }
 
+type UpdateRegionRequest struct {
+	Region string `json:"region" binding:"required"`
+}
+
 type ProfileRepository interface {
 	UpdateSocials(profileID uint, request UpdateSocialsRequest) error
 	UpdatePicture(profileID uint, path string) error
+	UpdateRegion(profileID uint, region string) error
 }
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
+
+// QuizAnswerRequest is the option a user picks for a question.
+type QuizAnswerRequest struct {
+	QuestionID uint `json:"question_id" binding:"required"`
+	OptionID   uint `json:"option_id" binding:"required"`
+}
+
+type AnswerQuizRequest struct {
+	Answers []QuizAnswerRequest `json:"answers" binding:"required,min=1,dive"`
+}
+
+type QuizRepository interface {
+	GetOpen(gameID *uint, at time.Time) ([]domain.Quiz, error)
+	FindByID(id uint) (domain.Quiz, error)
+	GetAttempts(userID uint, quizIDs []uint) ([]domain.QuizAttempt, error)
+	CreateAttempt(attempt *domain.QuizAttempt) error
+	GetResults(quiz domain.Quiz) (domain.QuizResults, error)
+}
@@ -0,0 +1,7 @@
+package ports
+
+import "gcstatus/internal/domain"
+
+type RewardRepository interface {
+	GrantRewards(grant domain.RewardGrant, rewards []domain.Reward) (domain.GrantedRewards, error)
+}
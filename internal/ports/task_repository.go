@@ -1,6 +1,9 @@
 package ports
 
-import "gcstatus/internal/domain"
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
 
 type TaskRepository interface {
 	GetTitleRequirementsByKey(actionKey string) ([]domain.TitleRequirement, error)
@@ -11,4 +14,9 @@ type TaskRepository interface {
 	GetMissionRequirementsByKey(actionKey string) ([]domain.MissionRequirement, error)
 	GetOrCreateMissionProgress(userID, requirementID uint) (*domain.MissionProgress, error)
 	UpdateMissionProgress(progress *domain.MissionProgress) error
+	RecordAction(action *domain.UserAction) error
+	GetUserActions(userID uint, actionKey string, since *time.Time, until *time.Time) ([]domain.UserAction, error)
+	GetTargetAttributes(targetType string, targetID uint) (map[string][]string, error)
+	GetActionPolicy(actionKey string) (*domain.ActionPolicy, error)
+	DecideAction(action domain.UserAction, policy domain.ActionPolicy) (domain.ActionDecision, error)
 }
@@ -11,8 +11,9 @@ type UpdateNickAndEmailRequest struct {
 }
 
 type UpdateUserBasicsRequest struct {
-	Name      string `json:"name" binding:"required"`
-	Birthdate string `json:"birthdate" binding:"required"`
+	Name      string  `json:"name" binding:"required"`
+	Birthdate string  `json:"birthdate" binding:"required"`
+	Timezone  *string `json:"timezone"`
 }
 
 type UserRepository interface {
@@ -21,10 +22,11 @@ type UserRepository interface {
 	GetUserByIDForAdmin(id uint) (*domain.User, error)
 	GetAllUsers() ([]domain.User, error)
 	FindUserByEmailOrNickname(EmailOrNickname string) (*domain.User, error)
+	FindUserByNickname(nickname string) (*domain.User, error)
 	FindUserByEmailForAdmin(email string) (*domain.User, error)
 	UpdateUserPassword(userID uint, hashedPassword string) error
 	CreateWithProfile(user *domain.User) error
 	UpdateUserNickAndEmail(userID uint, request UpdateNickAndEmailRequest) error
 	UpdateUserBasics(userID uint, request UpdateUserBasicsRequest) error
-	AddExperience(userID uint, experienceAmount uint, awardTitleToUserFunc func(userID uint, titleID uint) error) error
+	AddExperience(userID uint, experienceAmount uint) error
 }
@@ -0,0 +1,90 @@
+package resources_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type ActionPolicyResource struct {
+	ID                uint    `json:"id"`
+	ActionKey         string  `json:"action_key"`
+	CooldownSeconds   uint    `json:"cooldown_seconds"`
+	MaxPerDay         uint    `json:"max_per_day"`
+	OncePerTarget     bool    `json:"once_per_target"`
+	DiminishAfter     uint    `json:"diminish_after"`
+	DiminishingFactor float64 `json:"diminishing_factor"`
+	Enabled           bool    `json:"enabled"`
+	CreatedAt         string  `json:"created_at"`
+	UpdatedAt         string  `json:"updated_at"`
+}
+
+type ActionDecisionResource struct {
+	ID         uint                `json:"id"`
+	User       *AuditActorResource `json:"user"`
+	ActionKey  string              `json:"action_key"`
+	PolicyID   uint                `json:"policy_id"`
+	TargetType *string             `json:"target_type"`
+	TargetID   *uint               `json:"target_id"`
+	Outcome    string              `json:"outcome"`
+	Weight     float64             `json:"weight"`
+	Increment  int                 `json:"increment"`
+	OccurredAt string              `json:"occurred_at"`
+}
+
+func TransformActionPolicy(policy domain.ActionPolicy) ActionPolicyResource {
+	return ActionPolicyResource{
+		ID:                policy.ID,
+		ActionKey:         policy.ActionKey,
+		CooldownSeconds:   policy.CooldownSeconds,
+		MaxPerDay:         policy.MaxPerDay,
+		OncePerTarget:     policy.OncePerTarget,
+		DiminishAfter:     policy.DiminishAfter,
+		DiminishingFactor: policy.DiminishingFactor,
+		Enabled:           policy.Enabled,
+		CreatedAt:         utils.FormatTimestamp(policy.CreatedAt),
+		UpdatedAt:         utils.FormatTimestamp(policy.UpdatedAt),
+	}
+}
+
+func TransformActionPolicies(policies []domain.ActionPolicy) []ActionPolicyResource {
+	resources := make([]ActionPolicyResource, 0, len(policies))
+	for _, policy := range policies {
+		resources = append(resources, TransformActionPolicy(policy))
+	}
+
+	return resources
+}
+
+func TransformActionDecision(decision domain.ActionDecision) ActionDecisionResource {
+	resource := ActionDecisionResource{
+		ID:         decision.ID,
+		ActionKey:  decision.ActionKey,
+		PolicyID:   decision.PolicyID,
+		TargetType: decision.TargetType,
+		TargetID:   decision.TargetID,
+		Outcome:    decision.Outcome,
+		Weight:     decision.Weight,
+		Increment:  decision.Increment,
+		OccurredAt: utils.FormatTimestamp(decision.OccurredAt),
+	}
+
+	if decision.User.ID != 0 {
+		resource.User = &AuditActorResource{
+			ID:       decision.User.ID,
+			Name:     decision.User.Name,
+			Nickname: decision.User.Nickname,
+			Email:    decision.User.Email,
+		}
+	}
+
+	return resource
+}
+
+func TransformActionDecisions(decisions []domain.ActionDecision) []ActionDecisionResource {
+	resources := make([]ActionDecisionResource, 0, len(decisions))
+	for _, decision := range decisions {
+		resources = append(resources, TransformActionDecision(decision))
+	}
+
+	return resources
+}
@@ -0,0 +1,65 @@
+package resources_admin
+
+import (
+	"encoding/json"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type AuditLogResource struct {
+	ID         uint                `json:"id"`
+	Actor      *AuditActorResource `json:"actor"`
+	Action     string              `json:"action"`
+	TargetType string              `json:"target_type"`
+	TargetID   *uint               `json:"target_id"`
+	Changes    json.RawMessage     `json:"changes"`
+	Status     int                 `json:"status"`
+	IP         string              `json:"ip"`
+	UserAgent  string              `json:"user_agent"`
+	CreatedAt  string              `json:"created_at"`
+}
+
+type AuditActorResource struct {
+	ID       uint   `json:"id"`
+	Name     string `json:"name"`
+	Nickname string `json:"nickname"`
+	Email    string `json:"email"`
+}
+
+func TransformAuditLog(auditLog domain.AuditLog) AuditLogResource {
+	resource := AuditLogResource{
+		ID:         auditLog.ID,
+		Action:     auditLog.Action,
+		TargetType: auditLog.TargetType,
+		TargetID:   auditLog.TargetID,
+		Changes:    json.RawMessage("{}"),
+		Status:     auditLog.Status,
+		IP:         auditLog.IP,
+		UserAgent:  auditLog.UserAgent,
+		CreatedAt:  utils.FormatTimestamp(auditLog.CreatedAt),
+	}
+
+	if auditLog.Changes != nil && json.Valid([]byte(*auditLog.Changes)) {
+		resource.Changes = json.RawMessage(*auditLog.Changes)
+	}
+
+	if auditLog.User != nil {
+		resource.Actor = &AuditActorResource{
+			ID:       auditLog.User.ID,
+			Name:     auditLog.User.Name,
+			Nickname: auditLog.User.Nickname,
+			Email:    auditLog.User.Email,
+		}
+	}
+
+	return resource
+}
+
+func TransformAuditLogs(auditLogs []domain.AuditLog) []AuditLogResource {
+	resources := make([]AuditLogResource, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		resources = append(resources, TransformAuditLog(auditLog))
+	}
+
+	return resources
+}
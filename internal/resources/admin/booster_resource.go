@@ -0,0 +1,41 @@
+package resources_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type BoosterResource struct {
+	ID              uint    `json:"id"`
+	Name            string  `json:"name"`
+	Scope           string  `json:"scope"`
+	Multiplier      float64 `json:"multiplier"`
+	DurationMinutes uint    `json:"duration_minutes"`
+	Cost            uint    `json:"cost"`
+	Purchasable     bool    `json:"purchasable"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+func TransformBooster(booster domain.Booster) BoosterResource {
+	return BoosterResource{
+		ID:              booster.ID,
+		Name:            booster.Name,
+		Scope:           booster.Scope,
+		Multiplier:      booster.Multiplier,
+		DurationMinutes: booster.DurationMinutes,
+		Cost:            booster.Cost,
+		Purchasable:     booster.Purchasable,
+		CreatedAt:       utils.FormatTimestamp(booster.CreatedAt),
+		UpdatedAt:       utils.FormatTimestamp(booster.UpdatedAt),
+	}
+}
+
+func TransformBoosters(boosters []domain.Booster) []BoosterResource {
+	resources := make([]BoosterResource, 0, len(boosters))
+	for _, booster := range boosters {
+		resources = append(resources, TransformBooster(booster))
+	}
+
+	return resources
+}
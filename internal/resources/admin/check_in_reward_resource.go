@@ -0,0 +1,39 @@
+package resources_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type CheckInRewardResource struct {
+	ID         uint             `json:"id"`
+	Streak     uint             `json:"streak"`
+	Milestone  bool             `json:"milestone"`
+	Coins      uint             `json:"coins"`
+	Experience uint             `json:"experience"`
+	CreatedAt  string           `json:"created_at"`
+	UpdatedAt  string           `json:"updated_at"`
+	Rewards    []RewardResource `json:"rewards"`
+}
+
+func TransformCheckInReward(checkInReward domain.CheckInReward) CheckInRewardResource {
+	return CheckInRewardResource{
+		ID:         checkInReward.ID,
+		Streak:     checkInReward.Streak,
+		Milestone:  checkInReward.Milestone,
+		Coins:      checkInReward.Coins,
+		Experience: checkInReward.Experience,
+		CreatedAt:  utils.FormatTimestamp(checkInReward.CreatedAt),
+		UpdatedAt:  utils.FormatTimestamp(checkInReward.UpdatedAt),
+		Rewards:    TransformRewards(checkInReward.Rewards),
+	}
+}
+
+func TransformCheckInRewards(checkInRewards []domain.CheckInReward) []CheckInRewardResource {
+	resources := make([]CheckInRewardResource, 0, len(checkInRewards))
+	for _, checkInReward := range checkInRewards {
+		resources = append(resources, TransformCheckInReward(checkInReward))
+	}
+
+	return resources
+}
@@ -6,21 +6,23 @@ import (
 )
 
 type CrackResource struct {
-	ID         uint                `json:"id"`
-	Status     string              `json:"status"`
-	CrackedAt  *string             `json:"cracked_at"`
-	CreatedAt  string              `json:"created_at"`
-	UpdatedAt  string              `json:"updated_at"`
-	By         *CrackerResource    `json:"by"`
-	Protection *ProtectionResource `json:"protection"`
+	ID          uint                `json:"id"`
+	Status      string              `json:"status"`
+	CrackedAt   *string             `json:"cracked_at"`
+	DaysToCrack *uint               `json:"days_to_crack"`
+	CreatedAt   string              `json:"created_at"`
+	UpdatedAt   string              `json:"updated_at"`
+	By          *CrackerResource    `json:"by"`
+	Protection  *ProtectionResource `json:"protection"`
 }
 
 func TransformCrack(crack *domain.Crack) *CrackResource {
 	resource := CrackResource{
-		ID:        crack.ID,
-		Status:    crack.Status,
-		CreatedAt: utils.FormatTimestamp(crack.CreatedAt),
-		UpdatedAt: utils.FormatTimestamp(crack.UpdatedAt),
+		ID:          crack.ID,
+		Status:      crack.Status,
+		DaysToCrack: crack.DaysToCrack,
+		CreatedAt:   utils.FormatTimestamp(crack.CreatedAt),
+		UpdatedAt:   utils.FormatTimestamp(crack.UpdatedAt),
 	}
 
 	if crack.CrackedAt != nil {
@@ -0,0 +1,29 @@
+package resources_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type GameSyncLockResource struct {
+	ID        uint   `json:"id"`
+	Field     string `json:"field"`
+	CreatedAt string `json:"created_at"`
+}
+
+func TransformGameSyncLock(lock domain.GameSyncLock) GameSyncLockResource {
+	return GameSyncLockResource{
+		ID:        lock.ID,
+		Field:     lock.Field,
+		CreatedAt: utils.FormatTimestamp(lock.CreatedAt),
+	}
+}
+
+func TransformGameSyncLocks(locks []domain.GameSyncLock) []GameSyncLockResource {
+	resources := make([]GameSyncLockResource, 0, len(locks))
+	for _, lock := range locks {
+		resources = append(resources, TransformGameSyncLock(lock))
+	}
+
+	return resources
+}
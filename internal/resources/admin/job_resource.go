@@ -0,0 +1,62 @@
+package resources_admin
+
+import (
+	"encoding/json"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type JobResource struct {
+	ID          uint            `json:"id"`
+	Type        string          `json:"type"`
+	Payload     json.RawMessage `json:"payload"`
+	Status      string          `json:"status"`
+	Attempts    uint            `json:"attempts"`
+	MaxAttempts uint            `json:"max_attempts"`
+	Error       *string         `json:"error"`
+	AvailableAt string          `json:"available_at"`
+	StartedAt   *string         `json:"started_at"`
+	FinishedAt  *string         `json:"finished_at"`
+	CreatedAt   string          `json:"created_at"`
+	UpdatedAt   string          `json:"updated_at"`
+}
+
+func TransformJob(job domain.Job) JobResource {
+	resource := JobResource{
+		ID:          job.ID,
+		Type:        job.Type,
+		Payload:     json.RawMessage(job.Payload),
+		Status:      job.Status,
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		Error:       job.Error,
+		AvailableAt: utils.FormatTimestamp(job.AvailableAt),
+		CreatedAt:   utils.FormatTimestamp(job.CreatedAt),
+		UpdatedAt:   utils.FormatTimestamp(job.UpdatedAt),
+	}
+
+	if !json.Valid(resource.Payload) {
+		resource.Payload = json.RawMessage("null")
+	}
+
+	if job.StartedAt != nil {
+		formattedTime := utils.FormatTimestamp(*job.StartedAt)
+		resource.StartedAt = &formattedTime
+	}
+
+	if job.FinishedAt != nil {
+		formattedTime := utils.FormatTimestamp(*job.FinishedAt)
+		resource.FinishedAt = &formattedTime
+	}
+
+	return resource
+}
+
+func TransformJobs(jobs []domain.Job) []JobResource {
+	resources := make([]JobResource, 0, len(jobs))
+	for _, job := range jobs {
+		resources = append(resources, TransformJob(job))
+	}
+
+	return resources
+}
@@ -0,0 +1,37 @@
+package resources_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type LevelResource struct {
+	ID         uint             `json:"id"`
+	Level      uint             `json:"level"`
+	Experience uint             `json:"experience"`
+	Coins      uint             `json:"coins"`
+	CreatedAt  string           `json:"created_at"`
+	UpdatedAt  string           `json:"updated_at"`
+	Rewards    []RewardResource `json:"rewards"`
+}
+
+func TransformLevel(level domain.Level) LevelResource {
+	return LevelResource{
+		ID:         level.ID,
+		Level:      level.Level,
+		Experience: level.Experience,
+		Coins:      level.Coins,
+		CreatedAt:  utils.FormatTimestamp(level.CreatedAt),
+		UpdatedAt:  utils.FormatTimestamp(level.UpdatedAt),
+		Rewards:    TransformRewards(level.Rewards),
+	}
+}
+
+func TransformLevels(levels []domain.Level) []LevelResource {
+	resources := make([]LevelResource, 0, len(levels))
+	for _, level := range levels {
+		resources = append(resources, TransformLevel(level))
+	}
+
+	return resources
+}
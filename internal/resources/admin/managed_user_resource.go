@@ -0,0 +1,182 @@
+package resources_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/utils"
+	"gcstatus/pkg/s3"
+)
+
+// ManagedUserResource is an user as listed in the admin users console.
+type ManagedUserResource struct {
+	ID                uint           `json:"id"`
+	Name              string         `json:"name"`
+	Email             string         `json:"email"`
+	Nickname          string         `json:"nickname"`
+	Experience        uint           `json:"experience"`
+	Blocked           bool           `json:"blocked"`
+	BlockedReason     *string        `json:"blocked_reason"`
+	BlockedUntil      *string        `json:"blocked_until"`
+	SessionsRevokedAt *string        `json:"sessions_revoked_at"`
+	CreatedAt         string         `json:"created_at"`
+	Roles             []RoleResource `json:"roles"`
+}
+
+// ManagedUserDetailResource adds the account details admins act upon to the
+// listed user.
+type ManagedUserDetailResource struct {
+	ManagedUserResource
+	Birthdate    string                          `json:"birthdate"`
+	Profile      *resources.ProfileResource      `json:"profile"`
+	Wallet       *resources.WalletResource       `json:"wallet"`
+	Transactions []resources.TransactionResource `json:"transactions"`
+	Titles       []UserTitleResource             `json:"titles"`
+	Missions     []ManagedUserMissionResource    `json:"missions"`
+	Permissions  []PermissionResource            `json:"permissions"`
+}
+
+// ManagedUserMissionResource is a mission the user completed or made
+// progress on, along with the progress of each of its requirements.
+type ManagedUserMissionResource struct {
+	ID              uint                                   `json:"id"`
+	Mission         string                                 `json:"mission"`
+	Frequency       string                                 `json:"frequency"`
+	Completed       bool                                   `json:"completed"`
+	LastCompletedAt *string                                `json:"last_completed_at"`
+	Requirements    []resources.MissionRequirementResource `json:"requirements"`
+}
+
+type UserTitleResource struct {
+	ID        uint                    `json:"id"`
+	Enabled   bool                    `json:"enabled"`
+	CreatedAt string                  `json:"created_at"`
+	Title     resources.TitleResource `json:"title"`
+}
+
+func TransformManagedUser(user domain.User) ManagedUserResource {
+	resource := ManagedUserResource{
+		ID:            user.ID,
+		Name:          user.Name,
+		Email:         user.Email,
+		Nickname:      user.Nickname,
+		Experience:    user.Experience,
+		Blocked:       user.Blocked,
+		BlockedReason: user.BlockedReason,
+		CreatedAt:     utils.FormatTimestamp(user.CreatedAt),
+		Roles:         []RoleResource{},
+	}
+
+	if user.BlockedUntil != nil {
+		blockedUntil := utils.FormatTimestamp(*user.BlockedUntil)
+		resource.BlockedUntil = &blockedUntil
+	}
+
+	if user.SessionsRevokedAt != nil {
+		sessionsRevokedAt := utils.FormatTimestamp(*user.SessionsRevokedAt)
+		resource.SessionsRevokedAt = &sessionsRevokedAt
+	}
+
+	for _, roleable := range user.Roles {
+		resource.Roles = append(resource.Roles, TransformRole(roleable.Role))
+	}
+
+	return resource
+}
+
+func TransformManagedUsers(users []domain.User) []ManagedUserResource {
+	resources := make([]ManagedUserResource, 0, len(users))
+	for _, user := range users {
+		resources = append(resources, TransformManagedUser(user))
+	}
+
+	return resources
+}
+
+func TransformManagedUserDetail(user domain.User, s3Client s3.S3ClientInterface) ManagedUserDetailResource {
+	resource := ManagedUserDetailResource{
+		ManagedUserResource: TransformManagedUser(user),
+		Birthdate:           utils.FormatTimestamp(user.Birthdate),
+		Transactions:        []resources.TransactionResource{},
+		Titles:              []UserTitleResource{},
+		Missions:            TransformManagedUserMissions(user),
+		Permissions:         []PermissionResource{},
+	}
+
+	if user.Profile.ID != 0 {
+		resource.Profile = resources.TransformProfile(user.Profile, s3Client)
+	}
+
+	if user.Wallet.ID != 0 {
+		resource.Wallet = resources.TransformWallet(&user.Wallet)
+	}
+
+	for _, transaction := range user.Transactions {
+		resource.Transactions = append(resource.Transactions, resources.TransformTransaction(transaction))
+	}
+
+	for _, userTitle := range user.Titles {
+		resource.Titles = append(resource.Titles, TransformUserTitle(userTitle))
+	}
+
+	for _, permissionable := range user.Permissions {
+		resource.Permissions = append(resource.Permissions, TransformPermission(permissionable.Permission))
+	}
+
+	return resource
+}
+
+// TransformManagedUserMissions groups the progress of the user by mission,
+// listing the missions in the order the user first got to them.
+func TransformManagedUserMissions(user domain.User) []ManagedUserMissionResource {
+	missions := []ManagedUserMissionResource{}
+	positions := make(map[uint]int)
+
+	missionOf := func(mission domain.Mission) *ManagedUserMissionResource {
+		if position, exists := positions[mission.ID]; exists {
+			return &missions[position]
+		}
+
+		positions[mission.ID] = len(missions)
+		missions = append(missions, ManagedUserMissionResource{
+			ID:           mission.ID,
+			Mission:      mission.Mission,
+			Frequency:    mission.Frequency,
+			Requirements: []resources.MissionRequirementResource{},
+		})
+
+		return &missions[len(missions)-1]
+	}
+
+	for _, userMission := range user.Missions {
+		mission := missionOf(userMission.Mission)
+		mission.Completed = userMission.Completed
+
+		if !userMission.LastCompletedAt.IsZero() {
+			lastCompletedAt := utils.FormatTimestamp(userMission.LastCompletedAt)
+			mission.LastCompletedAt = &lastCompletedAt
+		}
+	}
+
+	for _, progress := range user.MissionProgresses {
+		if progress.MissionRequirement == nil {
+			continue
+		}
+
+		requirement := *progress.MissionRequirement
+		requirement.MissionProgress = progress
+
+		mission := missionOf(requirement.Mission)
+		mission.Requirements = append(mission.Requirements, resources.TransformMissionRequirement(requirement))
+	}
+
+	return missions
+}
+
+func TransformUserTitle(userTitle domain.UserTitle) UserTitleResource {
+	return UserTitleResource{
+		ID:        userTitle.ID,
+		Enabled:   userTitle.Enabled,
+		CreatedAt: utils.FormatTimestamp(userTitle.CreatedAt),
+		Title:     resources.TransformTitle(userTitle.Title),
+	}
+}
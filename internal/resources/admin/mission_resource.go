@@ -0,0 +1,63 @@
+package resources_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type MissionResource struct {
+	ID            uint                      `json:"id"`
+	Mission       string                    `json:"mission"`
+	Description   string                    `json:"description"`
+	Status        string                    `json:"status"`
+	ForAll        bool                      `json:"for_all"`
+	Coins         uint                      `json:"coins"`
+	Experience    uint                      `json:"experience"`
+	Frequency     string                    `json:"frequency"`
+	ResetTime     string                    `json:"reset_time"`
+	ResetHour     uint                      `json:"reset_hour"`
+	ResetWeekday  uint                      `json:"reset_weekday"`
+	ResetTimezone *string                   `json:"reset_timezone"`
+	CreatedAt     string                    `json:"created_at"`
+	UpdatedAt     string                    `json:"updated_at"`
+	Requirements  []TaskRequirementResource `json:"requirements"`
+	Rewards       []RewardResource          `json:"rewards"`
+	AssignedUsers []MinimalUserResource     `json:"assigned_users"`
+}
+
+func TransformMission(mission domain.Mission) MissionResource {
+	resource := MissionResource{
+		ID:            mission.ID,
+		Mission:       mission.Mission,
+		Description:   mission.Description,
+		Status:        mission.Status,
+		ForAll:        mission.ForAll,
+		Coins:         mission.Coins,
+		Experience:    mission.Experience,
+		Frequency:     mission.Frequency,
+		ResetTime:     utils.FormatTimestamp(mission.ResetTime),
+		ResetHour:     mission.ResetHour,
+		ResetWeekday:  mission.ResetWeekday,
+		ResetTimezone: mission.ResetTimezone,
+		CreatedAt:     utils.FormatTimestamp(mission.CreatedAt),
+		UpdatedAt:     utils.FormatTimestamp(mission.UpdatedAt),
+		Requirements:  TransformMissionRequirements(mission.MissionRequirements),
+		Rewards:       TransformRewards(mission.Rewards),
+		AssignedUsers: []MinimalUserResource{},
+	}
+
+	for _, assignment := range mission.Assignments {
+		resource.AssignedUsers = append(resource.AssignedUsers, TransformMinimalUser(assignment.User))
+	}
+
+	return resource
+}
+
+func TransformMissions(missions []domain.Mission) []MissionResource {
+	resources := make([]MissionResource, 0, len(missions))
+	for _, mission := range missions {
+		resources = append(resources, TransformMission(mission))
+	}
+
+	return resources
+}
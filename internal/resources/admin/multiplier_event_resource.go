@@ -0,0 +1,46 @@
+package resources_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type MultiplierEventResource struct {
+	ID         uint    `json:"id"`
+	Name       string  `json:"name"`
+	Scope      string  `json:"scope"`
+	Multiplier float64 `json:"multiplier"`
+	ActionKey  *string `json:"action_key"`
+	StartsAt   string  `json:"starts_at"`
+	EndsAt     string  `json:"ends_at"`
+	CreatedAt  string  `json:"created_at"`
+	UpdatedAt  string  `json:"updated_at"`
+}
+
+func TransformMultiplierEvent(event domain.MultiplierEvent) MultiplierEventResource {
+	resource := MultiplierEventResource{
+		ID:         event.ID,
+		Name:       event.Name,
+		Scope:      event.Scope,
+		Multiplier: event.Multiplier,
+		StartsAt:   utils.FormatTimestamp(event.StartsAt),
+		EndsAt:     utils.FormatTimestamp(event.EndsAt),
+		CreatedAt:  utils.FormatTimestamp(event.CreatedAt),
+		UpdatedAt:  utils.FormatTimestamp(event.UpdatedAt),
+	}
+
+	if event.ActionKey != "" {
+		resource.ActionKey = &event.ActionKey
+	}
+
+	return resource
+}
+
+func TransformMultiplierEvents(events []domain.MultiplierEvent) []MultiplierEventResource {
+	resources := make([]MultiplierEventResource, 0, len(events))
+	for _, event := range events {
+		resources = append(resources, TransformMultiplierEvent(event))
+	}
+
+	return resources
+}
@@ -1,6 +1,9 @@
 package resources_admin
 
-import "gcstatus/internal/domain"
+import (
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+)
 
 type PermissionResource struct {
 	ID    uint   `json:"id"`
@@ -13,3 +16,39 @@ func TransformPermission(permission domain.Permission) PermissionResource {
 		Scope: permission.Scope,
 	}
 }
+
+func TransformPermissions(permissions []domain.Permission) []PermissionResource {
+	resources := make([]PermissionResource, 0, len(permissions))
+	for _, permission := range permissions {
+		resources = append(resources, TransformPermission(permission))
+	}
+
+	return resources
+}
+
+// PermissionCatalogResource tells whether a scope is required by the admin
+// routes and stored as a permission admins can grant.
+type PermissionCatalogResource struct {
+	Scope      string              `json:"scope"`
+	InRoutes   bool                `json:"in_routes"`
+	Permission *PermissionResource `json:"permission"`
+}
+
+func TransformPermissionCatalog(catalog []ports_admin.PermissionCatalogEntry) []PermissionCatalogResource {
+	resources := make([]PermissionCatalogResource, 0, len(catalog))
+	for _, entry := range catalog {
+		resource := PermissionCatalogResource{
+			Scope:    entry.Scope,
+			InRoutes: entry.InRoutes,
+		}
+
+		if entry.Permission != nil {
+			permission := TransformPermission(*entry.Permission)
+			resource.Permission = &permission
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return resources
+}
@@ -0,0 +1,93 @@
+package resources_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type QuizResource struct {
+	ID          uint                   `json:"id"`
+	Kind        string                 `json:"kind"`
+	Title       string                 `json:"title"`
+	Description string                 `json:"description"`
+	GameID      *uint                  `json:"game_id"`
+	OpensAt     string                 `json:"opens_at"`
+	ClosesAt    string                 `json:"closes_at"`
+	CreatedAt   string                 `json:"created_at"`
+	UpdatedAt   string                 `json:"updated_at"`
+	Questions   []QuizQuestionResource `json:"questions,omitempty"`
+	Rewards     []QuizRewardResource   `json:"rewards,omitempty"`
+}
+
+type QuizQuestionResource struct {
+	ID       uint                 `json:"id"`
+	Question string               `json:"question"`
+	Options  []QuizOptionResource `json:"options"`
+}
+
+type QuizOptionResource struct {
+	ID      uint   `json:"id"`
+	Text    string `json:"text"`
+	Correct bool   `json:"correct"`
+}
+
+type QuizRewardResource struct {
+	ID         uint             `json:"id"`
+	MinScore   uint             `json:"min_score"`
+	Coins      uint             `json:"coins"`
+	Experience uint             `json:"experience"`
+	Rewards    []RewardResource `json:"rewards"`
+}
+
+func TransformQuiz(quiz domain.Quiz) QuizResource {
+	resource := QuizResource{
+		ID:          quiz.ID,
+		Kind:        quiz.Kind,
+		Title:       quiz.Title,
+		Description: quiz.Description,
+		GameID:      quiz.GameID,
+		OpensAt:     utils.FormatTimestamp(quiz.OpensAt),
+		ClosesAt:    utils.FormatTimestamp(quiz.ClosesAt),
+		CreatedAt:   utils.FormatTimestamp(quiz.CreatedAt),
+		UpdatedAt:   utils.FormatTimestamp(quiz.UpdatedAt),
+	}
+
+	for _, question := range quiz.Questions {
+		questionResource := QuizQuestionResource{
+			ID:       question.ID,
+			Question: question.Question,
+			Options:  make([]QuizOptionResource, 0, len(question.Options)),
+		}
+
+		for _, option := range question.Options {
+			questionResource.Options = append(questionResource.Options, QuizOptionResource{
+				ID:      option.ID,
+				Text:    option.Text,
+				Correct: option.Correct,
+			})
+		}
+
+		resource.Questions = append(resource.Questions, questionResource)
+	}
+
+	for _, tier := range quiz.Rewards {
+		resource.Rewards = append(resource.Rewards, QuizRewardResource{
+			ID:         tier.ID,
+			MinScore:   tier.MinScore,
+			Coins:      tier.Coins,
+			Experience: tier.Experience,
+			Rewards:    TransformRewards(tier.Rewards),
+		})
+	}
+
+	return resource
+}
+
+func TransformQuizzes(quizzes []domain.Quiz) []QuizResource {
+	resources := make([]QuizResource, 0, len(quizzes))
+	for _, quiz := range quizzes {
+		resources = append(resources, TransformQuiz(quiz))
+	}
+
+	return resources
+}
@@ -0,0 +1,49 @@
+package resources_admin
+
+import "encoding/json"
+
+// ReferenceResource is the resource of a catalog reference entity along with
+// the number of records using it.
+type ReferenceResource struct {
+	Resource any
+	Usages   int64
+}
+
+func TransformReference[T any, R any](entity T, transform func(T) R, usages int64) ReferenceResource {
+	return ReferenceResource{
+		Resource: transform(entity),
+		Usages:   usages,
+	}
+}
+
+func TransformReferences[T any, R any](entities []T, transform func(T) R, usages map[uint]int64, id func(T) uint) []ReferenceResource {
+	resources := make([]ReferenceResource, 0, len(entities))
+
+	for _, entity := range entities {
+		resources = append(resources, TransformReference(entity, transform, usages[id(entity)]))
+	}
+
+	return resources
+}
+
+// MarshalJSON flattens the usage count into the entity resource.
+func (r ReferenceResource) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(r.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	usages, err := json.Marshal(r.Usages)
+	if err != nil {
+		return nil, err
+	}
+
+	fields["usages"] = usages
+
+	return json.Marshal(fields)
+}
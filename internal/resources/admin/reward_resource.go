@@ -0,0 +1,43 @@
+package resources_admin
+
+import "gcstatus/internal/domain"
+
+type RewardResource struct {
+	ID             uint                  `json:"id"`
+	RewardableType string                `json:"rewardable_type"`
+	RewardableID   uint                  `json:"rewardable_id"`
+	Amount         uint                  `json:"amount"`
+	Title          *MinimalTitleResource `json:"title,omitempty"`
+	Name           string                `json:"name,omitempty"`
+}
+
+func TransformRewards(rewards []domain.Reward) []RewardResource {
+	resources := make([]RewardResource, 0, len(rewards))
+	for _, reward := range rewards {
+		resource := RewardResource{
+			ID:             reward.ID,
+			RewardableType: reward.RewardableType,
+			RewardableID:   reward.RewardableID,
+			Amount:         reward.Quantity(),
+		}
+
+		switch rewardable := reward.Rewardable.(type) {
+		case *domain.Title:
+			resource.Title = &MinimalTitleResource{
+				ID:     rewardable.ID,
+				Title:  rewardable.Title,
+				Status: rewardable.Status,
+			}
+		case *domain.Booster:
+			resource.Name = rewardable.Name
+		case *domain.Cosmetic:
+			resource.Name = rewardable.Name
+		case *domain.Item:
+			resource.Name = rewardable.Name
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return resources
+}
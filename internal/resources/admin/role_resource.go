@@ -23,3 +23,12 @@ func TransformRole(role domain.Role) RoleResource {
 
 	return resource
 }
+
+func TransformRoles(roles []domain.Role) []RoleResource {
+	resources := make([]RoleResource, 0, len(roles))
+	for _, role := range roles {
+		resources = append(resources, TransformRole(role))
+	}
+
+	return resources
+}
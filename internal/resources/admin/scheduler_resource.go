@@ -0,0 +1,98 @@
+package resources_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type ScheduledJobResource struct {
+	ID        uint    `json:"id"`
+	Name      string  `json:"name"`
+	Schedule  string  `json:"schedule"`
+	NextRunAt *string `json:"next_run_at"`
+	LastRunAt *string `json:"last_run_at"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+type ScheduledRunResource struct {
+	ID          uint    `json:"id"`
+	JobName     string  `json:"job_name"`
+	Trigger     string  `json:"trigger"`
+	Status      string  `json:"status"`
+	Owner       *string `json:"owner"`
+	TriggeredBy *uint   `json:"triggered_by"`
+	Error       *string `json:"error"`
+	StartedAt   *string `json:"started_at"`
+	FinishedAt  *string `json:"finished_at"`
+	DurationMs  *int64  `json:"duration_ms"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+func TransformScheduledJob(job domain.ScheduledJob) ScheduledJobResource {
+	resource := ScheduledJobResource{
+		ID:        job.ID,
+		Name:      job.Name,
+		Schedule:  job.Schedule,
+		CreatedAt: utils.FormatTimestamp(job.CreatedAt),
+		UpdatedAt: utils.FormatTimestamp(job.UpdatedAt),
+	}
+
+	if job.NextRunAt != nil {
+		formattedTime := utils.FormatTimestamp(*job.NextRunAt)
+		resource.NextRunAt = &formattedTime
+	}
+
+	if job.LastRunAt != nil {
+		formattedTime := utils.FormatTimestamp(*job.LastRunAt)
+		resource.LastRunAt = &formattedTime
+	}
+
+	return resource
+}
+
+func TransformScheduledJobs(jobs []domain.ScheduledJob) []ScheduledJobResource {
+	resources := make([]ScheduledJobResource, 0, len(jobs))
+	for _, job := range jobs {
+		resources = append(resources, TransformScheduledJob(job))
+	}
+
+	return resources
+}
+
+func TransformScheduledRun(run domain.ScheduledRun) ScheduledRunResource {
+	resource := ScheduledRunResource{
+		ID:          run.ID,
+		JobName:     run.JobName,
+		Trigger:     run.Trigger,
+		Status:      run.Status,
+		Owner:       run.Owner,
+		TriggeredBy: run.TriggeredBy,
+		Error:       run.Error,
+		DurationMs:  run.DurationMs,
+		CreatedAt:   utils.FormatTimestamp(run.CreatedAt),
+		UpdatedAt:   utils.FormatTimestamp(run.UpdatedAt),
+	}
+
+	if run.StartedAt != nil {
+		formattedTime := utils.FormatTimestamp(*run.StartedAt)
+		resource.StartedAt = &formattedTime
+	}
+
+	if run.FinishedAt != nil {
+		formattedTime := utils.FormatTimestamp(*run.FinishedAt)
+		resource.FinishedAt = &formattedTime
+	}
+
+	return resource
+}
+
+func TransformScheduledRuns(runs []domain.ScheduledRun) []ScheduledRunResource {
+	resources := make([]ScheduledRunResource, 0, len(runs))
+	for _, run := range runs {
+		resources = append(resources, TransformScheduledRun(run))
+	}
+
+	return resources
+}
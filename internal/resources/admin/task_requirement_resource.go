@@ -0,0 +1,61 @@
+package resources_admin
+
+import (
+	"gcstatus/internal/domain"
+	"time"
+)
+
+// TaskRequirementResource is a mission or title requirement, evaluating the
+// actions of a tracked key.
+type TaskRequirementResource struct {
+	ID          uint                `json:"id"`
+	Task        string              `json:"task"`
+	Key         string              `json:"key"`
+	Goal        int                 `json:"goal"`
+	Description string              `json:"description"`
+	Type        string              `json:"type"`
+	WithinDays  *uint               `json:"within_days"`
+	StartsAt    *time.Time          `json:"starts_at"`
+	EndsAt      *time.Time          `json:"ends_at"`
+	Conditions  map[string][]string `json:"conditions"`
+}
+
+func TransformMissionRequirements(requirements []domain.MissionRequirement) []TaskRequirementResource {
+	resources := make([]TaskRequirementResource, 0, len(requirements))
+	for _, requirement := range requirements {
+		resources = append(resources, TaskRequirementResource{
+			ID:          requirement.ID,
+			Task:        requirement.Task,
+			Key:         requirement.Key,
+			Goal:        requirement.Goal,
+			Description: requirement.Description,
+			Type:        requirement.Type,
+			WithinDays:  requirement.WithinDays,
+			StartsAt:    requirement.StartsAt,
+			EndsAt:      requirement.EndsAt,
+			Conditions:  requirement.ConditionValues(),
+		})
+	}
+
+	return resources
+}
+
+func TransformTitleRequirements(requirements []domain.TitleRequirement) []TaskRequirementResource {
+	resources := make([]TaskRequirementResource, 0, len(requirements))
+	for _, requirement := range requirements {
+		resources = append(resources, TaskRequirementResource{
+			ID:          requirement.ID,
+			Task:        requirement.Task,
+			Key:         requirement.Key,
+			Goal:        requirement.Goal,
+			Description: requirement.Description,
+			Type:        requirement.Type,
+			WithinDays:  requirement.WithinDays,
+			StartsAt:    requirement.StartsAt,
+			EndsAt:      requirement.EndsAt,
+			Conditions:  requirement.ConditionValues(),
+		})
+	}
+
+	return resources
+}
@@ -0,0 +1,47 @@
+package resources_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type TitleResource struct {
+	ID           uint                      `json:"id"`
+	Title        string                    `json:"title"`
+	Description  string                    `json:"description"`
+	Cost         *int                      `json:"cost"`
+	Purchasable  bool                      `json:"purchasable"`
+	Status       string                    `json:"status"`
+	CreatedAt    string                    `json:"created_at"`
+	UpdatedAt    string                    `json:"updated_at"`
+	Requirements []TaskRequirementResource `json:"requirements"`
+}
+
+type MinimalTitleResource struct {
+	ID     uint   `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+}
+
+func TransformTitle(title domain.Title) TitleResource {
+	return TitleResource{
+		ID:           title.ID,
+		Title:        title.Title,
+		Description:  title.Description,
+		Cost:         title.Cost,
+		Purchasable:  title.Purchasable,
+		Status:       title.Status,
+		CreatedAt:    utils.FormatTimestamp(title.CreatedAt),
+		UpdatedAt:    utils.FormatTimestamp(title.UpdatedAt),
+		Requirements: TransformTitleRequirements(title.TitleRequirements),
+	}
+}
+
+func TransformTitles(titles []domain.Title) []TitleResource {
+	resources := make([]TitleResource, 0, len(titles))
+	for _, title := range titles {
+		resources = append(resources, TransformTitle(title))
+	}
+
+	return resources
+}
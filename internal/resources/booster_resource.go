@@ -0,0 +1,30 @@
+package resources
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type BoosterResource struct {
+	ID              uint    `json:"id"`
+	Name            string  `json:"name"`
+	Scope           string  `json:"scope"`
+	Multiplier      float64 `json:"multiplier"`
+	DurationMinutes uint    `json:"duration_minutes"`
+	Cost            uint    `json:"cost"`
+	Purchasable     bool    `json:"purchasable"`
+	CreatedAt       string  `json:"created_at,omitempty"`
+}
+
+func TransformBooster(booster domain.Booster) BoosterResource {
+	return BoosterResource{
+		ID:              booster.ID,
+		Name:            booster.Name,
+		Scope:           booster.Scope,
+		Multiplier:      booster.Multiplier,
+		DurationMinutes: booster.DurationMinutes,
+		Cost:            booster.Cost,
+		Purchasable:     booster.Purchasable,
+		CreatedAt:       utils.FormatTimestamp(booster.CreatedAt),
+	}
+}
@@ -0,0 +1,87 @@
+package resources
+
+import "gcstatus/internal/domain"
+
+const checkInDayFormat = "2006-01-02"
+
+type CheckInStatusResource struct {
+	Streak         CheckInStreakResource `json:"streak"`
+	CheckedInToday bool                  `json:"checked_in_today"`
+	FreezeCost     uint                  `json:"freeze_cost"`
+	Recent         []CheckInResource     `json:"recent"`
+}
+
+type CheckInStreakResource struct {
+	Current       uint    `json:"current"`
+	Longest       uint    `json:"longest"`
+	Freezes       uint    `json:"freezes"`
+	LastCheckInOn *string `json:"last_check_in_on"`
+}
+
+type CheckInResource struct {
+	Day        string `json:"day"`
+	Streak     uint   `json:"streak"`
+	Frozen     bool   `json:"frozen"`
+	Coins      uint   `json:"coins"`
+	Experience uint   `json:"experience"`
+}
+
+type CheckInResultResource struct {
+	CheckIn    CheckInResource       `json:"check_in"`
+	Streak     CheckInStreakResource `json:"streak"`
+	FrozenDays []string              `json:"frozen_days"`
+}
+
+func TransformCheckInStatus(streak domain.CheckInStreak, recent []domain.CheckIn, checkedInToday bool, freezeCost uint) CheckInStatusResource {
+	resource := CheckInStatusResource{
+		Streak:         TransformCheckInStreak(streak),
+		CheckedInToday: checkedInToday,
+		FreezeCost:     freezeCost,
+		Recent:         make([]CheckInResource, 0, len(recent)),
+	}
+
+	for _, checkIn := range recent {
+		resource.Recent = append(resource.Recent, TransformCheckIn(checkIn))
+	}
+
+	return resource
+}
+
+func TransformCheckInStreak(streak domain.CheckInStreak) CheckInStreakResource {
+	resource := CheckInStreakResource{
+		Current: streak.Current,
+		Longest: streak.Longest,
+		Freezes: streak.Freezes,
+	}
+
+	if streak.LastCheckInOn != nil {
+		day := streak.LastCheckInOn.Format(checkInDayFormat)
+		resource.LastCheckInOn = &day
+	}
+
+	return resource
+}
+
+func TransformCheckIn(checkIn domain.CheckIn) CheckInResource {
+	return CheckInResource{
+		Day:        checkIn.Day.Format(checkInDayFormat),
+		Streak:     checkIn.Streak,
+		Frozen:     checkIn.Frozen,
+		Coins:      checkIn.Coins,
+		Experience: checkIn.Experience,
+	}
+}
+
+func TransformCheckInResult(result domain.CheckInResult) CheckInResultResource {
+	resource := CheckInResultResource{
+		CheckIn:    TransformCheckIn(result.CheckIn),
+		Streak:     TransformCheckInStreak(result.Streak),
+		FrozenDays: make([]string, 0, len(result.FrozenDays)),
+	}
+
+	for _, day := range result.FrozenDays {
+		resource.FrozenDays = append(resource.FrozenDays, day.Format(checkInDayFormat))
+	}
+
+	return resource
+}
@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type CosmeticResource struct {
+	ID        uint   `json:"id"`
+	Name      string `json:"name"`
+	Slot      string `json:"slot"`
+	Asset     string `json:"asset"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+func TransformCosmetic(cosmetic domain.Cosmetic) CosmeticResource {
+	return CosmeticResource{
+		ID:        cosmetic.ID,
+		Name:      cosmetic.Name,
+		Slot:      cosmetic.Slot,
+		Asset:     cosmetic.Asset,
+		CreatedAt: utils.FormatTimestamp(cosmetic.CreatedAt),
+	}
+}
@@ -6,17 +6,19 @@ import (
 )
 
 type CrackResource struct {
-	ID         uint                `json:"id"`
-	Status     string              `json:"status"`
-	CrackedAt  *string             `json:"cracked_at"`
-	By         *CrackerResource    `json:"by"`
-	Protection *ProtectionResource `json:"protection"`
+	ID          uint                `json:"id"`
+	Status      string              `json:"status"`
+	CrackedAt   *string             `json:"cracked_at"`
+	DaysToCrack *uint               `json:"days_to_crack"`
+	By          *CrackerResource    `json:"by"`
+	Protection  *ProtectionResource `json:"protection"`
 }
 
 func TransformCrack(crack *domain.Crack) *CrackResource {
 	resource := CrackResource{
-		ID:     crack.ID,
-		Status: crack.Status,
+		ID:          crack.ID,
+		Status:      crack.Status,
+		DaysToCrack: crack.DaysToCrack,
 	}
 
 	if crack.CrackedAt != nil {
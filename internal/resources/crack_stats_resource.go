@@ -0,0 +1,36 @@
+package resources
+
+import "gcstatus/internal/ports"
+
+type CrackStatsResource struct {
+	ID                  uint     `json:"id"`
+	Name                string   `json:"name"`
+	Slug                string   `json:"slug"`
+	Total               uint     `json:"total"`
+	Cracked             uint     `json:"cracked"`
+	Uncracked           uint     `json:"uncracked"`
+	UncrackedPercentage float64  `json:"uncracked_percentage"`
+	MedianDaysToCrack   *float64 `json:"median_days_to_crack"`
+}
+
+func TransformCrackStats(stats ports.CrackGroupStats) CrackStatsResource {
+	return CrackStatsResource{
+		ID:                  stats.ID,
+		Name:                stats.Name,
+		Slug:                stats.Slug,
+		Total:               stats.Stats.Total,
+		Cracked:             stats.Stats.Cracked,
+		Uncracked:           stats.Stats.Uncracked,
+		UncrackedPercentage: stats.Stats.UncrackedPercentage,
+		MedianDaysToCrack:   stats.Stats.MedianDaysToCrack,
+	}
+}
+
+func TransformCrackStatsList(stats []ports.CrackGroupStats) []CrackStatsResource {
+	resources := make([]CrackStatsResource, 0, len(stats))
+	for _, stat := range stats {
+		resources = append(resources, TransformCrackStats(stat))
+	}
+
+	return resources
+}
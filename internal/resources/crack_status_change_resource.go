@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type CrackStatusChangeResource struct {
+	ID             uint                `json:"id"`
+	Status         string              `json:"status"`
+	PreviousStatus *string             `json:"previous_status"`
+	CrackedAt      *string             `json:"cracked_at"`
+	DaysToCrack    *uint               `json:"days_to_crack"`
+	By             *CrackerResource    `json:"by"`
+	Protection     *ProtectionResource `json:"protection"`
+	ChangedAt      string              `json:"changed_at"`
+}
+
+func TransformCrackStatusChange(change domain.CrackStatusChange) CrackStatusChangeResource {
+	resource := CrackStatusChangeResource{
+		ID:          change.ID,
+		Status:      change.Status,
+		DaysToCrack: change.DaysToCrack,
+		ChangedAt:   utils.FormatTimestamp(change.CreatedAt),
+	}
+
+	if change.PreviousStatus != "" {
+		resource.PreviousStatus = &change.PreviousStatus
+	}
+
+	if change.CrackedAt != nil {
+		formattedTime := utils.FormatTimestamp(*change.CrackedAt)
+		resource.CrackedAt = &formattedTime
+	}
+
+	if change.Cracker.ID != 0 {
+		resource.By = TransformCracker(change.Cracker)
+	}
+
+	if change.Protection.ID != 0 {
+		resource.Protection = TransformProtection(change.Protection)
+	}
+
+	return resource
+}
+
+func TransformCrackStatusChanges(changes []domain.CrackStatusChange) []CrackStatusChangeResource {
+	resources := make([]CrackStatusChangeResource, 0, len(changes))
+	for _, change := range changes {
+		resources = append(resources, TransformCrackStatusChange(change))
+	}
+
+	return resources
+}
@@ -7,3 +7,29 @@ type Response struct {
 type MapResponse struct {
 	Data map[string]any `json:"data"`
 }
+
+type PaginatedResponse struct {
+	Data any            `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+type PaginationMeta struct {
+	Page     int   `json:"page"`
+	PerPage  int   `json:"per_page"`
+	Total    int64 `json:"total"`
+	LastPage int   `json:"last_page"`
+}
+
+func NewPaginationMeta(page int, perPage int, total int64) PaginationMeta {
+	lastPage := int((total + int64(perPage) - 1) / int64(perPage))
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	return PaginationMeta{
+		Page:     page,
+		PerPage:  perPage,
+		Total:    total,
+		LastPage: lastPage,
+	}
+}
@@ -3,19 +3,32 @@ package resources
 import "gcstatus/internal/domain"
 
 type DLCStoreResource struct {
-	ID         uint          `json:"id"`
-	Price      uint          `json:"price"`
-	URL        string        `json:"url"`
-	Store      StoreResource `json:"store"`
-	StoreDLCID string        `json:"store_dlc_id"`
+	ID           uint                `json:"id"`
+	Price        uint                `json:"price"`
+	InitialPrice uint                `json:"initial_price"`
+	LowestPrice  uint                `json:"lowest_price"`
+	Discount     uint                `json:"discount"`
+	URL          string              `json:"url"`
+	Store        StoreResource       `json:"store"`
+	StoreDLCID   string              `json:"store_dlc_id"`
+	RegionPrice  *StorePriceResource `json:"region_price,omitempty"`
 }
 
 func TransformDLCtore(DLCStore domain.DLCStore) DLCStoreResource {
-	return DLCStoreResource{
-		ID:         DLCStore.ID,
-		Price:      DLCStore.Price,
-		URL:        DLCStore.URL,
-		StoreDLCID: DLCStore.StorDLCID,
-		Store:      TransformStore(DLCStore.Store),
+	resource := DLCStoreResource{
+		ID:           DLCStore.ID,
+		Price:        DLCStore.Price,
+		InitialPrice: DLCStore.InitialPrice,
+		LowestPrice:  DLCStore.LowestPrice,
+		Discount:     DLCStore.Discount(),
+		URL:          DLCStore.URL,
+		StoreDLCID:   DLCStore.StorDLCID,
+		Store:        TransformStore(DLCStore.Store),
 	}
+
+	if len(DLCStore.Prices) > 0 {
+		resource.RegionPrice = TransformStorePrice(DLCStore.Prices[0])
+	}
+
+	return resource
 }
@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/pkg/feeds"
+)
+
+func TransformReleaseEvents(games []domain.Game) []feeds.Event {
+	events := make([]feeds.Event, 0, len(games))
+
+	for _, game := range games {
+		events = append(events, feeds.Event{
+			UID:         fmt.Sprintf("game-%d@gcstatus.cloud", game.ID),
+			Summary:     game.Title,
+			Description: game.ShortDescription,
+			URL:         fmt.Sprintf("%s/games/%s", feeds.SiteURL, game.Slug),
+			Date:        game.ReleaseDate,
+			Stamp:       game.UpdatedAt,
+		})
+	}
+
+	return events
+}
+
+func TransformGameFeedItems(games []domain.Game) []feeds.Item {
+	items := make([]feeds.Item, 0, len(games))
+
+	for _, game := range games {
+		link := fmt.Sprintf("%s/games/%s", feeds.SiteURL, game.Slug)
+		items = append(items, feeds.Item{
+			ID:          link,
+			Title:       game.Title,
+			Link:        link,
+			Description: game.ShortDescription,
+			Published:   game.CreatedAt,
+			Updated:     game.UpdatedAt,
+		})
+	}
+
+	return items
+}
+
+func TransformDLCFeedItems(dlcs []domain.DLC) []feeds.Item {
+	items := make([]feeds.Item, 0, len(dlcs))
+
+	for _, dlc := range dlcs {
+		items = append(items, feeds.Item{
+			ID:          fmt.Sprintf("%s/dlcs/%d", feeds.SiteURL, dlc.ID),
+			Title:       fmt.Sprintf("%s: %s", dlc.Game.Title, dlc.Name),
+			Link:        fmt.Sprintf("%s/games/%s", feeds.SiteURL, dlc.Game.Slug),
+			Description: dlc.ShortDescription,
+			Published:   dlc.CreatedAt,
+			Updated:     dlc.UpdatedAt,
+		})
+	}
+
+	return items
+}
+
+func TransformCrackChangeFeedItems(changes []domain.CrackStatusChange) []feeds.Item {
+	items := make([]feeds.Item, 0, len(changes))
+
+	for _, change := range changes {
+		var description string
+		if change.Status != domain.UncrackedStatus && change.Cracker.ID != 0 {
+			description = fmt.Sprintf("Cracked by %s.", change.Cracker.Name)
+		}
+
+		items = append(items, feeds.Item{
+			ID:          fmt.Sprintf("%s/cracks/changes/%d", feeds.SiteURL, change.ID),
+			Title:       domain.CrackStatusHeadline(change.Game.Title, change.Status, change.PreviousStatus, change.DaysToCrack),
+			Link:        fmt.Sprintf("%s/games/%s", feeds.SiteURL, change.Game.Slug),
+			Description: description,
+			Published:   change.CreatedAt,
+			Updated:     change.UpdatedAt,
+		})
+	}
+
+	return items
+}
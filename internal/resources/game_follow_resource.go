@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type GameFollowResource struct {
+	ID            uint                 `json:"id"`
+	NotifyRelease bool                 `json:"notify_release"`
+	NotifyCrack   bool                 `json:"notify_crack"`
+	NotifyPrice   bool                 `json:"notify_price"`
+	NotifyDLC     bool                 `json:"notify_dlc"`
+	GameID        uint                 `json:"game_id"`
+	Game          *LibraryItemResource `json:"game,omitempty"`
+	CreatedAt     string               `json:"created_at"`
+	UpdatedAt     string               `json:"updated_at"`
+}
+
+func TransformGameFollow(follow domain.GameFollow) GameFollowResource {
+	resource := GameFollowResource{
+		ID:            follow.ID,
+		NotifyRelease: follow.NotifyRelease,
+		NotifyCrack:   follow.NotifyCrack,
+		NotifyPrice:   follow.NotifyPrice,
+		NotifyDLC:     follow.NotifyDLC,
+		GameID:        follow.GameID,
+		CreatedAt:     utils.FormatTimestamp(follow.CreatedAt),
+		UpdatedAt:     utils.FormatTimestamp(follow.UpdatedAt),
+	}
+
+	if follow.Game.ID != 0 {
+		resource.Game = &LibraryItemResource{
+			ID:    follow.Game.ID,
+			Title: follow.Game.Title,
+			Slug:  follow.Game.Slug,
+			Cover: follow.Game.Cover,
+		}
+	}
+
+	return resource
+}
+
+func TransformGameFollows(follows []domain.GameFollow) []GameFollowResource {
+	resources := make([]GameFollowResource, 0, len(follows))
+
+	for _, follow := range follows {
+		resources = append(resources, TransformGameFollow(follow))
+	}
+
+	return resources
+}
@@ -3,19 +3,32 @@ package resources
 import "gcstatus/internal/domain"
 
 type GameStoreResource struct {
-	ID          uint          `json:"id"`
-	Price       uint          `json:"price"`
-	URL         string        `json:"url"`
-	Store       StoreResource `json:"store"`
-	StoreGameID string        `json:"store_game_id"`
+	ID           uint                `json:"id"`
+	Price        uint                `json:"price"`
+	InitialPrice uint                `json:"initial_price"`
+	LowestPrice  uint                `json:"lowest_price"`
+	Discount     uint                `json:"discount"`
+	URL          string              `json:"url"`
+	Store        StoreResource       `json:"store"`
+	StoreGameID  string              `json:"store_game_id"`
+	RegionPrice  *StorePriceResource `json:"region_price,omitempty"`
 }
 
 func TransformGameStore(gameStore domain.GameStore) GameStoreResource {
-	return GameStoreResource{
-		ID:          gameStore.ID,
-		Price:       gameStore.Price,
-		URL:         gameStore.URL,
-		StoreGameID: gameStore.StoreGameID,
-		Store:       TransformStore(gameStore.Store),
+	resource := GameStoreResource{
+		ID:           gameStore.ID,
+		Price:        gameStore.Price,
+		InitialPrice: gameStore.InitialPrice,
+		LowestPrice:  gameStore.LowestPrice,
+		Discount:     gameStore.Discount(),
+		URL:          gameStore.URL,
+		StoreGameID:  gameStore.StoreGameID,
+		Store:        TransformStore(gameStore.Store),
 	}
+
+	if len(gameStore.Prices) > 0 {
+		resource.RegionPrice = TransformStorePrice(gameStore.Prices[0])
+	}
+
+	return resource
 }
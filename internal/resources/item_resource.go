@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type ItemResource struct {
+	ID          uint   `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at,omitempty"`
+}
+
+func TransformItem(item domain.Item) ItemResource {
+	return ItemResource{
+		ID:          item.ID,
+		Name:        item.Name,
+		Description: item.Description,
+		CreatedAt:   utils.FormatTimestamp(item.CreatedAt),
+	}
+}
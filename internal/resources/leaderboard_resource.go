@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"context"
+	"gcstatus/internal/domain"
+	"gcstatus/pkg/s3"
+	"log"
+	"time"
+)
+
+type LeaderboardEntryResource struct {
+	Rank  int64                   `json:"rank"`
+	Score float64                 `json:"score"`
+	User  LeaderboardUserResource `json:"user"`
+}
+
+type LeaderboardUserResource struct {
+	ID       uint    `json:"id"`
+	Name     string  `json:"name"`
+	Nickname string  `json:"nickname"`
+	Photo    *string `json:"photo"`
+}
+
+func TransformLeaderboardEntry(entry domain.LeaderboardEntry, s3Client s3.S3ClientInterface) LeaderboardEntryResource {
+	resource := LeaderboardEntryResource{
+		Rank:  entry.Rank,
+		Score: entry.Score,
+		User: LeaderboardUserResource{
+			ID:       entry.User.ID,
+			Name:     entry.User.Name,
+			Nickname: entry.User.Nickname,
+		},
+	}
+
+	if entry.User.Profile.Photo != "" {
+		url, err := s3Client.GetPresignedURL(context.TODO(), entry.User.Profile.Photo, time.Hour*3)
+		if err != nil {
+			log.Printf("Error generating presigned URL: %v", err)
+		} else {
+			resource.User.Photo = &url
+		}
+	}
+
+	return resource
+}
+
+func TransformLeaderboardEntries(entries []domain.LeaderboardEntry, s3Client s3.S3ClientInterface) []LeaderboardEntryResource {
+	resources := make([]LeaderboardEntryResource, 0, len(entries))
+	for _, entry := range entries {
+		resources = append(resources, TransformLeaderboardEntry(entry, s3Client))
+	}
+
+	return resources
+}
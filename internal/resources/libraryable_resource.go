@@ -0,0 +1,98 @@
+package resources
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type LibraryItemResource struct {
+	ID    uint   `json:"id"`
+	Title string `json:"title"`
+	Slug  string `json:"slug,omitempty"`
+	Cover string `json:"cover"`
+}
+
+type LibraryableResource struct {
+	ID              uint                 `json:"id"`
+	Status          string               `json:"status"`
+	HoursPlayed     *uint                `json:"hours_played"`
+	CompletedAt     *string              `json:"completed_at"`
+	Private         bool                 `json:"private"`
+	LibraryableID   uint                 `json:"libraryable_id"`
+	LibraryableType string               `json:"libraryable_type"`
+	Item            *LibraryItemResource `json:"item,omitempty"`
+	CreatedAt       string               `json:"created_at"`
+	UpdatedAt       string               `json:"updated_at"`
+}
+
+type LibraryStatsResource struct {
+	Total       uint `json:"total"`
+	Wishlist    uint `json:"wishlist"`
+	Playing     uint `json:"playing"`
+	Completed   uint `json:"completed"`
+	Dropped     uint `json:"dropped"`
+	Backlog     uint `json:"backlog"`
+	HoursPlayed uint `json:"hours_played"`
+}
+
+func TransformLibraryable(libraryable domain.Libraryable) LibraryableResource {
+	resource := LibraryableResource{
+		ID:              libraryable.ID,
+		Status:          libraryable.Status,
+		HoursPlayed:     libraryable.HoursPlayed,
+		Private:         libraryable.Private,
+		LibraryableID:   libraryable.LibraryableID,
+		LibraryableType: libraryable.LibraryableType,
+		CreatedAt:       utils.FormatTimestamp(libraryable.CreatedAt),
+		UpdatedAt:       utils.FormatTimestamp(libraryable.UpdatedAt),
+	}
+
+	if libraryable.CompletedAt != nil {
+		completedAt := utils.FormatTimestamp(*libraryable.CompletedAt)
+		resource.CompletedAt = &completedAt
+	}
+
+	switch libraryable.LibraryableType {
+	case domain.LibraryableTypeGames:
+		if game, ok := libraryable.Libraryable.(*domain.Game); ok {
+			resource.Item = &LibraryItemResource{
+				ID:    game.ID,
+				Title: game.Title,
+				Slug:  game.Slug,
+				Cover: game.Cover,
+			}
+		}
+	case domain.LibraryableTypeDLCs:
+		if dlc, ok := libraryable.Libraryable.(*domain.DLC); ok {
+			resource.Item = &LibraryItemResource{
+				ID:    dlc.ID,
+				Title: dlc.Name,
+				Cover: dlc.Cover,
+			}
+		}
+	}
+
+	return resource
+}
+
+func TransformLibraryables(libraryables []domain.Libraryable) []LibraryableResource {
+	resources := make([]LibraryableResource, 0, len(libraryables))
+
+	for _, libraryable := range libraryables {
+		resources = append(resources, TransformLibraryable(libraryable))
+	}
+
+	return resources
+}
+
+func TransformLibraryStats(stats domain.LibraryStats) *LibraryStatsResource {
+	return &LibraryStatsResource{
+		Total:       stats.Total,
+		Wishlist:    stats.Wishlist,
+		Playing:     stats.Playing,
+		Completed:   stats.Completed,
+		Dropped:     stats.Dropped,
+		Backlog:     stats.Backlog,
+		HoursPlayed: stats.HoursPlayed,
+	}
+}
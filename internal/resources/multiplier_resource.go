@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type MultipliersResource struct {
+	Experience float64                   `json:"experience"`
+	Coins      float64                   `json:"coins"`
+	Events     []MultiplierEventResource `json:"events"`
+	Boosters   []UserBoosterResource     `json:"boosters"`
+}
+
+type MultiplierEventResource struct {
+	ID         uint    `json:"id"`
+	Name       string  `json:"name"`
+	Scope      string  `json:"scope"`
+	Multiplier float64 `json:"multiplier"`
+	ActionKey  *string `json:"action_key"`
+	StartsAt   string  `json:"starts_at"`
+	EndsAt     string  `json:"ends_at"`
+}
+
+type UserBoosterResource struct {
+	ID         uint             `json:"id"`
+	Scope      string           `json:"scope"`
+	Multiplier float64          `json:"multiplier"`
+	ExpiresAt  string           `json:"expires_at"`
+	Booster    *BoosterResource `json:"booster,omitempty"`
+}
+
+func TransformMultipliers(multipliers domain.Multipliers) *MultipliersResource {
+	resource := &MultipliersResource{
+		Experience: multipliers.For(domain.BoosterScopeExperience),
+		Coins:      multipliers.For(domain.BoosterScopeCoins),
+		Events:     make([]MultiplierEventResource, 0, len(multipliers.Events)),
+		Boosters:   make([]UserBoosterResource, 0, len(multipliers.Boosters)),
+	}
+
+	for _, event := range multipliers.Events {
+		resource.Events = append(resource.Events, TransformMultiplierEvent(event))
+	}
+
+	for _, userBooster := range multipliers.Boosters {
+		resource.Boosters = append(resource.Boosters, TransformUserBooster(userBooster))
+	}
+
+	return resource
+}
+
+func TransformMultiplierEvent(event domain.MultiplierEvent) MultiplierEventResource {
+	resource := MultiplierEventResource{
+		ID:         event.ID,
+		Name:       event.Name,
+		Scope:      event.Scope,
+		Multiplier: event.Multiplier,
+		StartsAt:   utils.FormatTimestamp(event.StartsAt),
+		EndsAt:     utils.FormatTimestamp(event.EndsAt),
+	}
+
+	if event.ActionKey != "" {
+		resource.ActionKey = &event.ActionKey
+	}
+
+	return resource
+}
+
+func TransformUserBooster(userBooster domain.UserBooster) UserBoosterResource {
+	resource := UserBoosterResource{
+		ID:         userBooster.ID,
+		Scope:      userBooster.Scope,
+		Multiplier: userBooster.Multiplier,
+		ExpiresAt:  utils.FormatTimestamp(userBooster.ExpiresAt),
+	}
+
+	if userBooster.Booster.ID != 0 {
+		booster := TransformBooster(userBooster.Booster)
+		resource.Booster = &booster
+	}
+
+	return resource
+}
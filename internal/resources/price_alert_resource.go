@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+)
+
+type PriceAlertResource struct {
+	ID                uint                 `json:"id"`
+	TargetPrice       *uint                `json:"target_price"`
+	AnyDiscount       bool                 `json:"any_discount"`
+	Active            bool                 `json:"active"`
+	LastNotifiedPrice *uint                `json:"last_notified_price"`
+	GameID            uint                 `json:"game_id"`
+	Game              *LibraryItemResource `json:"game,omitempty"`
+	CreatedAt         string               `json:"created_at"`
+	UpdatedAt         string               `json:"updated_at"`
+}
+
+func TransformPriceAlert(alert domain.PriceAlert) PriceAlertResource {
+	resource := PriceAlertResource{
+		ID:                alert.ID,
+		TargetPrice:       alert.TargetPrice,
+		AnyDiscount:       alert.AnyDiscount,
+		Active:            alert.Active,
+		LastNotifiedPrice: alert.LastNotifiedPrice,
+		GameID:            alert.GameID,
+		CreatedAt:         utils.FormatTimestamp(alert.CreatedAt),
+		UpdatedAt:         utils.FormatTimestamp(alert.UpdatedAt),
+	}
+
+	if alert.Game.ID != 0 {
+		resource.Game = &LibraryItemResource{
+			ID:    alert.Game.ID,
+			Title: alert.Game.Title,
+			Slug:  alert.Game.Slug,
+			Cover: alert.Game.Cover,
+		}
+	}
+
+	return resource
+}
+
+func TransformPriceAlerts(alerts []domain.PriceAlert) []PriceAlertResource {
+	resources := make([]PriceAlertResource, 0, len(alerts))
+
+	for _, alert := range alerts {
+		resources = append(resources, TransformPriceAlert(alert))
+	}
+
+	return resources
+}
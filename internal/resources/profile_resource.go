@@ -20,8 +20,11 @@ type ProfileResource struct {
 	Youtube   string `json:"youtube,omitempty"`
 	Twitch    string `json:"twitch,omitempty"`
 	Github    string `json:"github,omitempty"`
+	Region    string `json:"region,omitempty"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
+
+	LibraryStats *LibraryStatsResource `json:"library_stats,omitempty"`
 }
 
 func TransformProfile(profile domain.Profile, s3Client s3.S3ClientInterface) *ProfileResource {
@@ -41,6 +44,7 @@ func TransformProfile(profile domain.Profile, s3Client s3.S3ClientInterface) *Pr
 		Youtube:   profile.Youtube,
 		Twitch:    profile.Twitch,
 		Github:    profile.Github,
+		Region:    profile.Region,
 		CreatedAt: utils.FormatTimestamp(profile.CreatedAt),
 		UpdatedAt: utils.FormatTimestamp(profile.UpdatedAt),
 	}
@@ -0,0 +1,241 @@
+package resources
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+	"time"
+)
+
+type QuizResource struct {
+	ID          uint                   `json:"id"`
+	Kind        string                 `json:"kind"`
+	Title       string                 `json:"title"`
+	Description string                 `json:"description"`
+	Open        bool                   `json:"open"`
+	OpensAt     string                 `json:"opens_at"`
+	ClosesAt    string                 `json:"closes_at"`
+	GameID      *uint                  `json:"game_id"`
+	Game        *LibraryItemResource   `json:"game,omitempty"`
+	Answered    bool                   `json:"answered"`
+	Questions   []QuizQuestionResource `json:"questions,omitempty"`
+	Rewards     []QuizRewardResource   `json:"rewards,omitempty"`
+	Attempt     *QuizAttemptResource   `json:"attempt,omitempty"`
+}
+
+type QuizQuestionResource struct {
+	ID       uint                 `json:"id"`
+	Question string               `json:"question"`
+	Options  []QuizOptionResource `json:"options"`
+}
+
+// QuizOptionResource leaves out whether the option is correct until the user
+// answered the quiz or it closed.
+type QuizOptionResource struct {
+	ID      uint   `json:"id"`
+	Text    string `json:"text"`
+	Correct *bool  `json:"correct,omitempty"`
+}
+
+type QuizRewardResource struct {
+	MinScore   uint             `json:"min_score"`
+	Coins      uint             `json:"coins"`
+	Experience uint             `json:"experience"`
+	Rewards    []RewardResource `json:"rewards"`
+}
+
+type QuizAttemptResource struct {
+	Correct    uint                 `json:"correct"`
+	Questions  int                  `json:"questions"`
+	Score      uint                 `json:"score"`
+	Answers    []QuizAnswerResource `json:"answers"`
+	Rewards    []QuizRewardResource `json:"rewards"`
+	AnsweredAt string               `json:"answered_at"`
+}
+
+type QuizAnswerResource struct {
+	QuestionID uint  `json:"question_id"`
+	OptionID   uint  `json:"option_id"`
+	Correct    *bool `json:"correct,omitempty"`
+}
+
+type QuizResultsResource struct {
+	ID           uint                         `json:"id"`
+	Kind         string                       `json:"kind"`
+	Title        string                       `json:"title"`
+	Attempts     uint                         `json:"attempts"`
+	AverageScore *float64                     `json:"average_score,omitempty"`
+	Questions    []QuizQuestionResultResource `json:"questions"`
+}
+
+type QuizQuestionResultResource struct {
+	ID       uint                       `json:"id"`
+	Question string                     `json:"question"`
+	Options  []QuizOptionResultResource `json:"options"`
+}
+
+type QuizOptionResultResource struct {
+	ID      uint    `json:"id"`
+	Text    string  `json:"text"`
+	Correct *bool   `json:"correct,omitempty"`
+	Votes   uint    `json:"votes"`
+	Share   float64 `json:"share"`
+}
+
+// TransformQuizzes lists the quizzes without their questions, telling which
+// ones the user already answered.
+func TransformQuizzes(quizzes []domain.Quiz, attempts map[uint]domain.QuizAttempt, now time.Time) []QuizResource {
+	resources := make([]QuizResource, 0, len(quizzes))
+	for _, quiz := range quizzes {
+		_, answered := attempts[quiz.ID]
+		resource := transformQuizSummary(quiz, now)
+		resource.Answered = answered
+		resources = append(resources, resource)
+	}
+
+	return resources
+}
+
+// TransformQuiz is the quiz with its questions and reward tiers, revealing
+// the correct options and the answers of the user once answered.
+func TransformQuiz(quiz domain.Quiz, attempt *domain.QuizAttempt, now time.Time) QuizResource {
+	reveal := attempt != nil || quiz.IsClosed(now)
+
+	resource := transformQuizSummary(quiz, now)
+	resource.Answered = attempt != nil
+	resource.Questions = make([]QuizQuestionResource, 0, len(quiz.Questions))
+	resource.Rewards = transformQuizRewards(quiz.Rewards)
+
+	for _, question := range quiz.Questions {
+		questionResource := QuizQuestionResource{
+			ID:       question.ID,
+			Question: question.Question,
+			Options:  make([]QuizOptionResource, 0, len(question.Options)),
+		}
+
+		for _, option := range question.Options {
+			questionResource.Options = append(questionResource.Options, QuizOptionResource{
+				ID:      option.ID,
+				Text:    option.Text,
+				Correct: revealCorrect(quiz, reveal, option.Correct),
+			})
+		}
+
+		resource.Questions = append(resource.Questions, questionResource)
+	}
+
+	if attempt != nil {
+		attemptResource := TransformQuizAttempt(quiz, *attempt)
+		resource.Attempt = &attemptResource
+	}
+
+	return resource
+}
+
+// TransformQuizAttempt is the score of the user along with the reward tiers
+// it reached.
+func TransformQuizAttempt(quiz domain.Quiz, attempt domain.QuizAttempt) QuizAttemptResource {
+	resource := QuizAttemptResource{
+		Correct:    attempt.Correct,
+		Questions:  len(quiz.Questions),
+		Score:      attempt.Score,
+		Answers:    make([]QuizAnswerResource, 0, len(attempt.Answers)),
+		Rewards:    transformQuizRewards(quiz.RewardsFor(attempt.Score)),
+		AnsweredAt: utils.FormatTimestamp(attempt.CreatedAt),
+	}
+
+	for _, answer := range attempt.Answers {
+		resource.Answers = append(resource.Answers, QuizAnswerResource{
+			QuestionID: answer.QuestionID,
+			OptionID:   answer.OptionID,
+			Correct:    revealCorrect(quiz, true, answer.Correct),
+		})
+	}
+
+	return resource
+}
+
+func TransformQuizResults(results domain.QuizResults) QuizResultsResource {
+	quiz := results.Quiz
+	resource := QuizResultsResource{
+		ID:        quiz.ID,
+		Kind:      quiz.Kind,
+		Title:     quiz.Title,
+		Attempts:  results.Attempts,
+		Questions: make([]QuizQuestionResultResource, 0, len(quiz.Questions)),
+	}
+
+	if !quiz.IsPoll() {
+		averageScore := results.AverageScore
+		resource.AverageScore = &averageScore
+	}
+
+	for _, question := range quiz.Questions {
+		questionResource := QuizQuestionResultResource{
+			ID:       question.ID,
+			Question: question.Question,
+			Options:  make([]QuizOptionResultResource, 0, len(question.Options)),
+		}
+
+		for _, option := range question.Options {
+			questionResource.Options = append(questionResource.Options, QuizOptionResultResource{
+				ID:      option.ID,
+				Text:    option.Text,
+				Correct: revealCorrect(quiz, true, option.Correct),
+				Votes:   results.Votes[option.ID],
+				Share:   results.Share(option.ID),
+			})
+		}
+
+		resource.Questions = append(resource.Questions, questionResource)
+	}
+
+	return resource
+}
+
+func transformQuizSummary(quiz domain.Quiz, now time.Time) QuizResource {
+	resource := QuizResource{
+		ID:          quiz.ID,
+		Kind:        quiz.Kind,
+		Title:       quiz.Title,
+		Description: quiz.Description,
+		Open:        quiz.IsOpen(now),
+		OpensAt:     utils.FormatTimestamp(quiz.OpensAt),
+		ClosesAt:    utils.FormatTimestamp(quiz.ClosesAt),
+		GameID:      quiz.GameID,
+	}
+
+	if quiz.Game != nil && quiz.Game.ID != 0 {
+		resource.Game = &LibraryItemResource{
+			ID:    quiz.Game.ID,
+			Title: quiz.Game.Title,
+			Slug:  quiz.Game.Slug,
+			Cover: quiz.Game.Cover,
+		}
+	}
+
+	return resource
+}
+
+func transformQuizRewards(tiers []domain.QuizReward) []QuizRewardResource {
+	resources := make([]QuizRewardResource, 0, len(tiers))
+	for _, tier := range tiers {
+		resources = append(resources, QuizRewardResource{
+			MinScore:   tier.MinScore,
+			Coins:      tier.Coins,
+			Experience: tier.Experience,
+			Rewards:    TransformRewards(tier.Rewards),
+		})
+	}
+
+	return resources
+}
+
+// revealCorrect tells whether an option is correct when it can be shown,
+// never on polls, which have no correct options.
+func revealCorrect(quiz domain.Quiz, reveal bool, correct bool) *bool {
+	if quiz.IsPoll() || !reveal {
+		return nil
+	}
+
+	return &correct
+}
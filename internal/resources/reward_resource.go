@@ -12,9 +12,13 @@ type RewardResource struct {
 
 	SourceableType string `json:"sourceable_type"`
 
-	RewardableType string           `json:"rewardable_type"`
-	Title          *TitleResource   `json:"title,omitempty"`
-	Mission        *MissionResource `json:"mission,omitempty"`
+	RewardableType string            `json:"rewardable_type"`
+	Amount         uint              `json:"amount"`
+	Title          *TitleResource    `json:"title,omitempty"`
+	Mission        *MissionResource  `json:"mission,omitempty"`
+	Booster        *BoosterResource  `json:"booster,omitempty"`
+	Cosmetic       *CosmeticResource `json:"cosmetic,omitempty"`
+	Item           *ItemResource     `json:"item,omitempty"`
 }
 
 func TransformReward(reward domain.Reward) *RewardResource {
@@ -24,6 +28,7 @@ func TransformReward(reward domain.Reward) *RewardResource {
 		UpdatedAt:      utils.FormatTimestamp(reward.UpdatedAt),
 		SourceableType: reward.SourceableType,
 		RewardableType: reward.RewardableType,
+		Amount:         reward.Quantity(),
 	}
 
 	// Check for polymorphic Rewardable types
@@ -36,6 +41,21 @@ func TransformReward(reward domain.Reward) *RewardResource {
 		if mission, ok := reward.Rewardable.(*domain.Mission); ok {
 			rewardResource.Mission = transformMission(mission)
 		}
+	case domain.RewardableTypeBoosters:
+		if booster, ok := reward.Rewardable.(*domain.Booster); ok {
+			boosterResource := TransformBooster(*booster)
+			rewardResource.Booster = &boosterResource
+		}
+	case domain.RewardableTypeCosmetics:
+		if cosmetic, ok := reward.Rewardable.(*domain.Cosmetic); ok {
+			cosmeticResource := TransformCosmetic(*cosmetic)
+			rewardResource.Cosmetic = &cosmeticResource
+		}
+	case domain.RewardableTypeItems:
+		if item, ok := reward.Rewardable.(*domain.Item); ok {
+			itemResource := TransformItem(*item)
+			rewardResource.Item = &itemResource
+		}
 	}
 
 	return rewardResource
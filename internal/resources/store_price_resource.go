@@ -0,0 +1,21 @@
+package resources
+
+import "gcstatus/internal/domain"
+
+type StorePriceResource struct {
+	Region       string `json:"region"`
+	Currency     string `json:"currency"`
+	InitialPrice uint   `json:"initial_price"`
+	FinalPrice   uint   `json:"final_price"`
+	Discount     uint   `json:"discount"`
+}
+
+func TransformStorePrice(storePrice domain.StorePrice) *StorePriceResource {
+	return &StorePriceResource{
+		Region:       storePrice.Region,
+		Currency:     storePrice.Currency,
+		InitialPrice: storePrice.InitialPrice,
+		FinalPrice:   storePrice.FinalPrice,
+		Discount:     storePrice.Discount,
+	}
+}
@@ -17,11 +17,14 @@ type UserResource struct {
 	Experience uint             `json:"experience"`
 	Nickname   string           `json:"nickname"`
 	Birthdate  string           `json:"birthdate"`
+	Timezone   string           `json:"timezone"`
 	CreatedAt  string           `json:"created_at"`
 	UpdatedAt  string           `json:"updated_at"`
 	Profile    *ProfileResource `json:"profile,omitempty"`
 	Title      *TitleResource   `json:"title,omitempty"`
 	Wallet     *WalletResource  `json:"wallet"`
+	// Multipliers are only filled for the authenticated user.
+	Multipliers *MultipliersResource `json:"multipliers,omitempty"`
 }
 
 type MinimalUserResource struct {
@@ -41,6 +44,7 @@ func TransformUser(user domain.User, s3Client s3.S3ClientInterface) UserResource
 		Nickname:   user.Nickname,
 		Experience: user.Experience,
 		Birthdate:  utils.FormatTimestamp(user.Birthdate),
+		Timezone:   user.Timezone,
 		CreatedAt:  utils.FormatTimestamp(user.CreatedAt),
 		UpdatedAt:  utils.FormatTimestamp(user.UpdatedAt),
 	}
@@ -0,0 +1,102 @@
+package usecases_admin
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+)
+
+type AdminActionPolicyService struct {
+	repo ports_admin.AdminActionPolicyRepository
+}
+
+func NewAdminActionPolicyService(repo ports_admin.AdminActionPolicyRepository) *AdminActionPolicyService {
+	return &AdminActionPolicyService{
+		repo: repo,
+	}
+}
+
+func (h *AdminActionPolicyService) GetAll() ([]domain.ActionPolicy, error) {
+	return h.repo.GetAll()
+}
+
+func (h *AdminActionPolicyService) FindByID(id uint) (domain.ActionPolicy, error) {
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminActionPolicyService) Create(request ports_admin.ActionPolicyRequest) (domain.ActionPolicy, error) {
+	policy, err := h.build(request, 0)
+	if err != nil {
+		return domain.ActionPolicy{}, err
+	}
+
+	if err := h.repo.Create(&policy); err != nil {
+		return domain.ActionPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (h *AdminActionPolicyService) Update(id uint, request ports_admin.ActionPolicyRequest) (domain.ActionPolicy, error) {
+	if _, err := h.repo.FindByID(id); err != nil {
+		return domain.ActionPolicy{}, err
+	}
+
+	policy, err := h.build(request, id)
+	if err != nil {
+		return domain.ActionPolicy{}, err
+	}
+
+	policy.ID = id
+	if err := h.repo.Update(&policy); err != nil {
+		return domain.ActionPolicy{}, err
+	}
+
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminActionPolicyService) Delete(id uint) error {
+	return h.repo.Delete(id)
+}
+
+// GetDecisions returns a page of the decisions matching the filters, along
+// with the normalized page, page size and total of matching decisions.
+func (h *AdminActionPolicyService) GetDecisions(filters ports_admin.ActionDecisionFilters) ([]domain.ActionDecision, ports_admin.ActionDecisionFilters, int64, error) {
+	offset := paginate(&filters.Page, &filters.PerPage)
+	decisions, total, err := h.repo.GetDecisions(filters, offset, filters.PerPage)
+
+	return decisions, filters, total, err
+}
+
+func (h *AdminActionPolicyService) build(request ports_admin.ActionPolicyRequest, id uint) (domain.ActionPolicy, error) {
+	policy := domain.ActionPolicy{
+		ActionKey:         request.ActionKey,
+		CooldownSeconds:   request.CooldownSeconds,
+		MaxPerDay:         request.MaxPerDay,
+		OncePerTarget:     request.OncePerTarget,
+		DiminishAfter:     request.DiminishAfter,
+		DiminishingFactor: request.DiminishingFactor,
+		Enabled:           request.Enabled == nil || *request.Enabled,
+	}
+
+	if err := policy.ValidateActionPolicy(); err != nil {
+		return domain.ActionPolicy{}, errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	if !domain.IsTrackedActionKey(policy.ActionKey) {
+		return domain.ActionPolicy{}, errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The action key %q is not tracked.", policy.ActionKey))
+	}
+
+	exists, err := h.repo.ExistsByActionKey(policy.ActionKey, id)
+	if err != nil {
+		return domain.ActionPolicy{}, err
+	}
+
+	if exists {
+		return domain.ActionPolicy{}, errors.NewHttpError(http.StatusConflict, "The policy for this action key already exists.")
+	}
+
+	return policy, nil
+}
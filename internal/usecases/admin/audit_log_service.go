@@ -0,0 +1,40 @@
+package usecases_admin
+
+import (
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+	"time"
+)
+
+type AdminAuditLogService struct {
+	repo ports_admin.AdminAuditLogRepository
+}
+
+func NewAdminAuditLogService(repo ports_admin.AdminAuditLogRepository) *AdminAuditLogService {
+	return &AdminAuditLogService{
+		repo: repo,
+	}
+}
+
+func (h *AdminAuditLogService) Record(auditLog *domain.AuditLog) error {
+	return h.repo.Create(auditLog)
+}
+
+// GetAll returns a page of the audit logs matching the filters, along with
+// the normalized page, page size and total of matching logs.
+func (h *AdminAuditLogService) GetAll(filters ports_admin.AuditLogFilters) ([]domain.AuditLog, ports_admin.AuditLogFilters, int64, error) {
+	offset := paginate(&filters.Page, &filters.PerPage)
+	auditLogs, total, err := h.repo.GetAll(filters, offset, filters.PerPage)
+
+	return auditLogs, filters, total, err
+}
+
+// Prune removes the audit logs older than the retention, keeping every log
+// when the retention is not positive.
+func (h *AdminAuditLogService) Prune(retentionDays int, now time.Time) (int64, error) {
+	if retentionDays <= 0 {
+		return 0, nil
+	}
+
+	return h.repo.DeleteOlderThan(now.AddDate(0, 0, -retentionDays))
+}
@@ -0,0 +1,78 @@
+package usecases_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+)
+
+type AdminBoosterService struct {
+	repo ports_admin.AdminBoosterRepository
+}
+
+func NewAdminBoosterService(repo ports_admin.AdminBoosterRepository) *AdminBoosterService {
+	return &AdminBoosterService{
+		repo: repo,
+	}
+}
+
+func (h *AdminBoosterService) GetAll() ([]domain.Booster, error) {
+	return h.repo.GetAll()
+}
+
+func (h *AdminBoosterService) FindByID(id uint) (domain.Booster, error) {
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminBoosterService) Create(request ports_admin.BoosterRequest) (domain.Booster, error) {
+	booster, err := buildBooster(request)
+	if err != nil {
+		return domain.Booster{}, err
+	}
+
+	if err := h.repo.Create(&booster); err != nil {
+		return domain.Booster{}, err
+	}
+
+	return booster, nil
+}
+
+func (h *AdminBoosterService) Update(id uint, request ports_admin.BoosterRequest) (domain.Booster, error) {
+	if _, err := h.repo.FindByID(id); err != nil {
+		return domain.Booster{}, err
+	}
+
+	booster, err := buildBooster(request)
+	if err != nil {
+		return domain.Booster{}, err
+	}
+
+	booster.ID = id
+	if err := h.repo.Update(&booster); err != nil {
+		return domain.Booster{}, err
+	}
+
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminBoosterService) Delete(id uint) error {
+	return h.repo.Delete(id)
+}
+
+func buildBooster(request ports_admin.BoosterRequest) (domain.Booster, error) {
+	booster := domain.Booster{
+		Name:            request.Name,
+		Scope:           request.Scope,
+		Multiplier:      request.Multiplier,
+		DurationMinutes: request.DurationMinutes,
+		Cost:            request.Cost,
+		Purchasable:     request.Purchasable,
+	}
+
+	if err := booster.ValidateBooster(); err != nil {
+		return domain.Booster{}, errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	return booster, nil
+}
@@ -0,0 +1,96 @@
+package usecases_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+)
+
+type AdminCheckInRewardService struct {
+	repo ports_admin.AdminCheckInRewardRepository
+}
+
+func NewAdminCheckInRewardService(repo ports_admin.AdminCheckInRewardRepository) *AdminCheckInRewardService {
+	return &AdminCheckInRewardService{
+		repo: repo,
+	}
+}
+
+func (h *AdminCheckInRewardService) GetAll() ([]domain.CheckInReward, error) {
+	return h.repo.GetAll()
+}
+
+func (h *AdminCheckInRewardService) FindByID(id uint) (domain.CheckInReward, error) {
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminCheckInRewardService) Create(request ports_admin.CheckInRewardRequest) (domain.CheckInReward, error) {
+	checkInReward, err := h.build(request, 0)
+	if err != nil {
+		return domain.CheckInReward{}, err
+	}
+
+	if err := h.repo.Create(&checkInReward); err != nil {
+		return domain.CheckInReward{}, err
+	}
+
+	return h.repo.FindByID(checkInReward.ID)
+}
+
+func (h *AdminCheckInRewardService) Update(id uint, request ports_admin.CheckInRewardRequest) (domain.CheckInReward, error) {
+	if _, err := h.repo.FindByID(id); err != nil {
+		return domain.CheckInReward{}, err
+	}
+
+	checkInReward, err := h.build(request, id)
+	if err != nil {
+		return domain.CheckInReward{}, err
+	}
+
+	checkInReward.ID = id
+	if err := h.repo.Update(&checkInReward); err != nil {
+		return domain.CheckInReward{}, err
+	}
+
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminCheckInRewardService) Delete(id uint) error {
+	return h.repo.Delete(id)
+}
+
+func (h *AdminCheckInRewardService) build(request ports_admin.CheckInRewardRequest, id uint) (domain.CheckInReward, error) {
+	checkInReward := domain.CheckInReward{
+		Streak:     request.Streak,
+		Milestone:  request.Milestone,
+		Coins:      request.Coins,
+		Experience: request.Experience,
+	}
+
+	if err := checkInReward.ValidateCheckInReward(); err != nil {
+		return domain.CheckInReward{}, errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	exists, err := h.repo.ExistsByStreak(checkInReward.Streak, checkInReward.Milestone, id)
+	if err != nil {
+		return domain.CheckInReward{}, err
+	}
+
+	if exists {
+		return domain.CheckInReward{}, errors.NewHttpError(http.StatusConflict, "The check-in reward for this streak already exists.")
+	}
+
+	rewards, err := buildRewards(request.Rewards)
+	if err != nil {
+		return domain.CheckInReward{}, err
+	}
+
+	if checkInReward.Coins == 0 && checkInReward.Experience == 0 && len(rewards) == 0 {
+		return domain.CheckInReward{}, errors.NewHttpError(http.StatusUnprocessableEntity, "The check-in reward must give coins, experience or titles.")
+	}
+
+	checkInReward.Rewards = rewards
+
+	return checkInReward, nil
+}
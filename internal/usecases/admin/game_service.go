@@ -1,8 +1,12 @@
 package usecases_admin
 
 import (
+	"fmt"
 	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
 	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/utils"
+	"net/http"
 )
 
 type AdminGameService struct {
@@ -20,3 +24,204 @@ func (h *AdminGameService) GetAll() ([]domain.Game, error) {
 func (h *AdminGameService) FindByID(id uint) (domain.Game, error) {
 	return h.repo.FindByID(id)
 }
+
+func (h *AdminGameService) UpdateCrack(gameID uint, request ports_admin.UpdateCrackRequest) (*domain.Crack, *domain.CrackStatusChange, error) {
+	return h.repo.UpdateCrack(gameID, request)
+}
+
+func (h *AdminGameService) GetSyncLocks(gameID uint) ([]domain.GameSyncLock, error) {
+	return h.repo.GetSyncLocks(gameID)
+}
+
+func (h *AdminGameService) UpdateSyncLocks(gameID uint, request ports_admin.UpdateSyncLocksRequest) ([]domain.GameSyncLock, error) {
+	fields := make([]string, 0, len(request.Fields))
+	seen := make(map[string]bool, len(request.Fields))
+
+	for _, field := range request.Fields {
+		if !domain.IsSyncLockableField(field) {
+			return nil, errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The field %q can not be locked.", field))
+		}
+
+		if !seen[field] {
+			seen[field] = true
+			fields = append(fields, field)
+		}
+	}
+
+	return h.repo.UpdateSyncLocks(gameID, fields)
+}
+
+func (h *AdminGameService) Create(request ports_admin.UpsertGameRequest) (domain.Game, *domain.CrackStatusChange, error) {
+	game, err := buildGame(request)
+	if err != nil {
+		return domain.Game{}, nil, err
+	}
+
+	change, err := h.repo.Create(&game, request.Crack)
+	if err != nil {
+		return domain.Game{}, nil, err
+	}
+
+	created, err := h.repo.FindByID(game.ID)
+
+	return created, change, err
+}
+
+func (h *AdminGameService) Update(id uint, request ports_admin.UpsertGameRequest) (domain.Game, *domain.CrackStatusChange, error) {
+	game, err := buildGame(request)
+	if err != nil {
+		return domain.Game{}, nil, err
+	}
+
+	game.ID = id
+
+	change, err := h.repo.Update(&game, request.Crack)
+	if err != nil {
+		return domain.Game{}, nil, err
+	}
+
+	updated, err := h.repo.FindByID(id)
+
+	return updated, change, err
+}
+
+func (h *AdminGameService) Delete(id uint) error {
+	return h.repo.Delete(id)
+}
+
+// buildGame maps the admin request to the game aggregate and validates it
+// with the domain rules before anything is written.
+func buildGame(request ports_admin.UpsertGameRequest) (domain.Game, error) {
+	condition := request.Condition
+	if condition == "" {
+		condition = domain.CommomCondition
+	}
+
+	switch condition {
+	case domain.HotCondition, domain.SaleCondition, domain.CommomCondition, domain.PopularCondition:
+	default:
+		return domain.Game{}, errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The condition %q is not valid.", condition))
+	}
+
+	game := domain.Game{
+		Age:              request.Age,
+		Slug:             utils.Slugify(request.Title),
+		Title:            request.Title,
+		Condition:        condition,
+		Cover:            request.Cover,
+		About:            request.About,
+		Description:      request.Description,
+		ShortDescription: request.ShortDescription,
+		Free:             request.Free,
+		GreatRelease:     request.GreatRelease,
+		Legal:            request.Legal,
+		Website:          request.Website,
+		ReleaseDate:      request.ReleaseDate,
+	}
+
+	for _, id := range request.CategoryIDs {
+		game.Categories = append(game.Categories, domain.Categoriable{CategoryID: id})
+	}
+
+	for _, id := range request.GenreIDs {
+		game.Genres = append(game.Genres, domain.Genreable{GenreID: id})
+	}
+
+	for _, id := range request.TagIDs {
+		game.Tags = append(game.Tags, domain.Taggable{TagID: id})
+	}
+
+	for _, id := range request.PlatformIDs {
+		game.Platforms = append(game.Platforms, domain.Platformable{PlatformID: id})
+	}
+
+	for _, id := range request.DeveloperIDs {
+		game.Developers = append(game.Developers, domain.GameDeveloper{DeveloperID: id})
+	}
+
+	for _, id := range request.PublisherIDs {
+		game.Publishers = append(game.Publishers, domain.GamePublisher{PublisherID: id})
+	}
+
+	for _, language := range request.Languages {
+		game.Languages = append(game.Languages, domain.GameLanguage{
+			Menu:       language.Menu,
+			Dubs:       language.Dubs,
+			Subtitles:  language.Subtitles,
+			LanguageID: language.LanguageID,
+		})
+	}
+
+	for _, requirement := range request.Requirements {
+		game.Requirements = append(game.Requirements, domain.Requirement{
+			OS:      requirement.OS,
+			DX:      requirement.DX,
+			CPU:     requirement.CPU,
+			RAM:     requirement.RAM,
+			GPU:     requirement.GPU,
+			ROM:     requirement.ROM,
+			OBS:     requirement.OBS,
+			Network: requirement.Network,
+			RequirementType: domain.RequirementType{
+				Potential: requirement.Potential,
+				OS:        requirement.System,
+			},
+		})
+	}
+
+	seenStores := make(map[uint]bool, len(request.Stores))
+	for _, store := range request.Stores {
+		if seenStores[store.StoreID] {
+			return domain.Game{}, errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The store %d is listed more than once.", store.StoreID))
+		}
+
+		seenStores[store.StoreID] = true
+		game.Stores = append(game.Stores, domain.GameStore{
+			Price:       store.Price,
+			URL:         store.URL,
+			StoreID:     store.StoreID,
+			StoreGameID: store.StoreGameID,
+		})
+	}
+
+	for _, gallery := range request.Galleries {
+		game.Galleries = append(game.Galleries, domain.Galleriable{
+			S3:          gallery.S3,
+			Path:        gallery.Path,
+			MediaTypeID: gallery.MediaTypeID,
+		})
+	}
+
+	for _, dlc := range request.DLCs {
+		item := domain.DLC{
+			Name:             dlc.Name,
+			Cover:            dlc.Cover,
+			About:            dlc.About,
+			Description:      dlc.Description,
+			ShortDescription: dlc.ShortDescription,
+			Free:             dlc.Free,
+			Legal:            dlc.Legal,
+			ReleaseDate:      dlc.ReleaseDate,
+		}
+
+		if dlc.ID != nil {
+			item.ID = *dlc.ID
+		}
+
+		game.DLCs = append(game.DLCs, item)
+	}
+
+	if request.Support != nil {
+		game.Support = &domain.GameSupport{
+			URL:     request.Support.URL,
+			Email:   request.Support.Email,
+			Contact: request.Support.Contact,
+		}
+	}
+
+	if err := game.ValidateGameAggregate(); err != nil {
+		return domain.Game{}, errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	return game, nil
+}
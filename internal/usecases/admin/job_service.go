@@ -0,0 +1,63 @@
+package usecases_admin
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+)
+
+const adminJobsListLimit = 200
+
+type AdminJobService struct {
+	repo ports_admin.AdminJobRepository
+}
+
+func NewAdminJobService(repo ports_admin.AdminJobRepository) *AdminJobService {
+	return &AdminJobService{
+		repo: repo,
+	}
+}
+
+func (h *AdminJobService) GetAll(filters ports_admin.JobFilters) ([]domain.Job, error) {
+	return h.repo.GetAll(filters, adminJobsListLimit)
+}
+
+func (h *AdminJobService) FindByID(id uint) (domain.Job, error) {
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminJobService) Retry(id uint) (domain.Job, error) {
+	job, err := h.repo.FindByID(id)
+	if err != nil {
+		return job, err
+	}
+
+	if !job.IsRetryable() {
+		return job, errors.NewHttpError(http.StatusConflict, fmt.Sprintf("A %s job can not be retried.", job.Status))
+	}
+
+	if err := h.repo.Retry(&job); err != nil {
+		return job, err
+	}
+
+	return job, nil
+}
+
+func (h *AdminJobService) Cancel(id uint) (domain.Job, error) {
+	job, err := h.repo.FindByID(id)
+	if err != nil {
+		return job, err
+	}
+
+	if !job.IsCancellable() {
+		return job, errors.NewHttpError(http.StatusConflict, fmt.Sprintf("A %s job can not be cancelled.", job.Status))
+	}
+
+	if err := h.repo.Cancel(&job); err != nil {
+		return job, err
+	}
+
+	return job, nil
+}
@@ -0,0 +1,91 @@
+package usecases_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+)
+
+type AdminLevelService struct {
+	repo ports_admin.AdminLevelRepository
+}
+
+func NewAdminLevelService(repo ports_admin.AdminLevelRepository) *AdminLevelService {
+	return &AdminLevelService{
+		repo: repo,
+	}
+}
+
+func (h *AdminLevelService) GetAll() ([]domain.Level, error) {
+	return h.repo.GetAll()
+}
+
+func (h *AdminLevelService) FindByID(id uint) (domain.Level, error) {
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminLevelService) Create(request ports_admin.LevelRequest) (domain.Level, error) {
+	level, err := h.build(request, 0)
+	if err != nil {
+		return domain.Level{}, err
+	}
+
+	if err := h.repo.Create(&level); err != nil {
+		return domain.Level{}, err
+	}
+
+	return h.repo.FindByID(level.ID)
+}
+
+func (h *AdminLevelService) Update(id uint, request ports_admin.LevelRequest) (domain.Level, error) {
+	if _, err := h.repo.FindByID(id); err != nil {
+		return domain.Level{}, err
+	}
+
+	level, err := h.build(request, id)
+	if err != nil {
+		return domain.Level{}, err
+	}
+
+	level.ID = id
+	if err := h.repo.Update(&level); err != nil {
+		return domain.Level{}, err
+	}
+
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminLevelService) Delete(id uint) error {
+	return h.repo.Delete(id)
+}
+
+func (h *AdminLevelService) build(request ports_admin.LevelRequest, id uint) (domain.Level, error) {
+	level := domain.Level{
+		Level:      request.Level,
+		Experience: request.Experience,
+		Coins:      request.Coins,
+	}
+
+	if err := level.ValidateLevel(); err != nil {
+		return domain.Level{}, errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	exists, err := h.repo.ExistsByLevel(level.Level, id)
+	if err != nil {
+		return domain.Level{}, err
+	}
+
+	if exists {
+		return domain.Level{}, errors.NewHttpError(http.StatusConflict, "The level already exists.")
+	}
+
+	rewards, err := buildRewards(request.Rewards)
+	if err != nil {
+		return domain.Level{}, err
+	}
+
+	level.Rewards = rewards
+
+	return level, nil
+}
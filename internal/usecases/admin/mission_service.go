@@ -0,0 +1,260 @@
+package usecases_admin
+
+import (
+	stdErrors "errors"
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+
+	"gorm.io/gorm"
+)
+
+type AdminMissionService struct {
+	repo ports_admin.AdminMissionRepository
+}
+
+func NewAdminMissionService(repo ports_admin.AdminMissionRepository) *AdminMissionService {
+	return &AdminMissionService{
+		repo: repo,
+	}
+}
+
+func (h *AdminMissionService) GetAll() ([]domain.Mission, error) {
+	return h.repo.GetAll()
+}
+
+func (h *AdminMissionService) FindByID(id uint) (domain.Mission, error) {
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminMissionService) Create(request ports_admin.MissionRequest) (domain.Mission, error) {
+	mission, err := buildMission(request)
+	if err != nil {
+		return domain.Mission{}, err
+	}
+
+	if err := h.repo.Create(&mission); err != nil {
+		return domain.Mission{}, err
+	}
+
+	return h.repo.FindByID(mission.ID)
+}
+
+func (h *AdminMissionService) Update(id uint, request ports_admin.MissionRequest) (domain.Mission, error) {
+	if _, err := h.repo.FindByID(id); err != nil {
+		return domain.Mission{}, err
+	}
+
+	mission, err := buildMission(request)
+	if err != nil {
+		return domain.Mission{}, err
+	}
+
+	mission.ID = id
+	if err := h.repo.Update(&mission); err != nil {
+		return domain.Mission{}, err
+	}
+
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminMissionService) Delete(id uint) error {
+	return h.repo.Delete(id)
+}
+
+// SyncAssignments replaces the users a mission not given to everyone is
+// assigned to.
+func (h *AdminMissionService) SyncAssignments(id uint, request ports_admin.AssignMissionRequest) (domain.Mission, error) {
+	if _, err := h.repo.FindByID(id); err != nil {
+		return domain.Mission{}, err
+	}
+
+	if err := h.repo.SyncAssignments(id, request.UserIDs); err != nil {
+		return domain.Mission{}, err
+	}
+
+	return h.repo.FindByID(id)
+}
+
+// RequirementAchievers returns a page of the users that currently satisfy the
+// mission requirement, along with the normalized page and their total.
+func (h *AdminMissionService) RequirementAchievers(missionID uint, requirementID uint, page ports_admin.PageRequest) ([]domain.User, ports_admin.PageRequest, int64, error) {
+	if _, err := h.repo.FindRequirement(missionID, requirementID); err != nil {
+		return nil, page, 0, requirementNotFound(err)
+	}
+
+	offset := paginate(&page.Page, &page.PerPage)
+	users, total, err := h.repo.RequirementAchievers(requirementID, offset, page.PerPage)
+
+	return users, page, total, err
+}
+
+// buildMission maps the admin request to the mission and validates it with
+// the domain rules before anything is written.
+func buildMission(request ports_admin.MissionRequest) (domain.Mission, error) {
+	status := request.Status
+	if status == "" {
+		status = domain.MissionAvailable
+	}
+
+	if !isOneOf(status, domain.MissionStatuses) {
+		return domain.Mission{}, errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The status %q is not valid.", status))
+	}
+
+	frequency := request.Frequency
+	if frequency == "" {
+		frequency = domain.OneTimeMission
+	}
+
+	if !isOneOf(frequency, domain.MissionFrequencies) {
+		return domain.Mission{}, errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The frequency %q is not valid.", frequency))
+	}
+
+	mission := domain.Mission{
+		Mission:       request.Mission,
+		Description:   request.Description,
+		Status:        status,
+		ForAll:        request.ForAll,
+		Coins:         request.Coins,
+		Experience:    request.Experience,
+		Frequency:     frequency,
+		ResetHour:     request.ResetHour,
+		ResetWeekday:  1,
+		ResetTimezone: request.ResetTimezone,
+	}
+
+	if request.ResetWeekday != nil {
+		mission.ResetWeekday = *request.ResetWeekday
+	}
+
+	if err := mission.ValidateMission(); err != nil {
+		return domain.Mission{}, errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	if err := ensureTrackedActionKeys(request.Requirements); err != nil {
+		return domain.Mission{}, err
+	}
+
+	for _, requirement := range request.Requirements {
+		rule, err := buildTaskRule(requirement)
+		if err != nil {
+			return domain.Mission{}, err
+		}
+
+		item := domain.MissionRequirement{
+			Task:        requirement.Task,
+			Key:         requirement.Key,
+			Goal:        requirement.Goal,
+			Description: requirement.Description,
+			TaskRule:    rule,
+		}
+
+		if requirement.ID != nil {
+			item.ID = *requirement.ID
+		}
+
+		mission.MissionRequirements = append(mission.MissionRequirements, item)
+	}
+
+	rewards, err := buildRewards(request.Rewards)
+	if err != nil {
+		return domain.Mission{}, err
+	}
+
+	mission.Rewards = rewards
+
+	return mission, nil
+}
+
+// ensureTrackedActionKeys refuses requirements counting actions the tracking
+// system never reports, since their progress could never move.
+func ensureTrackedActionKeys(requirements []ports_admin.TaskRequirementRequest) error {
+	for _, requirement := range requirements {
+		if !domain.IsTrackedActionKey(requirement.Key) {
+			return errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The action key %q is not tracked.", requirement.Key))
+		}
+	}
+
+	return nil
+}
+
+func buildTaskRule(request ports_admin.TaskRequirementRequest) (domain.TaskRule, error) {
+	rule := domain.TaskRule{
+		Type:       request.Type,
+		WithinDays: request.WithinDays,
+		StartsAt:   request.StartsAt,
+		EndsAt:     request.EndsAt,
+	}
+
+	if rule.Type == "" {
+		rule.Type = domain.RequirementCounter
+	}
+
+	rule.SetConditionValues(request.Conditions)
+
+	if err := rule.ValidateTaskRule(); err != nil {
+		return domain.TaskRule{}, errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	return rule, nil
+}
+
+func buildRewards(requests []ports_admin.RewardRequest) ([]domain.Reward, error) {
+	rewards := make([]domain.Reward, 0, len(requests))
+	index := make(map[string]int, len(requests))
+
+	for _, request := range requests {
+		if !domain.IsRewardableType(request.RewardableType) {
+			return nil, errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The reward type %q is not valid.", request.RewardableType))
+		}
+
+		rewardableID := request.RewardableID
+		if !domain.IsRewardableEntity(request.RewardableType) {
+			if request.Amount == 0 {
+				return nil, errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The %s reward needs an amount.", request.RewardableType))
+			}
+
+			rewardableID = 0
+		} else if rewardableID == 0 {
+			return nil, errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The %s reward needs the rewarded entity.", request.RewardableType))
+		}
+
+		amount := domain.Reward{Amount: request.Amount}.Quantity()
+
+		// Repeated rewards are given together, adding up their amounts.
+		key := fmt.Sprintf("%s:%d", request.RewardableType, rewardableID)
+		if i, ok := index[key]; ok {
+			rewards[i].Amount += amount
+			continue
+		}
+
+		index[key] = len(rewards)
+		rewards = append(rewards, domain.Reward{
+			RewardableType: request.RewardableType,
+			RewardableID:   rewardableID,
+			Amount:         amount,
+		})
+	}
+
+	return rewards, nil
+}
+
+func requirementNotFound(err error) error {
+	if stdErrors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.NewHttpError(http.StatusNotFound, "The requirement could not be found.")
+	}
+
+	return err
+}
+
+func isOneOf(value string, allowed []string) bool {
+	for _, candidate := range allowed {
+		if candidate == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+package usecases_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+)
+
+type AdminMultiplierEventService struct {
+	repo ports_admin.AdminMultiplierEventRepository
+}
+
+func NewAdminMultiplierEventService(repo ports_admin.AdminMultiplierEventRepository) *AdminMultiplierEventService {
+	return &AdminMultiplierEventService{
+		repo: repo,
+	}
+}
+
+func (h *AdminMultiplierEventService) GetAll() ([]domain.MultiplierEvent, error) {
+	return h.repo.GetAll()
+}
+
+func (h *AdminMultiplierEventService) FindByID(id uint) (domain.MultiplierEvent, error) {
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminMultiplierEventService) Create(request ports_admin.MultiplierEventRequest) (domain.MultiplierEvent, error) {
+	event, err := buildMultiplierEvent(request)
+	if err != nil {
+		return domain.MultiplierEvent{}, err
+	}
+
+	if err := h.repo.Create(&event); err != nil {
+		return domain.MultiplierEvent{}, err
+	}
+
+	return event, nil
+}
+
+func (h *AdminMultiplierEventService) Update(id uint, request ports_admin.MultiplierEventRequest) (domain.MultiplierEvent, error) {
+	if _, err := h.repo.FindByID(id); err != nil {
+		return domain.MultiplierEvent{}, err
+	}
+
+	event, err := buildMultiplierEvent(request)
+	if err != nil {
+		return domain.MultiplierEvent{}, err
+	}
+
+	event.ID = id
+	if err := h.repo.Update(&event); err != nil {
+		return domain.MultiplierEvent{}, err
+	}
+
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminMultiplierEventService) Delete(id uint) error {
+	return h.repo.Delete(id)
+}
+
+func buildMultiplierEvent(request ports_admin.MultiplierEventRequest) (domain.MultiplierEvent, error) {
+	event := domain.MultiplierEvent{
+		Name:       request.Name,
+		Scope:      request.Scope,
+		Multiplier: request.Multiplier,
+		ActionKey:  request.ActionKey,
+		StartsAt:   request.StartsAt,
+		EndsAt:     request.EndsAt,
+	}
+
+	if err := event.ValidateMultiplierEvent(); err != nil {
+		return domain.MultiplierEvent{}, errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	return event, nil
+}
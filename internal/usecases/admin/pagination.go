@@ -0,0 +1,24 @@
+package usecases_admin
+
+const (
+	defaultPerPage = 25
+	maxPerPage     = 100
+)
+
+// paginate normalizes the requested page and page size, returning the offset
+// of the page.
+func paginate(page *int, perPage *int) int {
+	if *page < 1 {
+		*page = 1
+	}
+
+	if *perPage < 1 {
+		*perPage = defaultPerPage
+	}
+
+	if *perPage > maxPerPage {
+		*perPage = maxPerPage
+	}
+
+	return (*page - 1) * *perPage
+}
@@ -0,0 +1,136 @@
+package usecases_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+	"sort"
+)
+
+type AdminPermissionService struct {
+	repo ports_admin.AdminPermissionRepository
+}
+
+func NewAdminPermissionService(repo ports_admin.AdminPermissionRepository) *AdminPermissionService {
+	return &AdminPermissionService{
+		repo: repo,
+	}
+}
+
+func (h *AdminPermissionService) GetAll() ([]domain.Permission, error) {
+	return h.repo.GetAll()
+}
+
+func (h *AdminPermissionService) FindByID(id uint) (domain.Permission, error) {
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminPermissionService) Create(request ports_admin.PermissionRequest) (domain.Permission, error) {
+	permission := domain.Permission{Scope: request.Scope}
+	if err := h.validate(&permission, 0); err != nil {
+		return permission, err
+	}
+
+	if err := h.repo.Create(&permission); err != nil {
+		return permission, err
+	}
+
+	return permission, nil
+}
+
+// Update renames the permission scope, returning the users granted the
+// permission so their cached permission checks can be dropped.
+func (h *AdminPermissionService) Update(id uint, request ports_admin.PermissionRequest) (domain.Permission, []uint, error) {
+	permission, err := h.repo.FindByID(id)
+	if err != nil {
+		return permission, nil, err
+	}
+
+	permission.Scope = request.Scope
+	if err := h.validate(&permission, id); err != nil {
+		return permission, nil, err
+	}
+
+	if err := h.repo.Save(&permission); err != nil {
+		return permission, nil, err
+	}
+
+	userIDs, err := h.repo.UserIDs(id)
+	if err != nil {
+		return permission, nil, err
+	}
+
+	return permission, userIDs, nil
+}
+
+// Delete removes the permission, returning the users that lost it.
+func (h *AdminPermissionService) Delete(id uint) ([]uint, error) {
+	userIDs, err := h.repo.UserIDs(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.Delete(id); err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+// Catalog compares the scopes required by the admin routes with the stored
+// permissions.
+func (h *AdminPermissionService) Catalog(scopes []string) ([]ports_admin.PermissionCatalogEntry, error) {
+	permissions, err := h.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]*ports_admin.PermissionCatalogEntry, len(scopes))
+	for _, scope := range scopes {
+		entries[scope] = &ports_admin.PermissionCatalogEntry{Scope: scope, InRoutes: true}
+	}
+
+	for i := range permissions {
+		entry, exists := entries[permissions[i].Scope]
+		if !exists {
+			entry = &ports_admin.PermissionCatalogEntry{Scope: permissions[i].Scope}
+			entries[entry.Scope] = entry
+		}
+		entry.Permission = &permissions[i]
+	}
+
+	catalog := make([]ports_admin.PermissionCatalogEntry, 0, len(entries))
+	for _, entry := range entries {
+		catalog = append(catalog, *entry)
+	}
+
+	sort.Slice(catalog, func(i, j int) bool {
+		return catalog[i].Scope < catalog[j].Scope
+	})
+
+	return catalog, nil
+}
+
+// SyncCatalog stores a permission for every scope required by the admin
+// routes, returning the ones created.
+func (h *AdminPermissionService) SyncCatalog(scopes []string) ([]domain.Permission, error) {
+	return h.repo.CreateMissing(scopes)
+}
+
+func (h *AdminPermissionService) validate(permission *domain.Permission, id uint) error {
+	if err := permission.ValidatePermission(); err != nil {
+		return errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	exists, err := h.repo.ExistsByScope(permission.Scope, id)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return errors.NewHttpError(http.StatusConflict, "The permission already exists.")
+	}
+
+	return nil
+}
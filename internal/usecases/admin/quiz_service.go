@@ -0,0 +1,137 @@
+package usecases_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+)
+
+type AdminQuizService struct {
+	repo ports_admin.AdminQuizRepository
+}
+
+func NewAdminQuizService(repo ports_admin.AdminQuizRepository) *AdminQuizService {
+	return &AdminQuizService{
+		repo: repo,
+	}
+}
+
+func (h *AdminQuizService) GetAll() ([]domain.Quiz, error) {
+	return h.repo.GetAll()
+}
+
+func (h *AdminQuizService) FindByID(id uint) (domain.Quiz, error) {
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminQuizService) Create(request ports_admin.QuizRequest) (domain.Quiz, error) {
+	quiz, err := h.build(request, nil)
+	if err != nil {
+		return domain.Quiz{}, err
+	}
+
+	if err := h.repo.Create(&quiz); err != nil {
+		return domain.Quiz{}, err
+	}
+
+	return h.repo.FindByID(quiz.ID)
+}
+
+// Update writes the quiz, keeping its questions when none are given. The
+// questions of a quiz already answered can not change, so the answers keep
+// pointing to the options they picked.
+func (h *AdminQuizService) Update(id uint, request ports_admin.QuizRequest) (domain.Quiz, error) {
+	existing, err := h.repo.FindByID(id)
+	if err != nil {
+		return domain.Quiz{}, err
+	}
+
+	replaceQuestions := request.Questions != nil
+	if replaceQuestions {
+		answered, err := h.repo.HasAttempts(id)
+		if err != nil {
+			return domain.Quiz{}, err
+		}
+
+		if answered {
+			return domain.Quiz{}, errors.NewHttpError(http.StatusConflict, "The questions can not change once the "+existing.Kind+" was answered.")
+		}
+	} else if request.Kind != existing.Kind {
+		return domain.Quiz{}, errors.NewHttpError(http.StatusUnprocessableEntity, "The questions must be given to change the kind of the "+existing.Kind+".")
+	}
+
+	quiz, err := h.build(request, existing.Questions)
+	if err != nil {
+		return domain.Quiz{}, err
+	}
+
+	quiz.ID = id
+	if err := h.repo.Update(&quiz, replaceQuestions); err != nil {
+		return domain.Quiz{}, err
+	}
+
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminQuizService) Delete(id uint) error {
+	return h.repo.Delete(id)
+}
+
+// build writes the quiz of the request, with the given questions when the
+// request has none.
+func (h *AdminQuizService) build(request ports_admin.QuizRequest, questions []domain.QuizQuestion) (domain.Quiz, error) {
+	quiz := domain.Quiz{
+		Kind:        request.Kind,
+		Title:       request.Title,
+		Description: request.Description,
+		GameID:      request.GameID,
+		OpensAt:     request.OpensAt,
+		ClosesAt:    request.ClosesAt,
+		Questions:   questions,
+	}
+
+	if request.Questions != nil {
+		quiz.Questions = make([]domain.QuizQuestion, 0, len(request.Questions))
+		for i, questionRequest := range request.Questions {
+			question := domain.QuizQuestion{
+				Question: questionRequest.Question,
+				Position: uint(i),
+			}
+
+			for j, optionRequest := range questionRequest.Options {
+				question.Options = append(question.Options, domain.QuizOption{
+					Text:     optionRequest.Text,
+					Correct:  optionRequest.Correct,
+					Position: uint(j),
+				})
+			}
+
+			quiz.Questions = append(quiz.Questions, question)
+		}
+	}
+
+	for _, rewardRequest := range request.Rewards {
+		rewards, err := buildRewards(rewardRequest.Rewards)
+		if err != nil {
+			return domain.Quiz{}, err
+		}
+
+		if rewardRequest.Coins == 0 && rewardRequest.Experience == 0 && len(rewards) == 0 {
+			return domain.Quiz{}, errors.NewHttpError(http.StatusUnprocessableEntity, "Every reward tier must give coins, experience or titles.")
+		}
+
+		quiz.Rewards = append(quiz.Rewards, domain.QuizReward{
+			MinScore:   rewardRequest.MinScore,
+			Coins:      rewardRequest.Coins,
+			Experience: rewardRequest.Experience,
+			Rewards:    rewards,
+		})
+	}
+
+	if err := quiz.ValidateQuiz(); err != nil {
+		return domain.Quiz{}, errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	return quiz, nil
+}
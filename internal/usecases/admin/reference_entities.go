@@ -0,0 +1,230 @@
+package usecases_admin
+
+import (
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+)
+
+// AdminReferenceServices groups the services of the catalog reference
+// entities managed through the generic admin CRUD.
+type AdminReferenceServices struct {
+	Categories       *AdminReferenceService[domain.Category]
+	Genres           *AdminReferenceService[domain.Genre]
+	Platforms        *AdminReferenceService[domain.Platform]
+	Tags             *AdminReferenceService[domain.Tag]
+	Crackers         *AdminReferenceService[domain.Cracker]
+	Protections      *AdminReferenceService[domain.Protection]
+	Developers       *AdminReferenceService[domain.Developer]
+	Publishers       *AdminReferenceService[domain.Publisher]
+	Stores           *AdminReferenceService[domain.Store]
+	Critics          *AdminReferenceService[domain.Critic]
+	Languages        *AdminReferenceService[domain.Language]
+	MediaTypes       *AdminReferenceService[domain.MediaType]
+	RequirementTypes *AdminReferenceService[domain.RequirementType]
+	TorrentProviders *AdminReferenceService[domain.TorrentProvider]
+}
+
+func NewAdminCategoryService(repo ports_admin.AdminReferenceRepository[domain.Category]) *AdminReferenceService[domain.Category] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.Category]{
+		Label:    "category",
+		Resource: "categories",
+		ID:       func(category domain.Category) uint { return category.ID },
+		Fill: func(category *domain.Category, request ports_admin.ReferenceRequest) {
+			category.Name = request.Name
+			category.Slug = request.Slug
+		},
+		Validate: func(category *domain.Category) error { return category.ValidateCategory() },
+		UniqueBy: func(category *domain.Category) map[string]any { return map[string]any{"slug": category.Slug} },
+	})
+}
+
+func NewAdminGenreService(repo ports_admin.AdminReferenceRepository[domain.Genre]) *AdminReferenceService[domain.Genre] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.Genre]{
+		Label:    "genre",
+		Resource: "genres",
+		ID:       func(genre domain.Genre) uint { return genre.ID },
+		Fill: func(genre *domain.Genre, request ports_admin.ReferenceRequest) {
+			genre.Name = request.Name
+			genre.Slug = request.Slug
+		},
+		Validate: func(genre *domain.Genre) error { return genre.ValidateGenre() },
+		UniqueBy: func(genre *domain.Genre) map[string]any { return map[string]any{"slug": genre.Slug} },
+	})
+}
+
+func NewAdminPlatformService(repo ports_admin.AdminReferenceRepository[domain.Platform]) *AdminReferenceService[domain.Platform] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.Platform]{
+		Label:    "platform",
+		Resource: "platforms",
+		ID:       func(platform domain.Platform) uint { return platform.ID },
+		Fill: func(platform *domain.Platform, request ports_admin.ReferenceRequest) {
+			platform.Name = request.Name
+			platform.Slug = request.Slug
+		},
+		Validate: func(platform *domain.Platform) error { return platform.ValidatePlatform() },
+		UniqueBy: func(platform *domain.Platform) map[string]any { return map[string]any{"slug": platform.Slug} },
+	})
+}
+
+func NewAdminTagService(repo ports_admin.AdminReferenceRepository[domain.Tag]) *AdminReferenceService[domain.Tag] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.Tag]{
+		Label:    "tag",
+		Resource: "tags",
+		ID:       func(tag domain.Tag) uint { return tag.ID },
+		Fill: func(tag *domain.Tag, request ports_admin.ReferenceRequest) {
+			tag.Name = request.Name
+			tag.Slug = request.Slug
+		},
+		Validate: func(tag *domain.Tag) error { return tag.ValidateTag() },
+		UniqueBy: func(tag *domain.Tag) map[string]any { return map[string]any{"slug": tag.Slug} },
+	})
+}
+
+func NewAdminCrackerService(repo ports_admin.AdminReferenceRepository[domain.Cracker]) *AdminReferenceService[domain.Cracker] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.Cracker]{
+		Label:    "cracker",
+		Resource: "crackers",
+		ID:       func(cracker domain.Cracker) uint { return cracker.ID },
+		Fill: func(cracker *domain.Cracker, request ports_admin.ReferenceRequest) {
+			cracker.Name = request.Name
+			cracker.Slug = request.Slug
+			cracker.Acting = request.Acting
+		},
+		Validate: func(cracker *domain.Cracker) error { return cracker.ValidateCracker() },
+		UniqueBy: func(cracker *domain.Cracker) map[string]any { return map[string]any{"slug": cracker.Slug} },
+	})
+}
+
+func NewAdminProtectionService(repo ports_admin.AdminReferenceRepository[domain.Protection]) *AdminReferenceService[domain.Protection] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.Protection]{
+		Label:    "protection",
+		Resource: "protections",
+		ID:       func(protection domain.Protection) uint { return protection.ID },
+		Fill: func(protection *domain.Protection, request ports_admin.ReferenceRequest) {
+			protection.Name = request.Name
+			protection.Slug = request.Slug
+		},
+		Validate: func(protection *domain.Protection) error { return protection.ValidateProtection() },
+		UniqueBy: func(protection *domain.Protection) map[string]any { return map[string]any{"slug": protection.Slug} },
+	})
+}
+
+func NewAdminDeveloperService(repo ports_admin.AdminReferenceRepository[domain.Developer]) *AdminReferenceService[domain.Developer] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.Developer]{
+		Label:    "developer",
+		Resource: "developers",
+		ID:       func(developer domain.Developer) uint { return developer.ID },
+		Fill: func(developer *domain.Developer, request ports_admin.ReferenceRequest) {
+			developer.Name = request.Name
+			developer.Slug = request.Slug
+			developer.Acting = request.Acting
+		},
+		Validate: func(developer *domain.Developer) error { return developer.ValidateDeveloper() },
+		UniqueBy: func(developer *domain.Developer) map[string]any { return map[string]any{"slug": developer.Slug} },
+	})
+}
+
+func NewAdminPublisherService(repo ports_admin.AdminReferenceRepository[domain.Publisher]) *AdminReferenceService[domain.Publisher] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.Publisher]{
+		Label:    "publisher",
+		Resource: "publishers",
+		ID:       func(publisher domain.Publisher) uint { return publisher.ID },
+		Fill: func(publisher *domain.Publisher, request ports_admin.ReferenceRequest) {
+			publisher.Name = request.Name
+			publisher.Slug = request.Slug
+			publisher.Acting = request.Acting
+		},
+		Validate: func(publisher *domain.Publisher) error { return publisher.ValidatePublisher() },
+		UniqueBy: func(publisher *domain.Publisher) map[string]any { return map[string]any{"slug": publisher.Slug} },
+	})
+}
+
+func NewAdminStoreService(repo ports_admin.AdminReferenceRepository[domain.Store]) *AdminReferenceService[domain.Store] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.Store]{
+		Label:    "store",
+		Resource: "stores",
+		ID:       func(store domain.Store) uint { return store.ID },
+		Fill: func(store *domain.Store, request ports_admin.ReferenceRequest) {
+			store.Name = request.Name
+			store.Slug = request.Slug
+			store.URL = request.URL
+			store.Logo = request.Logo
+		},
+		Validate: func(store *domain.Store) error { return store.ValidateStore() },
+		UniqueBy: func(store *domain.Store) map[string]any { return map[string]any{"slug": store.Slug} },
+	})
+}
+
+func NewAdminCriticService(repo ports_admin.AdminReferenceRepository[domain.Critic]) *AdminReferenceService[domain.Critic] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.Critic]{
+		Label:    "critic",
+		Resource: "critics",
+		ID:       func(critic domain.Critic) uint { return critic.ID },
+		Fill: func(critic *domain.Critic, request ports_admin.ReferenceRequest) {
+			critic.Name = request.Name
+			critic.URL = request.URL
+			critic.Logo = request.Logo
+		},
+		Validate: func(critic *domain.Critic) error { return critic.ValidateCritic() },
+		UniqueBy: func(critic *domain.Critic) map[string]any { return map[string]any{"name": critic.Name} },
+	})
+}
+
+func NewAdminLanguageService(repo ports_admin.AdminReferenceRepository[domain.Language]) *AdminReferenceService[domain.Language] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.Language]{
+		Label:    "language",
+		Resource: "languages",
+		ID:       func(language domain.Language) uint { return language.ID },
+		Fill: func(language *domain.Language, request ports_admin.ReferenceRequest) {
+			language.Name = request.Name
+			language.ISO = request.ISO
+		},
+		Validate: func(language *domain.Language) error { return language.ValidateLanguage() },
+		UniqueBy: func(language *domain.Language) map[string]any { return map[string]any{"iso": language.ISO} },
+	})
+}
+
+func NewAdminMediaTypeService(repo ports_admin.AdminReferenceRepository[domain.MediaType]) *AdminReferenceService[domain.MediaType] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.MediaType]{
+		Label:    "media type",
+		Resource: "media-types",
+		ID:       func(mediaType domain.MediaType) uint { return mediaType.ID },
+		Fill: func(mediaType *domain.MediaType, request ports_admin.ReferenceRequest) {
+			mediaType.Name = request.Name
+		},
+		Validate: func(mediaType *domain.MediaType) error { return mediaType.ValidateMediaType() },
+		UniqueBy: func(mediaType *domain.MediaType) map[string]any { return map[string]any{"name": mediaType.Name} },
+	})
+}
+
+func NewAdminRequirementTypeService(repo ports_admin.AdminReferenceRepository[domain.RequirementType]) *AdminReferenceService[domain.RequirementType] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.RequirementType]{
+		Label:    "requirement type",
+		Resource: "requirement-types",
+		ID:       func(requirementType domain.RequirementType) uint { return requirementType.ID },
+		Fill: func(requirementType *domain.RequirementType, request ports_admin.ReferenceRequest) {
+			requirementType.Potential = request.Potential
+			requirementType.OS = request.OS
+		},
+		Validate: func(requirementType *domain.RequirementType) error { return requirementType.ValidateRequirementType() },
+		UniqueBy: func(requirementType *domain.RequirementType) map[string]any {
+			return map[string]any{"potential": requirementType.Potential, "os": requirementType.OS}
+		},
+	})
+}
+
+func NewAdminTorrentProviderService(repo ports_admin.AdminReferenceRepository[domain.TorrentProvider]) *AdminReferenceService[domain.TorrentProvider] {
+	return NewAdminReferenceService(repo, ReferenceEntity[domain.TorrentProvider]{
+		Label:    "torrent provider",
+		Resource: "torrent-providers",
+		ID:       func(torrentProvider domain.TorrentProvider) uint { return torrentProvider.ID },
+		Fill: func(torrentProvider *domain.TorrentProvider, request ports_admin.ReferenceRequest) {
+			torrentProvider.Name = request.Name
+			torrentProvider.URL = request.URL
+		},
+		Validate: func(torrentProvider *domain.TorrentProvider) error { return torrentProvider.ValidateTorrentProvider() },
+		UniqueBy: func(torrentProvider *domain.TorrentProvider) map[string]any {
+			return map[string]any{"name": torrentProvider.Name}
+		},
+	})
+}
@@ -0,0 +1,139 @@
+package usecases_admin
+
+import (
+	stdErrors "errors"
+	"fmt"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/utils"
+	"net/http"
+)
+
+// ReferenceEntity describes how admins manage a catalog reference entity.
+type ReferenceEntity[T any] struct {
+	// Label names a single entity in messages, such as "category".
+	Label string
+	// Resource names the entity collection in routes and permissions, such
+	// as "categories".
+	Resource string
+	ID       func(entity T) uint
+	Fill     func(entity *T, request ports_admin.ReferenceRequest)
+	Validate func(entity *T) error
+	// UniqueBy returns the column values no other entity may share.
+	UniqueBy func(entity *T) map[string]any
+}
+
+type AdminReferenceService[T any] struct {
+	repo   ports_admin.AdminReferenceRepository[T]
+	entity ReferenceEntity[T]
+}
+
+func NewAdminReferenceService[T any](repo ports_admin.AdminReferenceRepository[T], entity ReferenceEntity[T]) *AdminReferenceService[T] {
+	return &AdminReferenceService[T]{
+		repo:   repo,
+		entity: entity,
+	}
+}
+
+func (h *AdminReferenceService[T]) Entity() ReferenceEntity[T] {
+	return h.entity
+}
+
+func (h *AdminReferenceService[T]) GetAll() ([]T, map[uint]int64, error) {
+	entities, err := h.repo.GetAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usages, err := h.repo.CountUsages()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entities, usages, nil
+}
+
+func (h *AdminReferenceService[T]) FindByID(id uint) (T, int64, error) {
+	entity, err := h.repo.FindByID(id)
+	if err != nil {
+		return entity, 0, err
+	}
+
+	usages, err := h.repo.CountUsages(id)
+	if err != nil {
+		return entity, 0, err
+	}
+
+	return entity, usages[id], nil
+}
+
+func (h *AdminReferenceService[T]) Create(request ports_admin.ReferenceRequest) (T, error) {
+	var entity T
+	if err := h.write(&entity, 0, request); err != nil {
+		return entity, err
+	}
+
+	if err := h.repo.Create(&entity); err != nil {
+		return entity, err
+	}
+
+	return entity, nil
+}
+
+func (h *AdminReferenceService[T]) Update(id uint, request ports_admin.ReferenceRequest) (T, error) {
+	entity, err := h.repo.FindByID(id)
+	if err != nil {
+		return entity, err
+	}
+
+	if err := h.write(&entity, id, request); err != nil {
+		return entity, err
+	}
+
+	if err := h.repo.Save(&entity); err != nil {
+		return entity, err
+	}
+
+	return entity, nil
+}
+
+// Delete refuses to remove an entity still in use, unless another entity of
+// the same kind is given to take over its records.
+func (h *AdminReferenceService[T]) Delete(id uint, reassignTo *uint) error {
+	if reassignTo != nil {
+		if *reassignTo == id {
+			return errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The %s can not be reassigned to itself.", h.entity.Label))
+		}
+
+		if _, err := h.repo.FindByID(*reassignTo); err != nil {
+			return errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The %s to reassign to could not be found.", h.entity.Label))
+		}
+	}
+
+	err := h.repo.Delete(id, reassignTo)
+	if stdErrors.Is(err, ports_admin.ErrReferenceInUse) {
+		return errors.NewHttpError(http.StatusConflict, fmt.Sprintf("The %s is still in use, reassign its records before deleting it.", h.entity.Label))
+	}
+
+	return err
+}
+
+func (h *AdminReferenceService[T]) write(entity *T, id uint, request ports_admin.ReferenceRequest) error {
+	request.Slug = utils.Slugify(request.Name)
+	h.entity.Fill(entity, request)
+
+	if err := h.entity.Validate(entity); err != nil {
+		return errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	exists, err := h.repo.Exists(h.entity.UniqueBy(entity), id)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return errors.NewHttpError(http.StatusConflict, fmt.Sprintf("The %s already exists.", h.entity.Label))
+	}
+
+	return nil
+}
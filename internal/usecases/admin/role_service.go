@@ -0,0 +1,110 @@
+package usecases_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+)
+
+type AdminRoleService struct {
+	repo          ports_admin.AdminRoleRepository
+	superuserRole string
+}
+
+func NewAdminRoleService(repo ports_admin.AdminRoleRepository, superuserRole string) *AdminRoleService {
+	return &AdminRoleService{
+		repo:          repo,
+		superuserRole: superuserRole,
+	}
+}
+
+func (h *AdminRoleService) GetAll() ([]domain.Role, error) {
+	return h.repo.GetAll()
+}
+
+func (h *AdminRoleService) FindByID(id uint) (domain.Role, error) {
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminRoleService) Create(request ports_admin.RoleRequest) (domain.Role, error) {
+	role := domain.Role{Name: request.Name}
+	if err := h.validate(&role, 0); err != nil {
+		return role, err
+	}
+
+	if err := h.repo.Create(&role, request.PermissionIDs); err != nil {
+		return role, err
+	}
+
+	return role, nil
+}
+
+// Update changes the role and its permissions, returning the users of the
+// role so their cached permission checks can be dropped.
+func (h *AdminRoleService) Update(id uint, request ports_admin.RoleRequest) (domain.Role, []uint, error) {
+	role, err := h.repo.FindByID(id)
+	if err != nil {
+		return role, nil, err
+	}
+
+	if role.Name == h.superuserRole && request.Name != h.superuserRole {
+		return role, nil, errors.NewHttpError(http.StatusUnprocessableEntity, "The superuser role can not be renamed.")
+	}
+
+	role.Name = request.Name
+	if err := h.validate(&role, id); err != nil {
+		return role, nil, err
+	}
+
+	if err := h.repo.Update(&role, request.PermissionIDs); err != nil {
+		return role, nil, err
+	}
+
+	userIDs, err := h.repo.UserIDs(id)
+	if err != nil {
+		return role, nil, err
+	}
+
+	return role, userIDs, nil
+}
+
+// Delete removes the role, returning the users that lost it.
+func (h *AdminRoleService) Delete(id uint) ([]uint, error) {
+	role, err := h.repo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.Name == h.superuserRole {
+		return nil, errors.NewHttpError(http.StatusUnprocessableEntity, "The superuser role can not be deleted.")
+	}
+
+	userIDs, err := h.repo.UserIDs(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.Delete(id); err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func (h *AdminRoleService) validate(role *domain.Role, id uint) error {
+	if err := role.ValidateRole(); err != nil {
+		return errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	exists, err := h.repo.ExistsByName(role.Name, id)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return errors.NewHttpError(http.StatusConflict, "The role already exists.")
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package usecases_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+)
+
+type AdminSchedulerService struct {
+	repo ports_admin.AdminSchedulerRepository
+}
+
+func NewAdminSchedulerService(repo ports_admin.AdminSchedulerRepository) *AdminSchedulerService {
+	return &AdminSchedulerService{
+		repo: repo,
+	}
+}
+
+func (h *AdminSchedulerService) GetJobs() ([]domain.ScheduledJob, error) {
+	return h.repo.GetJobs()
+}
+
+// GetRuns returns a page of the runs matching the filters, along with the
+// normalized page, page size and total of matching runs.
+func (h *AdminSchedulerService) GetRuns(filters ports_admin.ScheduledRunFilters) ([]domain.ScheduledRun, ports_admin.ScheduledRunFilters, int64, error) {
+	offset := paginate(&filters.Page, &filters.PerPage)
+	runs, total, err := h.repo.GetRuns(filters, offset, filters.PerPage)
+
+	return runs, filters, total, err
+}
+
+// Trigger queues a run of the job, which the leading scheduler starts on its
+// next tick once the job is not running anymore.
+func (h *AdminSchedulerService) Trigger(name string, userID uint) (domain.ScheduledRun, error) {
+	var run domain.ScheduledRun
+
+	if _, err := h.repo.FindJob(name); err != nil {
+		return run, err
+	}
+
+	pending, err := h.repo.HasPendingRun(name)
+	if err != nil {
+		return run, err
+	}
+
+	if pending {
+		return run, errors.NewHttpError(http.StatusConflict, "The job already has a run waiting to start.")
+	}
+
+	run = domain.ScheduledRun{
+		JobName:     name,
+		Trigger:     domain.ScheduledTriggerManual,
+		Status:      domain.ScheduledRunPending,
+		TriggeredBy: &userID,
+	}
+
+	if err := h.repo.CreateRun(&run); err != nil {
+		return run, err
+	}
+
+	return run, nil
+}
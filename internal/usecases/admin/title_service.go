@@ -0,0 +1,131 @@
+package usecases_admin
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"net/http"
+)
+
+type AdminTitleService struct {
+	repo ports_admin.AdminTitleRepository
+}
+
+func NewAdminTitleService(repo ports_admin.AdminTitleRepository) *AdminTitleService {
+	return &AdminTitleService{
+		repo: repo,
+	}
+}
+
+func (h *AdminTitleService) GetAll() ([]domain.Title, error) {
+	return h.repo.GetAll()
+}
+
+func (h *AdminTitleService) FindByID(id uint) (domain.Title, error) {
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminTitleService) Create(request ports_admin.TitleRequest) (domain.Title, error) {
+	title, err := buildTitle(request)
+	if err != nil {
+		return domain.Title{}, err
+	}
+
+	if err := h.repo.Create(&title); err != nil {
+		return domain.Title{}, err
+	}
+
+	return h.repo.FindByID(title.ID)
+}
+
+func (h *AdminTitleService) Update(id uint, request ports_admin.TitleRequest) (domain.Title, error) {
+	if _, err := h.repo.FindByID(id); err != nil {
+		return domain.Title{}, err
+	}
+
+	title, err := buildTitle(request)
+	if err != nil {
+		return domain.Title{}, err
+	}
+
+	title.ID = id
+	if err := h.repo.Update(&title); err != nil {
+		return domain.Title{}, err
+	}
+
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminTitleService) Delete(id uint) error {
+	return h.repo.Delete(id)
+}
+
+// RequirementAchievers returns a page of the users that currently satisfy the
+// title requirement, along with the normalized page and their total.
+func (h *AdminTitleService) RequirementAchievers(titleID uint, requirementID uint, page ports_admin.PageRequest) ([]domain.User, ports_admin.PageRequest, int64, error) {
+	if _, err := h.repo.FindRequirement(titleID, requirementID); err != nil {
+		return nil, page, 0, requirementNotFound(err)
+	}
+
+	offset := paginate(&page.Page, &page.PerPage)
+	users, total, err := h.repo.RequirementAchievers(requirementID, offset, page.PerPage)
+
+	return users, page, total, err
+}
+
+// buildTitle maps the admin request to the title and validates it with the
+// domain rules before anything is written.
+func buildTitle(request ports_admin.TitleRequest) (domain.Title, error) {
+	status := request.Status
+	if status == "" {
+		status = domain.TitleAvailable
+	}
+
+	if !isOneOf(status, domain.TitleStatuses) {
+		return domain.Title{}, errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The status %q is not valid.", status))
+	}
+
+	if request.Purchasable && request.Cost == nil {
+		return domain.Title{}, errors.NewHttpError(http.StatusUnprocessableEntity, "A purchasable title needs a cost.")
+	}
+
+	title := domain.Title{
+		Title:       request.Title,
+		Description: request.Description,
+		Cost:        request.Cost,
+		Purchasable: request.Purchasable,
+		Status:      status,
+	}
+
+	if err := title.ValidateTitle(); err != nil {
+		return domain.Title{}, errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	if err := ensureTrackedActionKeys(request.Requirements); err != nil {
+		return domain.Title{}, err
+	}
+
+	for _, requirement := range request.Requirements {
+		rule, err := buildTaskRule(requirement)
+		if err != nil {
+			return domain.Title{}, err
+		}
+
+		item := domain.TitleRequirement{
+			Task:        requirement.Task,
+			Key:         requirement.Key,
+			Goal:        requirement.Goal,
+			Description: requirement.Description,
+			TaskRule:    rule,
+		}
+
+		if requirement.ID != nil {
+			item.ID = *requirement.ID
+		}
+
+		title.TitleRequirements = append(title.TitleRequirements, item)
+	}
+
+	return title, nil
+}
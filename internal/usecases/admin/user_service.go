@@ -0,0 +1,132 @@
+package usecases_admin
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/pkg/leaderboard"
+	"net/http"
+	"time"
+)
+
+const (
+	adminUsersListLimit     = 100
+	adminPasswordResetValid = 24 * time.Hour
+)
+
+type AdminUserService struct {
+	repo ports_admin.AdminUserRepository
+}
+
+func NewAdminUserService(repo ports_admin.AdminUserRepository) *AdminUserService {
+	return &AdminUserService{
+		repo: repo,
+	}
+}
+
+func (h *AdminUserService) GetAll(filters ports_admin.UserFilters) ([]domain.User, error) {
+	return h.repo.GetAll(filters, adminUsersListLimit)
+}
+
+func (h *AdminUserService) FindByID(id uint) (domain.User, error) {
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminUserService) Block(actorID uint, id uint, request ports_admin.BlockUserRequest) (domain.User, error) {
+	if actorID == id {
+		return domain.User{}, errors.NewHttpError(http.StatusUnprocessableEntity, "You can not block yourself.")
+	}
+
+	if request.Until != nil && !request.Until.After(time.Now()) {
+		return domain.User{}, errors.NewHttpError(http.StatusUnprocessableEntity, "The block expiry must be in the future.")
+	}
+
+	if err := h.repo.Block(id, request.Reason, request.Until); err != nil {
+		return domain.User{}, err
+	}
+
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminUserService) Unblock(id uint) (domain.User, error) {
+	if err := h.repo.Unblock(id); err != nil {
+		return domain.User{}, err
+	}
+
+	return h.repo.FindByID(id)
+}
+
+// AdjustWallet credits or debits the wallet of the user. Credits count on
+// the coins leaderboard, like the other coins received.
+func (h *AdminUserService) AdjustWallet(id uint, request ports_admin.AdjustWalletRequest) (domain.Transaction, error) {
+	transaction, err := h.repo.AdjustWallet(id, request.Amount, request.Description)
+	if err != nil {
+		return transaction, err
+	}
+
+	if transaction.TransactionTypeID == domain.AdditionTransactionTypeID {
+		leaderboard.Record(id, domain.LeaderboardCoins, float64(transaction.Amount), time.Now())
+	}
+
+	return transaction, nil
+}
+
+func (h *AdminUserService) GrantTitle(id uint, request ports_admin.GrantTitleRequest) (domain.UserTitle, error) {
+	if _, err := h.repo.FindByID(id); err != nil {
+		return domain.UserTitle{}, err
+	}
+
+	userTitle, err := h.repo.GrantTitle(id, request.TitleID)
+	if err != nil {
+		return userTitle, err
+	}
+
+	leaderboard.Record(id, domain.LeaderboardTitles, 1, time.Now())
+
+	return userTitle, nil
+}
+
+func (h *AdminUserService) Logout(id uint) error {
+	return h.repo.RevokeSessions(id, time.Now())
+}
+
+func (h *AdminUserService) SyncRoles(id uint, request ports_admin.SyncUserRolesRequest) (domain.User, error) {
+	if err := h.repo.SyncRoles(id, request.RoleIDs); err != nil {
+		return domain.User{}, err
+	}
+
+	return h.repo.FindByID(id)
+}
+
+func (h *AdminUserService) SyncPermissions(id uint, request ports_admin.SyncUserPermissionsRequest) (domain.User, error) {
+	if err := h.repo.SyncPermissions(id, request.PermissionIDs); err != nil {
+		return domain.User{}, err
+	}
+
+	return h.repo.FindByID(id)
+}
+
+// ResetPassword creates a password reset for the user with the given token
+// and logs them out everywhere. The user is returned so the reset link can
+// be mailed to them.
+func (h *AdminUserService) ResetPassword(id uint, token string) (domain.User, error) {
+	user, err := h.repo.FindByID(id)
+	if err != nil {
+		return user, err
+	}
+
+	now := time.Now()
+	reset := domain.PasswordReset{
+		Email:     user.Email,
+		Token:     token,
+		ExpiresAt: now.Add(adminPasswordResetValid),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := h.repo.CreatePasswordReset(id, &reset); err != nil {
+		return user, err
+	}
+
+	return user, nil
+}
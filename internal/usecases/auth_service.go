@@ -57,6 +57,7 @@ func (s *AuthService) GetCookieSettings(httpSecureStr, httpOnlyStr string) (bool
 func (s *AuthService) CreateJWTToken(userID uint, expirationSeconds int) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		"user_id": userID,
+		"iat":     time.Now().Unix(),
 		"exp":     time.Now().Add(time.Duration(expirationSeconds) * time.Second).Unix(),
 	})
 
@@ -0,0 +1,71 @@
+package usecases
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"net/http"
+	"time"
+)
+
+const recentCheckInsLimit = 30
+
+type CheckInService struct {
+	repo        ports.CheckInRepository
+	freezeCost  uint
+	freezeLimit uint
+}
+
+func NewCheckInService(repo ports.CheckInRepository, freezeCost uint, freezeLimit uint) *CheckInService {
+	return &CheckInService{
+		repo:        repo,
+		freezeCost:  freezeCost,
+		freezeLimit: freezeLimit,
+	}
+}
+
+// GetStatus returns the streak of the user, its latest days and whether the
+// user already checked in today.
+func (s *CheckInService) GetStatus(user *domain.User, now time.Time) (domain.CheckInStreak, []domain.CheckIn, bool, error) {
+	streak, err := s.repo.GetStreak(user.ID)
+	if err != nil {
+		return streak, nil, false, err
+	}
+
+	recent, err := s.repo.GetRecent(user.ID, recentCheckInsLimit)
+	if err != nil {
+		return streak, nil, false, err
+	}
+
+	return streak, recent, streak.HasCheckedIn(domain.CheckInDay(now, user.Location())), nil
+}
+
+// CheckIn checks the user in for the current day of the user's timezone.
+func (s *CheckInService) CheckIn(user *domain.User, now time.Time) (domain.CheckInResult, error) {
+	day := domain.CheckInDay(now, user.Location())
+
+	streak, err := s.repo.GetStreak(user.ID)
+	if err != nil {
+		return domain.CheckInResult{}, err
+	}
+
+	if streak.HasCheckedIn(day) {
+		return domain.CheckInResult{}, errors.NewHttpError(http.StatusConflict, "You have already checked in today.")
+	}
+
+	table, err := s.repo.GetRewardTable()
+	if err != nil {
+		return domain.CheckInResult{}, err
+	}
+
+	return s.repo.CheckIn(user.ID, day, table)
+}
+
+// BuyFreeze spends the user's coins on a streak freeze.
+func (s *CheckInService) BuyFreeze(userID uint) (domain.CheckInStreak, domain.Transaction, error) {
+	return s.repo.BuyFreeze(userID, s.freezeCost, s.freezeLimit)
+}
+
+func (s *CheckInService) FreezeCost() uint {
+	return s.freezeCost
+}
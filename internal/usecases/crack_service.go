@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+	"sort"
+)
+
+type CrackService struct {
+	repo ports.CrackRepository
+}
+
+func NewCrackService(repo ports.CrackRepository) *CrackService {
+	return &CrackService{repo: repo}
+}
+
+func (h *CrackService) GetHistoryByGameSlug(slug string) ([]domain.CrackStatusChange, error) {
+	return h.repo.GetHistoryByGameSlug(slug)
+}
+
+func (h *CrackService) StatsByProtection() ([]ports.CrackGroupStats, error) {
+	cracks, err := h.repo.FindForStats()
+	if err != nil {
+		return nil, err
+	}
+
+	return groupCrackStats(cracks, func(crack domain.Crack) (uint, string, string) {
+		return crack.Protection.ID, crack.Protection.Name, crack.Protection.Slug
+	}), nil
+}
+
+func (h *CrackService) StatsByCracker() ([]ports.CrackGroupStats, error) {
+	cracks, err := h.repo.FindForStats()
+	if err != nil {
+		return nil, err
+	}
+
+	return groupCrackStats(cracks, func(crack domain.Crack) (uint, string, string) {
+		return crack.Cracker.ID, crack.Cracker.Name, crack.Cracker.Slug
+	}), nil
+}
+
+func groupCrackStats(cracks []domain.Crack, keyOf func(domain.Crack) (uint, string, string)) []ports.CrackGroupStats {
+	groups := make(map[uint]*ports.CrackGroupStats)
+	grouped := make(map[uint][]domain.Crack)
+
+	for _, crack := range cracks {
+		id, name, slug := keyOf(crack)
+		if id == 0 {
+			continue
+		}
+
+		if _, exists := groups[id]; !exists {
+			groups[id] = &ports.CrackGroupStats{ID: id, Name: name, Slug: slug}
+		}
+
+		grouped[id] = append(grouped[id], crack)
+	}
+
+	stats := make([]ports.CrackGroupStats, 0, len(groups))
+	for id, group := range groups {
+		group.Stats = domain.SummarizeCracks(grouped[id])
+		stats = append(stats, *group)
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+
+	return stats
+}
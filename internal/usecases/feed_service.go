@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"errors"
+	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"gcstatus/internal/utils"
+	"net/http"
+
+	"gorm.io/gorm"
+)
+
+const FeedItemsLimit = 50
+
+type FeedService struct {
+	repo ports.FeedRepository
+}
+
+func NewFeedService(repo ports.FeedRepository) *FeedService {
+	return &FeedService{repo: repo}
+}
+
+func (h *FeedService) UpcomingReleases(genre string, platform string) ([]domain.Game, error) {
+	return h.repo.UpcomingReleases(ports.ReleaseFeedFilters{Genre: genre, Platform: platform})
+}
+
+// FollowedReleases returns the upcoming releases followed by the owner of
+// the given feed token.
+func (h *FeedService) FollowedReleases(token string) ([]domain.Game, error) {
+	feedToken, err := h.repo.FindToken(token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, self_errors.NewHttpError(http.StatusNotFound, "This feed does not exist.")
+		}
+
+		return nil, err
+	}
+
+	return h.repo.UpcomingReleases(ports.ReleaseFeedFilters{FollowedBy: feedToken.UserID})
+}
+
+func (h *FeedService) LatestGames() ([]domain.Game, error) {
+	return h.repo.LatestGames(FeedItemsLimit)
+}
+
+func (h *FeedService) LatestDLCs() ([]domain.DLC, error) {
+	return h.repo.LatestDLCs(FeedItemsLimit)
+}
+
+func (h *FeedService) LatestCrackChanges() ([]domain.CrackStatusChange, error) {
+	return h.repo.LatestCrackChanges(FeedItemsLimit)
+}
+
+func (h *FeedService) GetOrCreateToken(userID uint) (*domain.FeedToken, error) {
+	feedToken, err := h.repo.FindTokenForUser(userID)
+	if err == nil {
+		return feedToken, nil
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	return h.RotateToken(userID)
+}
+
+// RotateToken replaces the feed token of the user, invalidating every
+// subscribed calendar that uses the previous one.
+func (h *FeedService) RotateToken(userID uint) (*domain.FeedToken, error) {
+	feedToken, err := h.repo.FindTokenForUser(userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if feedToken == nil {
+		feedToken = &domain.FeedToken{UserID: userID}
+	}
+
+	token, err := utils.GenerateResetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	feedToken.Token = token
+
+	if err := h.repo.SaveToken(feedToken); err != nil {
+		return nil, err
+	}
+
+	return feedToken, nil
+}
@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"errors"
+	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"gcstatus/internal/utils"
+	"net/http"
+
+	"gorm.io/gorm"
+)
+
+type GameFollowService struct {
+	repo ports.GameFollowRepository
+}
+
+func NewGameFollowService(repo ports.GameFollowRepository) *GameFollowService {
+	return &GameFollowService{repo: repo}
+}
+
+func (s *GameFollowService) GetAllForUser(userID uint) ([]domain.GameFollow, error) {
+	return s.repo.GetAllForUser(userID)
+}
+
+func (s *GameFollowService) FindFollowersForEvent(gameID uint, event string) ([]domain.GameFollow, error) {
+	return s.repo.FindFollowersForEvent(gameID, event)
+}
+
+// Upsert follows the requested game for the given user. New follows notify
+// every event unless told otherwise, while existing ones only change the
+// preferences present in the request.
+func (s *GameFollowService) Upsert(userID uint, request ports.UpsertGameFollowRequest) (*domain.GameFollow, error) {
+	exists, err := s.repo.GameExists(request.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, self_errors.NewHttpError(http.StatusNotFound, "The game you are trying to follow does not exist.")
+	}
+
+	follow, err := s.repo.FindForUser(request.GameID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if follow == nil {
+		token, err := utils.GenerateResetToken()
+		if err != nil {
+			return nil, err
+		}
+
+		follow = &domain.GameFollow{
+			GameID:        request.GameID,
+			UserID:        userID,
+			Token:         token,
+			NotifyRelease: true,
+			NotifyCrack:   true,
+			NotifyPrice:   true,
+			NotifyDLC:     true,
+		}
+	}
+
+	if request.NotifyRelease != nil {
+		follow.NotifyRelease = *request.NotifyRelease
+	}
+	if request.NotifyCrack != nil {
+		follow.NotifyCrack = *request.NotifyCrack
+	}
+	if request.NotifyPrice != nil {
+		follow.NotifyPrice = *request.NotifyPrice
+	}
+	if request.NotifyDLC != nil {
+		follow.NotifyDLC = *request.NotifyDLC
+	}
+
+	if err := s.repo.Save(follow); err != nil {
+		return nil, err
+	}
+
+	return follow, nil
+}
+
+func (s *GameFollowService) Delete(id uint, userID uint) error {
+	follow, err := s.repo.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	if follow.UserID != userID {
+		return self_errors.NewHttpError(http.StatusForbidden, "This follow does not belongs to you user!")
+	}
+
+	return s.repo.Delete(id)
+}
+
+// Unsubscribe mutes the given event of the follow identified by the token
+// sent on emails. Without an event the game is unfollowed altogether.
+func (s *GameFollowService) Unsubscribe(token string, event string) error {
+	if _, ok := domain.FollowEventColumn(event); event != "" && !ok {
+		return self_errors.NewHttpError(http.StatusBadRequest, "The given event is not supported.")
+	}
+
+	follow, err := s.repo.FindByToken(token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return self_errors.NewHttpError(http.StatusNotFound, "This unsubscribe link is invalid or has already been used.")
+		}
+
+		return err
+	}
+
+	if event == "" {
+		return s.repo.Delete(follow.ID)
+	}
+
+	follow.Mute(event)
+
+	return s.repo.Save(follow)
+}
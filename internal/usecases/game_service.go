@@ -23,20 +23,20 @@ func (h *GameService) HomeGames() ([]domain.Game, []domain.Game, []domain.Game,
 	return h.repo.HomeGames()
 }
 
-func (h *GameService) FindBySlug(slug string, userID uint) (domain.Game, error) {
-	return h.repo.FindBySlug(slug, userID)
+func (h *GameService) FindBySlug(slug string, userID uint, region string) (domain.Game, error) {
+	return h.repo.FindBySlug(slug, userID, region)
 }
 
 func (h *GameService) ExistsForStore(storeID uint, appID uint) (bool, error) {
 	return h.repo.ExistsForStore(storeID, appID)
 }
 
-func (h *GameService) Search(input string) ([]domain.Game, error) {
-	return h.repo.Search(input)
+func (h *GameService) Search(input string, filters ports.GameSearchFilters) ([]domain.Game, error) {
+	return h.repo.Search(input, filters)
 }
 
-func (h *GameService) CalendarGames() ([]domain.Game, error) {
-	return h.repo.CalendarGames()
+func (h *GameService) CalendarGames(followedBy uint) ([]domain.Game, error) {
+	return h.repo.CalendarGames(followedBy)
 }
 
 func (h *GameService) FindByClassification(classification string, filterable string) ([]domain.Game, error) {
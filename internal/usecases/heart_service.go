@@ -16,14 +16,16 @@ func NewHeartService(repo ports.HeartRepositry) *HeartService {
 	return &HeartService{repo: repo}
 }
 
-func (h *HeartService) ToggleHeartable(heartableID uint, heartableType string, userID uint) error {
+// ToggleHeartable hearts the entity for the user, or removes the heart when
+// there is one, and tells whether the entity ended up hearted.
+func (h *HeartService) ToggleHeartable(heartableID uint, heartableType string, userID uint) (bool, error) {
 	heart, err := h.repo.FindForUser(heartableID, heartableType, userID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return err
+		return false, err
 	}
 
 	if heart != nil {
-		return h.repo.Delete(heart.ID)
+		return false, h.repo.Delete(heart.ID)
 	}
 
 	newHeart := domain.Heartable{
@@ -32,5 +34,9 @@ func (h *HeartService) ToggleHeartable(heartableID uint, heartableType string, u
 		HeartableType: heartableType,
 	}
 
-	return h.repo.Create(&newHeart)
+	if err := h.repo.Create(&newHeart); err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
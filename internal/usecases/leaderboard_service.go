@@ -0,0 +1,279 @@
+package usecases
+
+import (
+	"errors"
+	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"net/http"
+	"sort"
+	"time"
+)
+
+const (
+	defaultLeaderboardPerPage = 25
+	maxLeaderboardPerPage     = 100
+	defaultLeaderboardRadius  = 5
+	maxLeaderboardRadius      = 25
+)
+
+type LeaderboardService struct {
+	store ports.LeaderboardStore
+	repo  ports.LeaderboardRepository
+}
+
+func NewLeaderboardService(store ports.LeaderboardStore, repo ports.LeaderboardRepository) *LeaderboardService {
+	return &LeaderboardService{
+		store: store,
+		repo:  repo,
+	}
+}
+
+// GetPage returns a page of the leaderboard, along with the normalized query
+// and the total of ranked users.
+func (s *LeaderboardService) GetPage(board string, query ports.LeaderboardQuery, now time.Time) ([]domain.LeaderboardEntry, ports.LeaderboardQuery, int64, error) {
+	period, err := s.period(board, &query, now)
+	if err != nil {
+		return nil, query, 0, err
+	}
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+
+	if query.PerPage < 1 {
+		query.PerPage = defaultLeaderboardPerPage
+	}
+
+	if query.PerPage > maxLeaderboardPerPage {
+		query.PerPage = maxLeaderboardPerPage
+	}
+
+	blocked, err := s.blockedRanks(period.Key(), now)
+	if err != nil {
+		return nil, query, 0, err
+	}
+
+	total, err := s.store.Count(period.Key())
+	if err != nil {
+		return nil, query, 0, err
+	}
+
+	total -= int64(len(blocked))
+
+	entries, err := s.rankedRange(period.Key(), int64((query.Page-1)*query.PerPage), int64(query.PerPage), blocked)
+
+	return entries, query, total, err
+}
+
+// GetRank returns the place of the user on the leaderboard, telling through
+// a nil entry that the user is not ranked.
+func (s *LeaderboardService) GetRank(board string, query ports.LeaderboardQuery, userID uint, now time.Time) (*domain.LeaderboardEntry, error) {
+	period, err := s.period(board, &query, now)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := s.blockedRanks(period.Key(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	score, err := s.userRank(period.Key(), userID, blocked)
+	if err != nil || score == nil {
+		return nil, err
+	}
+
+	users, err := s.repo.GetUsersByIDs([]uint{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	entry := domain.LeaderboardEntry{Rank: score.Rank, Score: score.Score}
+	if len(users) > 0 {
+		entry.User = users[0]
+	}
+
+	return &entry, nil
+}
+
+// GetAround returns the users ranked right above and below the user, the user
+// included.
+func (s *LeaderboardService) GetAround(board string, query ports.LeaderboardQuery, userID uint, now time.Time) ([]domain.LeaderboardEntry, error) {
+	period, err := s.period(board, &query, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Radius < 1 {
+		query.Radius = defaultLeaderboardRadius
+	}
+
+	if query.Radius > maxLeaderboardRadius {
+		query.Radius = maxLeaderboardRadius
+	}
+
+	blocked, err := s.blockedRanks(period.Key(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	score, err := s.userRank(period.Key(), userID, blocked)
+	if err != nil {
+		return nil, err
+	}
+
+	if score == nil {
+		return []domain.LeaderboardEntry{}, nil
+	}
+
+	offset := score.Rank - 1 - int64(query.Radius)
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.rankedRange(period.Key(), offset, score.Rank-offset+int64(query.Radius), blocked)
+}
+
+// Rebuild recomputes every window of every board holding the given time from
+// the database.
+func (s *LeaderboardService) Rebuild(now time.Time) error {
+	blockedIDs, err := s.repo.GetBlockedUserIDs(now)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, board := range domain.LeaderboardBoards {
+		for _, window := range domain.LeaderboardWindows {
+			period := domain.NewLeaderboardPeriod(board, window, now)
+
+			scores, err := s.repo.GetScores(period)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			for _, userID := range blockedIDs {
+				delete(scores, userID)
+			}
+
+			if err := s.store.Replace(period.Key(), scores, period.ExpiresAt()); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *LeaderboardService) period(board string, query *ports.LeaderboardQuery, now time.Time) (domain.LeaderboardPeriod, error) {
+	if query.Window == "" {
+		query.Window = domain.LeaderboardAllTime
+	}
+
+	if !domain.IsLeaderboard(board, query.Window) {
+		return domain.LeaderboardPeriod{}, self_errors.NewHttpError(http.StatusNotFound, "The leaderboard could not be found.")
+	}
+
+	return domain.NewLeaderboardPeriod(board, query.Window, now), nil
+}
+
+// blockedRanks returns the raw ranks the blocked users hold on the board,
+// lowest first.
+func (s *LeaderboardService) blockedRanks(key string, now time.Time) ([]int64, error) {
+	blockedIDs, err := s.repo.GetBlockedUserIDs(now)
+	if err != nil || len(blockedIDs) == 0 {
+		return nil, err
+	}
+
+	scores, err := s.store.Ranks(key, blockedIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ranks := make([]int64, 0, len(scores))
+	for _, score := range scores {
+		ranks = append(ranks, score.Rank)
+	}
+
+	sort.Slice(ranks, func(i, j int) bool { return ranks[i] < ranks[j] })
+
+	return ranks, nil
+}
+
+// userRank returns the score of the user with the rank left once the blocked
+// users ranked above are skipped.
+func (s *LeaderboardService) userRank(key string, userID uint, blocked []int64) (*domain.LeaderboardScore, error) {
+	scores, err := s.store.Ranks(key, []uint{userID})
+	if err != nil || len(scores) == 0 {
+		return nil, err
+	}
+
+	score := scores[0]
+	for _, rank := range blocked {
+		if rank == score.Rank {
+			return nil, nil
+		}
+
+		if rank < score.Rank {
+			score.Rank--
+		}
+	}
+
+	return &score, nil
+}
+
+// rankedRange returns up to limit entries starting at the given zero-based
+// offset of the board without the blocked users.
+func (s *LeaderboardService) rankedRange(key string, offset int64, limit int64, blocked []int64) ([]domain.LeaderboardEntry, error) {
+	// Each blocked user ranked up to the start pushes the start one place down.
+	start := offset
+	for _, rank := range blocked {
+		if rank-1 <= start {
+			start++
+		}
+	}
+
+	scores, err := s.store.Range(key, start, start+limit+int64(len(blocked))-1)
+	if err != nil {
+		return nil, err
+	}
+
+	isBlocked := make(map[int64]bool, len(blocked))
+	for _, rank := range blocked {
+		isBlocked[rank] = true
+	}
+
+	kept := make([]domain.LeaderboardScore, 0, limit)
+	userIDs := make([]uint, 0, limit)
+	for _, score := range scores {
+		if isBlocked[score.Rank] || int64(len(kept)) == limit {
+			continue
+		}
+
+		kept = append(kept, score)
+		userIDs = append(userIDs, score.UserID)
+	}
+
+	users, err := s.repo.GetUsersByIDs(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	userMap := make(map[uint]domain.User, len(users))
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+
+	entries := make([]domain.LeaderboardEntry, 0, len(kept))
+	for i, score := range kept {
+		entries = append(entries, domain.LeaderboardEntry{
+			Rank:  offset + int64(i) + 1,
+			Score: score.Score,
+			User:  userMap[score.UserID],
+		})
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,159 @@
+package usecases
+
+import (
+	"errors"
+	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type LibraryService struct {
+	repo ports.LibraryRepository
+}
+
+func NewLibraryService(repo ports.LibraryRepository) *LibraryService {
+	return &LibraryService{repo: repo}
+}
+
+func (s *LibraryService) GetAllForUser(userID uint, status string) ([]domain.Libraryable, error) {
+	if status != "" && !domain.IsValidLibraryStatus(status) {
+		return nil, self_errors.NewHttpError(http.StatusBadRequest, "The given library status is not valid. The valid statuses are: wishlist, playing, completed, dropped and backlog.")
+	}
+
+	return s.repo.GetAllForUser(userID, status, false)
+}
+
+func (s *LibraryService) GetPublicForUser(userID uint, status string) ([]domain.Libraryable, error) {
+	if status != "" && !domain.IsValidLibraryStatus(status) {
+		return nil, self_errors.NewHttpError(http.StatusBadRequest, "The given library status is not valid. The valid statuses are: wishlist, playing, completed, dropped and backlog.")
+	}
+
+	return s.repo.GetAllForUser(userID, status, true)
+}
+
+func (s *LibraryService) GetStatsForUser(userID uint) (domain.LibraryStats, error) {
+	return s.repo.GetStatsForUser(userID)
+}
+
+// Upsert creates or updates the library entry of the given user for the requested
+// game or DLC. Besides the entry, it returns the action keys that should be
+// tracked for the user titles and missions because of this change.
+func (s *LibraryService) Upsert(userID uint, request ports.UpsertLibraryRequest) (*domain.Libraryable, []string, error) {
+	if request.LibraryableType != domain.LibraryableTypeGames && request.LibraryableType != domain.LibraryableTypeDLCs {
+		return nil, nil, self_errors.NewHttpError(http.StatusBadRequest, "The given libraryable type is not valid. The valid types are: games and dlcs.")
+	}
+
+	if !domain.IsValidLibraryStatus(request.Status) {
+		return nil, nil, self_errors.NewHttpError(http.StatusBadRequest, "The given library status is not valid. The valid statuses are: wishlist, playing, completed, dropped and backlog.")
+	}
+
+	exists, err := s.repo.LibraryableExists(request.LibraryableID, request.LibraryableType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !exists {
+		return nil, nil, self_errors.NewHttpError(http.StatusNotFound, "The game or DLC you are trying to add to your library does not exist.")
+	}
+
+	entry, err := s.repo.FindForUser(request.LibraryableID, request.LibraryableType, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, err
+	}
+
+	var actionKeys []string
+
+	if entry == nil {
+		entry = &domain.Libraryable{
+			UserID:          userID,
+			LibraryableID:   request.LibraryableID,
+			LibraryableType: request.LibraryableType,
+		}
+
+		actionKeys = append(actionKeys, domain.AddToLibraryRequirementKey)
+	}
+
+	wasCompleted := entry.CompletedAt != nil
+
+	if err := s.fillEntry(entry, request); err != nil {
+		return nil, nil, err
+	}
+
+	if !wasCompleted && entry.CompletedAt != nil && entry.LibraryableType == domain.LibraryableTypeGames {
+		actionKeys = append(actionKeys, domain.CompleteGameRequirementKey)
+	}
+
+	if err := s.repo.Save(entry); err != nil {
+		return nil, nil, err
+	}
+
+	actionKeys, err = s.untrackedActionKeys(userID, entry, actionKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entry, actionKeys, nil
+}
+
+// untrackedActionKeys leaves out the keys already tracked for the game or DLC,
+// so removing and adding it back to the library does not count it again.
+func (s *LibraryService) untrackedActionKeys(userID uint, entry *domain.Libraryable, actionKeys []string) ([]string, error) {
+	var untracked []string
+	for _, actionKey := range actionKeys {
+		tracked, err := s.repo.HasTrackedAction(userID, actionKey, entry.LibraryableID, entry.LibraryableType)
+		if err != nil {
+			return nil, err
+		}
+
+		if !tracked {
+			untracked = append(untracked, actionKey)
+		}
+	}
+
+	return untracked, nil
+}
+
+func (s *LibraryService) Delete(id uint, userID uint) error {
+	entry, err := s.repo.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	if entry.UserID != userID {
+		return self_errors.NewHttpError(http.StatusForbidden, "This library entry does not belongs to you user!")
+	}
+
+	return s.repo.Delete(id)
+}
+
+func (s *LibraryService) fillEntry(entry *domain.Libraryable, request ports.UpsertLibraryRequest) error {
+	entry.Status = request.Status
+
+	if request.HoursPlayed != nil {
+		entry.HoursPlayed = request.HoursPlayed
+	}
+
+	if request.Private != nil {
+		entry.Private = *request.Private
+	}
+
+	if request.CompletedAt != nil {
+		completedAt, err := time.Parse("2006-01-02", *request.CompletedAt)
+		if err != nil {
+			return self_errors.NewHttpError(http.StatusBadRequest, "Invalid completion date format.")
+		}
+
+		entry.CompletedAt = &completedAt
+	}
+
+	if entry.Status == domain.LibraryCompleted && entry.CompletedAt == nil {
+		now := time.Now()
+		entry.CompletedAt = &now
+	}
+
+	return nil
+}
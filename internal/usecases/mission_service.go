@@ -3,6 +3,8 @@ package usecases
 import (
 	"gcstatus/internal/domain"
 	"gcstatus/internal/ports"
+	"gcstatus/pkg/leaderboard"
+	"time"
 )
 
 type MissionService struct {
@@ -22,5 +24,11 @@ func (h *MissionService) GetAllForUser(userID uint) ([]*domain.Mission, error) {
 }
 
 func (h *MissionService) CompleteMission(userID uint, missionID uint) error {
-	return h.repo.CompleteMission(userID, missionID)
+	if err := h.repo.CompleteMission(userID, missionID); err != nil {
+		return err
+	}
+
+	leaderboard.Record(userID, domain.LeaderboardMissions, 1, time.Now())
+
+	return nil
 }
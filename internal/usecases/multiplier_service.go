@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+	"time"
+)
+
+type MultiplierService struct {
+	repo ports.MultiplierRepository
+}
+
+func NewMultiplierService(repo ports.MultiplierRepository) *MultiplierService {
+	return &MultiplierService{repo: repo}
+}
+
+// GetMultipliers is what the experience and coins the user earns are
+// multiplied by right now. The events limited to an action are listed too,
+// so the user sees them, though they only count for that action.
+func (s *MultiplierService) GetMultipliers(userID uint) (domain.Multipliers, error) {
+	now := time.Now()
+
+	events, boosters, err := s.repo.GetActive(userID, now)
+	if err != nil {
+		return domain.Multipliers{}, err
+	}
+
+	multipliers := domain.NewMultipliers(events, boosters, "", now)
+	multipliers.Events = events
+
+	return multipliers, nil
+}
+
+func (s *MultiplierService) GetPurchasableBoosters() ([]domain.Booster, error) {
+	return s.repo.GetPurchasableBoosters()
+}
+
+// BuyBooster spends the user's coins on the booster, running it right away.
+func (s *MultiplierService) BuyBooster(userID uint, boosterID uint) (domain.UserBooster, domain.Transaction, error) {
+	return s.repo.BuyBooster(userID, boosterID)
+}
@@ -3,6 +3,7 @@ package usecases
 import (
 	"gcstatus/internal/domain"
 	"gcstatus/internal/ports"
+	"time"
 )
 
 type PasswordResetService struct {
@@ -33,3 +34,8 @@ func (h *PasswordResetService) DeletePasswordReset(id uint) error {
 	}
 	return nil
 }
+
+// PruneExpired removes the password resets that can no longer be used.
+func (h *PasswordResetService) PruneExpired(now time.Time) (int64, error) {
+	return h.repo.DeleteExpiredPasswordResets(now)
+}
@@ -0,0 +1,76 @@
+package usecases
+
+import (
+	"errors"
+	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"net/http"
+
+	"gorm.io/gorm"
+)
+
+type PriceAlertService struct {
+	repo ports.PriceAlertRepository
+}
+
+func NewPriceAlertService(repo ports.PriceAlertRepository) *PriceAlertService {
+	return &PriceAlertService{repo: repo}
+}
+
+func (s *PriceAlertService) GetAllForUser(userID uint) ([]domain.PriceAlert, error) {
+	return s.repo.GetAllForUser(userID)
+}
+
+// Upsert creates or updates the price alert of the given user for the requested
+// game. Updating an alert re-enables it and forgets the last notified price.
+func (s *PriceAlertService) Upsert(userID uint, request ports.UpsertPriceAlertRequest) (*domain.PriceAlert, error) {
+	if request.TargetPrice == nil && !request.AnyDiscount {
+		return nil, self_errors.NewHttpError(http.StatusBadRequest, "You should provide a target price or watch for any discount.")
+	}
+
+	exists, err := s.repo.GameExists(request.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, self_errors.NewHttpError(http.StatusNotFound, "The game you are trying to watch does not exist.")
+	}
+
+	alert, err := s.repo.FindForUser(request.GameID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if alert == nil {
+		alert = &domain.PriceAlert{
+			GameID: request.GameID,
+			UserID: userID,
+		}
+	}
+
+	alert.TargetPrice = request.TargetPrice
+	alert.AnyDiscount = request.AnyDiscount
+	alert.Active = true
+	alert.LastNotifiedPrice = nil
+
+	if err := s.repo.Save(alert); err != nil {
+		return nil, err
+	}
+
+	return alert, nil
+}
+
+func (s *PriceAlertService) Delete(id uint, userID uint) error {
+	alert, err := s.repo.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	if alert.UserID != userID {
+		return self_errors.NewHttpError(http.StatusForbidden, "This price alert does not belongs to you user!")
+	}
+
+	return s.repo.Delete(id)
+}
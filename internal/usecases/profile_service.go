@@ -19,3 +19,7 @@ func (h *ProfileService) UpdateSocials(profileID uint, request ports.UpdateSocia
 func (h *ProfileService) UpdatePicture(profileID uint, path string) error {
 	return h.repo.UpdatePicture(profileID, path)
 }
+
+func (h *ProfileService) UpdateRegion(profileID uint, region string) error {
+	return h.repo.UpdateRegion(profileID, region)
+}
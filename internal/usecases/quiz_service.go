@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"net/http"
+	"time"
+)
+
+type QuizService struct {
+	repo ports.QuizRepository
+}
+
+func NewQuizService(repo ports.QuizRepository) *QuizService {
+	return &QuizService{repo: repo}
+}
+
+// GetOpen returns the quizzes and polls taking answers, along with the
+// answers the user already gave to them by quiz ID.
+func (s *QuizService) GetOpen(userID uint, gameID *uint, now time.Time) ([]domain.Quiz, map[uint]domain.QuizAttempt, error) {
+	quizzes, err := s.repo.GetOpen(gameID, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids := make([]uint, 0, len(quizzes))
+	for _, quiz := range quizzes {
+		ids = append(ids, quiz.ID)
+	}
+
+	attempts, err := s.attemptsOf(userID, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return quizzes, attempts, nil
+}
+
+// Find returns the quiz along with the answers of the user, nil when the user
+// did not answer it yet.
+func (s *QuizService) Find(userID uint, id uint) (domain.Quiz, *domain.QuizAttempt, error) {
+	quiz, err := s.repo.FindByID(id)
+	if err != nil {
+		return domain.Quiz{}, nil, err
+	}
+
+	attempt, err := s.attemptOf(userID, id)
+
+	return quiz, attempt, err
+}
+
+// Answer grades and records the answers of the user to the quiz, which must
+// be open and can only be answered once.
+func (s *QuizService) Answer(userID uint, id uint, request ports.AnswerQuizRequest, now time.Time) (domain.Quiz, domain.QuizAttempt, error) {
+	quiz, err := s.repo.FindByID(id)
+	if err != nil {
+		return domain.Quiz{}, domain.QuizAttempt{}, err
+	}
+
+	if !quiz.IsOpen(now) {
+		return domain.Quiz{}, domain.QuizAttempt{}, errors.NewHttpError(http.StatusUnprocessableEntity, "The "+quiz.Kind+" is not open to answers.")
+	}
+
+	attempt, err := s.attemptOf(userID, id)
+	if err != nil {
+		return domain.Quiz{}, domain.QuizAttempt{}, err
+	}
+
+	if attempt != nil {
+		return domain.Quiz{}, domain.QuizAttempt{}, errors.NewHttpError(http.StatusConflict, "You have already answered this "+quiz.Kind+".")
+	}
+
+	choices := make(map[uint]uint, len(request.Answers))
+	for _, answer := range request.Answers {
+		if _, repeated := choices[answer.QuestionID]; repeated {
+			return domain.Quiz{}, domain.QuizAttempt{}, errors.NewHttpError(http.StatusUnprocessableEntity, "Every question can only be answered once.")
+		}
+
+		choices[answer.QuestionID] = answer.OptionID
+	}
+
+	graded, err := quiz.Grade(userID, choices)
+	if err != nil {
+		return domain.Quiz{}, domain.QuizAttempt{}, errors.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	if err := s.repo.CreateAttempt(&graded); err != nil {
+		return domain.Quiz{}, domain.QuizAttempt{}, err
+	}
+
+	return quiz, graded, nil
+}
+
+// GetResults returns the votes of the quiz to the users that answered it, or
+// to everyone once it is closed.
+func (s *QuizService) GetResults(userID uint, id uint, now time.Time) (domain.QuizResults, error) {
+	quiz, attempt, err := s.Find(userID, id)
+	if err != nil {
+		return domain.QuizResults{}, err
+	}
+
+	if attempt == nil && !quiz.IsClosed(now) {
+		return domain.QuizResults{}, errors.NewHttpError(http.StatusForbidden, "The results are shown once you answer the "+quiz.Kind+" or it closes.")
+	}
+
+	return s.repo.GetResults(quiz)
+}
+
+func (s *QuizService) attemptOf(userID uint, id uint) (*domain.QuizAttempt, error) {
+	attempts, err := s.attemptsOf(userID, []uint{id})
+	if err != nil {
+		return nil, err
+	}
+
+	attempt, ok := attempts[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &attempt, nil
+}
+
+func (s *QuizService) attemptsOf(userID uint, ids []uint) (map[uint]domain.QuizAttempt, error) {
+	attempts, err := s.repo.GetAttempts(userID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byQuiz := make(map[uint]domain.QuizAttempt, len(attempts))
+	for _, attempt := range attempts {
+		byQuiz[attempt.QuizID] = attempt
+	}
+
+	return byQuiz, nil
+}
@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"net/http"
+)
+
+type RewardService struct {
+	repo ports.RewardRepository
+}
+
+func NewRewardService(repo ports.RewardRepository) *RewardService {
+	return &RewardService{repo: repo}
+}
+
+// GrantRewards gives the rewards to the user of the grant at once, or none of
+// them. The key identifies the grant, so giving it again is a no-op, the
+// reason describes it on the ledger and the action key picks the multiplier
+// events applied to the coins and experience.
+func (s *RewardService) GrantRewards(grant domain.RewardGrant, rewards []domain.Reward) (domain.GrantedRewards, error) {
+	for _, reward := range rewards {
+		if !domain.IsRewardableType(reward.RewardableType) {
+			return domain.GrantedRewards{}, errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The reward type %q is not valid.", reward.RewardableType))
+		}
+
+		if domain.IsRewardableEntity(reward.RewardableType) && reward.RewardableID == 0 {
+			return domain.GrantedRewards{}, errors.NewHttpError(http.StatusUnprocessableEntity, fmt.Sprintf("The %s reward is missing the rewarded entity.", reward.RewardableType))
+		}
+	}
+
+	return s.repo.GrantRewards(grant, rewards)
+}
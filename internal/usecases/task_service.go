@@ -1,8 +1,11 @@
 package usecases
 
 import (
+	"errors"
 	"gcstatus/internal/domain"
 	"gcstatus/internal/ports"
+	"gcstatus/pkg/leaderboard"
+	"time"
 )
 
 type TaskService struct {
@@ -13,31 +16,81 @@ func NewTaskService(repo ports.TaskRepository) *TaskService {
 	return &TaskService{repo: repo}
 }
 
-func (s *TaskService) TrackTitleProgress(userID uint, actionKey string, increment int) error {
-	requirements, err := s.GetTitleRequirementsByKey(actionKey)
+// TrackAction records the action in the stream of the user and advances the
+// title and mission requirements of its key. Actions refused by the policy of
+// their key are left out, and the policy sets the progress of the others.
+func (s *TaskService) TrackAction(action domain.UserAction) error {
+	if action.OccurredAt.IsZero() {
+		action.OccurredAt = time.Now()
+	}
+
+	if action.Increment == 0 {
+		action.Increment = 1
+	}
+
+	policy, err := s.repo.GetActionPolicy(action.Key)
+	if err != nil {
+		return err
+	}
+
+	if policy != nil {
+		decision, err := s.repo.DecideAction(action, *policy)
+		if err != nil {
+			return err
+		}
+
+		if !decision.Allowed() {
+			return nil
+		}
+
+		action.Increment = decision.Increment
+	}
+
+	if action.Attributes == nil && action.TargetType != nil && action.TargetID != nil {
+		attributes, err := s.repo.GetTargetAttributes(*action.TargetType, *action.TargetID)
+		if err != nil {
+			return err
+		}
+
+		action.SetAttributeValues(attributes)
+	}
+
+	if err := s.repo.RecordAction(&action); err != nil {
+		return err
+	}
+
+	return errors.Join(s.TrackTitleProgress(action), s.TrackMissionProgress(action))
+}
+
+func (s *TaskService) TrackTitleProgress(action domain.UserAction) error {
+	requirements, err := s.GetTitleRequirementsByKey(action.Key)
 	if err != nil {
 		return err
 	}
 
 	for _, requirement := range requirements {
-		progress, err := s.GetOrCreateTitleProgress(userID, requirement.ID)
+		progress, err := s.GetOrCreateTitleProgress(action.UserID, requirement.ID)
 		if err != nil {
 			return err
 		}
 
 		if !progress.Completed {
-			progress.Progress += increment
+			progress.Progress, err = s.evaluate(requirement.TaskRule, progress.Progress, action, nil)
+			if err != nil {
+				return err
+			}
+
 			if progress.Progress >= requirement.Goal {
 				progress.Progress = requirement.Goal
 				progress.Completed = true
 
-				hasTitle, err := s.UserHasTitle(userID, requirement.TitleID)
+				hasTitle, err := s.UserHasTitle(action.UserID, requirement.TitleID)
 				if err != nil {
 					return err
 				}
 
 				if !hasTitle {
-					err = s.AwardTitleToUser(userID, requirement.TitleID)
+					err = s.AwardTitleToUser(action.UserID, requirement.TitleID)
 					if err != nil {
 						return err
 					}
@@ -54,20 +107,24 @@ func (s *TaskService) TrackTitleProgress(userID uint, actionKey string, incremen
 	return nil
 }
 
-func (s *TaskService) TrackMissionProgress(userID uint, actionKey string, increment int) error {
-	requirements, err := s.GetMissionRequirementsByKey(actionKey)
+func (s *TaskService) TrackMissionProgress(action domain.UserAction) error {
+	requirements, err := s.GetMissionRequirementsByKey(action.Key)
 	if err != nil {
 		return err
 	}
 
 	for _, requirement := range requirements {
-		progress, err := s.GetOrCreateMissionProgress(userID, requirement.ID)
+		progress, err := s.GetOrCreateMissionProgress(action.UserID, requirement.ID)
 		if err != nil {
 			return err
 		}
 
 		if !progress.Completed {
-			progress.Progress += increment
+			progress.Progress, err = s.evaluate(requirement.TaskRule, progress.Progress, action, progress.ResetAt)
+			if err != nil {
+				return err
+			}
+
 			if progress.Progress >= requirement.Goal {
 				progress.Progress = requirement.Goal
 				progress.Completed = true
@@ -83,6 +140,28 @@ func (s *TaskService) TrackMissionProgress(userID uint, actionKey string, increm
 	return nil
 }
 
+// evaluate returns the progress of a requirement after the action. Plain
+// counters keep adding to the running total, while the other rules are
+// evaluated over the actions of the user since the progress was last reset.
+func (s *TaskService) evaluate(rule domain.TaskRule, current int, action domain.UserAction, resetAt *time.Time) (int, error) {
+	if rule.IsPlainCounter() {
+		return current + action.Increment, nil
+	}
+
+	if !rule.Accepts(action, action.OccurredAt) {
+		return current, nil
+	}
+
+	since, until := rule.Window(action.OccurredAt, resetAt)
+
+	actions, err := s.repo.GetUserActions(action.UserID, action.Key, since, until)
+	if err != nil {
+		return current, err
+	}
+
+	return rule.Progress(actions, action.OccurredAt), nil
+}
+
 func (s *TaskService) GetTitleRequirementsByKey(actionKey string) ([]domain.TitleRequirement, error) {
 	return s.repo.GetTitleRequirementsByKey(actionKey)
 }
@@ -104,7 +183,13 @@ func (s *TaskService) UserHasTitle(userID uint, titleID uint) (bool, error) {
 }
 
 func (s *TaskService) AwardTitleToUser(userID uint, titleID uint) error {
-	return s.repo.AwardTitleToUser(userID, titleID)
+	if err := s.repo.AwardTitleToUser(userID, titleID); err != nil {
+		return err
+	}
+
+	leaderboard.Record(userID, domain.LeaderboardTitles, 1, time.Now())
+
+	return nil
 }
 
 func (s *TaskService) UpdateTitleProgress(progress *domain.TitleProgress) error {
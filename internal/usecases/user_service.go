@@ -7,6 +7,7 @@ import (
 	"gcstatus/internal/ports"
 	"gcstatus/internal/utils"
 	"net/http"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
@@ -48,8 +49,8 @@ func (s *UserService) UpdateUserBasics(userID uint, request ports.UpdateUserBasi
 	return s.repo.UpdateUserBasics(userID, request)
 }
 
-func (s *UserService) AddExperience(userID uint, experienceAmount uint, awardTitleToUserFunc func(userID uint, titleID uint) error) error {
-	return s.repo.AddExperience(userID, experienceAmount, awardTitleToUserFunc)
+func (s *UserService) AddExperience(userID uint, experienceAmount uint) error {
+	return s.repo.AddExperience(userID, experienceAmount)
 }
 
 func (s *UserService) FindUserByEmailOrNickname(emailOrNickname string) (*domain.User, error) {
@@ -61,6 +62,10 @@ func (s *UserService) FindUserByEmailOrNickname(emailOrNickname string) (*domain
 	return user, nil
 }
 
+func (s *UserService) FindUserByNickname(nickname string) (*domain.User, error) {
+	return s.repo.FindUserByNickname(nickname)
+}
+
 func (s *UserService) FindUserByEmailForAdmin(email string) (*domain.User, error) {
 	user, err := s.repo.FindUserByEmailForAdmin(email)
 	if err != nil {
@@ -101,7 +106,7 @@ func (s *UserService) AuthenticateUserForAdmin(emailOrNickname string, password
 		return nil, self_errors.NewHttpError(http.StatusForbidden, "Failed to autheticates user: insuficcient permissions")
 	}
 
-	if user.Blocked {
+	if user.IsBlocked(time.Now()) {
 		return nil, self_errors.NewHttpError(http.StatusForbidden, "You are blocked on GCStatus platform. If you think this is an error, please, contact support!")
 	}
 
@@ -1,6 +1,11 @@
 package usecases
 
-import "gcstatus/internal/ports"
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+	"gcstatus/pkg/leaderboard"
+	"time"
+)
 
 type WalletService struct {
 	repo ports.WalletRepository
@@ -10,7 +15,20 @@ func NewWalletService(repo ports.WalletRepository) *WalletService {
 	return &WalletService{repo: repo}
 }
 
+// Add credits coins earned by the user, which also count on the coins
+// leaderboard.
 func (r *WalletService) Add(userID uint, amount uint) error {
+	if err := r.repo.Add(userID, amount); err != nil {
+		return err
+	}
+
+	leaderboard.Record(userID, domain.LeaderboardCoins, float64(amount), time.Now())
+
+	return nil
+}
+
+// Refund gives back coins the user spent, without counting them as earned.
+func (r *WalletService) Refund(userID uint, amount uint) error {
 	return r.repo.Add(userID, amount)
 }
 
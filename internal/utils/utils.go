@@ -10,6 +10,7 @@ import (
 	"gcstatus/config"
 	"gcstatus/internal/domain"
 	"io"
+	"net/http"
 	"os"
 	"regexp"
 	"strings"
@@ -108,10 +109,14 @@ func ExtractAuthenticatedUser(c *gin.Context, fetchUser UserFetcher) (*domain.Us
 				return nil, errors.New("user not found")
 			}
 
-			if user.Blocked {
+			if user.IsBlocked(time.Now()) {
 				return nil, errors.New("user is blocked")
 			}
 
+			if user.SessionRevoked(issuedAt(claims)) {
+				return nil, errors.New("session was revoked")
+			}
+
 			return user, nil
 		}
 
@@ -121,7 +126,26 @@ func ExtractAuthenticatedUser(c *gin.Context, fetchUser UserFetcher) (*domain.Us
 	return nil, errors.New("invalid token claims")
 }
 
+// issuedAt reads the issue time of a token. Tokens issued before it was
+// recorded count as issued at the epoch.
+func issuedAt(claims jwt.MapClaims) int64 {
+	if iat, ok := claims["iat"].(float64); ok {
+		return int64(iat)
+	}
+
+	return 0
+}
+
 func GetAuthenticatedUserID(c *gin.Context, fetchUser UserFetcher) *uint {
+	user := GetAuthenticatedUser(c, fetchUser)
+	if user == nil {
+		return nil
+	}
+
+	return &user.ID
+}
+
+func GetAuthenticatedUser(c *gin.Context, fetchUser UserFetcher) *domain.User {
 	env := config.LoadConfig()
 
 	encryptedToken, err := c.Cookie(env.AccessTokenKey)
@@ -162,11 +186,11 @@ func GetAuthenticatedUserID(c *gin.Context, fetchUser UserFetcher) *uint {
 			return nil
 		}
 
-		if user.Blocked {
+		if user.IsBlocked(time.Now()) || user.SessionRevoked(issuedAt(claims)) {
 			return nil
 		}
 
-		return &userIDUint
+		return user
 	}
 
 	return nil
@@ -324,3 +348,68 @@ func Slugify(s string) string {
 
 	return s
 }
+
+// ParseRegions splits a comma separated list of store regions, keeping the
+// given order and dropping empty or duplicated entries.
+func ParseRegions(raw string) []string {
+	var regions []string
+	seen := make(map[string]bool)
+
+	for _, region := range strings.Split(raw, ",") {
+		region = strings.ToLower(strings.TrimSpace(region))
+		if region == "" || seen[region] {
+			continue
+		}
+
+		seen[region] = true
+		regions = append(regions, region)
+	}
+
+	return regions
+}
+
+// ResolveRegion returns the first candidate that belongs to the given regions,
+// falling back to the first (default) region.
+func ResolveRegion(regions []string, candidates ...string) string {
+	for _, candidate := range candidates {
+		candidate = strings.ToLower(strings.TrimSpace(candidate))
+		for _, region := range regions {
+			if candidate == region {
+				return region
+			}
+		}
+	}
+
+	if len(regions) > 0 {
+		return regions[0]
+	}
+
+	return ""
+}
+
+func SteamRegions() []string {
+	return ParseRegions(config.LoadConfig().SteamRegions)
+}
+
+// IsNotModified evaluates the conditional headers of the request against the
+// current validators of a resource. If-None-Match takes precedence over
+// If-Modified-Since, as stated by RFC 9110.
+func IsNotModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil || lastModified.IsZero() {
+		return false
+	}
+
+	return !lastModified.Truncate(time.Second).After(since)
+}
@@ -18,6 +18,9 @@ type Cache interface {
 	GetUserFromCache(userID uint) (*domain.User, bool)
 	SetUserInCache(user *domain.User)
 	RemoveUserFromCache(userID uint)
+	GetUserScopesFromCache(userID uint) ([]string, bool)
+	SetUserScopesInCache(userID uint, scopes []string)
+	RemoveUserScopesFromCache(userIDs ...uint)
 }
 
 type RedisCache struct {
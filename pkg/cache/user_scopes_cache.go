@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const userScopesTTL = time.Hour
+
+func (r *RedisCache) GetUserScopesFromCache(userID uint) ([]string, bool) {
+	key := fmt.Sprintf("user-scopes:%d", userID)
+	result, err := r.client.Get(ctx, key).Result()
+	if err != nil {
+		if err != redis.Nil {
+			log.Println("Redis error:", err)
+		}
+		return nil, false
+	}
+
+	var scopes []string
+	if err := json.Unmarshal([]byte(result), &scopes); err != nil {
+		log.Println("Unmarshal error:", err)
+		return nil, false
+	}
+	return scopes, true
+}
+
+func (r *RedisCache) SetUserScopesInCache(userID uint, scopes []string) {
+	key := fmt.Sprintf("user-scopes:%d", userID)
+	data, err := json.Marshal(scopes)
+	if err != nil {
+		log.Println("Marshal error:", err)
+		return
+	}
+	if err := r.client.Set(ctx, key, data, userScopesTTL).Err(); err != nil {
+		log.Println("Redis error:", err)
+	}
+}
+
+func (r *RedisCache) RemoveUserScopesFromCache(userIDs ...uint) {
+	if len(userIDs) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, fmt.Sprintf("user-scopes:%d", userID))
+	}
+	if err := r.client.Del(ctx, keys...).Err(); err != nil {
+		log.Println("Redis error:", err)
+	}
+}
@@ -0,0 +1,74 @@
+package feeds
+
+import (
+	"strings"
+	"time"
+)
+
+const icalMaxLineLength = 75
+
+var icalEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// Event is an all-day entry of an iCalendar feed.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	URL         string
+	Date        time.Time
+	Stamp       time.Time
+}
+
+// RenderCalendar renders the events as an RFC 5545 calendar.
+func RenderCalendar(name string, events []Event) string {
+	var b strings.Builder
+
+	writeICalLine(&b, "BEGIN:VCALENDAR")
+	writeICalLine(&b, "VERSION:2.0")
+	writeICalLine(&b, "PRODID:-//GCStatus//Releases//EN")
+	writeICalLine(&b, "CALSCALE:GREGORIAN")
+	writeICalLine(&b, "METHOD:PUBLISH")
+	writeICalLine(&b, "X-WR-CALNAME:"+icalEscaper.Replace(name))
+
+	for _, event := range events {
+		writeICalLine(&b, "BEGIN:VEVENT")
+		writeICalLine(&b, "UID:"+event.UID)
+		writeICalLine(&b, "DTSTAMP:"+event.Stamp.UTC().Format("20060102T150405Z"))
+		writeICalLine(&b, "DTSTART;VALUE=DATE:"+event.Date.Format("20060102"))
+		writeICalLine(&b, "DTEND;VALUE=DATE:"+event.Date.AddDate(0, 0, 1).Format("20060102"))
+		writeICalLine(&b, "SUMMARY:"+icalEscaper.Replace(event.Summary))
+		if event.Description != "" {
+			writeICalLine(&b, "DESCRIPTION:"+icalEscaper.Replace(event.Description))
+		}
+		if event.URL != "" {
+			writeICalLine(&b, "URL:"+event.URL)
+		}
+		writeICalLine(&b, "END:VEVENT")
+	}
+
+	writeICalLine(&b, "END:VCALENDAR")
+
+	return b.String()
+}
+
+// writeICalLine folds content lines longer than 75 octets, as required by
+// the spec, without splitting multi-byte characters.
+func writeICalLine(b *strings.Builder, line string) {
+	for len(line) > icalMaxLineLength {
+		cut := icalMaxLineLength
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+	}
+
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
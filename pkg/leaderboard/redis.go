@@ -0,0 +1,184 @@
+package leaderboard
+
+import (
+	"context"
+	"fmt"
+	"gcstatus/config"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const replaceBatchSize = 1000
+
+type RedisLeaderboard struct {
+	client *redis.Client
+}
+
+var ctx = context.Background()
+
+// GlobalLeaderboard keeps the leaderboards updated by the services and
+// repositories crediting experience, coins, titles and missions.
+var GlobalLeaderboard ports.LeaderboardStore
+
+func NewRedisLeaderboard() *RedisLeaderboard {
+	env := config.LoadConfig()
+
+	rdb := redis.NewClient(&redis.Options{
+		Addr: env.RedisHost,
+	})
+
+	return &RedisLeaderboard{client: rdb}
+}
+
+// Record adds the points earned by the user to every window of the board
+// through the global leaderboard. Failures are only logged, as the boards can
+// be rebuilt from the database.
+func Record(userID uint, board string, points float64, at time.Time) {
+	if GlobalLeaderboard == nil || points == 0 {
+		return
+	}
+
+	for _, window := range domain.LeaderboardWindows {
+		period := domain.NewLeaderboardPeriod(board, window, at)
+		if err := GlobalLeaderboard.Increment(period.Key(), userID, points, period.ExpiresAt()); err != nil {
+			log.Printf("Failed to record %v %s points of user %d: %+v", points, board, userID, err)
+		}
+	}
+}
+
+func (r *RedisLeaderboard) Increment(key string, userID uint, points float64, expiresAt *time.Time) error {
+	pipe := r.client.TxPipeline()
+	pipe.ZIncrBy(ctx, key, points, member(userID))
+	if expiresAt != nil {
+		pipe.ExpireAt(ctx, key, *expiresAt)
+	}
+
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+func (r *RedisLeaderboard) Count(key string) (int64, error) {
+	return r.client.ZCard(ctx, key).Result()
+}
+
+// Range returns the scores ranked between the given zero-based positions,
+// highest first.
+func (r *RedisLeaderboard) Range(key string, start int64, stop int64) ([]domain.LeaderboardScore, error) {
+	members, err := r.client.ZRevRangeWithScores(ctx, key, start, stop).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make([]domain.LeaderboardScore, 0, len(members))
+	for i, z := range members {
+		userID, err := parseMember(z.Member)
+		if err != nil {
+			return nil, err
+		}
+
+		scores = append(scores, domain.LeaderboardScore{UserID: userID, Score: z.Score, Rank: start + int64(i) + 1})
+	}
+
+	return scores, nil
+}
+
+// Ranks returns the scores of the given users, leaving out the users absent
+// from the board.
+func (r *RedisLeaderboard) Ranks(key string, userIDs []uint) ([]domain.LeaderboardScore, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	pipe := r.client.Pipeline()
+	ranks := make([]*redis.IntCmd, len(userIDs))
+	values := make([]*redis.FloatCmd, len(userIDs))
+	for i, userID := range userIDs {
+		ranks[i] = pipe.ZRevRank(ctx, key, member(userID))
+		values[i] = pipe.ZScore(ctx, key, member(userID))
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	var scores []domain.LeaderboardScore
+	for i, userID := range userIDs {
+		rank, err := ranks[i].Result()
+		if err == redis.Nil {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		scores = append(scores, domain.LeaderboardScore{UserID: userID, Score: values[i].Val(), Rank: rank + 1})
+	}
+
+	return scores, nil
+}
+
+// Replace swaps the board for the given scores at once, writing them to a
+// temporary key first so readers never see a partial board.
+func (r *RedisLeaderboard) Replace(key string, scores map[uint]float64, expiresAt *time.Time) error {
+	if len(scores) == 0 {
+		return r.client.Del(ctx, key).Err()
+	}
+
+	tmpKey := key + ":rebuild"
+	if err := r.client.Del(ctx, tmpKey).Err(); err != nil {
+		return err
+	}
+
+	members := make([]*redis.Z, 0, replaceBatchSize)
+	for userID, score := range scores {
+		members = append(members, &redis.Z{Score: score, Member: member(userID)})
+		if len(members) == replaceBatchSize {
+			if err := r.client.ZAdd(ctx, tmpKey, members...).Err(); err != nil {
+				return err
+			}
+
+			members = members[:0]
+		}
+	}
+
+	if len(members) > 0 {
+		if err := r.client.ZAdd(ctx, tmpKey, members...).Err(); err != nil {
+			return err
+		}
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Rename(ctx, tmpKey, key)
+	if expiresAt != nil {
+		pipe.ExpireAt(ctx, key, *expiresAt)
+	}
+
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+func member(userID uint) string {
+	return strconv.FormatUint(uint64(userID), 10)
+}
+
+func parseMember(value any) (uint, error) {
+	raw, ok := value.(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected leaderboard member %v", value)
+	}
+
+	userID, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected leaderboard member %q: %+v", raw, err)
+	}
+
+	return uint(userID), nil
+}
@@ -0,0 +1,413 @@
+package scheduler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"gcstatus/internal/domain"
+	"log"
+	"os"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultTickInterval = 10 * time.Second
+	defaultLeaseTTL     = 30 * time.Second
+)
+
+// Task runs a scheduled job. Returned errors are recorded on the run.
+type Task func(ctx context.Context) error
+
+type job struct {
+	name     string
+	spec     string
+	schedule cron.Schedule
+	task     Task
+}
+
+// Scheduler runs the registered jobs on their schedules. Every process runs a
+// scheduler, but only the one holding the leader lease starts the due jobs and
+// the runs triggered by admins. Each run holds the lease of its job, so a job
+// never runs twice at the same time, even while the leadership changes hands.
+type Scheduler struct {
+	db    *gorm.DB
+	owner string
+	jobs  map[string]*job
+
+	leader  atomic.Bool
+	active  sync.Map
+	running sync.WaitGroup
+
+	TickInterval time.Duration
+	LeaseTTL     time.Duration
+	Now          func() time.Time
+}
+
+func NewScheduler(db *gorm.DB) *Scheduler {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "scheduler"
+	}
+
+	// The random part tells apart processes reusing a hostname and pid, such
+	// as restarted containers.
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+
+	return &Scheduler{
+		db:           db,
+		owner:        fmt.Sprintf("%s:%d:%s", hostname, os.Getpid(), hex.EncodeToString(suffix)),
+		jobs:         make(map[string]*job),
+		TickInterval: defaultTickInterval,
+		LeaseTTL:     defaultLeaseTTL,
+		Now:          time.Now,
+	}
+}
+
+// Register adds a job running on the given cron schedule, such as "@hourly"
+// or "0 3 * * *".
+func (s *Scheduler) Register(name string, spec string, task Task) error {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return fmt.Errorf("invalid schedule %q for job %s: %+v", spec, name, err)
+	}
+
+	s.jobs[name] = &job{name: name, spec: spec, schedule: schedule, task: task}
+
+	return nil
+}
+
+func (s *Scheduler) Names() []string {
+	names := make([]string, 0, len(s.jobs))
+	for name := range s.jobs {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func (s *Scheduler) IsLeader() bool {
+	return s.leader.Load()
+}
+
+// Start stores the registered jobs and runs the scheduler until the context
+// is done, then waits for the running jobs and gives up the leadership.
+func (s *Scheduler) Start(ctx context.Context) {
+	if err := s.SyncJobs(); err != nil {
+		log.Printf("Failed to sync scheduled jobs: %+v", err)
+	}
+
+	ticker := time.NewTicker(s.TickInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Tick(ctx); err != nil {
+			log.Printf("Failed to run the scheduler: %+v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			s.running.Wait()
+			if err := s.release(domain.SchedulerLeaderLease); err != nil {
+				log.Printf("Failed to release the scheduler leadership: %+v", err)
+			}
+
+			log.Println("Stopping scheduler...")
+
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SyncJobs stores the registered jobs along with their schedules. The next
+// run of a job is kept when its schedule did not change, so a run missed while
+// every process was down still starts on the next tick.
+func (s *Scheduler) SyncJobs() error {
+	now := s.Now()
+
+	for _, name := range s.Names() {
+		job := s.jobs[name]
+		next := job.schedule.Next(now)
+
+		var stored domain.ScheduledJob
+		err := s.db.Where("name = ?", name).First(&stored).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			stored = domain.ScheduledJob{Name: name, Schedule: job.spec, NextRunAt: &next}
+			if err := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&stored).Error; err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if stored.Schedule != job.spec || stored.NextRunAt == nil {
+			if err := s.db.Model(&domain.ScheduledJob{}).Where("id = ?", stored.ID).Updates(map[string]any{
+				"schedule":    job.spec,
+				"next_run_at": next,
+			}).Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Tick renews or claims the leadership and, when leading, starts the due jobs
+// and the runs triggered by admins.
+func (s *Scheduler) Tick(ctx context.Context) error {
+	leader, err := s.acquire(domain.SchedulerLeaderLease)
+	if err != nil {
+		s.leader.Store(false)
+		return err
+	}
+
+	s.leader.Store(leader)
+	if !leader || ctx.Err() != nil {
+		return nil
+	}
+
+	return errors.Join(s.startDueJobs(ctx), s.startTriggeredRuns(ctx))
+}
+
+func (s *Scheduler) startDueJobs(ctx context.Context) error {
+	now := s.Now()
+
+	var due []domain.ScheduledJob
+	if err := s.db.Where("name IN ? AND next_run_at <= ?", s.Names(), now).Find(&due).Error; err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, scheduled := range due {
+		job := s.jobs[scheduled.Name]
+
+		// Claim the run by moving the next run forward, unless another
+		// process already did.
+		result := s.db.Model(&domain.ScheduledJob{}).
+			Where("id = ? AND next_run_at = ?", scheduled.ID, scheduled.NextRunAt).
+			Update("next_run_at", job.schedule.Next(now))
+		if result.Error != nil {
+			errs = append(errs, result.Error)
+			continue
+		}
+
+		if result.RowsAffected == 0 {
+			continue
+		}
+
+		run := domain.ScheduledRun{
+			JobName: job.name,
+			Trigger: domain.ScheduledTriggerSchedule,
+			Status:  domain.ScheduledRunPending,
+		}
+
+		if err := s.db.Create(&run).Error; err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		s.start(ctx, job, run)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Scheduler) startTriggeredRuns(ctx context.Context) error {
+	var runs []domain.ScheduledRun
+	if err := s.db.Where("job_name IN ? AND status = ? AND `trigger` = ?", s.Names(), domain.ScheduledRunPending, domain.ScheduledTriggerManual).
+		Order("id ASC").
+		Find(&runs).
+		Error; err != nil {
+		return err
+	}
+
+	for _, run := range runs {
+		s.start(ctx, s.jobs[run.JobName], run)
+	}
+
+	return nil
+}
+
+// start runs the pending run in the background once its job lease is held.
+// Runs of a job already running stay pending until the next tick, unless they
+// were due on schedule, in which case they are skipped.
+func (s *Scheduler) start(ctx context.Context, job *job, run domain.ScheduledRun) {
+	lease := domain.SchedulerJobLease(job.name)
+
+	// The lease is renewed rather than refused when this process holds it, so
+	// the jobs running here are checked first.
+	if _, running := s.active.LoadOrStore(job.name, true); running {
+		if run.Trigger == domain.ScheduledTriggerSchedule {
+			s.finish(run.ID, s.Now(), errors.New("The job was still running."))
+		}
+
+		return
+	}
+
+	acquired, err := s.acquire(lease)
+	if err != nil || !acquired {
+		s.active.Delete(job.name)
+		if run.Trigger == domain.ScheduledTriggerSchedule {
+			message := "The job was still running."
+			if err != nil {
+				message = err.Error()
+			}
+
+			s.finish(run.ID, s.Now(), errors.New(message))
+		}
+
+		return
+	}
+
+	now := s.Now()
+
+	// Runs left running hold no lease anymore, so their process stopped.
+	if err := s.db.Model(&domain.ScheduledRun{}).
+		Where("job_name = ? AND status = ?", job.name, domain.ScheduledRunRunning).
+		Updates(map[string]any{
+			"status":      domain.ScheduledRunFailed,
+			"error":       "The run was interrupted.",
+			"finished_at": now,
+		}).Error; err != nil {
+		log.Printf("Failed to fail the interrupted runs of %s: %+v", job.name, err)
+	}
+
+	result := s.db.Model(&domain.ScheduledRun{}).
+		Where("id = ? AND status = ?", run.ID, domain.ScheduledRunPending).
+		Updates(map[string]any{
+			"status":     domain.ScheduledRunRunning,
+			"owner":      s.owner,
+			"started_at": now,
+		})
+	if result.Error != nil || result.RowsAffected == 0 {
+		s.active.Delete(job.name)
+		if err := s.release(lease); err != nil {
+			log.Printf("Failed to release the lease of %s: %+v", job.name, err)
+		}
+
+		return
+	}
+
+	s.running.Add(1)
+	go func() {
+		defer s.running.Done()
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		go s.keepLease(runCtx, lease)
+
+		err := s.run(runCtx, job)
+		s.finish(run.ID, now, err)
+
+		if err := s.db.Model(&domain.ScheduledJob{}).Where("name = ?", job.name).Update("last_run_at", now).Error; err != nil {
+			log.Printf("Failed to update the last run of %s: %+v", job.name, err)
+		}
+
+		if err := s.release(lease); err != nil {
+			log.Printf("Failed to release the lease of %s: %+v", job.name, err)
+		}
+
+		s.active.Delete(job.name)
+	}()
+}
+
+func (s *Scheduler) run(ctx context.Context, job *job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v\n%s", r, debug.Stack())
+		}
+	}()
+
+	return job.task(ctx)
+}
+
+func (s *Scheduler) finish(runID uint, startedAt time.Time, runErr error) {
+	finishedAt := s.Now()
+
+	updates := map[string]any{
+		"status":      domain.ScheduledRunSucceeded,
+		"finished_at": finishedAt,
+		"duration_ms": finishedAt.Sub(startedAt).Milliseconds(),
+	}
+
+	if runErr != nil {
+		updates["status"] = domain.ScheduledRunFailed
+		updates["error"] = runErr.Error()
+	}
+
+	if err := s.db.Model(&domain.ScheduledRun{}).Where("id = ?", runID).Updates(updates).Error; err != nil {
+		log.Printf("Failed to finish scheduled run %d: %+v", runID, err)
+	}
+}
+
+// keepLease renews the lease of a running job until its context is done.
+func (s *Scheduler) keepLease(ctx context.Context, name string) {
+	ticker := time.NewTicker(s.LeaseTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.acquire(name); err != nil {
+				log.Printf("Failed to renew the lease %s: %+v", name, err)
+			}
+		}
+	}
+}
+
+// acquire claims the lease, or renews it when this process already holds it,
+// telling whether it is held.
+func (s *Scheduler) acquire(name string) (bool, error) {
+	now := s.Now()
+	expiresAt := now.Add(s.LeaseTTL)
+
+	lease := domain.SchedulerLease{Name: name, Owner: s.owner, ExpiresAt: expiresAt}
+	result := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&lease)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	if result.RowsAffected == 1 {
+		return true, nil
+	}
+
+	result = s.db.Model(&domain.SchedulerLease{}).
+		Where("name = ? AND (owner = ? OR expires_at < ?)", name, s.owner, now).
+		Updates(map[string]any{
+			"owner":      s.owner,
+			"expires_at": expiresAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (s *Scheduler) release(name string) error {
+	return s.db.Model(&domain.SchedulerLease{}).
+		Where("name = ? AND owner = ?", name, s.owner).
+		Update("expires_at", s.Now()).
+		Error
+}
@@ -0,0 +1,64 @@
+package ses
+
+import (
+	"bytes"
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+	"html/template"
+)
+
+// HTML Email Template for Followed Game Notification
+const gameFollowEmailTemplate = `
+  <main style="font-family: Arial, sans-serif; background-color: #f4f4f4; padding: 20px; margin: 0;">
+    <div style="max-width: 600px; background-color: #ffffff; padding: 20px; border-radius: 5px; margin: 0 auto; box-shadow: 0 4px 6px rgba(0, 0, 0, 0.1);">
+      <h2>Hello, {{.Name}}!</h2>
+      <p>There are news about a game you follow.</p>
+      <p><strong>{{.Title}}</strong></p>
+      <p><a href="{{.URL}}" style="background-color: #ff5500; color: white; padding: 10px 20px; text-decoration: none; border-radius: 5px; display: inline-block;">Check it out</a></p>
+      <p>You are receiving this email because you follow this game.</p>
+      <p style="font-size: 0.8rem;">
+        <a href="{{.UnsubscribeEventURL}}" style="color: #888;">Stop these notifications</a> or
+        <a href="{{.UnsubscribeURL}}" style="color: #888;">unfollow this game</a>.
+      </p>
+      <div style="margin-top: 20px; color: #888; text-align: center;">
+        <p style="font-size: 1rem;">Graciously,</p>
+        <p style="font-size: 1rem; font-weight: 900;">Team GCStatus</p>
+      </div>
+    </div>
+  </main>
+`
+
+type GameFollowEmailData struct {
+	Name                string
+	Title               string
+	URL                 string
+	UnsubscribeURL      string
+	UnsubscribeEventURL string
+}
+
+func SendGameFollowEmail(user *domain.User, follow *domain.GameFollow, event string, data GameFollowEmailData, sendFunc SendEmailFunc) error {
+	fName, _ := utils.GetFirstAndLastName(user.Name)
+	data.Name = fName
+	data.URL = fmt.Sprintf("https://gcstatus.cloud%s", data.URL)
+	data.UnsubscribeURL = fmt.Sprintf("https://gcstatus.cloud/follows/unsubscribe/%s", follow.Token)
+	data.UnsubscribeEventURL = fmt.Sprintf("%s?event=%s", data.UnsubscribeURL, event)
+
+	tmpl, err := template.New("gameFollowEmail").Parse(gameFollowEmailTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse email template: %v", err)
+	}
+
+	var body bytes.Buffer
+	err = tmpl.Execute(&body, data)
+	if err != nil {
+		return fmt.Errorf("failed to execute template: %v", err)
+	}
+
+	err = sendFunc(user.Email, body.String(), data.Title)
+	if err != nil {
+		return fmt.Errorf("failed to send email: %v", err)
+	}
+
+	return nil
+}
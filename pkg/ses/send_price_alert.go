@@ -0,0 +1,63 @@
+package ses
+
+import (
+	"bytes"
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+	"html/template"
+)
+
+// HTML Email Template for Price Alert Notification
+const priceAlertEmailTemplate = `
+  <main style="font-family: Arial, sans-serif; background-color: #f4f4f4; padding: 20px; margin: 0;">
+    <div style="max-width: 600px; background-color: #ffffff; padding: 20px; border-radius: 5px; margin: 0 auto; box-shadow: 0 4px 6px rgba(0, 0, 0, 0.1);">
+      <h2>Hello, {{.Name}}!</h2>
+      <p>Good news! A game you are watching just dropped its price.</p>
+      <ul>
+        <li>Game: <strong>{{.GameTitle}}</strong></li>
+        <li>Store: <strong>{{.Store}}</strong></li>
+        <li>Price: <strong>{{.Price}}</strong></li>
+        {{if .Discount}}<li>Discount: <strong>{{.Discount}}%</strong></li>{{end}}
+      </ul>
+      <p><a href="{{.URL}}" style="background-color: #ff5500; color: white; padding: 10px 20px; text-decoration: none; border-radius: 5px; display: inline-block;">Check it on the store</a></p>
+      <p>You are receiving this email because you created a price alert for this game.</p>
+      <div style="margin-top: 20px; color: #888; text-align: center;">
+        <p style="font-size: 1rem;">Graciously,</p>
+        <p style="font-size: 1rem; font-weight: 900;">Team GCStatus</p>
+      </div>
+    </div>
+  </main>
+`
+
+type PriceAlertEmailData struct {
+	Name      string
+	GameTitle string
+	Store     string
+	Price     string
+	Discount  uint
+	URL       string
+}
+
+func SendPriceAlertEmail(user *domain.User, data PriceAlertEmailData, sendFunc SendEmailFunc) error {
+	fName, _ := utils.GetFirstAndLastName(user.Name)
+	data.Name = fName
+
+	tmpl, err := template.New("priceAlertEmail").Parse(priceAlertEmailTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse email template: %v", err)
+	}
+
+	var body bytes.Buffer
+	err = tmpl.Execute(&body, data)
+	if err != nil {
+		return fmt.Errorf("failed to execute template: %v", err)
+	}
+
+	err = sendFunc(user.Email, body.String(), fmt.Sprintf("%s is cheaper now!", data.GameTitle))
+	if err != nil {
+		return fmt.Errorf("failed to send email: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package ses
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gcstatus/pkg/worker"
+)
+
+// HandleEmailJob sends an email queued by worker.SendEmail.
+func HandleEmailJob(ctx context.Context, payload []byte) error {
+	var email worker.EmailPayload
+	if err := json.Unmarshal(payload, &email); err != nil {
+		return fmt.Errorf("invalid email payload: %+v", err)
+	}
+
+	return Send(email.Recipient, email.Body, email.Subject)
+}
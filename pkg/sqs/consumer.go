@@ -18,6 +18,12 @@ type SQSConsumer struct {
 	purchaseHandler                    *messages.PurchaseMessageHandler
 	trackProgressProfilePictureHandler *messages.TrackProgressProfilePictureHandler
 	missionCompleteHandler             *messages.MissionCompleteMessageHandler
+	trackActionProgressHandler         *messages.TrackActionProgressHandler
+	priceAlertHandler                  *messages.PriceAlertMessageHandler
+	crackStatusHandler                 *messages.CrackStatusMessageHandler
+	gameFollowHandler                  *messages.GameFollowMessageHandler
+	checkInRewardHandler               *messages.CheckInRewardMessageHandler
+	quizRewardHandler                  *messages.QuizRewardMessageHandler
 }
 
 func NewSQSConsumer(
@@ -28,7 +34,8 @@ func NewSQSConsumer(
 	notificationService *usecases.NotificationService,
 	taskService *usecases.TaskService,
 	missionService *usecases.MissionService,
-	walletService *usecases.WalletService,
+	gameFollowService *usecases.GameFollowService,
+	rewardService *usecases.RewardService,
 ) *SQSConsumer {
 	purchaseHandler := messages.NewPurchaseMessageHandler(
 		userService,
@@ -41,11 +48,37 @@ func NewSQSConsumer(
 	)
 
 	missionCompleteHandler := messages.NewMissionCompleteMessageHandler(
-		walletService,
-		userService,
-		taskService,
 		missionService,
-		transactionService,
+		rewardService,
+		notificationService,
+	)
+
+	trackActionProgressHandler := messages.NewTrackActionProgressHandler(
+		taskService,
+	)
+
+	priceAlertHandler := messages.NewPriceAlertMessageHandler(
+		userService,
+		notificationService,
+	)
+
+	crackStatusHandler := messages.NewCrackStatusMessageHandler(
+		gameFollowService,
+		notificationService,
+	)
+
+	gameFollowHandler := messages.NewGameFollowMessageHandler(
+		gameFollowService,
+		notificationService,
+	)
+
+	checkInRewardHandler := messages.NewCheckInRewardMessageHandler(
+		rewardService,
+		notificationService,
+	)
+
+	quizRewardHandler := messages.NewQuizRewardMessageHandler(
+		rewardService,
 		notificationService,
 	)
 
@@ -55,6 +88,12 @@ func NewSQSConsumer(
 		purchaseHandler:                    purchaseHandler,
 		trackProgressProfilePictureHandler: trackProgressProfilePictureHandler,
 		missionCompleteHandler:             missionCompleteHandler,
+		trackActionProgressHandler:         trackActionProgressHandler,
+		priceAlertHandler:                  priceAlertHandler,
+		crackStatusHandler:                 crackStatusHandler,
+		gameFollowHandler:                  gameFollowHandler,
+		checkInRewardHandler:               checkInRewardHandler,
+		quizRewardHandler:                  quizRewardHandler,
 	}
 }
 
@@ -105,6 +144,18 @@ func (c *SQSConsumer) processMessage(ctx context.Context, message types.Message)
 		c.trackProgressProfilePictureHandler.HandleTrackProgressProfilePictureMessage(ctx, message)
 	case "CompleteMission":
 		c.missionCompleteHandler.HandleCompleteMissionMessage(ctx, message)
+	case "TrackActionProgress":
+		c.trackActionProgressHandler.HandleTrackActionProgressMessage(ctx, message)
+	case "PriceAlertTriggered":
+		c.priceAlertHandler.HandlePriceAlertMessage(ctx, message)
+	case "CrackStatusChanged":
+		c.crackStatusHandler.HandleCrackStatusMessage(ctx, message)
+	case "GameFollowEvent":
+		c.gameFollowHandler.HandleGameFollowMessage(ctx, message)
+	case "CheckInReward":
+		c.checkInRewardHandler.HandleCheckInRewardMessage(ctx, message)
+	case "QuizReward":
+		c.quizRewardHandler.HandleQuizRewardMessage(ctx, message)
 	default:
 		log.Printf("Unknown message type: %s", messageType.Type)
 	}
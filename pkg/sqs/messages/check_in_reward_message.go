@@ -0,0 +1,93 @@
+package messages
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/usecases"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+type CheckInRewardMessageHandler struct {
+	rewardService       *usecases.RewardService
+	notificationService *usecases.NotificationService
+}
+
+func NewCheckInRewardMessageHandler(
+	rewardService *usecases.RewardService,
+	notificationService *usecases.NotificationService,
+) *CheckInRewardMessageHandler {
+	return &CheckInRewardMessageHandler{
+		rewardService:       rewardService,
+		notificationService: notificationService,
+	}
+}
+
+func (h *CheckInRewardMessageHandler) HandleCheckInRewardMessage(ctx context.Context, message types.Message) {
+	var messageWrapper struct {
+		Type string          `json:"type"`
+		Body json.RawMessage `json:"body"`
+	}
+
+	if err := json.Unmarshal([]byte(*message.Body), &messageWrapper); err != nil {
+		log.Printf("Error unmarshalling main message wrapper: %v", err)
+		return
+	}
+
+	var rewardMsg struct {
+		UserID  uint            `json:"user_id"`
+		Streak  uint            `json:"streak"`
+		Key     string          `json:"key"`
+		Rewards []domain.Reward `json:"rewards"`
+	}
+
+	if err := json.Unmarshal(messageWrapper.Body, &rewardMsg); err != nil {
+		log.Printf("Error unmarshalling check-in reward body: %v", err)
+		return
+	}
+
+	grant := domain.RewardGrant{
+		UserID:    rewardMsg.UserID,
+		Key:       rewardMsg.Key,
+		Reason:    fmt.Sprintf("a %d day check-in streak", rewardMsg.Streak),
+		ActionKey: domain.CheckInRequirementKey,
+	}
+
+	granted, err := h.rewardService.GrantRewards(grant, rewardMsg.Rewards)
+	if err != nil {
+		log.Printf("failed to grant the check-in rewards to user %+v: %+v", rewardMsg.UserID, err)
+		return
+	}
+
+	if granted.Duplicate {
+		return
+	}
+
+	h.createCheckInRewardNotification(rewardMsg.UserID, rewardMsg.Streak)
+}
+
+func (h *CheckInRewardMessageHandler) createCheckInRewardNotification(userID uint, streak uint) {
+	notificationContent := &domain.NotificationData{
+		Title:     fmt.Sprintf("You have checked in %d days in a row and earned your rewards!", streak),
+		ActionUrl: "/profile/?section=check-ins",
+		Icon:      "FaCalendarCheck",
+	}
+
+	dataJson, err := json.Marshal(notificationContent)
+	if err != nil {
+		log.Printf("Failed to marshal notification content: %+v", err)
+	}
+
+	notification := &domain.Notification{
+		Type:   "NewCheckInReward",
+		Data:   string(dataJson),
+		UserID: userID,
+	}
+
+	if err := h.notificationService.CreateNotification(notification); err != nil {
+		log.Printf("Failed to save the check-in reward notification: %+v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package messages
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/usecases"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+type CrackStatusMessageHandler struct {
+	gameFollowService   *usecases.GameFollowService
+	notificationService *usecases.NotificationService
+}
+
+func NewCrackStatusMessageHandler(
+	gameFollowService *usecases.GameFollowService,
+	notificationService *usecases.NotificationService,
+) *CrackStatusMessageHandler {
+	return &CrackStatusMessageHandler{
+		gameFollowService:   gameFollowService,
+		notificationService: notificationService,
+	}
+}
+
+func (h *CrackStatusMessageHandler) HandleCrackStatusMessage(ctx context.Context, message types.Message) {
+	var messageWrapper struct {
+		Type string          `json:"type"`
+		Body json.RawMessage `json:"body"`
+	}
+
+	if err := json.Unmarshal([]byte(*message.Body), &messageWrapper); err != nil {
+		log.Printf("Error unmarshalling main message wrapper: %v", err)
+		return
+	}
+
+	var crackStatusMsg struct {
+		GameID         uint   `json:"game_id"`
+		GameTitle      string `json:"game_title"`
+		GameSlug       string `json:"game_slug"`
+		Status         string `json:"status"`
+		PreviousStatus string `json:"previous_status"`
+		DaysToCrack    *uint  `json:"days_to_crack"`
+	}
+
+	if err := json.Unmarshal(messageWrapper.Body, &crackStatusMsg); err != nil {
+		log.Printf("Error unmarshalling crack status body: %v", err)
+		return
+	}
+
+	notificationContent := &domain.NotificationData{
+		Title:     domain.CrackStatusHeadline(crackStatusMsg.GameTitle, crackStatusMsg.Status, crackStatusMsg.PreviousStatus, crackStatusMsg.DaysToCrack),
+		ActionUrl: fmt.Sprintf("/games/%s", crackStatusMsg.GameSlug),
+		Icon:      "CiUnlock",
+	}
+
+	if err := notifyGameFollowers(h.gameFollowService, h.notificationService, crackStatusMsg.GameID, domain.FollowCrackEvent, "CrackStatusChanged", notificationContent); err != nil {
+		log.Printf("Failed to notify the game followers: %+v", err)
+	}
+}
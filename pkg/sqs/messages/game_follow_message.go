@@ -0,0 +1,122 @@
+package messages
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/usecases"
+	"gcstatus/pkg/ses"
+	"gcstatus/pkg/worker"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+var gameFollowEventIcons = map[string]string{
+	domain.FollowReleaseEvent: "CiCalendar",
+	domain.FollowCrackEvent:   "CiUnlock",
+	domain.FollowPriceEvent:   "CiDiscount1",
+	domain.FollowDLCEvent:     "CiCirclePlus",
+}
+
+type GameFollowMessageHandler struct {
+	gameFollowService   *usecases.GameFollowService
+	notificationService *usecases.NotificationService
+}
+
+func NewGameFollowMessageHandler(
+	gameFollowService *usecases.GameFollowService,
+	notificationService *usecases.NotificationService,
+) *GameFollowMessageHandler {
+	return &GameFollowMessageHandler{
+		gameFollowService:   gameFollowService,
+		notificationService: notificationService,
+	}
+}
+
+func (h *GameFollowMessageHandler) HandleGameFollowMessage(ctx context.Context, message types.Message) {
+	var messageWrapper struct {
+		Type string          `json:"type"`
+		Body json.RawMessage `json:"body"`
+	}
+
+	if err := json.Unmarshal([]byte(*message.Body), &messageWrapper); err != nil {
+		log.Printf("Error unmarshalling main message wrapper: %v", err)
+		return
+	}
+
+	if err := h.HandleGameFollowJob(ctx, messageWrapper.Body); err != nil {
+		log.Printf("Failed to handle game follow message: %+v", err)
+	}
+}
+
+// HandleGameFollowJob notifies the followers of a game from the body of a
+// game follow event, be it queued as a job or as a message.
+func (h *GameFollowMessageHandler) HandleGameFollowJob(ctx context.Context, payload []byte) error {
+	var gameFollowMsg struct {
+		GameID    uint   `json:"game_id"`
+		Event     string `json:"event"`
+		Title     string `json:"title"`
+		ActionUrl string `json:"action_url"`
+	}
+
+	if err := json.Unmarshal(payload, &gameFollowMsg); err != nil {
+		return fmt.Errorf("error unmarshalling game follow body: %v", err)
+	}
+
+	notificationContent := &domain.NotificationData{
+		Title:     gameFollowMsg.Title,
+		ActionUrl: gameFollowMsg.ActionUrl,
+		Icon:      gameFollowEventIcons[gameFollowMsg.Event],
+	}
+
+	return notifyGameFollowers(h.gameFollowService, h.notificationService, gameFollowMsg.GameID, gameFollowMsg.Event, "GameFollowEvent", notificationContent)
+}
+
+// notifyGameFollowers notifies and emails every follower of the game that
+// opted in the given event. Emails are queued, so only failing to find the
+// followers fails the whole fan-out.
+func notifyGameFollowers(
+	gameFollowService *usecases.GameFollowService,
+	notificationService *usecases.NotificationService,
+	gameID uint,
+	event string,
+	notificationType string,
+	notificationContent *domain.NotificationData,
+) error {
+	follows, err := gameFollowService.FindFollowersForEvent(gameID, event)
+	if err != nil {
+		return fmt.Errorf("failed to find game followers: %+v", err)
+	}
+
+	dataJson, err := json.Marshal(notificationContent)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification content: %+v", err)
+	}
+
+	for i := range follows {
+		follow := &follows[i]
+
+		notification := &domain.Notification{
+			Type:   notificationType,
+			Data:   string(dataJson),
+			UserID: follow.UserID,
+		}
+
+		if err := notificationService.CreateNotification(notification); err != nil {
+			log.Printf("Failed to save the game follow notification: %+v", err)
+		}
+
+		emailData := ses.GameFollowEmailData{
+			Title: notificationContent.Title,
+			URL:   notificationContent.ActionUrl,
+		}
+
+		if err := ses.SendGameFollowEmail(&follow.User, follow, event, emailData, worker.SendEmail); err != nil {
+			log.Printf("Failed to send game follow email: %+v", err)
+		}
+	}
+
+	return nil
+}
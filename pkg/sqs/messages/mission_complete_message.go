@@ -8,32 +8,24 @@ import (
 	"gcstatus/internal/usecases"
 	"log"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 )
 
 type MissionCompleteMessageHandler struct {
-	walletService       *usecases.WalletService
-	userService         *usecases.UserService
-	taskService         *usecases.TaskService
 	missionService      *usecases.MissionService
-	transactionService  *usecases.TransactionService
+	rewardService       *usecases.RewardService
 	notificationService *usecases.NotificationService
 }
 
 func NewMissionCompleteMessageHandler(
-	walletService *usecases.WalletService,
-	userService *usecases.UserService,
-	taskService *usecases.TaskService,
 	missionService *usecases.MissionService,
-	transactionService *usecases.TransactionService,
+	rewardService *usecases.RewardService,
 	notificationService *usecases.NotificationService,
 ) *MissionCompleteMessageHandler {
 	return &MissionCompleteMessageHandler{
-		walletService:       walletService,
-		userService:         userService,
-		taskService:         taskService,
 		missionService:      missionService,
-		transactionService:  transactionService,
+		rewardService:       rewardService,
 		notificationService: notificationService,
 	}
 }
@@ -61,66 +53,31 @@ func (h *MissionCompleteMessageHandler) HandleCompleteMissionMessage(ctx context
 
 	mission, err := h.missionService.FindByID(completeMissionMsg.MissionID)
 	if err != nil {
-		log.Fatalf("mission not found: %+v", err)
-	}
-
-	for _, reward := range mission.Rewards {
-		if reward.RewardableType == "titles" {
-			if err := h.taskService.AwardTitleToUser(completeMissionMsg.UserID, reward.RewardableID); err != nil {
-				log.Fatalf("error awarding title: %+v", err)
-			}
-
-			h.createRewardNotification(*mission, completeMissionMsg.UserID)
-		}
-	}
-
-	if err := h.walletService.Add(completeMissionMsg.UserID, mission.Coins); err != nil {
-		log.Fatalf("failed to add coins to user wallet %+v. Error: %+s", completeMissionMsg.UserID, err.Error())
-	}
-
-	h.createTransactionForMissionCoins(*mission, completeMissionMsg.UserID)
-
-	h.createMissionCompleteNotification(*mission, completeMissionMsg.UserID)
-
-	if err := h.userService.AddExperience(completeMissionMsg.UserID, mission.Experience, h.taskService.AwardTitleToUser); err != nil {
-		log.Fatalf("failed to experience to user %+v. Error: %+s", completeMissionMsg.UserID, err.Error())
-	}
-}
-
-func (h *MissionCompleteMessageHandler) createTransactionForMissionCoins(mission domain.Mission, userID uint) {
-	transaction := &domain.Transaction{
-		Amount:            mission.Coins,
-		Description:       fmt.Sprintf("Received coins from mission %s.", mission.Mission),
-		UserID:            userID,
-		TransactionTypeID: domain.AdditionTransactionTypeID,
-	}
-
-	if err := h.transactionService.CreateTransaction(transaction); err != nil {
-		log.Printf("Failed to create a transaction for user title purchase: %+v", err)
+		log.Printf("mission not found: %+v", err)
+		return
 	}
-}
 
-func (h *MissionCompleteMessageHandler) createRewardNotification(mission domain.Mission, userID uint) {
-	notificationContent := &domain.NotificationData{
-		Title:     fmt.Sprintf("You have achieved a new title from mission: %s", mission.Mission),
-		ActionUrl: "/profile/?section=missions",
-		Icon:      "FaAward",
+	// The message ID is kept when the queue delivers the message again, so
+	// the rewards of a completion are only given once.
+	grant := domain.RewardGrant{
+		UserID:    completeMissionMsg.UserID,
+		Key:       fmt.Sprintf("missions:%d:%s", mission.ID, aws.ToString(message.MessageId)),
+		Reason:    fmt.Sprintf("mission %s", mission.Mission),
+		ActionKey: domain.CompleteMissionActionKey,
 	}
+	rewards := append(domain.AmountRewards(mission.Coins, mission.Experience), mission.Rewards...)
 
-	dataJson, err := json.Marshal(notificationContent)
+	granted, err := h.rewardService.GrantRewards(grant, rewards)
 	if err != nil {
-		log.Printf("Failed to marshal notification content: %+v", err)
+		log.Printf("failed to grant the rewards of mission %d to user %d: %+v", mission.ID, completeMissionMsg.UserID, err)
+		return
 	}
 
-	notification := &domain.Notification{
-		Type:   "NewTitleAchieved",
-		Data:   string(dataJson),
-		UserID: userID,
+	if granted.Duplicate {
+		return
 	}
 
-	if err := h.notificationService.CreateNotification(notification); err != nil {
-		log.Printf("Failed to save the reward title notification: %+v", err)
-	}
+	h.createMissionCompleteNotification(*mission, completeMissionMsg.UserID)
 }
 
 func (h *MissionCompleteMessageHandler) createMissionCompleteNotification(mission domain.Mission, userID uint) {
@@ -0,0 +1,99 @@
+package messages
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/usecases"
+	"gcstatus/pkg/ses"
+	"gcstatus/pkg/worker"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+type PriceAlertMessageHandler struct {
+	userService         *usecases.UserService
+	notificationService *usecases.NotificationService
+}
+
+func NewPriceAlertMessageHandler(
+	userService *usecases.UserService,
+	notificationService *usecases.NotificationService,
+) *PriceAlertMessageHandler {
+	return &PriceAlertMessageHandler{
+		userService:         userService,
+		notificationService: notificationService,
+	}
+}
+
+func (h *PriceAlertMessageHandler) HandlePriceAlertMessage(ctx context.Context, message types.Message) {
+	var messageWrapper struct {
+		Type string          `json:"type"`
+		Body json.RawMessage `json:"body"`
+	}
+
+	if err := json.Unmarshal([]byte(*message.Body), &messageWrapper); err != nil {
+		log.Printf("Error unmarshalling main message wrapper: %v", err)
+		return
+	}
+
+	var priceAlertMsg struct {
+		UserID       uint   `json:"user_id"`
+		GameTitle    string `json:"game_title"`
+		GameSlug     string `json:"game_slug"`
+		Store        string `json:"store"`
+		URL          string `json:"url"`
+		Price        uint   `json:"price"`
+		InitialPrice uint   `json:"initial_price"`
+		Discount     uint   `json:"discount"`
+	}
+
+	if err := json.Unmarshal(messageWrapper.Body, &priceAlertMsg); err != nil {
+		log.Printf("Error unmarshalling price alert body: %v", err)
+		return
+	}
+
+	price := fmt.Sprintf("%.2f", float64(priceAlertMsg.Price)/100)
+
+	notificationContent := &domain.NotificationData{
+		Title:     fmt.Sprintf("%s is now %s on %s!", priceAlertMsg.GameTitle, price, priceAlertMsg.Store),
+		ActionUrl: fmt.Sprintf("/games/%s", priceAlertMsg.GameSlug),
+		Icon:      "CiDiscount1",
+	}
+
+	dataJson, err := json.Marshal(notificationContent)
+	if err != nil {
+		log.Printf("Failed to marshal notification content: %+v", err)
+	}
+
+	notification := &domain.Notification{
+		Type:   "PriceAlert",
+		Data:   string(dataJson),
+		UserID: priceAlertMsg.UserID,
+	}
+
+	if err := h.notificationService.CreateNotification(notification); err != nil {
+		log.Printf("Failed to save the price alert notification: %+v", err)
+	}
+
+	user, err := h.userService.GetUserByID(priceAlertMsg.UserID)
+	if err != nil {
+		log.Printf("Failed to get user by id: %+v", err)
+		return
+	}
+
+	emailData := ses.PriceAlertEmailData{
+		GameTitle: priceAlertMsg.GameTitle,
+		Store:     priceAlertMsg.Store,
+		Price:     price,
+		Discount:  priceAlertMsg.Discount,
+		URL:       priceAlertMsg.URL,
+	}
+
+	if err := ses.SendPriceAlertEmail(user, emailData, worker.SendEmail); err != nil {
+		log.Printf("Failed to send price alert email: %+v", err)
+		return
+	}
+}
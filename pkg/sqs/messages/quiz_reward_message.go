@@ -0,0 +1,101 @@
+package messages
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/usecases"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+type QuizRewardMessageHandler struct {
+	rewardService       *usecases.RewardService
+	notificationService *usecases.NotificationService
+}
+
+func NewQuizRewardMessageHandler(
+	rewardService *usecases.RewardService,
+	notificationService *usecases.NotificationService,
+) *QuizRewardMessageHandler {
+	return &QuizRewardMessageHandler{
+		rewardService:       rewardService,
+		notificationService: notificationService,
+	}
+}
+
+func (h *QuizRewardMessageHandler) HandleQuizRewardMessage(ctx context.Context, message types.Message) {
+	var messageWrapper struct {
+		Type string          `json:"type"`
+		Body json.RawMessage `json:"body"`
+	}
+
+	if err := json.Unmarshal([]byte(*message.Body), &messageWrapper); err != nil {
+		log.Printf("Error unmarshalling main message wrapper: %v", err)
+		return
+	}
+
+	var rewardMsg struct {
+		UserID  uint            `json:"user_id"`
+		QuizID  uint            `json:"quiz_id"`
+		Kind    string          `json:"kind"`
+		Title   string          `json:"title"`
+		Score   uint            `json:"score"`
+		Key     string          `json:"key"`
+		Rewards []domain.Reward `json:"rewards"`
+	}
+
+	if err := json.Unmarshal(messageWrapper.Body, &rewardMsg); err != nil {
+		log.Printf("Error unmarshalling quiz reward body: %v", err)
+		return
+	}
+
+	grant := domain.RewardGrant{
+		UserID:    rewardMsg.UserID,
+		Key:       rewardMsg.Key,
+		Reason:    fmt.Sprintf("the %s %q", rewardMsg.Kind, rewardMsg.Title),
+		ActionKey: domain.AnswerQuizActionKey,
+	}
+
+	granted, err := h.rewardService.GrantRewards(grant, rewardMsg.Rewards)
+	if err != nil {
+		log.Printf("failed to grant the quiz rewards to user %+v: %+v", rewardMsg.UserID, err)
+		return
+	}
+
+	if granted.Duplicate {
+		return
+	}
+
+	h.createQuizRewardNotification(rewardMsg.UserID, rewardMsg.QuizID, rewardMsg.Kind, rewardMsg.Title, rewardMsg.Score)
+}
+
+func (h *QuizRewardMessageHandler) createQuizRewardNotification(userID uint, quizID uint, kind string, title string, score uint) {
+	notificationTitle := fmt.Sprintf("You scored %d%% on the quiz %q and earned your rewards!", score, title)
+	if kind == domain.QuizKindPoll {
+		notificationTitle = fmt.Sprintf("You took part in the poll %q and earned your rewards!", title)
+	}
+
+	notificationContent := &domain.NotificationData{
+		Title:     notificationTitle,
+		ActionUrl: fmt.Sprintf("/quizzes/%d", quizID),
+		Icon:      "FaQuestionCircle",
+	}
+
+	dataJson, err := json.Marshal(notificationContent)
+	if err != nil {
+		log.Printf("Failed to marshal notification content: %+v", err)
+	}
+
+	notification := &domain.Notification{
+		Type:   "NewQuizReward",
+		Data:   string(dataJson),
+		UserID: userID,
+	}
+
+	if err := h.notificationService.CreateNotification(notification); err != nil {
+		log.Printf("Failed to save the quiz reward notification: %+v", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"gcstatus/internal/domain"
 	"gcstatus/internal/usecases"
 	"gcstatus/pkg/ses"
+	"gcstatus/pkg/worker"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
@@ -91,7 +92,7 @@ func (h *PurchaseMessageHandler) HandlePurchaseMessage(ctx context.Context, mess
 		return
 	}
 
-	if err := ses.SendTransactionEmail(user, transaction, ses.Send); err != nil {
+	if err := ses.SendTransactionEmail(user, transaction, worker.SendEmail); err != nil {
 		log.Printf("Failed to send transaction email: %+v", err)
 		return
 	}
@@ -0,0 +1,66 @@
+package messages
+
+import (
+	"context"
+	"encoding/json"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/usecases"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+type TrackActionProgressHandler struct {
+	taskService *usecases.TaskService
+}
+
+func NewTrackActionProgressHandler(
+	taskService *usecases.TaskService,
+) *TrackActionProgressHandler {
+	return &TrackActionProgressHandler{
+		taskService: taskService,
+	}
+}
+
+func (h *TrackActionProgressHandler) HandleTrackActionProgressMessage(ctx context.Context, message types.Message) {
+	var messageWrapper struct {
+		Type string          `json:"type"`
+		Body json.RawMessage `json:"body"`
+	}
+
+	if err := json.Unmarshal([]byte(*message.Body), &messageWrapper); err != nil {
+		log.Printf("Error unmarshalling main message wrapper: %v", err)
+		return
+	}
+
+	var trackMsg struct {
+		UserID     uint       `json:"user_id"`
+		ActionKey  string     `json:"action_key"`
+		Increment  int        `json:"increment"`
+		TargetType *string    `json:"target_type"`
+		TargetID   *uint      `json:"target_id"`
+		OccurredAt *time.Time `json:"occurred_at"`
+	}
+
+	if err := json.Unmarshal(messageWrapper.Body, &trackMsg); err != nil {
+		log.Printf("Error unmarshalling track action progress body: %v", err)
+		return
+	}
+
+	action := domain.UserAction{
+		UserID:     trackMsg.UserID,
+		Key:        trackMsg.ActionKey,
+		Increment:  trackMsg.Increment,
+		TargetType: trackMsg.TargetType,
+		TargetID:   trackMsg.TargetID,
+	}
+
+	if trackMsg.OccurredAt != nil {
+		action.OccurredAt = *trackMsg.OccurredAt
+	}
+
+	if err := h.taskService.TrackAction(action); err != nil {
+		log.Printf("failed to track %s progress for user %+v: %+v", trackMsg.ActionKey, trackMsg.UserID, err)
+	}
+}
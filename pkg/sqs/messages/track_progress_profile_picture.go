@@ -43,11 +43,10 @@ func (h *TrackProgressProfilePictureHandler) HandleTrackProgressProfilePictureMe
 		return
 	}
 
-	if err := h.taskService.TrackTitleProgress(trackMsg.UserID, domain.ProfilePictureTitleRequirementKey, 1); err != nil {
-		log.Fatalf("failed to track progress for user on title %+v. Error: %+s", trackMsg.UserID, err.Error())
-	}
-
-	if err := h.taskService.TrackMissionProgress(trackMsg.UserID, domain.ProfilePictureTitleRequirementKey, 1); err != nil {
-		log.Fatalf("failed to track progress for user on mission %+v. Error: %+s", trackMsg.UserID, err.Error())
+	if err := h.taskService.TrackAction(domain.UserAction{
+		UserID: trackMsg.UserID,
+		Key:    domain.ProfilePictureTitleRequirementKey,
+	}); err != nil {
+		log.Fatalf("failed to track progress for user %+v. Error: %+s", trackMsg.UserID, err.Error())
 	}
 }
@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"gcstatus/internal/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GlobalDispatcher persists the jobs dispatched by handlers and services that
+// are not wired with a database connection.
+var GlobalDispatcher *Dispatcher
+
+type Dispatcher struct {
+	db *gorm.DB
+}
+
+func NewDispatcher(db *gorm.DB) *Dispatcher {
+	return &Dispatcher{db: db}
+}
+
+// Dispatch stores a pending job of the given type, the payload being
+// serialized to JSON for its handler.
+func (d *Dispatcher) Dispatch(jobType string, payload any) (*domain.Job, error) {
+	return d.DispatchAt(jobType, payload, time.Now())
+}
+
+// DispatchAt stores a pending job that will not run before the given time.
+func (d *Dispatcher) DispatchAt(jobType string, payload any, availableAt time.Time) (*domain.Job, error) {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize %s job payload to JSON: %+v", jobType, err)
+	}
+
+	job := domain.Job{
+		Type:        jobType,
+		Payload:     string(payloadJSON),
+		Status:      domain.JobStatusPending,
+		MaxAttempts: domain.DefaultJobMaxAttempts,
+		AvailableAt: availableAt,
+	}
+
+	if err := d.db.Create(&job).Error; err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// Dispatch stores a pending job through the global dispatcher.
+func Dispatch(jobType string, payload any) (*domain.Job, error) {
+	if GlobalDispatcher == nil {
+		return nil, fmt.Errorf("no job dispatcher configured to dispatch %s", jobType)
+	}
+
+	return GlobalDispatcher.Dispatch(jobType, payload)
+}
+
+type EmailPayload struct {
+	Recipient string `json:"recipient"`
+	Subject   string `json:"subject"`
+	Body      string `json:"body"`
+}
+
+// SendEmail queues the email instead of sending it right away, so it is
+// retried when the mail provider fails. It matches ses.SendEmailFunc.
+func SendEmail(recipient, body, subject string) error {
+	_, err := Dispatch(domain.JobTypeSendEmail, EmailPayload{
+		Recipient: recipient,
+		Subject:   subject,
+		Body:      body,
+	})
+
+	return err
+}
@@ -0,0 +1,300 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gcstatus/internal/domain"
+	"log"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultPollInterval        = 2 * time.Second
+	defaultRetryDelay          = 30 * time.Second
+	defaultLeaseTTL            = time.Minute
+	defaultCancelCheckInterval = 5 * time.Second
+)
+
+// Handler runs a job from its JSON payload. Returned errors are retried until
+// the job runs out of attempts.
+type Handler func(ctx context.Context, payload []byte) error
+
+// Pool runs the pending jobs stored on the database with a fixed number of
+// workers. Jobs are claimed with a conditional update, so several instances
+// can share the same table without running a job twice. A running job holds a
+// lease renewed by its worker, and only jobs whose lease expired are sent back
+// to the queue.
+type Pool struct {
+	db       *gorm.DB
+	workers  int
+	handlers map[string]Handler
+
+	PollInterval        time.Duration
+	RetryDelay          time.Duration
+	LeaseTTL            time.Duration
+	CancelCheckInterval time.Duration
+	Now                 func() time.Time
+}
+
+func NewPool(db *gorm.DB, workers int) *Pool {
+	if workers < 1 {
+		workers = 1
+	}
+
+	return &Pool{
+		db:                  db,
+		workers:             workers,
+		handlers:            make(map[string]Handler),
+		PollInterval:        defaultPollInterval,
+		RetryDelay:          defaultRetryDelay,
+		LeaseTTL:            defaultLeaseTTL,
+		CancelCheckInterval: defaultCancelCheckInterval,
+		Now:                 time.Now,
+	}
+}
+
+func (p *Pool) Register(jobType string, handler Handler) {
+	p.handlers[jobType] = handler
+}
+
+func (p *Pool) Types() []string {
+	types := make([]string, 0, len(p.handlers))
+	for jobType := range p.handlers {
+		types = append(types, jobType)
+	}
+
+	sort.Strings(types)
+
+	return types
+}
+
+// Start runs the workers until the context is done, requeueing on the way
+// the jobs left running by stopped processes.
+func (p *Pool) Start(ctx context.Context) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		p.requeueExpired(ctx)
+	}()
+
+	for i := 0; i < p.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.work(ctx)
+		}()
+	}
+
+	wg.Wait()
+	log.Println("Stopping job workers...")
+}
+
+func (p *Pool) work(ctx context.Context) {
+	for {
+		ran, err := p.RunNext(ctx)
+		if err != nil {
+			log.Printf("Failed to run the next job: %+v", err)
+		}
+
+		if ran && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.PollInterval):
+		}
+	}
+}
+
+// requeueExpired requeues the stale jobs right away and then once per lease
+// until the context is done.
+func (p *Pool) requeueExpired(ctx context.Context) {
+	ticker := time.NewTicker(p.LeaseTTL)
+	defer ticker.Stop()
+
+	for {
+		if err := p.RequeueStale(); err != nil {
+			log.Printf("Failed to requeue stale jobs: %+v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RequeueStale sends the running jobs whose lease expired back to the queue.
+// Their worker stopped renewing it, so its process is gone.
+func (p *Pool) RequeueStale() error {
+	now := p.Now()
+
+	return p.db.Model(&domain.Job{}).
+		Where("status = ? AND locked_until < ?", domain.JobStatusRunning, now).
+		Updates(map[string]any{
+			"status":       domain.JobStatusPending,
+			"available_at": now,
+			"locked_until": nil,
+		}).
+		Error
+}
+
+// RunNext claims and runs the next available job, telling whether one was
+// found.
+func (p *Pool) RunNext(ctx context.Context) (bool, error) {
+	if ctx.Err() != nil || len(p.handlers) == 0 {
+		return false, nil
+	}
+
+	job, err := p.claim()
+	if err != nil || job == nil {
+		return false, err
+	}
+
+	runErr := p.run(ctx, job)
+
+	return true, p.finish(job, runErr)
+}
+
+func (p *Pool) claim() (*domain.Job, error) {
+	now := p.Now()
+	lockedUntil := now.Add(p.LeaseTTL)
+
+	var job domain.Job
+	if err := p.db.Where("status = ? AND available_at <= ? AND type IN ?", domain.JobStatusPending, now, p.Types()).
+		Order("available_at ASC, id ASC").
+		First(&job).
+		Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	result := p.db.Model(&domain.Job{}).
+		Where("id = ? AND status = ?", job.ID, domain.JobStatusPending).
+		Updates(map[string]any{
+			"status":       domain.JobStatusRunning,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"started_at":   now,
+			"locked_until": lockedUntil,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	// Another worker claimed the job first.
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	job.Status = domain.JobStatusRunning
+	job.Attempts++
+	job.StartedAt = &now
+	job.LockedUntil = &lockedUntil
+
+	return &job, nil
+}
+
+func (p *Pool) run(ctx context.Context, job *domain.Job) (err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go p.watchCancellation(ctx, cancel, job.ID)
+	go p.keepLease(ctx, job.ID)
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v\n%s", r, debug.Stack())
+		}
+	}()
+
+	return p.handlers[job.Type](ctx, []byte(job.Payload))
+}
+
+// watchCancellation cancels the context of a running job once an admin
+// cancels it.
+func (p *Pool) watchCancellation(ctx context.Context, cancel context.CancelFunc, jobID uint) {
+	ticker := time.NewTicker(p.CancelCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			var status string
+			if err := p.db.Model(&domain.Job{}).Where("id = ?", jobID).Pluck("status", &status).Error; err == nil && status == domain.JobStatusCancelled {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// keepLease renews the lease of a running job until its context is done.
+func (p *Pool) keepLease(ctx context.Context, jobID uint) {
+	ticker := time.NewTicker(p.LeaseTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.db.Model(&domain.Job{}).
+				Where("id = ? AND status = ?", jobID, domain.JobStatusRunning).
+				Update("locked_until", p.Now().Add(p.LeaseTTL)).
+				Error; err != nil {
+				log.Printf("Failed to renew the lease of job %d: %+v", jobID, err)
+			}
+		}
+	}
+}
+
+// finish records the outcome of a job. Jobs cancelled while running keep
+// their cancelled status.
+func (p *Pool) finish(job *domain.Job, runErr error) error {
+	now := p.Now()
+	updates := map[string]any{
+		"status":       domain.JobStatusSucceeded,
+		"error":        nil,
+		"finished_at":  now,
+		"locked_until": nil,
+	}
+
+	if runErr != nil {
+		message := runErr.Error()
+		updates["error"] = message
+
+		if job.Attempts >= job.MaxAttempts {
+			updates["status"] = domain.JobStatusFailed
+			log.Printf("Job %d (%s) failed after %d attempts: %s", job.ID, job.Type, job.Attempts, message)
+		} else {
+			updates["status"] = domain.JobStatusPending
+			updates["finished_at"] = nil
+			updates["available_at"] = now.Add(p.backoff(job.Attempts))
+		}
+	}
+
+	return p.db.Model(&domain.Job{}).
+		Where("id = ? AND status = ?", job.ID, domain.JobStatusRunning).
+		Updates(updates).
+		Error
+}
+
+// backoff grows quadratically with the attempts already made.
+func (p *Pool) backoff(attempts uint) time.Duration {
+	return time.Duration(attempts*attempts) * p.RetryDelay
+}
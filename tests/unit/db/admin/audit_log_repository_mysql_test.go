@@ -0,0 +1,87 @@
+package tests
+
+import (
+	db_admin "gcstatus/internal/adapters/db/admin"
+	ports_admin "gcstatus/internal/ports/admin"
+	testutils "gcstatus/tests/utils"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminAuditLogRepositoryMySQL_GetAll(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		filters      ports_admin.AuditLogFilters
+		mockBehavior func(mock sqlmock.Sqlmock)
+		expectedLen  int
+	}{
+		"without filters": {
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `audit_logs` WHERE `audit_logs`.`deleted_at` IS NULL")).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(30))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `audit_logs` WHERE `audit_logs`.`deleted_at` IS NULL ORDER BY id DESC LIMIT ? OFFSET ?")).
+					WithArgs(25, 25).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "action"}).
+						AddRow(5, "PUT /admin/games/:id").
+						AddRow(4, "POST /admin/games"))
+			},
+			expectedLen: 2,
+		},
+		"filtered by actor, target and period": {
+			filters: ports_admin.AuditLogFilters{UserID: 1, TargetType: "games", TargetID: 7, From: &from, To: &to},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `audit_logs` WHERE user_id = ? AND target_type = ? AND target_id = ? AND created_at >= ? AND created_at < ? AND `audit_logs`.`deleted_at` IS NULL")).
+					WithArgs(1, "games", 7, from, to.AddDate(0, 0, 1)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `audit_logs` WHERE user_id = ? AND target_type = ? AND target_id = ? AND created_at >= ? AND created_at < ? AND `audit_logs`.`deleted_at` IS NULL ORDER BY id DESC LIMIT ? OFFSET ?")).
+					WithArgs(1, "games", 7, from, to.AddDate(0, 0, 1), 25, 25).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "action", "user_id"}).AddRow(5, "PUT /admin/games/:id", 1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE `users`.`id` = ? AND `users`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "Admin"))
+			},
+			expectedLen: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminAuditLogRepositoryMySQL(gormDB)
+
+			tc.mockBehavior(mock)
+
+			auditLogs, total, err := repo.GetAll(tc.filters, 25, 25)
+
+			assert.NoError(t, err)
+			assert.Len(t, auditLogs, tc.expectedLen)
+			assert.Positive(t, total)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAdminAuditLogRepositoryMySQL_DeleteOlderThan(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db_admin.NewAdminAuditLogRepositoryMySQL(gormDB)
+
+	before := time.Now().AddDate(0, 0, -30)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `audit_logs` WHERE created_at < ?")).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 12))
+	mock.ExpectCommit()
+
+	pruned, err := repo.DeleteOlderThan(before)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), pruned)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	db_admin "gcstatus/internal/adapters/db/admin"
 	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+	"gcstatus/internal/utils"
 	testutils "gcstatus/tests/utils"
 	"regexp"
 	"testing"
@@ -485,3 +487,278 @@ func gameRepositoryMySQL_GamesEqual(expected, actual []domain.Game) bool {
 	}
 	return true
 }
+
+func TestAdminGameRepositoryMySQL_UpdateCrack(t *testing.T) {
+	releaseDate := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)
+	crackedAt := releaseDate.AddDate(0, 0, 20)
+
+	testCases := map[string]struct {
+		gameID         uint
+		request        ports_admin.UpdateCrackRequest
+		mockBehavior   func(mock sqlmock.Sqlmock)
+		expectedStatus string
+		expectChange   bool
+		expectedErr    error
+	}{
+		"creates crack and appends timeline entry": {
+			gameID: 1,
+			request: ports_admin.UpdateCrackRequest{
+				CrackedAt:    &crackedAt,
+				CrackerID:    utils.UintPtr(1),
+				ProtectionID: utils.UintPtr(2),
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE `games`.`id` = ? AND `games`.`deleted_at` IS NULL ORDER BY `games`.`id` LIMIT ?")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title", "slug", "release_date"}).AddRow(1, "Game Test", "game-test", releaseDate))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `cracks` WHERE `cracks`.`game_id` = ? AND `cracks`.`deleted_at` IS NULL ORDER BY `cracks`.`id` LIMIT ?")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `cracks`")).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), domain.CrackedStatus, crackedAt, 20, 1, 2, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `crack_status_changes`")).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), domain.CrackedStatus, "", crackedAt, 20, 1, 1, 1, 2).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectedStatus: domain.CrackedStatus,
+			expectChange:   true,
+		},
+		"keeps timeline when status does not change": {
+			gameID: 1,
+			request: ports_admin.UpdateCrackRequest{
+				CrackerID:    utils.UintPtr(3),
+				ProtectionID: utils.UintPtr(2),
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE `games`.`id` = ? AND `games`.`deleted_at` IS NULL ORDER BY `games`.`id` LIMIT ?")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title", "slug", "release_date"}).AddRow(1, "Game Test", "game-test", releaseDate))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `cracks` WHERE `cracks`.`game_id` = ? AND `cracks`.`deleted_at` IS NULL ORDER BY `cracks`.`id` LIMIT ?")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "status", "game_id", "cracker_id", "protection_id"}).AddRow(5, domain.UncrackedStatus, 1, 1, 2))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `cracks`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectedStatus: domain.UncrackedStatus,
+			expectChange:   false,
+		},
+		"game not found": {
+			gameID: 99,
+			request: ports_admin.UpdateCrackRequest{
+				CrackerID:    utils.UintPtr(1),
+				ProtectionID: utils.UintPtr(1),
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE `games`.`id` = ? AND `games`.`deleted_at` IS NULL ORDER BY `games`.`id` LIMIT ?")).
+					WithArgs(99, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+				mock.ExpectRollback()
+			},
+			expectedErr: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminGameRepositoryMySQL(gormDB)
+
+			tc.mockBehavior(mock)
+
+			crack, change, err := repo.UpdateCrack(tc.gameID, tc.request)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, crack)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStatus, crack.Status)
+				assert.Equal(t, tc.expectChange, change != nil)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAdminGameRepositoryMySQL_UpdateSyncLocks(t *testing.T) {
+	fixedTime := time.Now()
+
+	testCases := map[string]struct {
+		gameID         uint
+		fields         []string
+		mockBehavior   func(mock sqlmock.Sqlmock)
+		expectedFields []string
+		expectedErr    error
+	}{
+		"replaces the locked fields": {
+			gameID: 1,
+			fields: []string{domain.SyncFieldTitle},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE `games`.`id` = ? AND `games`.`deleted_at` IS NULL ORDER BY `games`.`id` LIMIT ?")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `game_sync_locks` WHERE game_id = ? AND field NOT IN (?)")).
+					WithArgs(1, domain.SyncFieldTitle).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `game_sync_locks` WHERE (`game_sync_locks`.`field` = ? AND `game_sync_locks`.`game_id` = ?) AND `game_sync_locks`.`deleted_at` IS NULL ORDER BY `game_sync_locks`.`id` LIMIT ?")).
+					WithArgs(domain.SyncFieldTitle, 1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `game_sync_locks`")).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), domain.SyncFieldTitle, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `game_sync_locks` WHERE game_id = ? AND `game_sync_locks`.`deleted_at` IS NULL ORDER BY field ASC")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "field", "game_id", "created_at"}).AddRow(1, domain.SyncFieldTitle, 1, fixedTime))
+				mock.ExpectCommit()
+			},
+			expectedFields: []string{domain.SyncFieldTitle},
+		},
+		"unlocks every field": {
+			gameID: 1,
+			fields: []string{},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE `games`.`id` = ? AND `games`.`deleted_at` IS NULL ORDER BY `games`.`id` LIMIT ?")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `game_sync_locks` WHERE game_id = ?")).
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `game_sync_locks` WHERE game_id = ? AND `game_sync_locks`.`deleted_at` IS NULL ORDER BY field ASC")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "field", "game_id"}))
+				mock.ExpectCommit()
+			},
+			expectedFields: []string{},
+		},
+		"game not found": {
+			gameID: 99,
+			fields: []string{domain.SyncFieldTitle},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE `games`.`id` = ? AND `games`.`deleted_at` IS NULL ORDER BY `games`.`id` LIMIT ?")).
+					WithArgs(99, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+				mock.ExpectRollback()
+			},
+			expectedErr: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminGameRepositoryMySQL(gormDB)
+
+			tc.mockBehavior(mock)
+
+			locks, err := repo.UpdateSyncLocks(tc.gameID, tc.fields)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+
+				fields := make([]string, 0, len(locks))
+				for _, lock := range locks {
+					fields = append(fields, lock.Field)
+				}
+				assert.Equal(t, tc.expectedFields, fields)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAdminGameRepositoryMySQL_Delete(t *testing.T) {
+	testCases := map[string]struct {
+		gameID       uint
+		mockBehavior func(mock sqlmock.Sqlmock)
+		expectedErr  error
+	}{
+		"soft deletes the game and removes its store listings": {
+			gameID: 1,
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`slug` FROM `games` WHERE `games`.`id` = ? AND `games`.`deleted_at` IS NULL ORDER BY `games`.`id` LIMIT ?")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "slug"}).AddRow(1, "gta-vi"))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `dlcs` WHERE game_id = ? AND `dlcs`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `dlc_stores` WHERE dlc_id IN (?)")).
+					WithArgs(3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `dlcs` SET `deleted_at`=? WHERE id IN (?) AND `dlcs`.`deleted_at` IS NULL")).
+					WithArgs(sqlmock.AnyArg(), 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `game_stores` WHERE game_id = ?")).
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `games` SET `slug`=?,`updated_at`=? WHERE `games`.`deleted_at` IS NULL AND `id` = ?")).
+					WithArgs("deleted-1-gta-vi", sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `games` SET `deleted_at`=? WHERE `games`.`id` = ? AND `games`.`deleted_at` IS NULL")).
+					WithArgs(sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		"game not found": {
+			gameID: 99,
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`slug` FROM `games` WHERE `games`.`id` = ? AND `games`.`deleted_at` IS NULL ORDER BY `games`.`id` LIMIT ?")).
+					WithArgs(99, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+				mock.ExpectRollback()
+			},
+			expectedErr: gorm.ErrRecordNotFound,
+		},
+		"database error": {
+			gameID: 1,
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`slug` FROM `games` WHERE `games`.`id` = ? AND `games`.`deleted_at` IS NULL ORDER BY `games`.`id` LIMIT ?")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "slug"}).AddRow(1, "gta-vi"))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `dlcs` WHERE game_id = ? AND `dlcs`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `game_stores` WHERE game_id = ?")).
+					WithArgs(1).
+					WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminGameRepositoryMySQL(gormDB)
+
+			tc.mockBehavior(mock)
+
+			err := repo.Delete(tc.gameID)
+
+			if tc.expectedErr != nil {
+				assert.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
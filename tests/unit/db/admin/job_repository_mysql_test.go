@@ -0,0 +1,124 @@
+package tests
+
+import (
+	db_admin "gcstatus/internal/adapters/db/admin"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	testutils "gcstatus/tests/utils"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminJobRepositoryMySQL_GetAll(t *testing.T) {
+	fixedTime := time.Now()
+
+	testCases := map[string]struct {
+		filters      ports_admin.JobFilters
+		mockBehavior func(mock sqlmock.Sqlmock)
+		expectedLen  int
+	}{
+		"without filters": {
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "type", "status", "created_at"}).
+					AddRow(2, domain.JobTypeSendEmail, domain.JobStatusPending, fixedTime).
+					AddRow(1, domain.JobTypeSendEmail, domain.JobStatusFailed, fixedTime)
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `jobs` WHERE `jobs`.`deleted_at` IS NULL ORDER BY id DESC LIMIT ?")).
+					WithArgs(200).
+					WillReturnRows(rows)
+			},
+			expectedLen: 2,
+		},
+		"filtered by status and type": {
+			filters: ports_admin.JobFilters{Status: domain.JobStatusFailed, Type: domain.JobTypeSendEmail},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "type", "status", "created_at"}).
+					AddRow(1, domain.JobTypeSendEmail, domain.JobStatusFailed, fixedTime)
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `jobs` WHERE status = ? AND type = ? AND `jobs`.`deleted_at` IS NULL ORDER BY id DESC LIMIT ?")).
+					WithArgs(domain.JobStatusFailed, domain.JobTypeSendEmail, 200).
+					WillReturnRows(rows)
+			},
+			expectedLen: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminJobRepositoryMySQL(gormDB)
+
+			tc.mockBehavior(mock)
+
+			jobs, err := repo.GetAll(tc.filters, 200)
+
+			assert.NoError(t, err)
+			assert.Len(t, jobs, tc.expectedLen)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAdminJobRepositoryMySQL_Retry(t *testing.T) {
+	testCases := map[string]struct {
+		rowsAffected   int64
+		expectedErr    error
+		expectedStatus string
+	}{
+		"sends the job back to the queue": {
+			rowsAffected:   1,
+			expectedStatus: domain.JobStatusPending,
+		},
+		"conflicts when the job changed meanwhile": {
+			rowsAffected:   0,
+			expectedErr:    errors.NewHttpError(http.StatusConflict, "The job changed its status in the meantime, please try again."),
+			expectedStatus: domain.JobStatusFailed,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminJobRepositoryMySQL(gormDB)
+
+			errorMessage := "boom"
+			job := &domain.Job{ID: 1, Status: domain.JobStatusFailed, Attempts: 3, Error: &errorMessage}
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `jobs` SET `attempts`=?,`available_at`=?,`error`=?,`finished_at`=?,`locked_until`=?,`started_at`=?,`status`=?,`updated_at`=? WHERE (id = ? AND status = ?) AND `jobs`.`deleted_at` IS NULL")).
+				WithArgs(0, sqlmock.AnyArg(), nil, nil, nil, nil, domain.JobStatusPending, sqlmock.AnyArg(), job.ID, domain.JobStatusFailed).
+				WillReturnResult(sqlmock.NewResult(0, tc.rowsAffected))
+			mock.ExpectCommit()
+
+			err := repo.Retry(job)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, job.Status)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAdminJobRepositoryMySQL_Cancel(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db_admin.NewAdminJobRepositoryMySQL(gormDB)
+
+	job := &domain.Job{ID: 1, Status: domain.JobStatusRunning}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `jobs` SET `finished_at`=?,`status`=?,`updated_at`=? WHERE (id = ? AND status = ?) AND `jobs`.`deleted_at` IS NULL")).
+		WithArgs(sqlmock.AnyArg(), domain.JobStatusCancelled, sqlmock.AnyArg(), job.ID, domain.JobStatusRunning).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.Cancel(job)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.JobStatusCancelled, job.Status)
+	assert.NotNil(t, job.FinishedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	db_admin "gcstatus/internal/adapters/db/admin"
+	"gcstatus/internal/errors"
+	testutils "gcstatus/tests/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminMissionRepositoryMySQL_SyncAssignments(t *testing.T) {
+	testCases := map[string]struct {
+		userIDs      []uint
+		mockBehavior func(mock sqlmock.Sqlmock)
+		expectedErr  error
+	}{
+		"replaces the assigned users": {
+			userIDs: []uint{3, 4, 3},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `users` WHERE id IN (?,?) AND `users`.`deleted_at` IS NULL")).
+					WithArgs(3, 4).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `user_mission_assignments` WHERE mission_id = ?")).
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `user_mission_assignments`")).
+					WillReturnResult(sqlmock.NewResult(1, 2))
+				mock.ExpectCommit()
+			},
+		},
+		"clears the assigned users": {
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `user_mission_assignments` WHERE mission_id = ?")).
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+		},
+		"refuses unknown users": {
+			userIDs: []uint{3, 9},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `users` WHERE id IN (?,?) AND `users`.`deleted_at` IS NULL")).
+					WithArgs(3, 9).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "Some of the given users could not be found."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminMissionRepositoryMySQL(gormDB)
+
+			mock.ExpectBegin()
+			tc.mockBehavior(mock)
+
+			err := repo.SyncAssignments(1, tc.userIDs)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAdminMissionRepositoryMySQL_RequirementAchievers(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db_admin.NewAdminMissionRepositoryMySQL(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `users` JOIN mission_progresses ON mission_progresses.user_id = users.id AND mission_progresses.deleted_at IS NULL WHERE (mission_progresses.mission_requirement_id = ? AND mission_progresses.completed = ?) AND `users`.`deleted_at` IS NULL")).
+		WithArgs(2, true).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+	mock.ExpectQuery(regexp.QuoteMeta("AND `users`.`deleted_at` IS NULL ORDER BY users.id LIMIT ? OFFSET ?")).
+		WithArgs(2, true, 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "Player"))
+
+	users, total, err := repo.RequirementAchievers(2, 10, 10)
+
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, int64(12), total)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	db_admin "gcstatus/internal/adapters/db/admin"
+	testutils "gcstatus/tests/utils"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminPermissionRepositoryMySQL_UserIDs(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db_admin.NewAdminPermissionRepositoryMySQL(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `permissionable_id` FROM `permissionables` WHERE (permission_id = ? AND permissionable_type = ?) AND `permissionables`.`deleted_at` IS NULL")).
+		WithArgs(1, "users").
+		WillReturnRows(sqlmock.NewRows([]string{"permissionable_id"}).AddRow(3).AddRow(5))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `roleable_id` FROM `roleables` WHERE roleable_type = ? AND role_id IN (SELECT `permissionable_id` FROM `permissionables` WHERE (permission_id = ? AND permissionable_type = ?) AND `permissionables`.`deleted_at` IS NULL) AND `roleables`.`deleted_at` IS NULL")).
+		WithArgs("users", 1, "roles").
+		WillReturnRows(sqlmock.NewRows([]string{"roleable_id"}).AddRow(5).AddRow(8))
+
+	userIDs, err := repo.UserIDs(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{3, 5, 8}, userIDs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAdminPermissionRepositoryMySQL_CreateMissing(t *testing.T) {
+	testCases := map[string]struct {
+		existing     []string
+		expectInsert bool
+		expected     []string
+	}{
+		"creates the scopes not stored yet": {
+			existing:     []string{"view:games"},
+			expectInsert: true,
+			expected:     []string{"update:games"},
+		},
+		"nothing to create": {
+			existing: []string{"view:games", "update:games"},
+			expected: []string{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminPermissionRepositoryMySQL(gormDB)
+
+			rows := sqlmock.NewRows([]string{"scope"})
+			for _, scope := range tc.existing {
+				rows.AddRow(scope)
+			}
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT `scope` FROM `permissions` WHERE scope IN (?,?)")).
+				WithArgs("view:games", "update:games").
+				WillReturnRows(rows)
+			if tc.expectInsert {
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `permissions`")).
+					WillReturnResult(sqlmock.NewResult(4, 1))
+			}
+			mock.ExpectCommit()
+
+			permissions, err := repo.CreateMissing([]string{"view:games", "update:games"})
+
+			assert.NoError(t, err)
+			scopes := []string{}
+			for _, permission := range permissions {
+				scopes = append(scopes, permission.Scope)
+			}
+			assert.Equal(t, tc.expected, scopes)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package tests
+
+import (
+	"errors"
+	db_admin "gcstatus/internal/adapters/db/admin"
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+	testutils "gcstatus/tests/utils"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestAdminReferenceRepositoryMySQL_GetAll(t *testing.T) {
+	fixedTime := time.Now()
+
+	testCases := map[string]struct {
+		mockBehavior func(mock sqlmock.Sqlmock)
+		expectedLen  int
+		expectedErr  error
+	}{
+		"success case": {
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name", "slug", "created_at", "updated_at"}).
+					AddRow(1, "Category 1", "category-1", fixedTime, fixedTime).
+					AddRow(2, "Category 2", "category-2", fixedTime, fixedTime)
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `categories` WHERE `categories`.`deleted_at` IS NULL ORDER BY id ASC")).
+					WillReturnRows(rows)
+			},
+			expectedLen: 2,
+		},
+		"database error": {
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `categories` WHERE `categories`.`deleted_at` IS NULL ORDER BY id ASC")).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminCategoryRepositoryMySQL(gormDB)
+
+			tc.mockBehavior(mock)
+
+			categories, err := repo.GetAll()
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Len(t, categories, tc.expectedLen)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAdminReferenceRepositoryMySQL_Exists(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db_admin.NewAdminRequirementTypeRepositoryMySQL(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `requirement_types` WHERE (`os` = ? AND `potential` = ?) AND id <> ? AND `requirement_types`.`deleted_at` IS NULL")).
+		WithArgs(domain.WindowsOSRequirement, domain.MinimumRequirementType, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	exists, err := repo.Exists(map[string]any{"potential": domain.MinimumRequirementType, "os": domain.WindowsOSRequirement}, 3)
+
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAdminReferenceRepositoryMySQL_CountUsages(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db_admin.NewAdminDeveloperRepositoryMySQL(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT developer_id AS id, COUNT(*) AS total FROM `game_developers` WHERE developer_id IN (?,?) AND `game_developers`.`deleted_at` IS NULL GROUP BY `developer_id`")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "total"}).AddRow(1, 3).AddRow(2, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT developer_id AS id, COUNT(*) AS total FROM `dlc_developers` WHERE developer_id IN (?,?) AND `dlc_developers`.`deleted_at` IS NULL GROUP BY `developer_id`")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "total"}).AddRow(1, 2))
+
+	usages, err := repo.CountUsages(1, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint]int64{1: 5, 2: 1}, usages)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAdminReferenceRepositoryMySQL_Create(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db_admin.NewAdminCategoryRepositoryMySQL(gormDB)
+
+	category := domain.Category{Name: "Category 1", Slug: "category-1"}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `categories`").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), category.Name, category.Slug).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err := repo.Create(&category)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), category.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAdminReferenceRepositoryMySQL_Delete(t *testing.T) {
+	reassignTo := uint(2)
+
+	testCases := map[string]struct {
+		id           uint
+		reassignTo   *uint
+		mockBehavior func(mock sqlmock.Sqlmock)
+		expectedErr  error
+	}{
+		"deletes an unused entity": {
+			id: 1,
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `categoriables` WHERE category_id = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `categories` WHERE `categories`.`id` = ?")).
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		"refuses to delete an entity in use": {
+			id: 1,
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `categoriables` WHERE category_id = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+				mock.ExpectRollback()
+			},
+			expectedErr: ports_admin.ErrReferenceInUse,
+		},
+		"reassigns the usages before deleting": {
+			id:         1,
+			reassignTo: &reassignTo,
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT `categoriable_id`,`categoriable_type` FROM `categoriables` WHERE category_id = ?")).
+					WithArgs(2).
+					WillReturnRows(sqlmock.NewRows([]string{"categoriable_id", "categoriable_type"}).AddRow(7, "games"))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `categoriables` WHERE category_id = ? AND (`categoriable_id` = ? AND `categoriable_type` = ?)")).
+					WithArgs(1, 7, "games").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `categoriables` SET `category_id`=?,`updated_at`=? WHERE category_id = ?")).
+					WithArgs(2, sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 4))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `categories` WHERE `categories`.`id` = ?")).
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		"entity not found": {
+			id: 99,
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `categoriables` WHERE category_id = ?")).
+					WithArgs(99).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `categories` WHERE `categories`.`id` = ?")).
+					WithArgs(99).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminCategoryRepositoryMySQL(gormDB)
+
+			tc.mockBehavior(mock)
+
+			err := repo.Delete(tc.id, tc.reassignTo)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
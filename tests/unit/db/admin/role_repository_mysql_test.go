@@ -0,0 +1,140 @@
+package tests
+
+import (
+	db_admin "gcstatus/internal/adapters/db/admin"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	testutils "gcstatus/tests/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestAdminRoleRepositoryMySQL_Create(t *testing.T) {
+	testCases := map[string]struct {
+		permissionIDs []uint
+		mockBehavior  func(mock sqlmock.Sqlmock)
+		expectedErr   error
+	}{
+		"creates the role with its permissions": {
+			permissionIDs: []uint{1, 2, 1},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `roles`")).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `permissions` WHERE id IN (?,?) AND `permissions`.`deleted_at` IS NULL")).
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `permissionables` WHERE permissionable_id = ? AND permissionable_type = ?")).
+					WithArgs(3, "roles").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `permissionables`")).
+					WillReturnResult(sqlmock.NewResult(1, 2))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `permissionables` WHERE (permissionable_id = ? AND permissionable_type = ?) AND `permissionables`.`deleted_at` IS NULL")).
+					WithArgs(3, "roles").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "permissionable_id", "permissionable_type", "permission_id"}).
+						AddRow(1, 3, "roles", 1).
+						AddRow(2, 3, "roles", 2))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `permissions` WHERE `permissions`.`id` IN (?,?) AND `permissions`.`deleted_at` IS NULL")).
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "scope"}).
+						AddRow(1, "view:games").
+						AddRow(2, "update:games"))
+				mock.ExpectCommit()
+			},
+		},
+		"refuses unknown permissions": {
+			permissionIDs: []uint{1, 9},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `roles`")).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `permissions` WHERE id IN (?,?) AND `permissions`.`deleted_at` IS NULL")).
+					WithArgs(1, 9).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "Some of the given permissions could not be found."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminRoleRepositoryMySQL(gormDB)
+
+			mock.ExpectBegin()
+			tc.mockBehavior(mock)
+
+			role := domain.Role{Name: "Editor"}
+			err := repo.Create(&role, tc.permissionIDs)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Len(t, role.Permissions, 2)
+				assert.Equal(t, "update:games", role.Permissions[1].Permission.Scope)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAdminRoleRepositoryMySQL_Delete(t *testing.T) {
+	testCases := map[string]struct {
+		rowsAffected int64
+		expectedErr  error
+	}{
+		"deletes the role and its grants": {
+			rowsAffected: 1,
+		},
+		"role not found": {
+			rowsAffected: 0,
+			expectedErr:  gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminRoleRepositoryMySQL(gormDB)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `roles` SET `deleted_at`=? WHERE `roles`.`id` = ? AND `roles`.`deleted_at` IS NULL")).
+				WithArgs(sqlmock.AnyArg(), 2).
+				WillReturnResult(sqlmock.NewResult(0, tc.rowsAffected))
+			if tc.expectedErr == nil {
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `roleables` WHERE role_id = ?")).
+					WithArgs(2).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `permissionables` WHERE permissionable_id = ? AND permissionable_type = ?")).
+					WithArgs(2, "roles").
+					WillReturnResult(sqlmock.NewResult(0, 5))
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			err := repo.Delete(2)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAdminRoleRepositoryMySQL_UserIDs(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db_admin.NewAdminRoleRepositoryMySQL(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `roleable_id` FROM `roleables` WHERE (role_id = ? AND roleable_type = ?) AND `roleables`.`deleted_at` IS NULL")).
+		WithArgs(2, "users").
+		WillReturnRows(sqlmock.NewRows([]string{"roleable_id"}).AddRow(4).AddRow(7))
+
+	userIDs, err := repo.UserIDs(2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{4, 7}, userIDs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
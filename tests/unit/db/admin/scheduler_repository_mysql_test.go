@@ -0,0 +1,78 @@
+package tests
+
+import (
+	db_admin "gcstatus/internal/adapters/db/admin"
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+	testutils "gcstatus/tests/utils"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminSchedulerRepositoryMySQL_GetRuns(t *testing.T) {
+	testCases := map[string]struct {
+		filters      ports_admin.ScheduledRunFilters
+		mockBehavior func(mock sqlmock.Sqlmock)
+		expectedLen  int
+	}{
+		"without filters": {
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `scheduled_runs` WHERE `scheduled_runs`.`deleted_at` IS NULL")).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `scheduled_runs` WHERE `scheduled_runs`.`deleted_at` IS NULL ORDER BY id DESC LIMIT ?")).
+					WithArgs(25).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "job_name", "status"}).
+						AddRow(2, domain.ScheduledJobResetMissions, domain.ScheduledRunSucceeded).
+						AddRow(1, domain.ScheduledJobPruneAuditLogs, domain.ScheduledRunFailed))
+			},
+			expectedLen: 2,
+		},
+		"filtered by job and status": {
+			filters: ports_admin.ScheduledRunFilters{Job: domain.ScheduledJobPruneAuditLogs, Status: domain.ScheduledRunFailed},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `scheduled_runs` WHERE job_name = ? AND status = ? AND `scheduled_runs`.`deleted_at` IS NULL")).
+					WithArgs(domain.ScheduledJobPruneAuditLogs, domain.ScheduledRunFailed).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `scheduled_runs` WHERE job_name = ? AND status = ? AND `scheduled_runs`.`deleted_at` IS NULL ORDER BY id DESC LIMIT ?")).
+					WithArgs(domain.ScheduledJobPruneAuditLogs, domain.ScheduledRunFailed, 25).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "job_name", "status"}).
+						AddRow(1, domain.ScheduledJobPruneAuditLogs, domain.ScheduledRunFailed))
+			},
+			expectedLen: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminSchedulerRepositoryMySQL(gormDB)
+
+			tc.mockBehavior(mock)
+
+			runs, total, err := repo.GetRuns(tc.filters, 0, 25)
+
+			assert.NoError(t, err)
+			assert.Len(t, runs, tc.expectedLen)
+			assert.Equal(t, int64(tc.expectedLen), total)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAdminSchedulerRepositoryMySQL_HasPendingRun(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db_admin.NewAdminSchedulerRepositoryMySQL(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `scheduled_runs` WHERE (job_name = ? AND status = ?) AND `scheduled_runs`.`deleted_at` IS NULL")).
+		WithArgs(domain.ScheduledJobResetMissions, domain.ScheduledRunPending).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	pending, err := repo.HasPendingRun(domain.ScheduledJobResetMissions)
+
+	assert.NoError(t, err)
+	assert.True(t, pending)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
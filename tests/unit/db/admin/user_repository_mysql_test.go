@@ -0,0 +1,162 @@
+package tests
+
+import (
+	db_admin "gcstatus/internal/adapters/db/admin"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	testutils "gcstatus/tests/utils"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestAdminUserRepositoryMySQL_Block(t *testing.T) {
+	until := time.Now().Add(24 * time.Hour)
+
+	testCases := map[string]struct {
+		rowsAffected int64
+		expectedErr  error
+	}{
+		"blocks the user": {
+			rowsAffected: 1,
+		},
+		"user not found": {
+			rowsAffected: 0,
+			expectedErr:  gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminUserRepositoryMySQL(gormDB)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `blocked`=?,`blocked_reason`=?,`blocked_until`=?,`updated_at`=? WHERE id = ? AND `users`.`deleted_at` IS NULL")).
+				WithArgs(true, "Cheating", until, sqlmock.AnyArg(), 1).
+				WillReturnResult(sqlmock.NewResult(0, tc.rowsAffected))
+			mock.ExpectCommit()
+
+			err := repo.Block(1, "Cheating", &until)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAdminUserRepositoryMySQL_AdjustWallet(t *testing.T) {
+	testCases := map[string]struct {
+		amount       int
+		mockBehavior func(mock sqlmock.Sqlmock)
+		expectedErr  error
+		expectedType uint
+	}{
+		"credits the wallet": {
+			amount: 500,
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `wallets` SET `amount`=amount + ?,`updated_at`=? WHERE (user_id = ? AND amount + ? >= 0) AND `wallets`.`deleted_at` IS NULL")).
+					WithArgs(500, sqlmock.AnyArg(), 1, 500).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `transactions`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectedType: domain.AdditionTransactionTypeID,
+		},
+		"refuses to debit below zero": {
+			amount: -500,
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `wallets` SET `amount`=amount + ?,`updated_at`=? WHERE (user_id = ? AND amount + ? >= 0) AND `wallets`.`deleted_at` IS NULL")).
+					WithArgs(-500, sqlmock.AnyArg(), 1, -500).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `wallets` WHERE user_id = ? AND `wallets`.`deleted_at` IS NULL ORDER BY `wallets`.`id` LIMIT ?")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "amount", "user_id"}).AddRow(1, 100, 1))
+				mock.ExpectRollback()
+			},
+			expectedErr:  errors.NewHttpError(http.StatusUnprocessableEntity, "The wallet balance can not go below zero."),
+			expectedType: domain.SubtractionTransactionTypeID,
+		},
+		"wallet not found": {
+			amount: 500,
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `wallets` SET `amount`=amount + ?,`updated_at`=? WHERE (user_id = ? AND amount + ? >= 0) AND `wallets`.`deleted_at` IS NULL")).
+					WithArgs(500, sqlmock.AnyArg(), 1, 500).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `wallets` WHERE user_id = ? AND `wallets`.`deleted_at` IS NULL ORDER BY `wallets`.`id` LIMIT ?")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "amount", "user_id"}))
+				mock.ExpectRollback()
+			},
+			expectedErr:  gorm.ErrRecordNotFound,
+			expectedType: domain.AdditionTransactionTypeID,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminUserRepositoryMySQL(gormDB)
+
+			mock.ExpectBegin()
+			tc.mockBehavior(mock)
+
+			transaction, err := repo.AdjustWallet(1, tc.amount, "Manual adjustment")
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, uint(500), transaction.Amount)
+			assert.Equal(t, tc.expectedType, transaction.TransactionTypeID)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAdminUserRepositoryMySQL_GrantTitle(t *testing.T) {
+	testCases := map[string]struct {
+		mockBehavior func(mock sqlmock.Sqlmock)
+		expectedErr  error
+	}{
+		"title not found": {
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `titles` WHERE `titles`.`id` = ? AND `titles`.`deleted_at` IS NULL ORDER BY `titles`.`id` LIMIT ?")).
+					WithArgs(2, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "The title could not be found."),
+		},
+		"title already owned": {
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `titles` WHERE `titles`.`id` = ? AND `titles`.`deleted_at` IS NULL ORDER BY `titles`.`id` LIMIT ?")).
+					WithArgs(2, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).AddRow(2, "Veteran"))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `user_titles` WHERE (user_id = ? AND title_id = ?) AND `user_titles`.`deleted_at` IS NULL")).
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.NewHttpError(http.StatusConflict, "The user already has this title."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db_admin.NewAdminUserRepositoryMySQL(gormDB)
+
+			mock.ExpectBegin()
+			tc.mockBehavior(mock)
+
+			_, err := repo.GrantTitle(1, 2)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
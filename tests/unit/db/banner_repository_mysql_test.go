@@ -4,6 +4,7 @@ import (
 	"errors"
 	"gcstatus/internal/adapters/db"
 	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
 	testutils "gcstatus/tests/utils"
 	"regexp"
 	"testing"
@@ -114,8 +115,8 @@ func TestBannerRepositoryMySQL_GetBannersForHome(t *testing.T) {
 							ID:           1,
 							Status:       "uncracked",
 							CrackedAt:    &fixedTime,
-							CrackerID:    1,
-							ProtectionID: 1,
+							CrackerID:    utils.UintPtr(1),
+							ProtectionID: utils.UintPtr(1),
 							GameID:       1,
 						},
 					},
@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"gcstatus/internal/adapters/db"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	testutils "gcstatus/tests/utils"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckInRepositoryMySQL_GetStreak(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewCheckInRepositoryMySQL(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `check_in_streaks` WHERE user_id = ? AND `check_in_streaks`.`deleted_at` IS NULL LIMIT ?")).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}))
+
+	streak, err := repo.GetStreak(1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), streak.UserID)
+	assert.Equal(t, uint(0), streak.Current)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCheckInRepositoryMySQL_CheckIn(t *testing.T) {
+	day := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectedErr error
+	}{
+		"already checked in": {
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `check_in_streaks` WHERE user_id = ? AND `check_in_streaks`.`deleted_at` IS NULL LIMIT ? FOR UPDATE")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "current", "last_check_in_on"}).AddRow(1, 1, 3, day))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.NewHttpError(http.StatusConflict, "You have already checked in today."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db.NewCheckInRepositoryMySQL(gormDB)
+
+			tc.mockSetup(mock)
+
+			_, err := repo.CheckIn(1, day, []domain.CheckInReward{{Streak: 1, Coins: 10}})
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCheckInRepositoryMySQL_BuyFreeze(t *testing.T) {
+	testCases := map[string]struct {
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectedErr error
+	}{
+		"limit reached": {
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `check_in_streaks` WHERE user_id = ? AND `check_in_streaks`.`deleted_at` IS NULL LIMIT ? FOR UPDATE")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "freezes"}).AddRow(1, 1, 2))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "You can not hold more than 2 streak freezes."),
+		},
+		"insufficient funds": {
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `check_in_streaks` WHERE user_id = ? AND `check_in_streaks`.`deleted_at` IS NULL LIMIT ? FOR UPDATE")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "freezes"}).AddRow(1, 1, 0))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `wallets` SET `amount`=amount - ?,`updated_at`=? WHERE (user_id = ? AND amount >= ?) AND `wallets`.`deleted_at` IS NULL")).
+					WithArgs(100, sqlmock.AnyArg(), 1, 100).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "Insufficient funds to purchase the streak freeze!"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db.NewCheckInRepositoryMySQL(gormDB)
+
+			tc.mockSetup(mock)
+
+			_, _, err := repo.BuyFreeze(1, 100, 2)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
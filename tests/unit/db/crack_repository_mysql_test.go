@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/db"
+	testutils "gcstatus/tests/utils"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrackRepositoryMySQL_GetHistoryByGameSlug(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewCrackRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		slug         string
+		mockBehavior func(slug string)
+		expectedLen  int
+		expectedErr  error
+	}{
+		"timeline found": {
+			slug: "valid",
+			mockBehavior: func(slug string) {
+				rows := sqlmock.NewRows([]string{"id", "status", "previous_status", "game_id", "cracker_id", "protection_id"}).
+					AddRow(1, "uncracked", "", 1, 1, 1).
+					AddRow(2, "cracked", "uncracked", 1, 1, 1)
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT `crack_status_changes`.`id`,`crack_status_changes`.`created_at`,`crack_status_changes`.`updated_at`,`crack_status_changes`.`deleted_at`,`crack_status_changes`.`status`,`crack_status_changes`.`previous_status`,`crack_status_changes`.`cracked_at`,`crack_status_changes`.`days_to_crack`,`crack_status_changes`.`crack_id`,`crack_status_changes`.`game_id`,`crack_status_changes`.`cracker_id`,`crack_status_changes`.`protection_id` FROM `crack_status_changes` JOIN games ON games.id = crack_status_changes.game_id WHERE games.slug = ? AND `crack_status_changes`.`deleted_at` IS NULL ORDER BY crack_status_changes.created_at ASC")).
+					WithArgs(slug).
+					WillReturnRows(rows)
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `crackers` WHERE `crackers`.`id` = ? AND `crackers`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug"}).AddRow(1, "Cracker", "cracker"))
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `protections` WHERE `protections`.`id` = ? AND `protections`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug"}).AddRow(1, "Denuvo", "denuvo"))
+			},
+			expectedLen: 2,
+		},
+		"db error": {
+			slug: "error",
+			mockBehavior: func(slug string) {
+				mock.ExpectQuery(regexp.QuoteMeta("FROM `crack_status_changes` JOIN games ON games.id = crack_status_changes.game_id WHERE games.slug = ?")).
+					WithArgs(slug).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedLen: 0,
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockBehavior(tc.slug)
+
+			changes, err := repo.GetHistoryByGameSlug(tc.slug)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Len(t, changes, tc.expectedLen)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCrackRepositoryMySQL_FindForStats(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewCrackRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		mockBehavior func()
+		expectedLen  int
+		expectedErr  error
+	}{
+		"cracks found": {
+			mockBehavior: func() {
+				rows := sqlmock.NewRows([]string{"id", "status", "days_to_crack", "cracker_id", "protection_id", "game_id"}).
+					AddRow(1, "cracked", 12, 1, 1, 1).
+					AddRow(2, "uncracked", nil, 1, 1, 2)
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `cracks` WHERE `cracks`.`deleted_at` IS NULL")).
+					WillReturnRows(rows)
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `crackers` WHERE `crackers`.`id` = ? AND `crackers`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug"}).AddRow(1, "Cracker", "cracker"))
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `protections` WHERE `protections`.`id` = ? AND `protections`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug"}).AddRow(1, "Denuvo", "denuvo"))
+			},
+			expectedLen: 2,
+		},
+		"db error": {
+			mockBehavior: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `cracks` WHERE `cracks`.`deleted_at` IS NULL")).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedLen: 0,
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			cracks, err := repo.FindForStats()
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Len(t, cracks, tc.expectedLen)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/db"
+	"gcstatus/internal/ports"
+	testutils "gcstatus/tests/utils"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestFeedRepositoryMySQL_UpcomingReleases(t *testing.T) {
+	fixedTime := time.Now()
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewFeedRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		filters       ports.ReleaseFeedFilters
+		mockSetup     func()
+		expectedLen   int
+		expectedError error
+	}{
+		"global releases": {
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE release_date >= ? AND `games`.`deleted_at` IS NULL ORDER BY release_date ASC LIMIT ?")).
+					WithArgs(sqlmock.AnyArg(), 200).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "release_date"}).
+						AddRow(1, fixedTime).
+						AddRow(2, fixedTime.AddDate(0, 1, 0)))
+			},
+			expectedLen: 2,
+		},
+		"releases by genre and platform": {
+			filters: ports.ReleaseFeedFilters{Genre: "rpg", Platform: "pc"},
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE release_date >= ? AND id IN (SELECT genreables.genreable_id FROM `genreables` JOIN genres ON genres.id = genreables.genre_id WHERE genreables.genreable_type = ? AND genres.slug = ?) AND id IN (SELECT platformables.platformable_id FROM `platformables` JOIN platforms ON platforms.id = platformables.platform_id WHERE platformables.platformable_type = ? AND platforms.slug = ?) AND `games`.`deleted_at` IS NULL ORDER BY release_date ASC LIMIT ?")).
+					WithArgs(sqlmock.AnyArg(), "games", "rpg", "games", "pc", 200).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "release_date"}).AddRow(1, fixedTime))
+			},
+			expectedLen: 1,
+		},
+		"followed releases": {
+			filters: ports.ReleaseFeedFilters{FollowedBy: 1},
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE release_date >= ? AND id IN (SELECT `game_id` FROM `game_follows` WHERE user_id = ? AND `game_follows`.`deleted_at` IS NULL) AND `games`.`deleted_at` IS NULL ORDER BY release_date ASC LIMIT ?")).
+					WithArgs(sqlmock.AnyArg(), 1, 200).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "release_date"}))
+			},
+			expectedLen: 0,
+		},
+		"db error": {
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE release_date >= ? AND `games`.`deleted_at` IS NULL ORDER BY release_date ASC LIMIT ?")).
+					WithArgs(sqlmock.AnyArg(), 200).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedLen:   0,
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			games, err := repo.UpcomingReleases(tc.filters)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Len(t, games, tc.expectedLen)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFeedRepositoryMySQL_LatestCrackChanges(t *testing.T) {
+	fixedTime := time.Now()
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewFeedRepositoryMySQL(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `crack_status_changes` WHERE `crack_status_changes`.`deleted_at` IS NULL ORDER BY created_at DESC LIMIT ?")).
+		WithArgs(50).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "game_id", "cracker_id", "created_at"}).
+			AddRow(1, "cracked", 1, 1, fixedTime))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `crackers` WHERE `crackers`.`id` = ? AND `crackers`.`deleted_at` IS NULL")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "Empress"))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE `games`.`id` = ? AND `games`.`deleted_at` IS NULL")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).AddRow(1, "Game Test"))
+
+	changes, err := repo.LatestCrackChanges(50)
+
+	assert.NoError(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "Game Test", changes[0].Game.Title)
+	assert.Equal(t, "Empress", changes[0].Cracker.Name)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeedRepositoryMySQL_FindToken(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewFeedRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		token          string
+		mockSetup      func()
+		expectedUserID uint
+		expectedError  error
+	}{
+		"token found": {
+			token: "secret",
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `feed_tokens` WHERE token = ? AND `feed_tokens`.`deleted_at` IS NULL ORDER BY `feed_tokens`.`id` LIMIT ?")).
+					WithArgs("secret", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "token", "user_id"}).AddRow(1, "secret", 3))
+			},
+			expectedUserID: 3,
+		},
+		"token not found": {
+			token: "invalid",
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `feed_tokens` WHERE token = ? AND `feed_tokens`.`deleted_at` IS NULL ORDER BY `feed_tokens`.`id` LIMIT ?")).
+					WithArgs("invalid", 1).
+					WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+			expectedError: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			feedToken, err := repo.FindToken(tc.token)
+
+			assert.Equal(t, tc.expectedError, err)
+			if tc.expectedError == nil {
+				assert.Equal(t, tc.expectedUserID, feedToken.UserID)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package tests
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/db"
+	"gcstatus/internal/domain"
+	testutils "gcstatus/tests/utils"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestGameFollowRepositoryMySQL_GetAllForUser(t *testing.T) {
+	fixedTime := time.Now()
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewGameFollowRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		userID        uint
+		mockSetup     func()
+		expectedLen   int
+		expectedError error
+	}{
+		"success - follows found": {
+			userID: 1,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `game_follows` WHERE user_id = ? AND `game_follows`.`deleted_at` IS NULL ORDER BY created_at DESC")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "notify_release", "token", "game_id", "user_id", "created_at", "updated_at"}).
+						AddRow(1, true, "token", 1, 1, fixedTime, fixedTime))
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE `games`.`id` = ? AND `games`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title", "slug"}).AddRow(1, "Game Test", "game-test"))
+			},
+			expectedLen:   1,
+			expectedError: nil,
+		},
+		"error - db failure": {
+			userID: 2,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `game_follows` WHERE user_id = ? AND `game_follows`.`deleted_at` IS NULL ORDER BY created_at DESC")).
+					WithArgs(2).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedLen:   0,
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			follows, err := repo.GetAllForUser(tc.userID)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Len(t, follows, tc.expectedLen)
+
+			for _, follow := range follows {
+				assert.Equal(t, "Game Test", follow.Game.Title)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGameFollowRepositoryMySQL_FindByToken(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewGameFollowRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		token         string
+		mockSetup     func()
+		expectedID    uint
+		expectedError error
+	}{
+		"success - follow found": {
+			token: "token",
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `game_follows` WHERE token = ? AND `game_follows`.`deleted_at` IS NULL ORDER BY `game_follows`.`id` LIMIT ?")).
+					WithArgs("token", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "token", "game_id", "user_id"}).AddRow(1, "token", 1, 1))
+			},
+			expectedID:    1,
+			expectedError: nil,
+		},
+		"error - follow not found": {
+			token: "invalid",
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `game_follows` WHERE token = ? AND `game_follows`.`deleted_at` IS NULL ORDER BY `game_follows`.`id` LIMIT ?")).
+					WithArgs("invalid", 1).
+					WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+			expectedError: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			follow, err := repo.FindByToken(tc.token)
+
+			assert.Equal(t, tc.expectedError, err)
+			if tc.expectedError == nil {
+				assert.Equal(t, tc.expectedID, follow.ID)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGameFollowRepositoryMySQL_FindFollowersForEvent(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewGameFollowRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		gameID        uint
+		event         string
+		mockSetup     func()
+		expectedLen   int
+		expectedError error
+	}{
+		"success - followers found": {
+			gameID: 1,
+			event:  domain.FollowCrackEvent,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `game_follows` WHERE game_id = ? AND notify_crack = ? AND `game_follows`.`deleted_at` IS NULL")).
+					WithArgs(1, true).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "notify_crack", "game_id", "user_id"}).
+						AddRow(1, true, 1, 1).
+						AddRow(2, true, 1, 2))
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE `users`.`id` IN (?,?) AND `users`.`deleted_at` IS NULL")).
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}).
+						AddRow(1, "John Doe", "john@example.com").
+						AddRow(2, "Jane Doe", "jane@example.com"))
+			},
+			expectedLen:   2,
+			expectedError: nil,
+		},
+		"error - db failure": {
+			gameID: 2,
+			event:  domain.FollowReleaseEvent,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `game_follows` WHERE game_id = ? AND notify_release = ? AND `game_follows`.`deleted_at` IS NULL")).
+					WithArgs(2, true).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedLen:   0,
+			expectedError: errors.New("db error"),
+		},
+		"error - unknown event": {
+			gameID:        1,
+			event:         "unknown",
+			mockSetup:     func() {},
+			expectedLen:   0,
+			expectedError: errors.New("unknown follow event: unknown"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			follows, err := repo.FindFollowersForEvent(tc.gameID, tc.event)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Len(t, follows, tc.expectedLen)
+
+			for _, follow := range follows {
+				assert.NotEmpty(t, follow.User.Email)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGameFollowRepositoryMySQL_Delete(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewGameFollowRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		followID      uint
+		mockSetup     func()
+		expectedError error
+	}{
+		"success - follow deleted": {
+			followID: 1,
+			mockSetup: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `game_follows` WHERE `game_follows`.`id` = ?")).
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectedError: nil,
+		},
+		"error - db failure": {
+			followID: 2,
+			mockSetup: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `game_follows` WHERE `game_follows`.`id` = ?")).
+					WithArgs(2).
+					WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := repo.Delete(tc.followID)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
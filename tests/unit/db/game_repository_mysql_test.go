@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"gcstatus/internal/adapters/db"
 	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
 	"gcstatus/internal/utils"
 	testutils "gcstatus/tests/utils"
 	"regexp"
@@ -150,6 +151,12 @@ func TestGameRepositoryMySQL_FindBySlug(t *testing.T) {
 					WithArgs(1).
 					WillReturnRows(dlcStoresRows)
 
+				dlcPricesRows := mock.NewRows([]string{"id", "region", "currency", "initial_price", "final_price", "discount", "priceable_id", "priceable_type"}).
+					AddRow(1, "us", "USD", 2200, 2200, 0, 1, "dlc_stores")
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `store_prices` WHERE `priceable_type` = ? AND `store_prices`.`priceable_id` = ? AND region = ? AND `store_prices`.`deleted_at` IS NULL")).
+					WithArgs("dlc_stores", 1, "us").
+					WillReturnRows(dlcPricesRows)
+
 				storesRows := mock.NewRows([]string{"id", "name", "url", "slug", "logo"}).
 					AddRow(1, "Store 1", "https://photo.co", "store-1", "https://photo.co")
 				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `stores` WHERE `stores`.`id` = ? AND `stores`.`deleted_at` IS NULL")).
@@ -262,6 +269,12 @@ func TestGameRepositoryMySQL_FindBySlug(t *testing.T) {
 					WithArgs(1).
 					WillReturnRows(gameStoresRows)
 
+				gamePricesRows := mock.NewRows([]string{"id", "region", "currency", "initial_price", "final_price", "discount", "priceable_id", "priceable_type"}).
+					AddRow(2, "us", "USD", 22999, 22999, 0, 1, "game_stores")
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `store_prices` WHERE `priceable_type` = ? AND `store_prices`.`priceable_id` = ? AND region = ? AND `store_prices`.`deleted_at` IS NULL")).
+					WithArgs("game_stores", 1, "us").
+					WillReturnRows(gamePricesRows)
+
 				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `stores` WHERE `stores`.`id` = ? AND `stores`.`deleted_at` IS NULL")).
 					WithArgs(1).
 					WillReturnRows(storesRows)
@@ -344,7 +357,7 @@ func TestGameRepositoryMySQL_FindBySlug(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			tc.mockBehavior(tc.slug)
 
-			game, err := mockRepo.FindBySlug(tc.slug, tc.userID)
+			game, err := mockRepo.FindBySlug(tc.slug, tc.userID, "us")
 
 			assert.Equal(t, tc.expectedErr, err)
 			if err == gorm.ErrRecordNotFound {
@@ -523,6 +536,7 @@ func TestGameRepositoryMySQL_Search(t *testing.T) {
 
 	testCases := map[string]struct {
 		input        string
+		filters      ports.GameSearchFilters
 		mockBehavior func()
 		expected     []domain.Game
 		expectedErr  error
@@ -561,6 +575,28 @@ func TestGameRepositoryMySQL_Search(t *testing.T) {
 			expected:    []domain.Game{},
 			expectedErr: nil,
 		},
+		"price range in region": {
+			input:   "example",
+			filters: ports.GameSearchFilters{Region: "br", MinPrice: utils.UintPtr(1000), MaxPrice: utils.UintPtr(5000)},
+			mockBehavior: func() {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "about", "short_description"}).
+					AddRow(1, "Example Game 1", "Description 1", "About Game 1", "Short description 1").
+					AddRow(20, "Example Game 2", "Description 2", "About Game 2", "Short description 2")
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `games` WHERE (title LIKE ? OR description LIKE ? OR about LIKE ? OR short_description LIKE ?) AND EXISTS (SELECT 1 FROM `game_stores` JOIN store_prices ON store_prices.priceable_id = game_stores.id AND store_prices.priceable_type = ? WHERE (game_stores.game_id = games.id AND game_stores.deleted_at IS NULL AND store_prices.region = ?) AND store_prices.final_price >= ? AND store_prices.final_price <= ?) AND `games`.`deleted_at` IS NULL LIMIT ?",
+					),
+				).WithArgs("%example%", "%example%", "%example%", "%example%", "game_stores", "br", 1000, 5000, 100).WillReturnRows(rows)
+
+				baseQueries(mock, fixedTime, 1, 20)
+			},
+			expected: []domain.Game{
+				{ID: 1},
+				{ID: 20},
+			},
+			expectedErr: nil,
+		},
 		"query error": {
 			input: "error",
 			mockBehavior: func() {
@@ -579,7 +615,7 @@ func TestGameRepositoryMySQL_Search(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			tc.mockBehavior()
 
-			games, err := repo.Search(tc.input)
+			games, err := repo.Search(tc.input, tc.filters)
 
 			assert.Equal(t, tc.expectedErr, err)
 
@@ -785,6 +821,7 @@ func TestGameRepositoryMySQL_CalendarGames(t *testing.T) {
 	repo := db.NewGameRepositoryMySQL(gormDB)
 
 	testCases := map[string]struct {
+		followedBy   uint
 		mockBehavior func()
 		expected     []domain.Game
 		expectedErr  error
@@ -812,6 +849,25 @@ func TestGameRepositoryMySQL_CalendarGames(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		"only followed games": {
+			followedBy: 1,
+			mockBehavior: func() {
+				rows := sqlmock.NewRows([]string{"id", "release_date"}).
+					AddRow(2, fixedTime.AddDate(0, 6, 0))
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE release_date >= ? AND id IN (SELECT `game_id` FROM `game_follows` WHERE user_id = ? AND `game_follows`.`deleted_at` IS NULL) AND `games`.`deleted_at` IS NULL LIMIT ?")).
+					WithArgs(sqlmock.AnyArg(), 1, 100).
+					WillReturnRows(rows)
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `cracks` WHERE `cracks`.`game_id` = ? AND `cracks`.`deleted_at` IS NULL")).
+					WithArgs(2).
+					WillReturnRows(mock.NewRows([]string{"id", "game_id"}))
+			},
+			expected: []domain.Game{
+				{ID: 2},
+			},
+			expectedErr: nil,
+		},
 		"no matching records": {
 			mockBehavior: func() {
 				rows := sqlmock.NewRows([]string{"id", "title", "description", "about", "short_description"})
@@ -837,7 +893,7 @@ func TestGameRepositoryMySQL_CalendarGames(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			tc.mockBehavior()
 
-			games, err := repo.CalendarGames()
+			games, err := repo.CalendarGames(tc.followedBy)
 
 			assert.Equal(t, tc.expectedErr, err)
 
@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/db"
+	"gcstatus/internal/domain"
+	testutils "gcstatus/tests/utils"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaderboardRepositoryMySQL_GetBlockedUserIDs(t *testing.T) {
+	now := time.Now()
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewLeaderboardRepositoryMySQL(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `users` WHERE (blocked = ? AND (blocked_until IS NULL OR blocked_until > ?)) AND `users`.`deleted_at` IS NULL")).
+		WithArgs(true, now).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(7))
+
+	ids, err := repo.GetBlockedUserIDs(now)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{3, 7}, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLeaderboardRepositoryMySQL_GetScores(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewLeaderboardRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		period         domain.LeaderboardPeriod
+		mockSetup      func(period domain.LeaderboardPeriod)
+		expectedScores map[uint]float64
+		expectedError  error
+	}{
+		"all-time coins": {
+			period: domain.NewLeaderboardPeriod(domain.LeaderboardCoins, domain.LeaderboardAllTime, now),
+			mockSetup: func(period domain.LeaderboardPeriod) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT user_id, SUM(amount) AS score FROM `transactions` WHERE transaction_type_id = ? AND `transactions`.`deleted_at` IS NULL GROUP BY `user_id`")).
+					WithArgs(domain.AdditionTransactionTypeID).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "score"}).AddRow(1, 300).AddRow(2, 0))
+			},
+			expectedScores: map[uint]float64{1: 300},
+		},
+		"weekly titles": {
+			period: domain.NewLeaderboardPeriod(domain.LeaderboardTitles, domain.LeaderboardWeekly, now),
+			mockSetup: func(period domain.LeaderboardPeriod) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT user_id, COUNT(*) AS score FROM `user_titles` WHERE (created_at >= ? AND created_at < ?) AND `user_titles`.`deleted_at` IS NULL GROUP BY `user_id`")).
+					WithArgs(period.Start, period.End).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "score"}).AddRow(4, 2))
+			},
+			expectedScores: map[uint]float64{4: 2},
+		},
+		"db error": {
+			period: domain.NewLeaderboardPeriod(domain.LeaderboardCoins, domain.LeaderboardAllTime, now),
+			mockSetup: func(period domain.LeaderboardPeriod) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT user_id, SUM(amount) AS score FROM `transactions` WHERE transaction_type_id = ? AND `transactions`.`deleted_at` IS NULL GROUP BY `user_id`")).
+					WithArgs(domain.AdditionTransactionTypeID).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup(tc.period)
+
+			scores, err := repo.GetScores(tc.period)
+
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedScores, scores)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,430 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"gcstatus/internal/adapters/db"
+	"gcstatus/internal/domain"
+	testutils "gcstatus/tests/utils"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestLibraryRepositoryMySQL_FindByID(t *testing.T) {
+	fixedTime := time.Now()
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewLibraryRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		entryID       uint
+		mockSetup     func()
+		expectedError error
+		expectedEntry *domain.Libraryable
+	}{
+		"success - entry found": {
+			entryID: 1,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `libraryables` WHERE `libraryables`.`id` = ? AND `libraryables`.`deleted_at` IS NULL ORDER BY `libraryables`.`id` LIMIT ?")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "status", "private", "libraryable_id", "libraryable_type", "user_id", "created_at", "updated_at"}).
+						AddRow(1, "playing", false, 1, "games", 1, fixedTime, fixedTime))
+			},
+			expectedEntry: &domain.Libraryable{
+				ID:              1,
+				Status:          "playing",
+				Private:         false,
+				LibraryableID:   1,
+				LibraryableType: "games",
+				UserID:          1,
+				CreatedAt:       fixedTime,
+				UpdatedAt:       fixedTime,
+			},
+			expectedError: nil,
+		},
+		"error - entry not found": {
+			entryID: 2,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `libraryables` WHERE `libraryables`.`id` = ? AND `libraryables`.`deleted_at` IS NULL ORDER BY `libraryables`.`id` LIMIT ?")).
+					WithArgs(2, 1).
+					WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+			expectedEntry: nil,
+			expectedError: gorm.ErrRecordNotFound,
+		},
+		"error - db failure": {
+			entryID: 3,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `libraryables` WHERE `libraryables`.`id` = ? AND `libraryables`.`deleted_at` IS NULL ORDER BY `libraryables`.`id` LIMIT ?")).
+					WithArgs(3, 1).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedEntry: nil,
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			entry, err := repo.FindByID(tc.entryID)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedEntry, entry)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestLibraryRepositoryMySQL_FindForUser(t *testing.T) {
+	fixedTime := time.Now()
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewLibraryRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		libraryableID   uint
+		libraryableType string
+		userID          uint
+		mockSetup       func()
+		expectedError   error
+		expectedEntry   *domain.Libraryable
+	}{
+		"success - entry found": {
+			libraryableID:   1,
+			libraryableType: "games",
+			userID:          1,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `libraryables` WHERE (libraryable_id = ? AND libraryable_type = ? AND user_id = ?) AND `libraryables`.`deleted_at` IS NULL ORDER BY `libraryables`.`id` LIMIT ?")).
+					WithArgs(1, "games", 1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "status", "libraryable_id", "libraryable_type", "user_id", "created_at", "updated_at"}).
+						AddRow(1, "backlog", 1, "games", 1, fixedTime, fixedTime))
+			},
+			expectedEntry: &domain.Libraryable{
+				ID:              1,
+				Status:          "backlog",
+				LibraryableID:   1,
+				LibraryableType: "games",
+				UserID:          1,
+				CreatedAt:       fixedTime,
+				UpdatedAt:       fixedTime,
+			},
+			expectedError: nil,
+		},
+		"error - entry not found": {
+			libraryableID:   2,
+			libraryableType: "dlcs",
+			userID:          1,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `libraryables` WHERE (libraryable_id = ? AND libraryable_type = ? AND user_id = ?) AND `libraryables`.`deleted_at` IS NULL ORDER BY `libraryables`.`id` LIMIT ?")).
+					WithArgs(2, "dlcs", 1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+			expectedEntry: nil,
+			expectedError: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			entry, err := repo.FindForUser(tc.libraryableID, tc.libraryableType, tc.userID)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedEntry, entry)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestLibraryRepositoryMySQL_LibraryableExists(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewLibraryRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		libraryableID   uint
+		libraryableType string
+		mockSetup       func()
+		expected        bool
+		expectedError   error
+	}{
+		"game exists": {
+			libraryableID:   1,
+			libraryableType: "games",
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `games` WHERE id = ? AND `games`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			},
+			expected:      true,
+			expectedError: nil,
+		},
+		"dlc does not exist": {
+			libraryableID:   2,
+			libraryableType: "dlcs",
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `dlcs` WHERE id = ? AND `dlcs`.`deleted_at` IS NULL")).
+					WithArgs(2).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			},
+			expected:      false,
+			expectedError: nil,
+		},
+		"unknown type": {
+			libraryableID:   3,
+			libraryableType: "invalid",
+			mockSetup:       func() {},
+			expected:        false,
+			expectedError:   nil,
+		},
+		"db failure": {
+			libraryableID:   4,
+			libraryableType: "games",
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `games` WHERE id = ? AND `games`.`deleted_at` IS NULL")).
+					WithArgs(4).
+					WillReturnError(errors.New("db error"))
+			},
+			expected:      false,
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			exists, err := repo.LibraryableExists(tc.libraryableID, tc.libraryableType)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expected, exists)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestLibraryRepositoryMySQL_HasTrackedAction(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewLibraryRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		count         int
+		expected      bool
+		expectedError error
+	}{
+		"already tracked": {
+			count:    1,
+			expected: true,
+		},
+		"never tracked": {
+			count:    0,
+			expected: false,
+		},
+		"db failure": {
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			query := mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `user_actions` WHERE (user_id = ? AND `key` = ? AND target_type = ? AND target_id = ?) AND `user_actions`.`deleted_at` IS NULL")).
+				WithArgs(1, domain.AddToLibraryRequirementKey, domain.LibraryableTypeGames, 2)
+			if tc.expectedError != nil {
+				query.WillReturnError(tc.expectedError)
+			} else {
+				query.WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(tc.count))
+			}
+
+			tracked, err := repo.HasTrackedAction(1, domain.AddToLibraryRequirementKey, 2, domain.LibraryableTypeGames)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expected, tracked)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestLibraryRepositoryMySQL_GetAllForUser(t *testing.T) {
+	fixedTime := time.Now()
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewLibraryRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		userID        uint
+		status        string
+		onlyPublic    bool
+		mockSetup     func()
+		expectedLen   int
+		expectedError error
+	}{
+		"success - own library with games": {
+			userID: 1,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `libraryables` WHERE libraryables.user_id = ? AND `libraryables`.`deleted_at` IS NULL ORDER BY libraryables.updated_at DESC")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "status", "libraryable_id", "libraryable_type", "user_id", "created_at", "updated_at"}).
+						AddRow(1, "playing", 1, "games", 1, fixedTime, fixedTime))
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE id IN (?) AND `games`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title", "slug"}).AddRow(1, "Game Test", "game-test"))
+			},
+			expectedLen:   1,
+			expectedError: nil,
+		},
+		"success - public library filtered by status": {
+			userID:     2,
+			status:     "completed",
+			onlyPublic: true,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT `libraryables`.`id`,`libraryables`.`created_at`,`libraryables`.`updated_at`,`libraryables`.`deleted_at`,`libraryables`.`status`,`libraryables`.`hours_played`,`libraryables`.`completed_at`,`libraryables`.`private`,`libraryables`.`libraryable_id`,`libraryables`.`libraryable_type`,`libraryables`.`user_id` FROM `libraryables` JOIN profiles ON profiles.user_id = libraryables.user_id WHERE libraryables.user_id = ? AND libraryables.status = ? AND (profiles.share = ? AND libraryables.private = ?) AND `libraryables`.`deleted_at` IS NULL ORDER BY libraryables.updated_at DESC")).
+					WithArgs(2, "completed", true, false).
+					WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+			expectedLen:   0,
+			expectedError: nil,
+		},
+		"error - db failure": {
+			userID: 3,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `libraryables` WHERE libraryables.user_id = ? AND `libraryables`.`deleted_at` IS NULL ORDER BY libraryables.updated_at DESC")).
+					WithArgs(3).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedLen:   0,
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			entries, err := repo.GetAllForUser(tc.userID, tc.status, tc.onlyPublic)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Len(t, entries, tc.expectedLen)
+
+			for _, entry := range entries {
+				game, ok := entry.Libraryable.(*domain.Game)
+				assert.True(t, ok)
+				assert.Equal(t, "Game Test", game.Title)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestLibraryRepositoryMySQL_GetStatsForUser(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewLibraryRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		userID        uint
+		mockSetup     func()
+		expected      domain.LibraryStats
+		expectedError error
+	}{
+		"success": {
+			userID: 1,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT status, COUNT(*) AS total, COALESCE(SUM(hours_played), 0) AS hours_played FROM `libraryables` WHERE user_id = ? AND `libraryables`.`deleted_at` IS NULL GROUP BY `status`")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"status", "total", "hours_played"}).
+						AddRow("playing", 2, 30).
+						AddRow("completed", 3, 120).
+						AddRow("wishlist", 4, 0))
+			},
+			expected: domain.LibraryStats{
+				Total:       9,
+				Wishlist:    4,
+				Playing:     2,
+				Completed:   3,
+				HoursPlayed: 150,
+			},
+			expectedError: nil,
+		},
+		"db failure": {
+			userID: 2,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT status, COUNT(*) AS total, COALESCE(SUM(hours_played), 0) AS hours_played FROM `libraryables` WHERE user_id = ? AND `libraryables`.`deleted_at` IS NULL GROUP BY `status`")).
+					WithArgs(2).
+					WillReturnError(errors.New("db error"))
+			},
+			expected:      domain.LibraryStats{},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			stats, err := repo.GetStatsForUser(tc.userID)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expected, stats)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestLibraryRepositoryMySQL_Delete(t *testing.T) {
+	testCases := map[string]struct {
+		entryID      uint
+		mockBehavior func(mock sqlmock.Sqlmock, entryID uint)
+		wantErr      bool
+	}{
+		"can delete a library entry": {
+			entryID: 1,
+			mockBehavior: func(mock sqlmock.Sqlmock, entryID uint) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `libraryables` WHERE `libraryables`.`id` = ?")).
+					WithArgs(entryID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		"delete fails": {
+			entryID: 2,
+			mockBehavior: func(mock sqlmock.Sqlmock, entryID uint) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `libraryables` WHERE `libraryables`.`id` = ?")).
+					WithArgs(2).
+					WillReturnError(fmt.Errorf("failed to delete library entry"))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db.NewLibraryRepositoryMySQL(gormDB)
+
+			tc.mockBehavior(mock, tc.entryID)
+
+			err := repo.Delete(tc.entryID)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
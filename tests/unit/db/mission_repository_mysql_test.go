@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"gcstatus/internal/adapters/db"
 	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
 	testutils "gcstatus/tests/utils"
+	"net/http"
 	"regexp"
 	"testing"
 	"time"
@@ -105,7 +107,7 @@ func TestMissionRepositoryMySQL_GetAllForUser(t *testing.T) {
 			userID: 1,
 			mockSetup: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(
-					"SELECT `missions`.`id`,`missions`.`created_at`,`missions`.`updated_at`,`missions`.`deleted_at`,`missions`.`mission`,`missions`.`description`,`missions`.`status`,`missions`.`for_all`,`missions`.`coins`,`missions`.`experience`,`missions`.`frequency`,`missions`.`reset_time` FROM `missions` LEFT JOIN user_missions ON user_missions.mission_id = missions.id AND user_missions.user_id = ? WHERE (missions.for_all = ? OR user_missions.user_id = ?) AND missions.status NOT IN (?, ?) AND `missions`.`deleted_at` IS NULL")).
+					"SELECT `missions`.`id`,`missions`.`created_at`,`missions`.`updated_at`,`missions`.`deleted_at`,`missions`.`mission`,`missions`.`description`,`missions`.`status`,`missions`.`for_all`,`missions`.`coins`,`missions`.`experience`,`missions`.`frequency`,`missions`.`reset_time`,`missions`.`reset_hour`,`missions`.`reset_weekday`,`missions`.`reset_timezone` FROM `missions` LEFT JOIN user_mission_assignments ON user_mission_assignments.mission_id = missions.id AND user_mission_assignments.user_id = ? AND user_mission_assignments.deleted_at IS NULL WHERE (missions.for_all = ? OR user_mission_assignments.user_id = ?) AND missions.status NOT IN (?, ?) AND `missions`.`deleted_at` IS NULL")).
 					WithArgs(1, true, 1, domain.MissionUnavailable, domain.MissionCanceled).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "mission", "description", "status", "for_all", "coins", "experience", "frequency", "reset_time", "created_at", "updated_at"}).
 						AddRow(1, "Mission 1", "Description", "available", true, 10, 50, "daily", fixedTime, fixedTime, fixedTime))
@@ -123,10 +125,11 @@ func TestMissionRepositoryMySQL_GetAllForUser(t *testing.T) {
 						AddRow(1, 1, 1, true))
 
 				mock.ExpectQuery(regexp.QuoteMeta(
-					"SELECT * FROM `rewards` WHERE `sourceable_type` = ? AND `rewards`.`sourceable_id` = ? AND rewardable_type = ? AND `rewards`.`deleted_at` IS NULL")).
-					WithArgs("missions", 1, "titles").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "sourceable_id", "sourceable_type", "rewardable_id", "rewardable_type"}).
-						AddRow(1, 1, "missions", 1, "titles"))
+					"SELECT * FROM `rewards` WHERE `sourceable_type` = ? AND `rewards`.`sourceable_id` = ? AND `rewards`.`deleted_at` IS NULL")).
+					WithArgs("missions", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "sourceable_id", "sourceable_type", "rewardable_id", "rewardable_type", "amount"}).
+						AddRow(1, 1, "missions", 1, "titles", 1).
+						AddRow(2, 1, "missions", 0, "coins", 25))
 
 				mock.ExpectQuery(regexp.QuoteMeta(
 					"SELECT * FROM `user_missions` WHERE `user_missions`.`mission_id` = ? AND user_id = ? AND `user_missions`.`deleted_at` IS NULL",
@@ -161,6 +164,10 @@ func TestMissionRepositoryMySQL_GetAllForUser(t *testing.T) {
 								Title: "Title 1",
 							},
 						},
+						{
+							RewardableType: "coins",
+							Amount:         25,
+						},
 					},
 					UserMission: []domain.UserMission{
 						{
@@ -179,7 +186,7 @@ func TestMissionRepositoryMySQL_GetAllForUser(t *testing.T) {
 			userID: 2,
 			mockSetup: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(
-					"SELECT `missions`.`id`,`missions`.`created_at`,`missions`.`updated_at`,`missions`.`deleted_at`,`missions`.`mission`,`missions`.`description`,`missions`.`status`,`missions`.`for_all`,`missions`.`coins`,`missions`.`experience`,`missions`.`frequency`,`missions`.`reset_time` FROM `missions` LEFT JOIN user_missions ON user_missions.mission_id = missions.id AND user_missions.user_id = ? WHERE (missions.for_all = ? OR user_missions.user_id = ?) AND missions.status NOT IN (?, ?) AND `missions`.`deleted_at` IS NULL")).
+					"SELECT `missions`.`id`,`missions`.`created_at`,`missions`.`updated_at`,`missions`.`deleted_at`,`missions`.`mission`,`missions`.`description`,`missions`.`status`,`missions`.`for_all`,`missions`.`coins`,`missions`.`experience`,`missions`.`frequency`,`missions`.`reset_time`,`missions`.`reset_hour`,`missions`.`reset_weekday`,`missions`.`reset_timezone` FROM `missions` LEFT JOIN user_mission_assignments ON user_mission_assignments.mission_id = missions.id AND user_mission_assignments.user_id = ? AND user_mission_assignments.deleted_at IS NULL WHERE (missions.for_all = ? OR user_mission_assignments.user_id = ?) AND missions.status NOT IN (?, ?) AND `missions`.`deleted_at` IS NULL")).
 					WithArgs(2, true, 2, domain.MissionUnavailable, domain.MissionCanceled).
 					WillReturnRows(sqlmock.NewRows([]string{}))
 			},
@@ -190,7 +197,7 @@ func TestMissionRepositoryMySQL_GetAllForUser(t *testing.T) {
 			userID: 3,
 			mockSetup: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(
-					"SELECT `missions`.`id`,`missions`.`created_at`,`missions`.`updated_at`,`missions`.`deleted_at`,`missions`.`mission`,`missions`.`description`,`missions`.`status`,`missions`.`for_all`,`missions`.`coins`,`missions`.`experience`,`missions`.`frequency`,`missions`.`reset_time` FROM `missions` LEFT JOIN user_missions ON user_missions.mission_id = missions.id AND user_missions.user_id = ? WHERE (missions.for_all = ? OR user_missions.user_id = ?) AND missions.status NOT IN (?, ?) AND `missions`.`deleted_at` IS NULL")).
+					"SELECT `missions`.`id`,`missions`.`created_at`,`missions`.`updated_at`,`missions`.`deleted_at`,`missions`.`mission`,`missions`.`description`,`missions`.`status`,`missions`.`for_all`,`missions`.`coins`,`missions`.`experience`,`missions`.`frequency`,`missions`.`reset_time`,`missions`.`reset_hour`,`missions`.`reset_weekday`,`missions`.`reset_timezone` FROM `missions` LEFT JOIN user_mission_assignments ON user_mission_assignments.mission_id = missions.id AND user_mission_assignments.user_id = ? AND user_mission_assignments.deleted_at IS NULL WHERE (missions.for_all = ? OR user_mission_assignments.user_id = ?) AND missions.status NOT IN (?, ?) AND `missions`.`deleted_at` IS NULL")).
 					WithArgs(3, true, 3, domain.MissionUnavailable, domain.MissionCanceled).
 					WillReturnError(errors.New("db error"))
 			},
@@ -316,7 +323,7 @@ func TestMissionRepositoryMySQL_CompleteMission(t *testing.T) {
 					WithArgs(userID, missionID, 1).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "completed"}).AddRow(1, true))
 			},
-			expectedError: fmt.Errorf("mission already completed by user"),
+			expectedError: self_errors.NewHttpError(http.StatusConflict, "You have already completed this mission."),
 		},
 		"mission requirements not fully completed": {
 			userID:    1,
@@ -361,13 +368,37 @@ func TestMissionRepositoryMySQL_CompleteMission(t *testing.T) {
 					WillReturnRows(sqlmock.NewRows([]string{"id", "completed"}).AddRow(1, true))
 
 				mock.ExpectBegin()
-				mock.ExpectExec(regexp.QuoteMeta("UPDATE `user_missions` SET `created_at`=?,`updated_at`=?,`deleted_at`=?,`completed`=?,`last_completed_at`=?,`user_id`=?,`mission_id`=? WHERE `user_missions`.`deleted_at` IS NULL AND `id` = ?")).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), true, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 1).
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `user_missions` SET `completed`=?,`last_completed_at`=?,`updated_at`=? WHERE (id = ? AND completed = ?) AND `user_missions`.`deleted_at` IS NULL")).
+					WithArgs(true, sqlmock.AnyArg(), sqlmock.AnyArg(), 1, false).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 			},
 			expectedError: nil,
 		},
+		"mission completed by a concurrent request": {
+			userID:    1,
+			missionID: 6,
+			mockSetup: func(userID uint, missionID uint) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `missions` WHERE (id = ? AND status NOT IN (?, ?)) AND `missions`.`deleted_at` IS NULL ORDER BY `missions`.`id` LIMIT ?")).
+					WithArgs(missionID, domain.MissionUnavailable, domain.MissionCanceled, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "for_all"}).AddRow(missionID, true))
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_missions` WHERE (`user_missions`.`user_id` = ? AND `user_missions`.`mission_id` = ?) AND `user_missions`.`deleted_at` IS NULL ORDER BY `user_missions`.`id` LIMIT ?")).
+					WithArgs(userID, missionID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "completed"}).AddRow(1, false))
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `mission_requirements` WHERE mission_id = ? AND `mission_requirements`.`deleted_at` IS NULL")).
+					WithArgs(missionID).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `user_missions` SET `completed`=?,`last_completed_at`=?,`updated_at`=? WHERE (id = ? AND completed = ?) AND `user_missions`.`deleted_at` IS NULL")).
+					WithArgs(true, sqlmock.AnyArg(), sqlmock.AnyArg(), 1, false).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			expectedError: self_errors.NewHttpError(http.StatusConflict, "You have already completed this mission."),
+		},
 	}
 
 	for name, tc := range testCases {
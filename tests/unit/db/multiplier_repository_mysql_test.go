@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"gcstatus/internal/adapters/db"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	testutils "gcstatus/tests/utils"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestMultiplierRepositoryMySQL_BuyBooster(t *testing.T) {
+	boosterRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "name", "scope", "multiplier", "duration_minutes", "cost", "purchasable"}).
+			AddRow(4, "Double XP", domain.BoosterScopeExperience, 2, 60, 100, true)
+	}
+
+	testCases := map[string]struct {
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectedErr error
+	}{
+		"not purchasable": {
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `boosters` WHERE purchasable = ? AND `boosters`.`id` = ?")).
+					WithArgs(true, 4, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.NewHttpError(http.StatusNotFound, "The booster you are trying to buy does not exist."),
+		},
+		"insufficient funds": {
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `boosters` WHERE purchasable = ? AND `boosters`.`id` = ?")).
+					WithArgs(true, 4, 1).
+					WillReturnRows(boosterRows())
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `wallets` SET `amount`=amount - ?,`updated_at`=? WHERE (user_id = ? AND amount >= ?) AND `wallets`.`deleted_at` IS NULL")).
+					WithArgs(100, sqlmock.AnyArg(), 1, 100).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "Insufficient funds to purchase the booster!"),
+		},
+		"bought": {
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `boosters` WHERE purchasable = ? AND `boosters`.`id` = ?")).
+					WithArgs(true, 4, 1).
+					WillReturnRows(boosterRows())
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `wallets` SET `amount`=amount - ?,`updated_at`=? WHERE (user_id = ? AND amount >= ?) AND `wallets`.`deleted_at` IS NULL")).
+					WithArgs(100, sqlmock.AnyArg(), 1, 100).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `transactions`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_boosters` WHERE (user_id = ? AND booster_id = ? AND expires_at > ?)")).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `user_boosters`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db.NewMultiplierRepositoryMySQL(gormDB)
+
+			tc.mockSetup(mock)
+
+			userBooster, transaction, err := repo.BuyBooster(1, 4)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, "Purchase of the booster Double XP by 100 coins.", transaction.Description)
+				assert.Equal(t, uint(domain.SubtractionTransactionTypeID), transaction.TransactionTypeID)
+				assert.Equal(t, 2.0, userBooster.Multiplier)
+				assert.WithinDuration(t, time.Now().Add(time.Hour), userBooster.ExpiresAt, time.Minute)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
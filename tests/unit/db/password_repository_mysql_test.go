@@ -189,3 +189,57 @@ func TestPasswordResetRepositoryMySQL_DeletePasswordResetByID(t *testing.T) {
 		})
 	}
 }
+
+func TestPasswordResetRepositoryMySQL_DeleteExpiredPasswordResets(t *testing.T) {
+	now := time.Now()
+
+	testCases := map[string]struct {
+		mockBehavior func(mock sqlmock.Sqlmock)
+		expected     int64
+		wantErr      bool
+	}{
+		"Can delete the expired and used password resets": {
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `password_resets` WHERE expires_at < ? OR deleted_at IS NOT NULL")).
+					WithArgs(now).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectCommit()
+			},
+			expected: 3,
+		},
+		"Delete fails": {
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `password_resets` WHERE expires_at < ? OR deleted_at IS NOT NULL")).
+					WithArgs(now).
+					WillReturnError(fmt.Errorf("failed to delete password resets"))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+
+			repo := db.NewPasswordResetRepositoryMySQL(gormDB)
+
+			tc.mockBehavior(mock)
+
+			deleted, err := repo.DeleteExpiredPasswordResets(now)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, deleted)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
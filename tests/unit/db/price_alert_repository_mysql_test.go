@@ -0,0 +1,273 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"gcstatus/internal/adapters/db"
+	"gcstatus/internal/domain"
+	testutils "gcstatus/tests/utils"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestPriceAlertRepositoryMySQL_GetAllForUser(t *testing.T) {
+	fixedTime := time.Now()
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewPriceAlertRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		userID        uint
+		mockSetup     func()
+		expectedLen   int
+		expectedError error
+	}{
+		"success - alerts found": {
+			userID: 1,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `price_alerts` WHERE user_id = ? AND `price_alerts`.`deleted_at` IS NULL ORDER BY created_at DESC")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "any_discount", "active", "game_id", "user_id", "created_at", "updated_at"}).
+						AddRow(1, true, true, 1, 1, fixedTime, fixedTime))
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `games` WHERE `games`.`id` = ? AND `games`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title", "slug"}).AddRow(1, "Game Test", "game-test"))
+			},
+			expectedLen:   1,
+			expectedError: nil,
+		},
+		"error - db failure": {
+			userID: 2,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `price_alerts` WHERE user_id = ? AND `price_alerts`.`deleted_at` IS NULL ORDER BY created_at DESC")).
+					WithArgs(2).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedLen:   0,
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			alerts, err := repo.GetAllForUser(tc.userID)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Len(t, alerts, tc.expectedLen)
+
+			for _, alert := range alerts {
+				assert.Equal(t, "Game Test", alert.Game.Title)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPriceAlertRepositoryMySQL_FindByID(t *testing.T) {
+	fixedTime := time.Now()
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewPriceAlertRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		alertID       uint
+		mockSetup     func()
+		expectedAlert *domain.PriceAlert
+		expectedError error
+	}{
+		"success - alert found": {
+			alertID: 1,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `price_alerts` WHERE `price_alerts`.`id` = ? AND `price_alerts`.`deleted_at` IS NULL ORDER BY `price_alerts`.`id` LIMIT ?")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "any_discount", "active", "game_id", "user_id", "created_at", "updated_at"}).
+						AddRow(1, true, true, 1, 1, fixedTime, fixedTime))
+			},
+			expectedAlert: &domain.PriceAlert{
+				ID:          1,
+				AnyDiscount: true,
+				Active:      true,
+				GameID:      1,
+				UserID:      1,
+				CreatedAt:   fixedTime,
+				UpdatedAt:   fixedTime,
+			},
+			expectedError: nil,
+		},
+		"error - alert not found": {
+			alertID: 2,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `price_alerts` WHERE `price_alerts`.`id` = ? AND `price_alerts`.`deleted_at` IS NULL ORDER BY `price_alerts`.`id` LIMIT ?")).
+					WithArgs(2, 1).
+					WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+			expectedAlert: nil,
+			expectedError: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			alert, err := repo.FindByID(tc.alertID)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedAlert, alert)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPriceAlertRepositoryMySQL_FindForUser(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewPriceAlertRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		gameID        uint
+		userID        uint
+		mockSetup     func()
+		expectedError error
+	}{
+		"success - alert found": {
+			gameID: 1,
+			userID: 1,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `price_alerts` WHERE (game_id = ? AND user_id = ?) AND `price_alerts`.`deleted_at` IS NULL ORDER BY `price_alerts`.`id` LIMIT ?")).
+					WithArgs(1, 1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "game_id", "user_id"}).AddRow(1, 1, 1))
+			},
+			expectedError: nil,
+		},
+		"error - alert not found": {
+			gameID: 2,
+			userID: 1,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `price_alerts` WHERE (game_id = ? AND user_id = ?) AND `price_alerts`.`deleted_at` IS NULL ORDER BY `price_alerts`.`id` LIMIT ?")).
+					WithArgs(2, 1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+			expectedError: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			alert, err := repo.FindForUser(tc.gameID, tc.userID)
+
+			assert.Equal(t, tc.expectedError, err)
+			if tc.expectedError == nil {
+				assert.Equal(t, tc.gameID, alert.GameID)
+				assert.Equal(t, tc.userID, alert.UserID)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPriceAlertRepositoryMySQL_GameExists(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewPriceAlertRepositoryMySQL(gormDB)
+
+	testCases := map[string]struct {
+		gameID        uint
+		mockSetup     func()
+		expected      bool
+		expectedError error
+	}{
+		"game exists": {
+			gameID: 1,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `games` WHERE id = ? AND `games`.`deleted_at` IS NULL")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			},
+			expected:      true,
+			expectedError: nil,
+		},
+		"game does not exist": {
+			gameID: 2,
+			mockSetup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `games` WHERE id = ? AND `games`.`deleted_at` IS NULL")).
+					WithArgs(2).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			},
+			expected:      false,
+			expectedError: nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockSetup()
+
+			exists, err := repo.GameExists(tc.gameID)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expected, exists)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPriceAlertRepositoryMySQL_Delete(t *testing.T) {
+	testCases := map[string]struct {
+		alertID      uint
+		mockBehavior func(mock sqlmock.Sqlmock, alertID uint)
+		wantErr      bool
+	}{
+		"can delete a price alert": {
+			alertID: 1,
+			mockBehavior: func(mock sqlmock.Sqlmock, alertID uint) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `price_alerts` WHERE `price_alerts`.`id` = ?")).
+					WithArgs(alertID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		"delete fails": {
+			alertID: 2,
+			mockBehavior: func(mock sqlmock.Sqlmock, alertID uint) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `price_alerts` WHERE `price_alerts`.`id` = ?")).
+					WithArgs(2).
+					WillReturnError(fmt.Errorf("failed to delete price alert"))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db.NewPriceAlertRepositoryMySQL(gormDB)
+
+			tc.mockBehavior(mock, tc.alertID)
+
+			err := repo.Delete(tc.alertID)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -144,3 +144,56 @@ func TestUpdatePicture(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateRegion(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+
+	repo := db.NewProfileRepositoryMySQL(gormDB)
+
+	tests := map[string]struct {
+		profileID uint
+		region    string
+		mock      func()
+		expectErr bool
+	}{
+		"successful region update": {
+			profileID: 1,
+			region:    "br",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `profiles` SET `region`=?,`updated_at`=? WHERE id = ? AND `profiles`.`deleted_at` IS NULL")).
+					WithArgs("br", sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectErr: false,
+		},
+		"failed region update due to database error": {
+			profileID: 2,
+			region:    "eu",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `profiles` SET `region`=?,`updated_at`=? WHERE id = ? AND `profiles`.`deleted_at` IS NULL")).
+					WithArgs("eu", sqlmock.AnyArg(), 2).
+					WillReturnError(fmt.Errorf("database error"))
+				mock.ExpectRollback()
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt.mock()
+			err := repo.UpdateRegion(tt.profileID, tt.region)
+
+			if (err != nil) != tt.expectErr {
+				t.Errorf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/db"
+	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	testutils "gcstatus/tests/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuizRepositoryMySQL_CreateAttempt(t *testing.T) {
+	testCases := map[string]struct {
+		mock        func(mock sqlmock.Sqlmock)
+		expectedErr error
+	}{
+		"records the attempt and its answers": {
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `quiz_attempts`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `quiz_answers`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		"already answered": {
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `quiz_attempts`")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: self_errors.NewHttpError(http.StatusConflict, "You have already answered this quiz."),
+		},
+		"db failure": {
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `quiz_attempts`")).
+					WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db.NewQuizRepositoryMySQL(gormDB)
+			tc.mock(mock)
+
+			attempt := domain.QuizAttempt{
+				QuizID:  1,
+				UserID:  1,
+				Correct: 1,
+				Score:   100,
+				Answers: []domain.QuizAnswer{{QuizID: 1, QuestionID: 1, OptionID: 1, Correct: true}},
+			}
+
+			err := repo.CreateAttempt(&attempt)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, uint(1), attempt.ID)
+				assert.Equal(t, uint(1), attempt.Answers[0].AttemptID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestQuizRepositoryMySQL_GetResults(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewQuizRepositoryMySQL(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) AS attempts, COALESCE(AVG(score), 0) AS average_score FROM `quiz_attempts` WHERE quiz_id = ?")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"attempts", "average_score"}).AddRow(4, 62.5))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT option_id, COUNT(*) AS votes FROM `quiz_answers` WHERE quiz_id = ?")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"option_id", "votes"}).AddRow(1, 3).AddRow(2, 1))
+
+	results, err := repo.GetResults(domain.Quiz{ID: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(4), results.Attempts)
+	assert.Equal(t, 62.5, results.AverageScore)
+	assert.Equal(t, map[uint]uint{1: 3, 2: 1}, results.Votes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
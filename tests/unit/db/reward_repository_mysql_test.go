@@ -0,0 +1,206 @@
+package tests
+
+import (
+	"errors"
+	"gcstatus/internal/adapters/db"
+	"gcstatus/internal/domain"
+	testutils "gcstatus/tests/utils"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRewardRepositoryMySQL_GrantRewards(t *testing.T) {
+	testCases := map[string]struct {
+		rewards     []domain.Reward
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expected    domain.GrantedRewards
+		expectedErr bool
+	}{
+		"already granted": {
+			rewards: domain.AmountRewards(50, 0),
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `reward_grants`")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			expected: domain.GrantedRewards{Duplicate: true},
+		},
+		"coins and a title": {
+			rewards: []domain.Reward{
+				{RewardableType: domain.RewardableTypeCoins, Amount: 50},
+				{RewardableType: domain.RewardableTypeTitles, RewardableID: 3},
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `reward_grants`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectNoMultipliers(mock)
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `wallets` SET `amount`=amount + ?")).
+					WithArgs(50, sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `titles` WHERE `titles`.`id` = ?")).
+					WithArgs(3, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).AddRow(3, "Collector"))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `user_titles` WHERE (user_id = ? AND title_id = ?)")).
+					WithArgs(1, 3).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `user_titles`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `title_requirements` WHERE title_id = ?")).
+					WithArgs(3).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title_id"}))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `transactions`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `notifications`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expected: domain.GrantedRewards{Coins: 50, TitleIDs: []uint{3}},
+		},
+		"title already held": {
+			rewards: []domain.Reward{{RewardableType: domain.RewardableTypeTitles, RewardableID: 3}},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `reward_grants`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `titles` WHERE `titles`.`id` = ?")).
+					WithArgs(3, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).AddRow(3, "Collector"))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `user_titles` WHERE (user_id = ? AND title_id = ?)")).
+					WithArgs(1, 3).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectCommit()
+			},
+			expected: domain.GrantedRewards{},
+		},
+		"failure rolls back every reward": {
+			rewards: []domain.Reward{
+				{RewardableType: domain.RewardableTypeCoins, Amount: 50},
+				{RewardableType: domain.RewardableTypeItems, RewardableID: 9},
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `reward_grants`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectNoMultipliers(mock)
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `wallets` SET `amount`=amount + ?")).
+					WithArgs(50, sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `items` WHERE `items`.`id` = ?")).
+					WithArgs(9, 1).
+					WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			expectedErr: true,
+		},
+		"unknown reward type": {
+			rewards: []domain.Reward{{RewardableType: "badges", RewardableID: 1}},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `reward_grants`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectRollback()
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db.NewRewardRepositoryMySQL(gormDB)
+
+			tc.mockSetup(mock)
+
+			grant := domain.RewardGrant{UserID: 1, Key: "missions:1:message", Reason: "mission Collect", ActionKey: domain.CompleteMissionActionKey}
+
+			granted, err := repo.GrantRewards(grant, tc.rewards)
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected.Duplicate, granted.Duplicate)
+				assert.Equal(t, tc.expected.Coins, granted.Coins)
+				assert.Equal(t, tc.expected.TitleIDs, granted.TitleIDs)
+				assert.Len(t, granted.Notifications, len(tc.expected.TitleIDs))
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRewardRepositoryMySQL_GrantRewards_Transactions(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewRewardRepositoryMySQL(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `reward_grants`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectNoMultipliers(mock)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `wallets` SET `amount`=amount + ?")).
+		WithArgs(25, sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `transactions`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	grant := domain.RewardGrant{UserID: 1, Key: "check_ins:2026-10-19", Reason: "a 7 day check-in streak", ActionKey: domain.CheckInRequirementKey}
+
+	granted, err := repo.GrantRewards(grant, domain.AmountRewards(25, 0))
+
+	assert.NoError(t, err)
+	assert.Len(t, granted.Transactions, 1)
+	assert.Equal(t, "Received coins from a 7 day check-in streak.", granted.Transactions[0].Description)
+	assert.Equal(t, uint(domain.AdditionTransactionTypeID), granted.Transactions[0].TransactionTypeID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRewardRepositoryMySQL_GrantRewards_Multipliers(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	repo := db.NewRewardRepositoryMySQL(gormDB)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `reward_grants`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `multiplier_events` WHERE (starts_at <= ? AND ends_at > ?)")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "scope", "multiplier", "action_key", "starts_at", "ends_at"}).
+			AddRow(1, domain.BoosterScopeCoins, 2, "", now.Add(-time.Hour), now.Add(time.Hour)).
+			AddRow(2, domain.BoosterScopeCoins, 3, domain.CheckInRequirementKey, now.Add(-time.Hour), now.Add(time.Hour)))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_boosters` WHERE (user_id = ? AND expires_at > ?)")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "booster_id", "scope", "multiplier", "expires_at"}).
+			AddRow(1, 1, 4, domain.BoosterScopeCoins, 1.5, now.Add(time.Hour)))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `boosters` WHERE `boosters`.`id` = ?")).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(4, "Gold rush"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `wallets` SET `amount`=amount + ?")).
+		WithArgs(75, sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `transactions`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	grant := domain.RewardGrant{UserID: 1, Key: "missions:1:message", Reason: "mission Collect", ActionKey: domain.CompleteMissionActionKey}
+
+	granted, err := repo.GrantRewards(grant, domain.AmountRewards(25, 0))
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(75), granted.Coins)
+	assert.Equal(t, 3.0, granted.Multipliers.Coins)
+	assert.Equal(t, "Received coins from mission Collect (x3 multiplier).", granted.Transactions[0].Description)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func expectNoMultipliers(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `multiplier_events` WHERE (starts_at <= ? AND ends_at > ?)")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_boosters` WHERE (user_id = ? AND expires_at > ?)")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+}
@@ -305,13 +305,14 @@ func TestTaskRepositoryMySQL_UpdateMissionProgress(t *testing.T) {
 			},
 			mock: func(mock sqlmock.Sqlmock, progress domain.MissionProgress) {
 				mock.ExpectBegin()
-				mock.ExpectExec(regexp.QuoteMeta("UPDATE `mission_progresses` SET `created_at`=?,`updated_at`=?,`deleted_at`=?,`progress`=?,`completed`=?,`user_id`=?,`mission_requirement_id`=? WHERE `mission_progresses`.`deleted_at` IS NULL AND `id` = ?")).
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `mission_progresses` SET `created_at`=?,`updated_at`=?,`deleted_at`=?,`progress`=?,`completed`=?,`reset_at`=?,`user_id`=?,`mission_requirement_id`=? WHERE `mission_progresses`.`deleted_at` IS NULL AND `id` = ?")).
 					WithArgs(
 						sqlmock.AnyArg(),
 						sqlmock.AnyArg(),
 						sqlmock.AnyArg(),
 						progress.Progress,
 						progress.Completed,
+						sqlmock.AnyArg(),
 						progress.UserID,
 						progress.MissionRequirementID,
 						progress.ID,
@@ -574,7 +575,7 @@ func TestTaskRepositoryMySQL_GetOrCreateMissionProgress(t *testing.T) {
 			userID:               1,
 			missionRequirementID: 1,
 			mock: func() {
-				mock.ExpectQuery(regexp.QuoteMeta("SELECT `mission_progresses`.`id`,`mission_progresses`.`created_at`,`mission_progresses`.`updated_at`,`mission_progresses`.`deleted_at`,`mission_progresses`.`progress`,`mission_progresses`.`completed`,`mission_progresses`.`user_id`,`mission_progresses`.`mission_requirement_id` FROM `mission_progresses` JOIN mission_requirements ON mission_requirements.id = mission_progresses.mission_requirement_id JOIN missions ON missions.id = mission_requirements.mission_id WHERE (mission_requirements.id = ? AND mission_progresses.user_id = ?) AND missions.status NOT IN (?, ?) AND (`mission_progresses`.`user_id` = ? AND `mission_progresses`.`mission_requirement_id` = ?) AND `mission_progresses`.`deleted_at` IS NULL ORDER BY `mission_progresses`.`id` LIMIT ?")).
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT `mission_progresses`.`id`,`mission_progresses`.`created_at`,`mission_progresses`.`updated_at`,`mission_progresses`.`deleted_at`,`mission_progresses`.`progress`,`mission_progresses`.`completed`,`mission_progresses`.`reset_at`,`mission_progresses`.`user_id`,`mission_progresses`.`mission_requirement_id` FROM `mission_progresses` JOIN mission_requirements ON mission_requirements.id = mission_progresses.mission_requirement_id JOIN missions ON missions.id = mission_requirements.mission_id WHERE (mission_requirements.id = ? AND mission_progresses.user_id = ?) AND missions.status NOT IN (?, ?) AND (`mission_progresses`.`user_id` = ? AND `mission_progresses`.`mission_requirement_id` = ?) AND `mission_progresses`.`deleted_at` IS NULL ORDER BY `mission_progresses`.`id` LIMIT ?")).
 					WithArgs(1, 1, domain.MissionUnavailable, domain.MissionCanceled, 1, 1, 1).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "mission_requirement_id", "progress", "completed", "created_at", "updated_at"}).
 						AddRow(1, 1, 1, 0, false, fixedTime, fixedTime))
@@ -592,7 +593,7 @@ func TestTaskRepositoryMySQL_GetOrCreateMissionProgress(t *testing.T) {
 			userID:               1,
 			missionRequirementID: 2,
 			mock: func() {
-				mock.ExpectQuery(regexp.QuoteMeta("SELECT `mission_progresses`.`id`,`mission_progresses`.`created_at`,`mission_progresses`.`updated_at`,`mission_progresses`.`deleted_at`,`mission_progresses`.`progress`,`mission_progresses`.`completed`,`mission_progresses`.`user_id`,`mission_progresses`.`mission_requirement_id` FROM `mission_progresses` JOIN mission_requirements ON mission_requirements.id = mission_progresses.mission_requirement_id JOIN missions ON missions.id = mission_requirements.mission_id WHERE (mission_requirements.id = ? AND mission_progresses.user_id = ?) AND missions.status NOT IN (?, ?) AND (`mission_progresses`.`user_id` = ? AND `mission_progresses`.`mission_requirement_id` = ?) AND `mission_progresses`.`deleted_at` IS NULL ORDER BY `mission_progresses`.`id` LIMIT ?")).
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT `mission_progresses`.`id`,`mission_progresses`.`created_at`,`mission_progresses`.`updated_at`,`mission_progresses`.`deleted_at`,`mission_progresses`.`progress`,`mission_progresses`.`completed`,`mission_progresses`.`reset_at`,`mission_progresses`.`user_id`,`mission_progresses`.`mission_requirement_id` FROM `mission_progresses` JOIN mission_requirements ON mission_requirements.id = mission_progresses.mission_requirement_id JOIN missions ON missions.id = mission_requirements.mission_id WHERE (mission_requirements.id = ? AND mission_progresses.user_id = ?) AND missions.status NOT IN (?, ?) AND (`mission_progresses`.`user_id` = ? AND `mission_progresses`.`mission_requirement_id` = ?) AND `mission_progresses`.`deleted_at` IS NULL ORDER BY `mission_progresses`.`id` LIMIT ?")).
 					WithArgs(2, 1, domain.TitleUnavailable, domain.TitleCanceled, 1, 2, 1).
 					WillReturnRows(sqlmock.NewRows([]string{}))
 
@@ -744,3 +745,145 @@ func TestTaskRepositoryMySQL_AwardTitleToUser(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskRepositoryMySQL_GetActionPolicy(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+	r := db.NewTaskRepositoryMySQL(gormDB)
+
+	query := regexp.QuoteMeta("SELECT * FROM `action_policies` WHERE (action_key = ? AND enabled = ?) AND `action_policies`.`deleted_at` IS NULL LIMIT ?")
+
+	testCases := map[string]struct {
+		mock         func()
+		expectPolicy bool
+		expectErr    bool
+	}{
+		"enabled policy": {
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(domain.CommentGameRequirementKey, true, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "action_key", "max_per_day", "enabled"}).
+						AddRow(1, domain.CommentGameRequirementKey, 5, true))
+			},
+			expectPolicy: true,
+		},
+		"no policy": {
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(domain.CommentGameRequirementKey, true, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+		},
+		"db failure": {
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(domain.CommentGameRequirementKey, true, 1).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mock()
+
+			policy, err := r.GetActionPolicy(domain.CommentGameRequirementKey)
+
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tc.expectPolicy {
+				assert.NotNil(t, policy)
+				assert.Equal(t, uint(5), policy.MaxPerDay)
+			} else {
+				assert.Nil(t, policy)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskRepositoryMySQL_DecideAction(t *testing.T) {
+	targetType := domain.ActionTargetGames
+	targetID := uint(2)
+	action := domain.UserAction{
+		UserID:     1,
+		Key:        domain.CommentGameRequirementKey,
+		TargetType: &targetType,
+		TargetID:   &targetID,
+		Increment:  1,
+		OccurredAt: time.Now(),
+	}
+
+	lockUser := regexp.QuoteMeta("SELECT `id` FROM `users` WHERE `users`.`id` = ? AND `users`.`deleted_at` IS NULL ORDER BY `users`.`id` LIMIT ? FOR UPDATE")
+	countedDecisions := regexp.QuoteMeta("SELECT * FROM `action_decisions` WHERE (user_id = ? AND action_key = ? AND occurred_at >= ? AND weight > 0) AND `action_decisions`.`deleted_at` IS NULL ORDER BY occurred_at")
+	countedTarget := regexp.QuoteMeta("SELECT count(*) FROM `action_decisions` WHERE (user_id = ? AND action_key = ? AND target_type = ? AND target_id = ? AND weight > 0) AND `action_decisions`.`deleted_at` IS NULL")
+
+	testCases := map[string]struct {
+		policy          domain.ActionPolicy
+		mockBehavior    func(mock sqlmock.Sqlmock)
+		expectedOutcome string
+		expectedErr     error
+	}{
+		"allows the first action": {
+			policy: domain.ActionPolicy{ID: 1, ActionKey: domain.CommentGameRequirementKey, CooldownSeconds: 60},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockUser).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectQuery(countedDecisions).
+					WithArgs(1, domain.CommentGameRequirementKey, sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `action_decisions`")).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectedOutcome: domain.ActionDecisionAllowed,
+		},
+		"refuses a target already counted": {
+			policy: domain.ActionPolicy{ID: 1, ActionKey: domain.CommentGameRequirementKey, OncePerTarget: true},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockUser).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectQuery(countedDecisions).
+					WithArgs(1, domain.CommentGameRequirementKey, sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectQuery(countedTarget).
+					WithArgs(1, domain.CommentGameRequirementKey, domain.ActionTargetGames, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `action_decisions`")).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectedOutcome: domain.ActionDecisionRepeatedTarget,
+		},
+		"user lock failure": {
+			policy: domain.ActionPolicy{ID: 1, ActionKey: domain.CommentGameRequirementKey, CooldownSeconds: 60},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockUser).WithArgs(1, 1).WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			r := db.NewTaskRepositoryMySQL(gormDB)
+
+			tc.mockBehavior(mock)
+
+			decision, err := r.DecideAction(action, tc.policy)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.expectedOutcome, decision.Outcome)
+				assert.Equal(t, uint(1), decision.ID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -277,6 +277,10 @@ func TestUserRepositoryMySQL_CreateUser(t *testing.T) {
 						user.Nickname,
 						user.Experience,
 						user.Blocked,
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
 						user.Birthdate,
 						user.Password,
 						user.LevelID,
@@ -297,6 +301,7 @@ func TestUserRepositoryMySQL_CreateUser(t *testing.T) {
 						"",
 						"",
 						"",
+						"",
 						user.ID,
 						user.Profile.ID,
 					).
@@ -339,6 +344,10 @@ func TestUserRepositoryMySQL_CreateUser(t *testing.T) {
 						user.Nickname,
 						user.Experience,
 						user.Blocked,
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
 						user.Birthdate,
 						user.Password,
 						user.LevelID,
@@ -431,6 +440,49 @@ func TestUserRepositoryMySQL_FindUserByEmailOrNickname(t *testing.T) {
 	}
 }
 
+func TestUserRepositoryMySQL_FindUserByNickname(t *testing.T) {
+	testCases := map[string]struct {
+		nickname    string
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectedID  uint
+		expectedErr error
+	}{
+		"find by nickname": {
+			nickname: "fake",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE nickname = ? AND `users`.`deleted_at` IS NULL ORDER BY `users`.`id` LIMIT ?")).
+					WithArgs("fake", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "email", "nickname"}).AddRow(1, "fake@gmail.com", "fake"))
+			},
+			expectedID: 1,
+		},
+		"email is not a nickname": {
+			nickname: "fake@gmail.com",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE nickname = ? AND `users`.`deleted_at` IS NULL ORDER BY `users`.`id` LIMIT ?")).
+					WithArgs("fake@gmail.com", 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			expectedErr: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+			repo := db.NewUserRepositoryMySQL(gormDB)
+
+			tc.mockSetup(mock)
+
+			user, err := repo.FindUserByNickname(tc.nickname)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedID, user.ID)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestUserRepositoryMySQL_FindUserByEmailForAdmin(t *testing.T) {
 	testCases := map[string]struct {
 		searchable   string
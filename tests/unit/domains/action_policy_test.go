@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionPolicy_Decide(t *testing.T) {
+	games := domain.ActionTargetGames
+	gameID := uint(1)
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	counted := func(at time.Time, weight float64) domain.ActionDecision {
+		return domain.ActionDecision{OccurredAt: at, Weight: weight}
+	}
+
+	testCases := map[string]struct {
+		policy     domain.ActionPolicy
+		counted    []domain.ActionDecision
+		targetSeen bool
+		outcome    string
+		weight     float64
+		increment  int
+	}{
+		"no limits": {
+			policy:    domain.ActionPolicy{},
+			counted:   []domain.ActionDecision{counted(now.Add(-time.Second), 1)},
+			outcome:   domain.ActionDecisionAllowed,
+			weight:    1,
+			increment: 1,
+		},
+		"repeated target": {
+			policy:     domain.ActionPolicy{OncePerTarget: true},
+			targetSeen: true,
+			outcome:    domain.ActionDecisionRepeatedTarget,
+		},
+		"within the cooldown": {
+			policy:  domain.ActionPolicy{CooldownSeconds: 60},
+			counted: []domain.ActionDecision{counted(now.Add(-30*time.Second), 1)},
+			outcome: domain.ActionDecisionCooldown,
+		},
+		"past the cooldown": {
+			policy:    domain.ActionPolicy{CooldownSeconds: 60},
+			counted:   []domain.ActionDecision{counted(now.Add(-time.Minute), 1)},
+			outcome:   domain.ActionDecisionAllowed,
+			weight:    1,
+			increment: 1,
+		},
+		"daily limit reached": {
+			policy:  domain.ActionPolicy{MaxPerDay: 2},
+			counted: []domain.ActionDecision{counted(now.Add(-time.Hour), 1), counted(now.Add(-time.Minute), 1)},
+			outcome: domain.ActionDecisionDailyLimit,
+		},
+		"daily limit counts today only": {
+			policy:    domain.ActionPolicy{MaxPerDay: 1},
+			counted:   []domain.ActionDecision{counted(now.AddDate(0, 0, -1), 1)},
+			outcome:   domain.ActionDecisionAllowed,
+			weight:    1,
+			increment: 1,
+		},
+		"diminished below a whole step": {
+			policy:  domain.ActionPolicy{DiminishAfter: 1, DiminishingFactor: 0.5},
+			counted: []domain.ActionDecision{counted(now.Add(-time.Hour), 1)},
+			outcome: domain.ActionDecisionDiminished,
+			weight:  0.5,
+		},
+		"diminished credit reaching a whole step": {
+			policy:    domain.ActionPolicy{DiminishAfter: 1, DiminishingFactor: 0.5},
+			counted:   []domain.ActionDecision{counted(now.Add(-time.Hour), 1), counted(now.Add(-time.Minute), 0.75)},
+			outcome:   domain.ActionDecisionDiminished,
+			weight:    0.25,
+			increment: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			action := domain.UserAction{UserID: 1, Key: domain.CommentGameRequirementKey, TargetType: &games, TargetID: &gameID, Increment: 1, OccurredAt: now}
+
+			decision := tc.policy.Decide(action, tc.counted, tc.targetSeen)
+
+			assert.Equal(t, tc.outcome, decision.Outcome)
+			assert.InDelta(t, tc.weight, decision.Weight, 1e-9)
+			assert.Equal(t, tc.increment, decision.Increment)
+			assert.Equal(t, tc.increment > 0, decision.Allowed())
+		})
+	}
+}
+
+func TestActionPolicy_Since(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, domain.ActionDay(now), domain.ActionPolicy{CooldownSeconds: 60}.Since(now))
+	assert.Equal(t, now.Add(-time.Hour), domain.ActionPolicy{CooldownSeconds: 3600}.Since(now))
+}
+
+func TestValidateActionPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		policy  domain.ActionPolicy
+		wantErr bool
+	}{
+		"valid": {
+			policy: domain.ActionPolicy{ActionKey: domain.CommentGameRequirementKey, DiminishAfter: 3, DiminishingFactor: 0.5},
+		},
+		"missing action key": {
+			policy:  domain.ActionPolicy{},
+			wantErr: true,
+		},
+		"factor not below one": {
+			policy:  domain.ActionPolicy{ActionKey: domain.CommentGameRequirementKey, DiminishingFactor: 1},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.policy.ValidateActionPolicy()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
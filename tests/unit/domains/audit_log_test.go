@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffAuditSnapshots(t *testing.T) {
+	testCases := map[string]struct {
+		before   map[string]any
+		after    map[string]any
+		expected map[string]domain.AuditChange
+	}{
+		"created target": {
+			after: map[string]any{"id": float64(1), "name": "Action"},
+			expected: map[string]domain.AuditChange{
+				"id":   {After: float64(1)},
+				"name": {After: "Action"},
+			},
+		},
+		"updated target keeps only the changed fields": {
+			before: map[string]any{"id": float64(1), "name": "Action", "tags": []any{"a"}},
+			after:  map[string]any{"id": float64(1), "name": "Adventure", "tags": []any{"a"}},
+			expected: map[string]domain.AuditChange{
+				"name": {Before: "Action", After: "Adventure"},
+			},
+		},
+		"deleted target": {
+			before: map[string]any{"id": float64(1)},
+			expected: map[string]domain.AuditChange{
+				"id": {Before: float64(1)},
+			},
+		},
+		"nothing changed": {
+			before:   map[string]any{"id": float64(1)},
+			after:    map[string]any{"id": float64(1)},
+			expected: map[string]domain.AuditChange{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, domain.DiffAuditSnapshots(tc.before, tc.after))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBooster_Duration(t *testing.T) {
+	booster := domain.Booster{DurationMinutes: 90}
+
+	assert.Equal(t, 90*time.Minute, booster.Duration())
+}
+
+func TestUserBooster_IsActive(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		expiresAt time.Time
+		expected  bool
+	}{
+		"running":      {expiresAt: now.Add(time.Minute), expected: true},
+		"just expired": {expiresAt: now, expected: false},
+		"expired":      {expiresAt: now.Add(-time.Hour), expected: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, domain.UserBooster{ExpiresAt: tc.expiresAt}.IsActive(now))
+		})
+	}
+}
+
+func TestValidateBooster(t *testing.T) {
+	testCases := map[string]struct {
+		booster domain.Booster
+		wantErr bool
+	}{
+		"valid": {
+			booster: domain.Booster{Name: "Double XP", Scope: domain.BoosterScopeExperience, Multiplier: 2, DurationMinutes: 60},
+		},
+		"unknown scope": {
+			booster: domain.Booster{Name: "Double XP", Scope: "titles", Multiplier: 2, DurationMinutes: 60},
+			wantErr: true,
+		},
+		"purchasable without cost": {
+			booster: domain.Booster{Name: "Double XP", Scope: domain.BoosterScopeExperience, Multiplier: 2, DurationMinutes: 60, Purchasable: true},
+			wantErr: true,
+		},
+		"purchasable with cost": {
+			booster: domain.Booster{Name: "Double XP", Scope: domain.BoosterScopeExperience, Multiplier: 2, DurationMinutes: 60, Purchasable: true, Cost: 500},
+		},
+		"multiplier not above one": {
+			booster: domain.Booster{Name: "Half XP", Scope: domain.BoosterScopeExperience, Multiplier: 0.5, DurationMinutes: 60},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.booster.ValidateBooster()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
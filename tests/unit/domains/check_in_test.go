@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckIn_CheckInDay(t *testing.T) {
+	// Late evening in São Paulo is already the next day in UTC.
+	at := time.Date(2026, 10, 20, 1, 30, 0, 0, time.UTC)
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+
+	assert.Equal(t, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), domain.CheckInDay(at, saoPaulo))
+	assert.Equal(t, time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC), domain.CheckInDay(at, time.UTC))
+}
+
+func TestCheckInStreak_CheckIn(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC) }
+	last := func(d int) *time.Time { t := day(d); return &t }
+
+	testCases := map[string]struct {
+		streak      domain.CheckInStreak
+		day         time.Time
+		ok          bool
+		current     uint
+		longest     uint
+		freezes     uint
+		frozenCount int
+	}{
+		"first check-in": {
+			day: day(19), ok: true, current: 1, longest: 1,
+		},
+		"next day": {
+			streak: domain.CheckInStreak{Current: 4, Longest: 4, LastCheckInOn: last(18)},
+			day:    day(19), ok: true, current: 5, longest: 5,
+		},
+		"same day": {
+			streak: domain.CheckInStreak{Current: 4, Longest: 6, LastCheckInOn: last(19)},
+			day:    day(19), current: 4, longest: 6,
+		},
+		"missed days covered by freezes": {
+			streak: domain.CheckInStreak{Current: 4, Longest: 4, Freezes: 2, LastCheckInOn: last(16)},
+			day:    day(19), ok: true, current: 7, longest: 7, frozenCount: 2,
+		},
+		"not enough freezes": {
+			streak: domain.CheckInStreak{Current: 4, Longest: 9, Freezes: 1, LastCheckInOn: last(16)},
+			day:    day(19), ok: true, current: 1, longest: 9, freezes: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			frozenDays, ok := tc.streak.CheckIn(tc.day)
+
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.current, tc.streak.Current)
+			assert.Equal(t, tc.longest, tc.streak.Longest)
+			assert.Equal(t, tc.freezes, tc.streak.Freezes)
+			assert.Len(t, frozenDays, tc.frozenCount)
+			if tc.frozenCount > 0 {
+				assert.Equal(t, day(17), frozenDays[0])
+			}
+		})
+	}
+}
+
+func TestCheckInReward_CheckInRewardsFor(t *testing.T) {
+	table := []domain.CheckInReward{
+		{ID: 1, Streak: 1, Coins: 10},
+		{ID: 2, Streak: 7, Coins: 25},
+		{ID: 3, Streak: 7, Milestone: true, Rewards: []domain.Reward{{RewardableType: domain.RewardableTypeTitles, RewardableID: 5}}},
+		{ID: 4, Streak: 30, Milestone: true, Experience: 500},
+	}
+
+	testCases := map[string]struct {
+		streak uint
+		ids    []uint
+	}{
+		"first tier":           {streak: 3, ids: []uint{1}},
+		"tier and milestone":   {streak: 7, ids: []uint{2, 3}},
+		"tier after milestone": {streak: 8, ids: []uint{2}},
+		"no tier reached":      {streak: 0},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var ids []uint
+			for _, reward := range domain.CheckInRewardsFor(table, tc.streak) {
+				ids = append(ids, reward.ID)
+			}
+
+			assert.Equal(t, tc.ids, ids)
+		})
+	}
+
+	result := domain.CheckInResult{
+		CheckIn: domain.CheckIn{Day: time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC), Coins: 30},
+		Rewards: domain.CheckInRewardsFor(table, 7),
+	}
+
+	assert.Equal(t, []domain.Reward{
+		{RewardableType: domain.RewardableTypeCoins, Amount: 30},
+		{RewardableType: domain.RewardableTypeTitles, RewardableID: 5},
+	}, result.RewardsToGrant())
+	assert.Equal(t, "check_ins:2024-03-07", result.RewardGrantKey())
+}
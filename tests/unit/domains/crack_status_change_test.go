@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+	testutils "gcstatus/tests/utils"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCrackStatusChange(t *testing.T) {
+	fixedTime := time.Now()
+
+	testCases := map[string]struct {
+		change       domain.CrackStatusChange
+		mockBehavior func(mock sqlmock.Sqlmock, change domain.CrackStatusChange)
+		expectError  bool
+	}{
+		"Success": {
+			change: domain.CrackStatusChange{
+				Status:         domain.CrackedStatus,
+				PreviousStatus: domain.UncrackedStatus,
+				CrackedAt:      utils.TimePtr(fixedTime),
+				DaysToCrack:    utils.UintPtr(10),
+				CrackID:        1,
+				GameID:         1,
+				CrackerID:      utils.UintPtr(1),
+				ProtectionID:   utils.UintPtr(1),
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, change domain.CrackStatusChange) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `crack_status_changes`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						change.Status,
+						change.PreviousStatus,
+						change.CrackedAt,
+						change.DaysToCrack,
+						change.CrackID,
+						change.GameID,
+						change.CrackerID,
+						change.ProtectionID,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		"Failure - Insert Error": {
+			change: domain.CrackStatusChange{
+				Status:       domain.UncrackedStatus,
+				CrackID:      1,
+				GameID:       1,
+				CrackerID:    utils.UintPtr(1),
+				ProtectionID: utils.UintPtr(1),
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, change domain.CrackStatusChange) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `crack_status_changes`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						change.Status,
+						change.PreviousStatus,
+						change.CrackedAt,
+						change.DaysToCrack,
+						change.CrackID,
+						change.GameID,
+						change.CrackerID,
+						change.ProtectionID,
+					).
+					WillReturnError(fmt.Errorf("some error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock := testutils.Setup(t)
+
+			tc.mockBehavior(mock, tc.change)
+
+			err := db.Create(&tc.change).Error
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestCreateCrackStatusChangeWithMissingFields(t *testing.T) {
+	change := domain.CrackStatusChange{}
+
+	err := change.ValidateCrackStatusChange()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Status is a required field")
+}
+
+func TestCrackStatusHeadline(t *testing.T) {
+	testCases := map[string]struct {
+		status         string
+		previousStatus string
+		daysToCrack    *uint
+		expected       string
+	}{
+		"first record uncracked": {status: domain.UncrackedStatus, expected: "Game Test is not cracked yet!"},
+		"uncracked again":        {status: domain.UncrackedStatus, previousStatus: domain.CrackedStatus, expected: "Game Test is uncracked again!"},
+		"cracked same day":       {status: domain.CrackedSameDay, daysToCrack: utils.UintPtr(0), expected: "Game Test was cracked on its release day!"},
+		"cracked with days":      {status: domain.CrackedStatus, daysToCrack: utils.UintPtr(12), expected: "Game Test was cracked after 12 days!"},
+		"cracked no days":        {status: domain.CrackedStatus, expected: "Game Test was cracked!"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, domain.CrackStatusHeadline("Game Test", tc.status, tc.previousStatus, tc.daysToCrack))
+		})
+	}
+}
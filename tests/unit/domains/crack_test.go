@@ -24,8 +24,8 @@ func TestCreateCrack(t *testing.T) {
 			crack: domain.Crack{
 				CrackedAt:    utils.TimePtr(fixedTime),
 				Status:       domain.UncrackedStatus,
-				CrackerID:    1,
-				ProtectionID: 1,
+				CrackerID:    utils.UintPtr(1),
+				ProtectionID: utils.UintPtr(1),
 				GameID:       1,
 			},
 			mockBehavior: func(mock sqlmock.Sqlmock, crack domain.Crack) {
@@ -37,6 +37,7 @@ func TestCreateCrack(t *testing.T) {
 						sqlmock.AnyArg(),
 						crack.Status,
 						crack.CrackedAt,
+						crack.DaysToCrack,
 						crack.CrackerID,
 						crack.ProtectionID,
 						crack.GameID,
@@ -49,8 +50,8 @@ func TestCreateCrack(t *testing.T) {
 		"Failure - Insert Error": {
 			crack: domain.Crack{
 				Status:       domain.UncrackedStatus,
-				CrackerID:    1,
-				ProtectionID: 1,
+				CrackerID:    utils.UintPtr(1),
+				ProtectionID: utils.UintPtr(1),
 				GameID:       1,
 			},
 			mockBehavior: func(mock sqlmock.Sqlmock, crack domain.Crack) {
@@ -62,6 +63,7 @@ func TestCreateCrack(t *testing.T) {
 						sqlmock.AnyArg(),
 						crack.Status,
 						crack.CrackedAt,
+						crack.DaysToCrack,
 						crack.CrackerID,
 						crack.ProtectionID,
 						crack.GameID,
@@ -106,8 +108,8 @@ func TestUpdateCrack(t *testing.T) {
 			crack: domain.Crack{
 				ID:           1,
 				Status:       domain.UncrackedStatus,
-				CrackerID:    1,
-				ProtectionID: 1,
+				CrackerID:    utils.UintPtr(1),
+				ProtectionID: utils.UintPtr(1),
 				GameID:       1,
 				CreatedAt:    fixedTime,
 				UpdatedAt:    fixedTime,
@@ -121,6 +123,7 @@ func TestUpdateCrack(t *testing.T) {
 						sqlmock.AnyArg(),
 						crack.Status,
 						crack.CrackedAt,
+						crack.DaysToCrack,
 						crack.CrackerID,
 						crack.ProtectionID,
 						crack.GameID,
@@ -135,8 +138,8 @@ func TestUpdateCrack(t *testing.T) {
 			crack: domain.Crack{
 				ID:           1,
 				Status:       domain.UncrackedStatus,
-				CrackerID:    1,
-				ProtectionID: 1,
+				CrackerID:    utils.UintPtr(1),
+				ProtectionID: utils.UintPtr(1),
 				GameID:       1,
 				CreatedAt:    fixedTime,
 				UpdatedAt:    fixedTime,
@@ -150,6 +153,7 @@ func TestUpdateCrack(t *testing.T) {
 						sqlmock.AnyArg(),
 						crack.Status,
 						crack.CrackedAt,
+						crack.DaysToCrack,
 						crack.CrackerID,
 						crack.ProtectionID,
 						crack.GameID,
@@ -317,3 +321,106 @@ func TestCreateCrackWithMissingFields(t *testing.T) {
 		})
 	}
 }
+
+func TestDeriveCrackStatus(t *testing.T) {
+	releaseDate := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		crackedAt      *time.Time
+		expectedStatus string
+		expectedDays   *uint
+	}{
+		"not cracked": {
+			crackedAt:      nil,
+			expectedStatus: domain.UncrackedStatus,
+			expectedDays:   nil,
+		},
+		"cracked on release day": {
+			crackedAt:      utils.TimePtr(releaseDate.Add(6 * time.Hour)),
+			expectedStatus: domain.CrackedSameDay,
+			expectedDays:   utils.UintPtr(0),
+		},
+		"cracked the day after release": {
+			crackedAt:      utils.TimePtr(releaseDate.Add(36 * time.Hour)),
+			expectedStatus: domain.CrackedSameDay,
+			expectedDays:   utils.UintPtr(1),
+		},
+		"cracked weeks after release": {
+			crackedAt:      utils.TimePtr(releaseDate.AddDate(0, 0, 15)),
+			expectedStatus: domain.CrackedStatus,
+			expectedDays:   utils.UintPtr(15),
+		},
+		"cracked before release": {
+			crackedAt:      utils.TimePtr(releaseDate.AddDate(0, 0, -2)),
+			expectedStatus: domain.CrackedSameDay,
+			expectedDays:   utils.UintPtr(0),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			status, days := domain.DeriveCrackStatus(releaseDate, tc.crackedAt)
+
+			assert.Equal(t, tc.expectedStatus, status)
+			assert.Equal(t, tc.expectedDays, days)
+		})
+	}
+}
+
+func TestSummarizeCracks(t *testing.T) {
+	median := func(value float64) *float64 { return &value }
+
+	testCases := map[string]struct {
+		cracks   []domain.Crack
+		expected domain.CrackStats
+	}{
+		"no cracks": {
+			cracks:   nil,
+			expected: domain.CrackStats{},
+		},
+		"odd amount of cracked games": {
+			cracks: []domain.Crack{
+				{Status: domain.CrackedStatus, DaysToCrack: utils.UintPtr(30)},
+				{Status: domain.CrackedSameDay, DaysToCrack: utils.UintPtr(0)},
+				{Status: domain.CrackedStatus, DaysToCrack: utils.UintPtr(12)},
+				{Status: domain.UncrackedStatus},
+			},
+			expected: domain.CrackStats{
+				Total:               4,
+				Cracked:             3,
+				Uncracked:           1,
+				UncrackedPercentage: 25,
+				MedianDaysToCrack:   median(12),
+			},
+		},
+		"even amount of cracked games": {
+			cracks: []domain.Crack{
+				{Status: domain.CrackedStatus, DaysToCrack: utils.UintPtr(10)},
+				{Status: domain.CrackedStatus, DaysToCrack: utils.UintPtr(5)},
+				{Status: domain.UncrackedStatus},
+			},
+			expected: domain.CrackStats{
+				Total:               3,
+				Cracked:             2,
+				Uncracked:           1,
+				UncrackedPercentage: 33.33,
+				MedianDaysToCrack:   median(7.5),
+			},
+		},
+		"cracked without known days": {
+			cracks: []domain.Crack{
+				{Status: domain.CrackedStatus},
+			},
+			expected: domain.CrackStats{
+				Total:   1,
+				Cracked: 1,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, domain.SummarizeCracks(tc.cracks))
+		})
+	}
+}
@@ -38,6 +38,8 @@ func TestCreateDLCStore(t *testing.T) {
 						DLCStore.DLCID,
 						DLCStore.StoreID,
 						DLCStore.StorDLCID,
+						DLCStore.InitialPrice,
+						DLCStore.LowestPrice,
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
@@ -64,6 +66,8 @@ func TestCreateDLCStore(t *testing.T) {
 						DLCStore.DLCID,
 						DLCStore.StoreID,
 						DLCStore.StorDLCID,
+						DLCStore.InitialPrice,
+						DLCStore.LowestPrice,
 					).
 					WillReturnError(fmt.Errorf("some error"))
 				mock.ExpectRollback()
@@ -124,6 +128,8 @@ func TestUpdateDLCStore(t *testing.T) {
 						DLCStore.DLCID,
 						DLCStore.StoreID,
 						DLCStore.StorDLCID,
+						DLCStore.InitialPrice,
+						DLCStore.LowestPrice,
 						DLCStore.ID,
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
@@ -154,6 +160,8 @@ func TestUpdateDLCStore(t *testing.T) {
 						DLCStore.DLCID,
 						DLCStore.StoreID,
 						DLCStore.StorDLCID,
+						DLCStore.InitialPrice,
+						DLCStore.LowestPrice,
 						DLCStore.ID,
 					).
 					WillReturnError(fmt.Errorf("some error"))
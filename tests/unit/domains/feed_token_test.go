@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	testutils "gcstatus/tests/utils"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateFeedToken(t *testing.T) {
+	testCases := map[string]struct {
+		feedToken    domain.FeedToken
+		mockBehavior func(mock sqlmock.Sqlmock, feedToken domain.FeedToken)
+		expectError  bool
+	}{
+		"Success": {
+			feedToken: domain.FeedToken{Token: "secret", UserID: 1},
+			mockBehavior: func(mock sqlmock.Sqlmock, feedToken domain.FeedToken) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `feed_tokens`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						feedToken.Token,
+						feedToken.UserID,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		"Failure - Insert Error": {
+			feedToken: domain.FeedToken{Token: "secret", UserID: 1},
+			mockBehavior: func(mock sqlmock.Sqlmock, feedToken domain.FeedToken) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `feed_tokens`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						feedToken.Token,
+						feedToken.UserID,
+					).
+					WillReturnError(fmt.Errorf("some error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock := testutils.Setup(t)
+
+			tc.mockBehavior(mock, tc.feedToken)
+
+			err := db.Create(&tc.feedToken).Error
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestCreateFeedTokenWithMissingFields(t *testing.T) {
+	feedToken := domain.FeedToken{}
+
+	err := feedToken.ValidateFeedToken()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Token is a required field")
+}
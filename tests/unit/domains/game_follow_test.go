@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	testutils "gcstatus/tests/utils"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateGameFollow(t *testing.T) {
+	testCases := map[string]struct {
+		gameFollow   domain.GameFollow
+		mockBehavior func(mock sqlmock.Sqlmock, gameFollow domain.GameFollow)
+		expectError  bool
+	}{
+		"Success": {
+			gameFollow: domain.GameFollow{
+				NotifyRelease: true,
+				NotifyCrack:   true,
+				NotifyPrice:   false,
+				NotifyDLC:     true,
+				Token:         "token",
+				GameID:        1,
+				UserID:        1,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, gameFollow domain.GameFollow) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `game_follows`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						gameFollow.NotifyRelease,
+						gameFollow.NotifyCrack,
+						gameFollow.NotifyPrice,
+						gameFollow.NotifyDLC,
+						gameFollow.Token,
+						gameFollow.GameID,
+						gameFollow.UserID,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		"Failure - Insert Error": {
+			gameFollow: domain.GameFollow{
+				NotifyRelease: true,
+				Token:         "token",
+				GameID:        1,
+				UserID:        1,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, gameFollow domain.GameFollow) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `game_follows`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						gameFollow.NotifyRelease,
+						gameFollow.NotifyCrack,
+						gameFollow.NotifyPrice,
+						gameFollow.NotifyDLC,
+						gameFollow.Token,
+						gameFollow.GameID,
+						gameFollow.UserID,
+					).
+					WillReturnError(fmt.Errorf("some error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock := testutils.Setup(t)
+
+			tc.mockBehavior(mock, tc.gameFollow)
+
+			err := db.Create(&tc.gameFollow).Error
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateGameFollowValidData(t *testing.T) {
+	fixedTime := time.Now()
+
+	gameFollow := domain.GameFollow{
+		NotifyRelease: true,
+		Token:         "token",
+		Game: domain.Game{
+			Slug:             "valid",
+			Age:              18,
+			Title:            "Game Test",
+			Condition:        domain.CommomCondition,
+			Cover:            "https://placehold.co/600x400/EEE/31343C",
+			About:            "About game",
+			Description:      "Description",
+			ShortDescription: "Short description",
+			Free:             false,
+			ReleaseDate:      fixedTime,
+		},
+		User: domain.User{
+			Name:       "John Doe",
+			Email:      "johndoe@example.com",
+			Nickname:   "johnny",
+			Experience: 500,
+			Birthdate:  fixedTime,
+			Password:   "supersecretpassword",
+			Profile: domain.Profile{
+				Share: true,
+			},
+			Wallet: domain.Wallet{
+				Amount: 10,
+			},
+			Level: domain.Level{
+				Level:      1,
+				Experience: 500,
+				Coins:      10,
+			},
+		},
+	}
+
+	assert.NoError(t, gameFollow.ValidateGameFollow())
+}
+
+func TestGameFollowNotifies(t *testing.T) {
+	gameFollow := domain.GameFollow{NotifyRelease: true, NotifyPrice: true}
+
+	testCases := map[string]struct {
+		event    string
+		expected bool
+	}{
+		"release enabled": {event: domain.FollowReleaseEvent, expected: true},
+		"crack disabled":  {event: domain.FollowCrackEvent, expected: false},
+		"price enabled":   {event: domain.FollowPriceEvent, expected: true},
+		"dlc disabled":    {event: domain.FollowDLCEvent, expected: false},
+		"unknown event":   {event: "unknown", expected: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, gameFollow.Notifies(tc.event))
+		})
+	}
+}
+
+func TestGameFollowMute(t *testing.T) {
+	testCases := map[string]struct {
+		event    string
+		expected domain.GameFollow
+	}{
+		"single event": {
+			event:    domain.FollowCrackEvent,
+			expected: domain.GameFollow{NotifyRelease: true, NotifyPrice: true, NotifyDLC: true},
+		},
+		"every event": {
+			event:    "",
+			expected: domain.GameFollow{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gameFollow := domain.GameFollow{NotifyRelease: true, NotifyCrack: true, NotifyPrice: true, NotifyDLC: true}
+
+			gameFollow.Mute(tc.event)
+
+			assert.Equal(t, tc.expected, gameFollow)
+		})
+	}
+}
@@ -38,6 +38,8 @@ func TestCreateGameStore(t *testing.T) {
 						gameStore.GameID,
 						gameStore.StoreID,
 						gameStore.StoreGameID,
+						gameStore.InitialPrice,
+						gameStore.LowestPrice,
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
@@ -64,6 +66,8 @@ func TestCreateGameStore(t *testing.T) {
 						gameStore.GameID,
 						gameStore.StoreID,
 						gameStore.StoreGameID,
+						gameStore.InitialPrice,
+						gameStore.LowestPrice,
 					).
 					WillReturnError(fmt.Errorf("some error"))
 				mock.ExpectRollback()
@@ -124,6 +128,8 @@ func TestUpdateGameStore(t *testing.T) {
 						gameStore.GameID,
 						gameStore.StoreID,
 						gameStore.StoreGameID,
+						gameStore.InitialPrice,
+						gameStore.LowestPrice,
 						gameStore.ID,
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
@@ -154,6 +160,8 @@ func TestUpdateGameStore(t *testing.T) {
 						gameStore.GameID,
 						gameStore.StoreID,
 						gameStore.StoreGameID,
+						gameStore.InitialPrice,
+						gameStore.LowestPrice,
 						gameStore.ID,
 					).
 					WillReturnError(fmt.Errorf("some error"))
@@ -0,0 +1,188 @@
+package tests
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	testutils "gcstatus/tests/utils"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateGameSyncLock(t *testing.T) {
+	testCases := map[string]struct {
+		lock         domain.GameSyncLock
+		mockBehavior func(mock sqlmock.Sqlmock, lock domain.GameSyncLock)
+		expectError  bool
+	}{
+		"Success": {
+			lock: domain.GameSyncLock{
+				Field:  domain.SyncFieldDescription,
+				GameID: 1,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, lock domain.GameSyncLock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `game_sync_locks`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						lock.Field,
+						lock.GameID,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		"Failure - Insert Error": {
+			lock: domain.GameSyncLock{
+				Field:  domain.SyncFieldGalleries,
+				GameID: 1,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, lock domain.GameSyncLock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `game_sync_locks`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						lock.Field,
+						lock.GameID,
+					).
+					WillReturnError(fmt.Errorf("some error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock := testutils.Setup(t)
+
+			tc.mockBehavior(mock, tc.lock)
+
+			err := db.Create(&tc.lock).Error
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateGameSyncLock(t *testing.T) {
+	game := domain.Game{
+		Slug:             "valid",
+		Age:              18,
+		Title:            "Game Test",
+		Condition:        domain.CommomCondition,
+		Cover:            "https://placehold.co/600x400/EEE/31343C",
+		About:            "About game",
+		Description:      "Description",
+		ShortDescription: "Short description",
+		ReleaseDate:      time.Now(),
+	}
+
+	testCases := map[string]struct {
+		lock        domain.GameSyncLock
+		expectError bool
+	}{
+		"valid field":      {lock: domain.GameSyncLock{Field: domain.SyncFieldRequirements, Game: game}},
+		"missing field":    {lock: domain.GameSyncLock{Game: game}, expectError: true},
+		"not a sync field": {lock: domain.GameSyncLock{Field: "slug", Game: game}, expectError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.lock.ValidateGameSyncLock()
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLockedSyncFields(t *testing.T) {
+	locked := domain.LockedSyncFields([]domain.GameSyncLock{
+		{Field: domain.SyncFieldTitle},
+		{Field: domain.SyncFieldGenres},
+	})
+
+	assert.True(t, locked[domain.SyncFieldTitle])
+	assert.True(t, locked[domain.SyncFieldGenres])
+	assert.False(t, locked[domain.SyncFieldCover])
+}
+
+func TestChangedSyncFields(t *testing.T) {
+	releaseDate := time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+	website := "https://example.com"
+
+	newGame := func() *domain.Game {
+		return &domain.Game{
+			Title:       "Game Test",
+			About:       "About",
+			ReleaseDate: releaseDate,
+			Website:     &website,
+			Genres:      []domain.Genreable{{GenreID: 1}, {GenreID: 2}},
+			DLCs:        []domain.DLC{{Name: "Expansion"}},
+		}
+	}
+
+	testCases := map[string]struct {
+		mutate         func(game *domain.Game)
+		expectedFields []string
+	}{
+		"nothing changed": {
+			mutate:         func(game *domain.Game) {},
+			expectedFields: []string{},
+		},
+		"reordered relations are not changes": {
+			mutate: func(game *domain.Game) {
+				game.Genres = []domain.Genreable{{GenreID: 2}, {GenreID: 1}}
+			},
+			expectedFields: []string{},
+		},
+		"edited dlc details": {
+			mutate: func(game *domain.Game) {
+				game.DLCs[0].Description = "Edited by an admin"
+			},
+			expectedFields: []string{domain.SyncFieldDLCs},
+		},
+		"scalar and relation changes": {
+			mutate: func(game *domain.Game) {
+				game.Title = "Another title"
+				game.Website = nil
+				game.Genres = []domain.Genreable{{GenreID: 1}}
+				game.DLCs = nil
+			},
+			expectedFields: []string{
+				domain.SyncFieldTitle,
+				domain.SyncFieldWebsite,
+				domain.SyncFieldGenres,
+				domain.SyncFieldDLCs,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			after := newGame()
+			tc.mutate(after)
+
+			assert.ElementsMatch(t, tc.expectedFields, domain.ChangedSyncFields(newGame(), after))
+		})
+	}
+}
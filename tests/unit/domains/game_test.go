@@ -420,3 +420,94 @@ func TestCreateGameWithMissingFields(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateGameAggregate(t *testing.T) {
+	fixedTime := time.Now()
+	invalidEmail := "not-an-email"
+
+	newGame := func() domain.Game {
+		return domain.Game{
+			Slug:             "valid",
+			Age:              18,
+			Title:            "Game Test",
+			Condition:        domain.CommomCondition,
+			Cover:            "https://placehold.co/600x400/EEE/31343C",
+			About:            "About game",
+			Description:      "Description",
+			ShortDescription: "Short description",
+			ReleaseDate:      fixedTime,
+			Stores: []domain.GameStore{
+				{StoreID: 1, StoreGameID: "10", URL: "https://store.steampowered.com/app/10"},
+			},
+			Requirements: []domain.Requirement{
+				{
+					OS:      "Windows 10",
+					DX:      "DirectX 12",
+					CPU:     "Intel i5",
+					RAM:     "8 GB",
+					GPU:     "GTX 1060",
+					ROM:     "50 GB",
+					Network: "Broadband",
+					RequirementType: domain.RequirementType{
+						Potential: domain.MinimumRequirementType,
+						OS:        domain.WindowsOSRequirement,
+					},
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		mutate      func(game *domain.Game)
+		expectError bool
+	}{
+		"valid aggregate with a free store listing": {
+			mutate: func(game *domain.Game) {},
+		},
+		"missing title": {
+			mutate: func(game *domain.Game) {
+				game.Title = ""
+			},
+			expectError: true,
+		},
+		"store listing without url": {
+			mutate: func(game *domain.Game) {
+				game.Stores[0].URL = ""
+			},
+			expectError: true,
+		},
+		"requirement with an unknown system": {
+			mutate: func(game *domain.Game) {
+				game.Requirements[0].RequirementType.OS = "amiga"
+			},
+			expectError: true,
+		},
+		"dlc without name": {
+			mutate: func(game *domain.Game) {
+				game.DLCs = []domain.DLC{{Cover: "cover", About: "about", Description: "description", ShortDescription: "short"}}
+			},
+			expectError: true,
+		},
+		"support with an invalid email": {
+			mutate: func(game *domain.Game) {
+				game.Support = &domain.GameSupport{Email: &invalidEmail}
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			game := newGame()
+			tc.mutate(&game)
+
+			err := game.ValidateGameAggregate()
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateImportCheckpoint(t *testing.T) {
+	testCases := map[string]struct {
+		checkpoint  domain.ImportCheckpoint
+		expectError bool
+	}{
+		"valid checkpoint": {checkpoint: domain.ImportCheckpoint{Source: "steam", LastAppID: 10}},
+		"missing source":   {checkpoint: domain.ImportCheckpoint{LastAppID: 10}, expectError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.checkpoint.ValidateImportCheckpoint()
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestImportCheckpoint_FailedIDs(t *testing.T) {
+	testCases := map[string]struct {
+		ids      []uint
+		expected []uint
+	}{
+		"sorted on save": {ids: []uint{70, 20}, expected: []uint{20, 70}},
+		"none":           {ids: nil, expected: nil},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var checkpoint domain.ImportCheckpoint
+			checkpoint.SetFailedIDs(tc.ids)
+
+			assert.Equal(t, tc.expected, checkpoint.FailedIDs())
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateJob(t *testing.T) {
+	testCases := map[string]struct {
+		job         domain.Job
+		expectError bool
+	}{
+		"valid job": {
+			job: domain.Job{
+				Type:        domain.JobTypeSendEmail,
+				Payload:     `{"recipient":"user@example.com"}`,
+				Status:      domain.JobStatusPending,
+				MaxAttempts: domain.DefaultJobMaxAttempts,
+				AvailableAt: time.Now(),
+			},
+		},
+		"missing type": {
+			job: domain.Job{
+				Status:      domain.JobStatusPending,
+				MaxAttempts: domain.DefaultJobMaxAttempts,
+			},
+			expectError: true,
+		},
+		"unknown status": {
+			job: domain.Job{
+				Type:        domain.JobTypeSendEmail,
+				Status:      "paused",
+				MaxAttempts: domain.DefaultJobMaxAttempts,
+			},
+			expectError: true,
+		},
+		"missing max attempts": {
+			job: domain.Job{
+				Type:   domain.JobTypeSendEmail,
+				Status: domain.JobStatusPending,
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.job.ValidateJob()
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJob_Transitions(t *testing.T) {
+	testCases := map[string]struct {
+		status      string
+		retryable   bool
+		cancellable bool
+	}{
+		"pending":   {status: domain.JobStatusPending, cancellable: true},
+		"running":   {status: domain.JobStatusRunning, cancellable: true},
+		"succeeded": {status: domain.JobStatusSucceeded},
+		"failed":    {status: domain.JobStatusFailed, retryable: true},
+		"cancelled": {status: domain.JobStatusCancelled, retryable: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			job := domain.Job{Status: tc.status}
+
+			assert.Equal(t, tc.retryable, job.IsRetryable())
+			assert.Equal(t, tc.cancellable, job.IsCancellable())
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaderboard_IsLeaderboard(t *testing.T) {
+	testCases := map[string]struct {
+		board  string
+		window string
+		want   bool
+	}{
+		"coins weekly":        {board: domain.LeaderboardCoins, window: domain.LeaderboardWeekly, want: true},
+		"experience all-time": {board: domain.LeaderboardExperience, window: domain.LeaderboardAllTime, want: true},
+		"unknown board":       {board: "hearts", window: domain.LeaderboardWeekly},
+		"unknown window":      {board: domain.LeaderboardTitles, window: "daily"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, domain.IsLeaderboard(tc.board, tc.window))
+		})
+	}
+}
+
+func TestLeaderboard_NewLeaderboardPeriod(t *testing.T) {
+	// A Sunday evening in São Paulo, already Monday in UTC.
+	at := time.Date(2026, 10, 18, 22, 30, 0, 0, time.FixedZone("BRT", -3*60*60))
+
+	testCases := map[string]struct {
+		window    string
+		start     time.Time
+		end       time.Time
+		key       string
+		expiresAt *time.Time
+	}{
+		"weekly": {
+			window:    domain.LeaderboardWeekly,
+			start:     time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+			end:       time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC),
+			key:       "leaderboard:coins:weekly:2026-W43",
+			expiresAt: func() *time.Time { t := time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC); return &t }(),
+		},
+		"monthly": {
+			window:    domain.LeaderboardMonthly,
+			start:     time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			end:       time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+			key:       "leaderboard:coins:monthly:2026-10",
+			expiresAt: func() *time.Time { t := time.Date(2026, 12, 2, 0, 0, 0, 0, time.UTC); return &t }(),
+		},
+		"all-time": {
+			window: domain.LeaderboardAllTime,
+			key:    "leaderboard:coins:all-time",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			period := domain.NewLeaderboardPeriod(domain.LeaderboardCoins, tc.window, at)
+
+			assert.Equal(t, tc.start, period.Start)
+			assert.Equal(t, tc.end, period.End)
+			assert.Equal(t, tc.key, period.Key())
+			assert.Equal(t, tc.expiresAt, period.ExpiresAt())
+			assert.Equal(t, tc.window == domain.LeaderboardAllTime, period.IsAllTime())
+		})
+	}
+}
@@ -0,0 +1,338 @@
+package tests
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	testutils "gcstatus/tests/utils"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateLibraryable(t *testing.T) {
+	hoursPlayed := uint(10)
+	completedAt := time.Now()
+
+	testCases := map[string]struct {
+		libraryable  domain.Libraryable
+		mockBehavior func(mock sqlmock.Sqlmock, libraryable domain.Libraryable)
+		expectError  bool
+	}{
+		"Success": {
+			libraryable: domain.Libraryable{
+				Status:          domain.LibraryCompleted,
+				HoursPlayed:     &hoursPlayed,
+				CompletedAt:     &completedAt,
+				Private:         true,
+				LibraryableID:   1,
+				LibraryableType: "games",
+				UserID:          1,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, libraryable domain.Libraryable) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `libraryables`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						libraryable.Status,
+						libraryable.Private,
+						libraryable.LibraryableID,
+						libraryable.LibraryableType,
+						libraryable.UserID,
+						*libraryable.HoursPlayed,
+						sqlmock.AnyArg(),
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		"Failure - Insert Error": {
+			libraryable: domain.Libraryable{
+				Status:          domain.LibraryCompleted,
+				HoursPlayed:     &hoursPlayed,
+				CompletedAt:     &completedAt,
+				Private:         true,
+				LibraryableID:   1,
+				LibraryableType: "games",
+				UserID:          1,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, libraryable domain.Libraryable) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `libraryables`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						libraryable.Status,
+						libraryable.Private,
+						libraryable.LibraryableID,
+						libraryable.LibraryableType,
+						libraryable.UserID,
+						*libraryable.HoursPlayed,
+						sqlmock.AnyArg(),
+					).
+					WillReturnError(fmt.Errorf("some error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock := testutils.Setup(t)
+
+			tc.mockBehavior(mock, tc.libraryable)
+
+			err := db.Create(&tc.libraryable).Error
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestUpdateLibraryable(t *testing.T) {
+	fixedTime := time.Now()
+	hoursPlayed := uint(10)
+
+	testCases := map[string]struct {
+		libraryable  domain.Libraryable
+		mockBehavior func(mock sqlmock.Sqlmock, libraryable domain.Libraryable)
+		expectError  bool
+	}{
+		"Success": {
+			libraryable: domain.Libraryable{
+				ID:              1,
+				Status:          domain.LibraryPlaying,
+				HoursPlayed:     &hoursPlayed,
+				Private:         false,
+				LibraryableID:   1,
+				LibraryableType: "games",
+				UserID:          1,
+				CreatedAt:       fixedTime,
+				UpdatedAt:       fixedTime,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, libraryable domain.Libraryable) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `libraryables`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						libraryable.Status,
+						*libraryable.HoursPlayed,
+						sqlmock.AnyArg(),
+						libraryable.Private,
+						libraryable.LibraryableID,
+						libraryable.LibraryableType,
+						libraryable.UserID,
+						libraryable.ID,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		"Failure - Update Error": {
+			libraryable: domain.Libraryable{
+				ID:              1,
+				Status:          domain.LibraryPlaying,
+				HoursPlayed:     &hoursPlayed,
+				Private:         false,
+				LibraryableID:   1,
+				LibraryableType: "games",
+				UserID:          1,
+				CreatedAt:       fixedTime,
+				UpdatedAt:       fixedTime,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, libraryable domain.Libraryable) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `libraryables`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						libraryable.Status,
+						*libraryable.HoursPlayed,
+						sqlmock.AnyArg(),
+						libraryable.Private,
+						libraryable.LibraryableID,
+						libraryable.LibraryableType,
+						libraryable.UserID,
+						libraryable.ID,
+					).
+					WillReturnError(fmt.Errorf("some error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock := testutils.Setup(t)
+
+			tc.mockBehavior(mock, tc.libraryable)
+
+			err := db.Save(&tc.libraryable).Error
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestSoftDeleteLibraryable(t *testing.T) {
+	db, mock := testutils.Setup(t)
+
+	testCases := map[string]struct {
+		libraryableID uint
+		mockBehavior  func(mock sqlmock.Sqlmock, libraryableID uint)
+		wantErr       bool
+	}{
+		"Can soft delete a Libraryable": {
+			libraryableID: 1,
+			mockBehavior: func(mock sqlmock.Sqlmock, libraryableID uint) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `libraryables` SET `deleted_at`").WithArgs(sqlmock.AnyArg(), libraryableID).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		"Soft delete fails": {
+			libraryableID: 2,
+			mockBehavior: func(mock sqlmock.Sqlmock, libraryableID uint) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `libraryables` SET `deleted_at`").WithArgs(sqlmock.AnyArg(), 2).
+					WillReturnError(fmt.Errorf("failed to delete Libraryable"))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockBehavior(mock, tc.libraryableID)
+
+			err := db.Delete(&domain.Libraryable{}, tc.libraryableID).Error
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateLibraryableValidData(t *testing.T) {
+	fixedTime := time.Now()
+
+	testCases := map[string]struct {
+		libraryable domain.Libraryable
+	}{
+		"Valid Libraryable on wishlist": {
+			libraryable: domain.Libraryable{
+				ID:              1,
+				Status:          domain.LibraryWishlist,
+				LibraryableID:   1,
+				LibraryableType: "games",
+				CreatedAt:       fixedTime,
+				UpdatedAt:       fixedTime,
+				User: domain.User{
+					Name:       "John Doe",
+					Email:      "johndoe@example.com",
+					Nickname:   "johnny",
+					Blocked:    false,
+					Experience: 500,
+					Birthdate:  fixedTime,
+					Password:   "supersecretpassword",
+					Profile: domain.Profile{
+						Share: true,
+					},
+					Wallet: domain.Wallet{
+						Amount: 10,
+					},
+					Level: domain.Level{
+						Level:      1,
+						Experience: 500,
+						Coins:      10,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.libraryable.ValidateLibraryable()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateLibraryableWithMissingFields(t *testing.T) {
+	testCases := map[string]struct {
+		libraryable domain.Libraryable
+		wantErr     string
+	}{
+		"Missing required fields": {
+			libraryable: domain.Libraryable{},
+			wantErr:     "Status is a required field, Name is a required field, Email is a required field, Nickname is a required field, Birthdate is a required field, Password is a required field, Share is a required field, Level is a required field, Experience is a required field, Coins is a required field, Amount is a required field",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.libraryable.ValidateLibraryable()
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestIsValidLibraryStatus(t *testing.T) {
+	testCases := map[string]struct {
+		status   string
+		expected bool
+	}{
+		"wishlist":  {status: domain.LibraryWishlist, expected: true},
+		"playing":   {status: domain.LibraryPlaying, expected: true},
+		"completed": {status: domain.LibraryCompleted, expected: true},
+		"dropped":   {status: domain.LibraryDropped, expected: true},
+		"backlog":   {status: domain.LibraryBacklog, expected: true},
+		"invalid":   {status: "finished", expected: false},
+		"empty":     {status: "", expected: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, domain.IsValidLibraryStatus(tc.status))
+		})
+	}
+}
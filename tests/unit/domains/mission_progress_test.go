@@ -33,6 +33,7 @@ func CreateMissionProgressTest(t *testing.T) {
 						sqlmock.AnyArg(),
 						missionProgress.Progress,
 						missionProgress.Completed,
+						sqlmock.AnyArg(),
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -53,6 +54,7 @@ func CreateMissionProgressTest(t *testing.T) {
 						sqlmock.AnyArg(),
 						missionProgress.Progress,
 						missionProgress.Completed,
+						sqlmock.AnyArg(),
 					).
 					WillReturnError(fmt.Errorf("some error"))
 				mock.ExpectRollback()
@@ -157,6 +159,7 @@ func TestUpdateMissionProgress(t *testing.T) {
 						sqlmock.AnyArg(),
 						missionProgress.Progress,
 						missionProgress.Completed,
+						sqlmock.AnyArg(),
 						missionProgress.UserID,
 						missionProgress.MissionRequirementID,
 						missionProgress.ID,
@@ -185,6 +188,7 @@ func TestUpdateMissionProgress(t *testing.T) {
 						sqlmock.AnyArg(),
 						missionProgress.Progress,
 						missionProgress.Completed,
+						sqlmock.AnyArg(),
 						missionProgress.UserID,
 						missionProgress.MissionRequirementID,
 						missionProgress.ID,
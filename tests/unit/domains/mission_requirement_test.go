@@ -35,6 +35,11 @@ func CreateMissionRequirementTest(t *testing.T) {
 						sqlmock.AnyArg(),
 						missionRequirement.Task,
 						missionRequirement.Description,
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
 						missionRequirement.Key,
 						missionRequirement.Goal,
 					).
@@ -59,6 +64,11 @@ func CreateMissionRequirementTest(t *testing.T) {
 						sqlmock.AnyArg(),
 						missionRequirement.Task,
 						missionRequirement.Description,
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
 						missionRequirement.Key,
 						missionRequirement.Goal,
 					).
@@ -168,6 +178,11 @@ func TestUpdateMissionRequirement(t *testing.T) {
 						missionRequirement.Key,
 						missionRequirement.Goal,
 						missionRequirement.Description,
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
 						missionRequirement.MissionID,
 						missionRequirement.ID,
 					).
@@ -198,6 +213,11 @@ func TestUpdateMissionRequirement(t *testing.T) {
 						missionRequirement.Key,
 						missionRequirement.Goal,
 						missionRequirement.Description,
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
 						missionRequirement.MissionID,
 						missionRequirement.ID,
 					).
@@ -190,6 +190,9 @@ func TestUpdateMission(t *testing.T) {
 						mission.Experience,
 						mission.Frequency,
 						mission.ResetTime,
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
 						mission.ID,
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
@@ -226,6 +229,9 @@ func TestUpdateMission(t *testing.T) {
 						mission.Experience,
 						mission.Frequency,
 						mission.ResetTime,
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
 						mission.ID,
 					).
 					WillReturnError(fmt.Errorf("some error"))
@@ -312,3 +318,86 @@ func TestCreateMissionWithMissingFields(t *testing.T) {
 		})
 	}
 }
+
+func TestMissionLastAndNextReset(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	assert.NoError(t, err)
+
+	// Wednesday, 10:00 in Sao Paulo.
+	now := time.Date(2026, 3, 11, 13, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mission   domain.Mission
+		loc       *time.Location
+		last      time.Time
+		next      time.Time
+		recurring bool
+	}{
+		"one-time missions never reset": {
+			mission: domain.Mission{Frequency: domain.OneTimeMission},
+			loc:     time.UTC,
+		},
+		"daily reset already passed today": {
+			mission:   domain.Mission{Frequency: domain.DailyMission, ResetHour: 6},
+			loc:       saoPaulo,
+			last:      time.Date(2026, 3, 11, 6, 0, 0, 0, saoPaulo),
+			next:      time.Date(2026, 3, 12, 6, 0, 0, 0, saoPaulo),
+			recurring: true,
+		},
+		"daily reset still to come today": {
+			mission:   domain.Mission{Frequency: domain.DailyMission, ResetHour: 18},
+			loc:       saoPaulo,
+			last:      time.Date(2026, 3, 10, 18, 0, 0, 0, saoPaulo),
+			next:      time.Date(2026, 3, 11, 18, 0, 0, 0, saoPaulo),
+			recurring: true,
+		},
+		"weekly reset on monday": {
+			mission:   domain.Mission{Frequency: domain.WeeklyMission, ResetWeekday: 1},
+			loc:       time.UTC,
+			last:      time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+			next:      time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC),
+			recurring: true,
+		},
+		"weekly reset later today": {
+			mission:   domain.Mission{Frequency: domain.WeeklyMission, ResetWeekday: 3, ResetHour: 20},
+			loc:       time.UTC,
+			last:      time.Date(2026, 3, 4, 20, 0, 0, 0, time.UTC),
+			next:      time.Date(2026, 3, 11, 20, 0, 0, 0, time.UTC),
+			recurring: true,
+		},
+		"monthly reset on the first day": {
+			mission:   domain.Mission{Frequency: domain.MonthlyMission},
+			loc:       saoPaulo,
+			last:      time.Date(2026, 3, 1, 0, 0, 0, 0, saoPaulo),
+			next:      time.Date(2026, 4, 1, 0, 0, 0, 0, saoPaulo),
+			recurring: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			last, ok := tc.mission.LastReset(now, tc.loc)
+			assert.Equal(t, tc.recurring, ok)
+			assert.True(t, tc.last.Equal(last), "last reset %s, expected %s", last, tc.last)
+
+			next, ok := tc.mission.NextReset(now, tc.loc)
+			assert.Equal(t, tc.recurring, ok)
+			assert.True(t, tc.next.Equal(next), "next reset %s, expected %s", next, tc.next)
+		})
+	}
+}
+
+func TestMissionInvalidResetTimezone(t *testing.T) {
+	timezone := "Mars/Olympus_Mons"
+	mission := domain.Mission{
+		Mission:       "Mission 1",
+		Description:   "Mission 1",
+		Status:        domain.MissionAvailable,
+		Coins:         100,
+		Experience:    100,
+		Frequency:     domain.DailyMission,
+		ResetTimezone: &timezone,
+	}
+
+	assert.EqualError(t, mission.ValidateMission(), `the reset timezone "Mars/Olympus_Mons" is not valid`)
+}
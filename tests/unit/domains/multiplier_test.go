@@ -0,0 +1,145 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMultipliers(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	running := func(scope string, multiplier float64, actionKey string) domain.MultiplierEvent {
+		return domain.MultiplierEvent{Scope: scope, Multiplier: multiplier, ActionKey: actionKey, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)}
+	}
+
+	testCases := map[string]struct {
+		events             []domain.MultiplierEvent
+		boosters           []domain.UserBooster
+		actionKey          string
+		expectedExperience float64
+		expectedCoins      float64
+		expectedEvents     int
+	}{
+		"nothing running": {
+			expectedExperience: 1,
+			expectedCoins:      1,
+		},
+		"event and booster multiply each other": {
+			events:             []domain.MultiplierEvent{running(domain.BoosterScopeExperience, 2, "")},
+			boosters:           []domain.UserBooster{{Scope: domain.BoosterScopeExperience, Multiplier: 1.5, ExpiresAt: now.Add(time.Minute)}},
+			expectedExperience: 3,
+			expectedCoins:      1,
+			expectedEvents:     1,
+		},
+		"events of the same scope do not stack": {
+			events: []domain.MultiplierEvent{
+				running(domain.BoosterScopeCoins, 2, ""),
+				running(domain.BoosterScopeCoins, 3, ""),
+			},
+			expectedExperience: 1,
+			expectedCoins:      3,
+			expectedEvents:     2,
+		},
+		"events of other actions are left out": {
+			events:             []domain.MultiplierEvent{running(domain.BoosterScopeCoins, 2, domain.CheckInRequirementKey)},
+			actionKey:          domain.CompleteMissionActionKey,
+			expectedExperience: 1,
+			expectedCoins:      1,
+		},
+		"events of the action apply": {
+			events:             []domain.MultiplierEvent{running(domain.BoosterScopeCoins, 2, domain.CompleteMissionActionKey)},
+			actionKey:          domain.CompleteMissionActionKey,
+			expectedExperience: 1,
+			expectedCoins:      2,
+			expectedEvents:     1,
+		},
+		"ended events and expired boosters are left out": {
+			events: []domain.MultiplierEvent{
+				{Scope: domain.BoosterScopeCoins, Multiplier: 2, StartsAt: now.Add(-2 * time.Hour), EndsAt: now},
+				{Scope: domain.BoosterScopeCoins, Multiplier: 2, StartsAt: now.Add(time.Minute), EndsAt: now.Add(time.Hour)},
+			},
+			boosters:           []domain.UserBooster{{Scope: domain.BoosterScopeExperience, Multiplier: 2, ExpiresAt: now}},
+			expectedExperience: 1,
+			expectedCoins:      1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			multipliers := domain.NewMultipliers(tc.events, tc.boosters, tc.actionKey, now)
+
+			assert.Equal(t, tc.expectedExperience, multipliers.Experience)
+			assert.Equal(t, tc.expectedCoins, multipliers.Coins)
+			assert.Len(t, multipliers.Events, tc.expectedEvents)
+		})
+	}
+}
+
+func TestMultipliers_Apply(t *testing.T) {
+	multipliers := domain.Multipliers{Experience: 2, Coins: 1.5}
+	rewards := append(domain.AmountRewards(25, 100), domain.Reward{RewardableType: domain.RewardableTypeItems, RewardableID: 3, Amount: 2})
+
+	applied := multipliers.Apply(rewards)
+
+	assert.Equal(t, uint(38), applied[0].Amount)
+	assert.Equal(t, 1.5, applied[0].Multiplier)
+	assert.Equal(t, uint(200), applied[1].Amount)
+	assert.Equal(t, 2.0, applied[1].Multiplier)
+	assert.Equal(t, uint(2), applied[2].Amount)
+	assert.Zero(t, applied[2].Multiplier)
+	assert.Equal(t, uint(25), rewards[0].Amount)
+}
+
+func TestReward_Boosted(t *testing.T) {
+	reward := domain.Reward{RewardableType: domain.RewardableTypeCoins, Amount: 10}
+
+	assert.Equal(t, reward, reward.Boosted(1))
+	assert.Equal(t, uint(25), reward.Boosted(2.5).Amount)
+}
+
+func TestFormatMultiplier(t *testing.T) {
+	assert.Equal(t, "x2", domain.FormatMultiplier(2))
+	assert.Equal(t, "x1.5", domain.FormatMultiplier(1.5))
+}
+
+func TestValidateMultiplierEvent(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		event   domain.MultiplierEvent
+		wantErr string
+	}{
+		"valid": {
+			event: domain.MultiplierEvent{Name: "Double XP weekend", Scope: domain.BoosterScopeExperience, Multiplier: 2, StartsAt: now, EndsAt: now.Add(48 * time.Hour)},
+		},
+		"valid for an action": {
+			event: domain.MultiplierEvent{Name: "Mission rush", Scope: domain.BoosterScopeCoins, Multiplier: 2, ActionKey: domain.CompleteMissionActionKey, StartsAt: now, EndsAt: now.Add(time.Hour)},
+		},
+		"unknown action": {
+			event:   domain.MultiplierEvent{Name: "Hearts", Scope: domain.BoosterScopeCoins, Multiplier: 2, ActionKey: domain.HeartGameRequirementKey, StartsAt: now, EndsAt: now.Add(time.Hour)},
+			wantErr: "ActionKey must be an action giving coins or experience",
+		},
+		"ends before it starts": {
+			event:   domain.MultiplierEvent{Name: "Backwards", Scope: domain.BoosterScopeCoins, Multiplier: 2, StartsAt: now, EndsAt: now.Add(-time.Hour)},
+			wantErr: "EndsAt is not valid",
+		},
+		"missing fields": {
+			event:   domain.MultiplierEvent{Scope: domain.BoosterScopeCoins, Multiplier: 2, StartsAt: now, EndsAt: now.Add(time.Hour)},
+			wantErr: "Name is a required field",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.event.ValidateMultiplierEvent()
+
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
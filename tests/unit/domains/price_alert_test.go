@@ -0,0 +1,240 @@
+package tests
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	testutils "gcstatus/tests/utils"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePriceAlert(t *testing.T) {
+	targetPrice := uint(4999)
+
+	testCases := map[string]struct {
+		priceAlert   domain.PriceAlert
+		mockBehavior func(mock sqlmock.Sqlmock, priceAlert domain.PriceAlert)
+		expectError  bool
+	}{
+		"Success": {
+			priceAlert: domain.PriceAlert{
+				TargetPrice: &targetPrice,
+				AnyDiscount: true,
+				Active:      true,
+				GameID:      1,
+				UserID:      1,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, priceAlert domain.PriceAlert) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `price_alerts`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						priceAlert.AnyDiscount,
+						priceAlert.Active,
+						priceAlert.GameID,
+						priceAlert.UserID,
+						*priceAlert.TargetPrice,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		"Failure - Insert Error": {
+			priceAlert: domain.PriceAlert{
+				TargetPrice: &targetPrice,
+				AnyDiscount: true,
+				Active:      true,
+				GameID:      1,
+				UserID:      1,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, priceAlert domain.PriceAlert) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `price_alerts`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						priceAlert.AnyDiscount,
+						priceAlert.Active,
+						priceAlert.GameID,
+						priceAlert.UserID,
+						*priceAlert.TargetPrice,
+					).
+					WillReturnError(fmt.Errorf("some error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock := testutils.Setup(t)
+
+			tc.mockBehavior(mock, tc.priceAlert)
+
+			err := db.Create(&tc.priceAlert).Error
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestSoftDeletePriceAlert(t *testing.T) {
+	db, mock := testutils.Setup(t)
+
+	testCases := map[string]struct {
+		priceAlertID uint
+		mockBehavior func(mock sqlmock.Sqlmock, priceAlertID uint)
+		wantErr      bool
+	}{
+		"Can soft delete a PriceAlert": {
+			priceAlertID: 1,
+			mockBehavior: func(mock sqlmock.Sqlmock, priceAlertID uint) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `price_alerts` SET `deleted_at`").WithArgs(sqlmock.AnyArg(), priceAlertID).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		"Soft delete fails": {
+			priceAlertID: 2,
+			mockBehavior: func(mock sqlmock.Sqlmock, priceAlertID uint) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `price_alerts` SET `deleted_at`").WithArgs(sqlmock.AnyArg(), 2).
+					WillReturnError(fmt.Errorf("failed to delete PriceAlert"))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockBehavior(mock, tc.priceAlertID)
+
+			err := db.Delete(&domain.PriceAlert{}, tc.priceAlertID).Error
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidatePriceAlertValidData(t *testing.T) {
+	fixedTime := time.Now()
+
+	priceAlert := domain.PriceAlert{
+		AnyDiscount: true,
+		Active:      true,
+		Game: domain.Game{
+			Slug:             "valid",
+			Age:              18,
+			Title:            "Game Test",
+			Condition:        domain.CommomCondition,
+			Cover:            "https://placehold.co/600x400/EEE/31343C",
+			About:            "About game",
+			Description:      "Description",
+			ShortDescription: "Short description",
+			Free:             false,
+			ReleaseDate:      fixedTime,
+		},
+		User: domain.User{
+			Name:       "John Doe",
+			Email:      "johndoe@example.com",
+			Nickname:   "johnny",
+			Experience: 500,
+			Birthdate:  fixedTime,
+			Password:   "supersecretpassword",
+			Profile: domain.Profile{
+				Share: true,
+			},
+			Wallet: domain.Wallet{
+				Amount: 10,
+			},
+			Level: domain.Level{
+				Level:      1,
+				Experience: 500,
+				Coins:      10,
+			},
+		},
+	}
+
+	assert.NoError(t, priceAlert.ValidatePriceAlert())
+}
+
+func TestPriceAlertShouldTrigger(t *testing.T) {
+	targetPrice := uint(5000)
+	notifiedPrice := uint(4000)
+
+	testCases := map[string]struct {
+		priceAlert   domain.PriceAlert
+		initialPrice uint
+		price        uint
+		expected     bool
+	}{
+		"price below target": {
+			priceAlert:   domain.PriceAlert{Active: true, TargetPrice: &targetPrice},
+			initialPrice: 10000,
+			price:        4000,
+			expected:     true,
+		},
+		"price above target": {
+			priceAlert:   domain.PriceAlert{Active: true, TargetPrice: &targetPrice},
+			initialPrice: 10000,
+			price:        6000,
+			expected:     false,
+		},
+		"any discount": {
+			priceAlert:   domain.PriceAlert{Active: true, AnyDiscount: true},
+			initialPrice: 10000,
+			price:        9000,
+			expected:     true,
+		},
+		"any discount without discount": {
+			priceAlert:   domain.PriceAlert{Active: true, AnyDiscount: true},
+			initialPrice: 10000,
+			price:        10000,
+			expected:     false,
+		},
+		"inactive alert": {
+			priceAlert:   domain.PriceAlert{Active: false, AnyDiscount: true},
+			initialPrice: 10000,
+			price:        1000,
+			expected:     false,
+		},
+		"already notified for the price": {
+			priceAlert:   domain.PriceAlert{Active: true, TargetPrice: &targetPrice, LastNotifiedPrice: &notifiedPrice},
+			initialPrice: 10000,
+			price:        4000,
+			expected:     false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.priceAlert.ShouldTrigger(tc.initialPrice, tc.price))
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	testutils "gcstatus/tests/utils"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePriceHistory(t *testing.T) {
+	testCases := map[string]struct {
+		priceHistory domain.PriceHistory
+		mockBehavior func(mock sqlmock.Sqlmock, priceHistory domain.PriceHistory)
+		expectError  bool
+	}{
+		"Success": {
+			priceHistory: domain.PriceHistory{
+				Price:         9999,
+				InitialPrice:  19999,
+				Discount:      50,
+				Currency:      "USD",
+				PriceableID:   1,
+				PriceableType: domain.PriceableTypeGameStores,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, priceHistory domain.PriceHistory) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `price_histories`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						priceHistory.Price,
+						priceHistory.InitialPrice,
+						priceHistory.Discount,
+						priceHistory.Currency,
+						priceHistory.PriceableID,
+						priceHistory.PriceableType,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		"Failure - Insert Error": {
+			priceHistory: domain.PriceHistory{
+				Price:         9999,
+				InitialPrice:  19999,
+				Discount:      50,
+				Currency:      "USD",
+				PriceableID:   1,
+				PriceableType: domain.PriceableTypeGameStores,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, priceHistory domain.PriceHistory) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `price_histories`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						priceHistory.Price,
+						priceHistory.InitialPrice,
+						priceHistory.Discount,
+						priceHistory.Currency,
+						priceHistory.PriceableID,
+						priceHistory.PriceableType,
+					).
+					WillReturnError(fmt.Errorf("some error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock := testutils.Setup(t)
+
+			tc.mockBehavior(mock, tc.priceHistory)
+
+			err := db.Create(&tc.priceHistory).Error
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestSoftDeletePriceHistory(t *testing.T) {
+	db, mock := testutils.Setup(t)
+
+	testCases := map[string]struct {
+		priceHistoryID uint
+		mockBehavior   func(mock sqlmock.Sqlmock, priceHistoryID uint)
+		wantErr        bool
+	}{
+		"Can soft delete a PriceHistory": {
+			priceHistoryID: 1,
+			mockBehavior: func(mock sqlmock.Sqlmock, priceHistoryID uint) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `price_histories` SET `deleted_at`").WithArgs(sqlmock.AnyArg(), priceHistoryID).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		"Soft delete fails": {
+			priceHistoryID: 2,
+			mockBehavior: func(mock sqlmock.Sqlmock, priceHistoryID uint) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `price_histories` SET `deleted_at`").WithArgs(sqlmock.AnyArg(), 2).
+					WillReturnError(fmt.Errorf("failed to delete PriceHistory"))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockBehavior(mock, tc.priceHistoryID)
+
+			err := db.Delete(&domain.PriceHistory{}, tc.priceHistoryID).Error
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestCalculateDiscount(t *testing.T) {
+	testCases := map[string]struct {
+		initialPrice uint
+		price        uint
+		expected     uint
+	}{
+		"no discount":           {initialPrice: 19999, price: 19999, expected: 0},
+		"half price":            {initialPrice: 20000, price: 10000, expected: 50},
+		"rounded down discount": {initialPrice: 3000, price: 1999, expected: 33},
+		"unknown initial price": {initialPrice: 0, price: 1999, expected: 0},
+		"price increase":        {initialPrice: 1999, price: 2999, expected: 0},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, domain.CalculateDiscount(tc.initialPrice, tc.price))
+		})
+	}
+}
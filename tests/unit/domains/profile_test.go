@@ -46,6 +46,7 @@ func TestCreateProfile(t *testing.T) {
 						profile.Youtube,
 						profile.Twitch,
 						profile.Github,
+						profile.Region,
 						profile.UserID,
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
@@ -82,6 +83,7 @@ func TestCreateProfile(t *testing.T) {
 						profile.Youtube,
 						profile.Twitch,
 						profile.Github,
+						profile.Region,
 						profile.UserID,
 					).
 					WillReturnError(fmt.Errorf("some error"))
@@ -152,6 +154,7 @@ func TestUpdateProfile(t *testing.T) {
 						profile.Youtube,
 						profile.Twitch,
 						profile.Github,
+						profile.Region,
 						profile.UserID,
 						profile.ID,
 					).
@@ -192,6 +195,7 @@ func TestUpdateProfile(t *testing.T) {
 						profile.Youtube,
 						profile.Twitch,
 						profile.Github,
+						profile.Region,
 						profile.UserID,
 						profile.ID,
 					).
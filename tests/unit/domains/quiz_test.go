@@ -0,0 +1,239 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestQuiz(kind string) domain.Quiz {
+	opensAt := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	correct := kind == domain.QuizKindQuiz
+
+	return domain.Quiz{
+		ID:       1,
+		Kind:     kind,
+		Title:    "Most awaited release",
+		OpensAt:  opensAt,
+		ClosesAt: opensAt.Add(7 * 24 * time.Hour),
+		Questions: []domain.QuizQuestion{
+			{ID: 1, Question: "Who develops it?", Options: []domain.QuizOption{
+				{ID: 1, Text: "Rockstar", Correct: correct},
+				{ID: 2, Text: "Valve"},
+			}},
+			{ID: 2, Question: "When does it release?", Options: []domain.QuizOption{
+				{ID: 3, Text: "2026"},
+				{ID: 4, Text: "2027", Correct: correct},
+			}},
+		},
+	}
+}
+
+func TestValidateQuiz(t *testing.T) {
+	testCases := map[string]struct {
+		quiz    func() domain.Quiz
+		wantErr bool
+	}{
+		"valid quiz": {
+			quiz: func() domain.Quiz { return newTestQuiz(domain.QuizKindQuiz) },
+		},
+		"valid poll": {
+			quiz: func() domain.Quiz { return newTestQuiz(domain.QuizKindPoll) },
+		},
+		"unknown kind": {
+			quiz: func() domain.Quiz {
+				quiz := newTestQuiz(domain.QuizKindQuiz)
+				quiz.Kind = "survey"
+				return quiz
+			},
+			wantErr: true,
+		},
+		"closes before it opens": {
+			quiz: func() domain.Quiz {
+				quiz := newTestQuiz(domain.QuizKindQuiz)
+				quiz.ClosesAt = quiz.OpensAt.Add(-time.Hour)
+				return quiz
+			},
+			wantErr: true,
+		},
+		"no questions": {
+			quiz: func() domain.Quiz {
+				quiz := newTestQuiz(domain.QuizKindQuiz)
+				quiz.Questions = nil
+				return quiz
+			},
+			wantErr: true,
+		},
+		"question with a single option": {
+			quiz: func() domain.Quiz {
+				quiz := newTestQuiz(domain.QuizKindQuiz)
+				quiz.Questions[0].Options = quiz.Questions[0].Options[:1]
+				return quiz
+			},
+			wantErr: true,
+		},
+		"quiz question without a correct option": {
+			quiz: func() domain.Quiz {
+				quiz := newTestQuiz(domain.QuizKindQuiz)
+				quiz.Questions[0].Options[0].Correct = false
+				return quiz
+			},
+			wantErr: true,
+		},
+		"poll question with a correct option": {
+			quiz: func() domain.Quiz {
+				quiz := newTestQuiz(domain.QuizKindPoll)
+				quiz.Questions[0].Options[0].Correct = true
+				return quiz
+			},
+			wantErr: true,
+		},
+		"poll reward asking for a score": {
+			quiz: func() domain.Quiz {
+				quiz := newTestQuiz(domain.QuizKindPoll)
+				quiz.Rewards = []domain.QuizReward{{MinScore: 50, Coins: 10}}
+				return quiz
+			},
+			wantErr: true,
+		},
+		"reward above a perfect score": {
+			quiz: func() domain.Quiz {
+				quiz := newTestQuiz(domain.QuizKindQuiz)
+				quiz.Rewards = []domain.QuizReward{{MinScore: 101, Coins: 10}}
+				return quiz
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			quiz := tc.quiz()
+			err := quiz.ValidateQuiz()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestQuiz_IsOpen(t *testing.T) {
+	quiz := newTestQuiz(domain.QuizKindQuiz)
+
+	assert.False(t, quiz.IsOpen(quiz.OpensAt.Add(-time.Second)))
+	assert.True(t, quiz.IsOpen(quiz.OpensAt))
+	assert.False(t, quiz.IsOpen(quiz.ClosesAt))
+	assert.True(t, quiz.IsClosed(quiz.ClosesAt))
+}
+
+func TestQuiz_Grade(t *testing.T) {
+	testCases := map[string]struct {
+		kind    string
+		choices map[uint]uint
+		correct uint
+		score   uint
+		wantErr bool
+	}{
+		"every answer right": {
+			kind:    domain.QuizKindQuiz,
+			choices: map[uint]uint{1: 1, 2: 4},
+			correct: 2,
+			score:   100,
+		},
+		"half the answers right": {
+			kind:    domain.QuizKindQuiz,
+			choices: map[uint]uint{1: 1, 2: 3},
+			correct: 1,
+			score:   50,
+		},
+		"poll is not scored": {
+			kind:    domain.QuizKindPoll,
+			choices: map[uint]uint{1: 2, 2: 3},
+		},
+		"question left out": {
+			kind:    domain.QuizKindQuiz,
+			choices: map[uint]uint{1: 1},
+			wantErr: true,
+		},
+		"option of another question": {
+			kind:    domain.QuizKindQuiz,
+			choices: map[uint]uint{1: 3, 2: 4},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			attempt, err := newTestQuiz(tc.kind).Grade(7, tc.choices)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uint(7), attempt.UserID)
+			assert.Equal(t, tc.correct, attempt.Correct)
+			assert.Equal(t, tc.score, attempt.Score)
+			assert.Len(t, attempt.Answers, 2)
+		})
+	}
+}
+
+func TestQuiz_RewardsToGrant(t *testing.T) {
+	quiz := newTestQuiz(domain.QuizKindQuiz)
+	quiz.Rewards = []domain.QuizReward{
+		{ID: 1, MinScore: 0, Coins: 10},
+		{ID: 2, MinScore: 50, Coins: 50, Experience: 100},
+		{ID: 3, MinScore: 100, Rewards: []domain.Reward{{RewardableType: domain.RewardableTypeTitles, RewardableID: 3, Amount: 1}}},
+	}
+
+	testCases := map[string]struct {
+		score    uint
+		expected []domain.Reward
+	}{
+		"lowest tier": {
+			score:    0,
+			expected: []domain.Reward{{RewardableType: domain.RewardableTypeCoins, Amount: 10}},
+		},
+		"tiers add up": {
+			score: 50,
+			expected: []domain.Reward{
+				{RewardableType: domain.RewardableTypeCoins, Amount: 10},
+				{RewardableType: domain.RewardableTypeCoins, Amount: 50},
+				{RewardableType: domain.RewardableTypeExperience, Amount: 100},
+			},
+		},
+		"perfect score gives the title": {
+			score: 100,
+			expected: []domain.Reward{
+				{RewardableType: domain.RewardableTypeCoins, Amount: 10},
+				{RewardableType: domain.RewardableTypeCoins, Amount: 50},
+				{RewardableType: domain.RewardableTypeExperience, Amount: 100},
+				{RewardableType: domain.RewardableTypeTitles, RewardableID: 3, Amount: 1},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, quiz.RewardsToGrant(domain.QuizAttempt{Score: tc.score}))
+		})
+	}
+
+	assert.Equal(t, "quizzes:1", quiz.RewardGrantKey())
+}
+
+func TestQuizResults_Share(t *testing.T) {
+	results := domain.QuizResults{Attempts: 3, Votes: map[uint]uint{1: 2, 2: 1}}
+
+	assert.Equal(t, 66.7, results.Share(1))
+	assert.Equal(t, 33.3, results.Share(2))
+	assert.Equal(t, float64(0), results.Share(3))
+	assert.Equal(t, float64(0), domain.QuizResults{}.Share(1))
+}
@@ -39,6 +39,7 @@ func CreateRewardTest(t *testing.T) {
 						reward.SourceableType,
 						reward.RewardableID,
 						reward.RewardableType,
+						reward.Amount,
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -65,6 +66,7 @@ func CreateRewardTest(t *testing.T) {
 						reward.SourceableType,
 						reward.RewardableID,
 						reward.RewardableType,
+						reward.Amount,
 					).
 					WillReturnError(fmt.Errorf("some error"))
 				mock.ExpectRollback()
@@ -157,6 +159,7 @@ func TestUpdateReward(t *testing.T) {
 				SourceableType: "levels",
 				RewardableID:   1,
 				RewardableType: "titles",
+				Amount:         1,
 				CreatedAt:      fixedTime,
 				UpdatedAt:      fixedTime,
 			},
@@ -171,6 +174,7 @@ func TestUpdateReward(t *testing.T) {
 						reward.SourceableType,
 						reward.RewardableID,
 						reward.RewardableType,
+						reward.Amount,
 						reward.ID,
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
@@ -185,6 +189,7 @@ func TestUpdateReward(t *testing.T) {
 				SourceableType: "levels",
 				RewardableID:   1,
 				RewardableType: "titles",
+				Amount:         1,
 				CreatedAt:      fixedTime,
 				UpdatedAt:      fixedTime,
 			},
@@ -199,6 +204,7 @@ func TestUpdateReward(t *testing.T) {
 						reward.SourceableType,
 						reward.RewardableID,
 						reward.RewardableType,
+						reward.Amount,
 						reward.ID,
 					).
 					WillReturnError(fmt.Errorf("some error"))
@@ -244,6 +250,14 @@ func TestValidateRewardValidData(t *testing.T) {
 				UpdatedAt:      time.Now(),
 			},
 		},
+		"Coins need no rewarded entity": {
+			reward: domain.Reward{
+				SourceableID:   1,
+				SourceableType: "missions",
+				RewardableType: "coins",
+				Amount:         50,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -271,6 +285,14 @@ func TestCreateRewardWithMissingFields(t *testing.T) {
 				RewardableType is a required field
 			`,
 		},
+		"Titles need the rewarded entity": {
+			reward: domain.Reward{
+				SourceableID:   1,
+				SourceableType: "missions",
+				RewardableType: "titles",
+			},
+			wantErr: "RewardableID is a required field",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -284,3 +306,38 @@ func TestCreateRewardWithMissingFields(t *testing.T) {
 		})
 	}
 }
+
+func TestReward_AmountRewards(t *testing.T) {
+	testCases := map[string]struct {
+		coins      uint
+		experience uint
+		expected   []domain.Reward
+	}{
+		"coins and experience": {
+			coins:      10,
+			experience: 50,
+			expected: []domain.Reward{
+				{RewardableType: domain.RewardableTypeCoins, Amount: 10},
+				{RewardableType: domain.RewardableTypeExperience, Amount: 50},
+			},
+		},
+		"only experience": {
+			experience: 50,
+			expected:   []domain.Reward{{RewardableType: domain.RewardableTypeExperience, Amount: 50}},
+		},
+		"nothing": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, domain.AmountRewards(tc.coins, tc.experience))
+		})
+	}
+}
+
+func TestReward_Quantity(t *testing.T) {
+	assert.Equal(t, uint(1), domain.Reward{RewardableType: domain.RewardableTypeTitles, RewardableID: 1}.Quantity())
+	assert.Equal(t, uint(3), domain.Reward{RewardableType: domain.RewardableTypeItems, RewardableID: 1, Amount: 3}.Quantity())
+	assert.True(t, domain.IsRewardableEntity(domain.RewardableTypeBoosters))
+	assert.False(t, domain.IsRewardableEntity(domain.RewardableTypeCoins))
+}
@@ -0,0 +1,185 @@
+package tests
+
+import (
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/utils"
+	testutils "gcstatus/tests/utils"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateStorePrice(t *testing.T) {
+	testCases := map[string]struct {
+		storePrice   domain.StorePrice
+		mockBehavior func(mock sqlmock.Sqlmock, storePrice domain.StorePrice)
+		expectError  bool
+	}{
+		"Success": {
+			storePrice: domain.StorePrice{
+				Region:        "br",
+				Currency:      "BRL",
+				InitialPrice:  19999,
+				FinalPrice:    9999,
+				Discount:      50,
+				PriceableID:   1,
+				PriceableType: domain.PriceableTypeGameStores,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, storePrice domain.StorePrice) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `store_prices`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						storePrice.Region,
+						storePrice.Currency,
+						storePrice.InitialPrice,
+						storePrice.FinalPrice,
+						storePrice.Discount,
+						storePrice.PriceableID,
+						storePrice.PriceableType,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		"Failure - Insert Error": {
+			storePrice: domain.StorePrice{
+				Region:        "us",
+				Currency:      "USD",
+				InitialPrice:  1999,
+				FinalPrice:    1999,
+				PriceableID:   1,
+				PriceableType: domain.PriceableTypeDLCStores,
+			},
+			mockBehavior: func(mock sqlmock.Sqlmock, storePrice domain.StorePrice) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO `store_prices`").
+					WithArgs(
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						storePrice.Region,
+						storePrice.Currency,
+						storePrice.InitialPrice,
+						storePrice.FinalPrice,
+						storePrice.Discount,
+						storePrice.PriceableID,
+						storePrice.PriceableType,
+					).
+					WillReturnError(fmt.Errorf("some error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock := testutils.Setup(t)
+
+			tc.mockBehavior(mock, tc.storePrice)
+
+			err := db.Create(&tc.storePrice).Error
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestSoftDeleteStorePrice(t *testing.T) {
+	db, mock := testutils.Setup(t)
+
+	testCases := map[string]struct {
+		storePriceID uint
+		mockBehavior func(mock sqlmock.Sqlmock, storePriceID uint)
+		wantErr      bool
+	}{
+		"Can soft delete a StorePrice": {
+			storePriceID: 1,
+			mockBehavior: func(mock sqlmock.Sqlmock, storePriceID uint) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `store_prices` SET `deleted_at`").WithArgs(sqlmock.AnyArg(), storePriceID).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		"Soft delete fails": {
+			storePriceID: 2,
+			mockBehavior: func(mock sqlmock.Sqlmock, storePriceID uint) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `store_prices` SET `deleted_at`").WithArgs(sqlmock.AnyArg(), 2).
+					WillReturnError(fmt.Errorf("failed to delete StorePrice"))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mockBehavior(mock, tc.storePriceID)
+
+			err := db.Delete(&domain.StorePrice{}, tc.storePriceID).Error
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateStorePrice(t *testing.T) {
+	testCases := map[string]struct {
+		storePrice domain.StorePrice
+		wantErr    string
+	}{
+		"Valid store price": {
+			storePrice: domain.StorePrice{
+				Region:       "eu",
+				Currency:     "EUR",
+				InitialPrice: 5999,
+				FinalPrice:   5999,
+			},
+		},
+		"Missing required fields": {
+			storePrice: domain.StorePrice{},
+			wantErr: `
+				Region is a required field,
+				Currency is a required field
+			`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.storePrice.ValidateStorePrice()
+
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), utils.NormalizeWhitespace(tc.wantErr))
+		})
+	}
+}
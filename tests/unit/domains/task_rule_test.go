@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskRule_ValidateTaskRule(t *testing.T) {
+	zero := uint(0)
+	invalidConditions := `["rpg"]`
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, -1)
+
+	testCases := map[string]struct {
+		rule    domain.TaskRule
+		wantErr bool
+	}{
+		"plain counter":      {rule: domain.TaskRule{Type: domain.RequirementCounter}},
+		"unknown type":       {rule: domain.TaskRule{Type: "average"}, wantErr: true},
+		"empty window":       {rule: domain.TaskRule{WithinDays: &zero}, wantErr: true},
+		"ends before start":  {rule: domain.TaskRule{StartsAt: &start, EndsAt: &end}, wantErr: true},
+		"invalid conditions": {rule: domain.TaskRule{Conditions: &invalidConditions}, wantErr: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.rule.ValidateTaskRule()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskRule_Progress(t *testing.T) {
+	games := domain.ActionTargetGames
+	withinDays := uint(3)
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	endsAt := now.AddDate(0, 0, -1)
+
+	action := func(targetID uint, daysAgo int, genres ...string) domain.UserAction {
+		action := domain.UserAction{
+			Key:        domain.HeartGameRequirementKey,
+			TargetType: &games,
+			TargetID:   &targetID,
+			Increment:  1,
+			OccurredAt: now.AddDate(0, 0, -daysAgo),
+		}
+		action.SetAttributeValues(map[string][]string{"genres": genres})
+
+		return action
+	}
+
+	conditions := func(genres ...string) *string {
+		rule := domain.TaskRule{}
+		rule.SetConditionValues(map[string][]string{"genres": genres})
+
+		return rule.Conditions
+	}
+
+	testCases := map[string]struct {
+		rule     domain.TaskRule
+		actions  []domain.UserAction
+		expected int
+	}{
+		"counter sums the increments": {
+			rule:     domain.TaskRule{Type: domain.RequirementCounter},
+			actions:  []domain.UserAction{action(1, 0), action(1, 0), action(2, 0)},
+			expected: 3,
+		},
+		"distinct counts the targets": {
+			rule:     domain.TaskRule{Type: domain.RequirementDistinct},
+			actions:  []domain.UserAction{action(1, 0), action(1, 1), action(2, 2)},
+			expected: 2,
+		},
+		"streak counts the longest run of days": {
+			rule:     domain.TaskRule{Type: domain.RequirementStreak},
+			actions:  []domain.UserAction{action(1, 6), action(1, 5), action(1, 4), action(1, 1), action(1, 0)},
+			expected: 3,
+		},
+		"window drops older actions": {
+			rule:     domain.TaskRule{Type: domain.RequirementStreak, WithinDays: &withinDays},
+			actions:  []domain.UserAction{action(1, 6), action(1, 5), action(1, 4), action(1, 1), action(1, 0)},
+			expected: 2,
+		},
+		"end drops later actions": {
+			rule:     domain.TaskRule{Type: domain.RequirementCounter, EndsAt: &endsAt},
+			actions:  []domain.UserAction{action(1, 2), action(1, 0)},
+			expected: 1,
+		},
+		"conditions match any listed value": {
+			rule:     domain.TaskRule{Type: domain.RequirementDistinct, Conditions: conditions("rpg", "strategy")},
+			actions:  []domain.UserAction{action(1, 0, "rpg"), action(2, 0, "strategy", "indie"), action(3, 0, "racing")},
+			expected: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.rule.Progress(tc.actions, now))
+		})
+	}
+}
+
+func TestTaskRule_Window(t *testing.T) {
+	withinDays := uint(7)
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	startsAt := now.AddDate(0, 0, -30)
+	resetAt := now.AddDate(0, 0, -2)
+
+	rule := domain.TaskRule{Type: domain.RequirementStreak, WithinDays: &withinDays, StartsAt: &startsAt}
+
+	since, until := rule.Window(now, nil)
+	assert.Equal(t, now.AddDate(0, 0, -7), *since)
+	assert.Nil(t, until)
+
+	since, _ = rule.Window(now, &resetAt)
+	assert.Equal(t, resetAt, *since)
+	assert.False(t, rule.IsPlainCounter())
+	assert.True(t, domain.TaskRule{}.IsPlainCounter())
+}
@@ -35,6 +35,11 @@ func CreateTitleRequirementTest(t *testing.T) {
 						sqlmock.AnyArg(),
 						titleRequirement.Task,
 						titleRequirement.Description,
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
 						titleRequirement.Key,
 						titleRequirement.Goal,
 					).
@@ -59,6 +64,11 @@ func CreateTitleRequirementTest(t *testing.T) {
 						sqlmock.AnyArg(),
 						titleRequirement.Task,
 						titleRequirement.Description,
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
 						titleRequirement.Key,
 						titleRequirement.Goal,
 					).
@@ -168,6 +178,11 @@ func TestUpdateTitleRequirement(t *testing.T) {
 						titleRequirement.Key,
 						titleRequirement.Goal,
 						titleRequirement.Description,
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
 						titleRequirement.TitleID,
 						titleRequirement.ID,
 					).
@@ -198,6 +213,11 @@ func TestUpdateTitleRequirement(t *testing.T) {
 						titleRequirement.Key,
 						titleRequirement.Goal,
 						titleRequirement.Description,
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
+						sqlmock.AnyArg(),
 						titleRequirement.TitleID,
 						titleRequirement.ID,
 					).
@@ -0,0 +1,25 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTrackedActionKey(t *testing.T) {
+	testCases := map[string]struct {
+		key      string
+		expected bool
+	}{
+		"library action":   {key: domain.AddToLibraryRequirementKey, expected: true},
+		"profile action":   {key: domain.ProfilePictureTitleRequirementKey, expected: true},
+		"untracked action": {key: "fly_to_the_moon", expected: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, domain.IsTrackedActionKey(tc.key))
+		})
+	}
+}
@@ -43,6 +43,10 @@ func TestCreateUser(t *testing.T) {
 					"johnny",
 					500,
 					false,
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
 					fixedTime,
 					sqlmock.AnyArg(),
 					1,
@@ -72,6 +76,10 @@ func TestCreateUser(t *testing.T) {
 					"johnny",
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
 					fixedTime,
 					sqlmock.AnyArg(),
 					1,
@@ -244,6 +252,10 @@ func TestUpdateUser(t *testing.T) {
 					"johnny",
 					500,
 					false,
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
 					fixedTime,
 					sqlmock.AnyArg(),
 					1,
@@ -277,6 +289,10 @@ func TestUpdateUser(t *testing.T) {
 					"jane",
 					600,
 					false,
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
 					fixedTime,
 					sqlmock.AnyArg(),
 					2,
@@ -336,3 +352,93 @@ func TestCreateUserWithMissingFields(t *testing.T) {
 		})
 	}
 }
+
+func TestUserIsBlocked(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	testCases := map[string]struct {
+		user     domain.User
+		expected bool
+	}{
+		"not blocked": {
+			user:     domain.User{},
+			expected: false,
+		},
+		"blocked permanently": {
+			user:     domain.User{Blocked: true},
+			expected: true,
+		},
+		"blocked until the future": {
+			user:     domain.User{Blocked: true, BlockedUntil: &future},
+			expected: true,
+		},
+		"block expired": {
+			user:     domain.User{Blocked: true, BlockedUntil: &past},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.user.IsBlocked(now))
+		})
+	}
+}
+
+func TestUserSessionRevoked(t *testing.T) {
+	revokedAt := time.Now()
+
+	testCases := map[string]struct {
+		user     domain.User
+		issuedAt int64
+		expected bool
+	}{
+		"never revoked": {
+			user:     domain.User{},
+			issuedAt: revokedAt.Unix(),
+			expected: false,
+		},
+		"issued before the revocation": {
+			user:     domain.User{SessionsRevokedAt: &revokedAt},
+			issuedAt: revokedAt.Add(-time.Minute).Unix(),
+			expected: true,
+		},
+		"issued in the second of the revocation": {
+			user:     domain.User{SessionsRevokedAt: &revokedAt},
+			issuedAt: revokedAt.Unix(),
+			expected: false,
+		},
+		"issued after the revocation": {
+			user:     domain.User{SessionsRevokedAt: &revokedAt},
+			issuedAt: revokedAt.Add(time.Minute).Unix(),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.user.SessionRevoked(tc.issuedAt))
+		})
+	}
+}
+
+func TestUserLocation(t *testing.T) {
+	testCases := map[string]struct {
+		timezone string
+		expected string
+	}{
+		"missing timezone": {timezone: "", expected: "UTC"},
+		"known timezone":   {timezone: "America/Sao_Paulo", expected: "America/Sao_Paulo"},
+		"unknown timezone": {timezone: "Mars/Olympus_Mons", expected: "UTC"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			user := domain.User{Timezone: tc.timezone}
+
+			assert.Equal(t, tc.expected, user.Location().String())
+		})
+	}
+}
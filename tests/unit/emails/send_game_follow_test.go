@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/pkg/ses"
+	"strings"
+	"testing"
+)
+
+func TestSendGameFollowEmail(t *testing.T) {
+	follow := &domain.GameFollow{Token: "follow-token"}
+	data := ses.GameFollowEmailData{
+		Title: "Game Test is out today!",
+		URL:   "/games/game-test",
+	}
+
+	tests := map[string]struct {
+		user        *domain.User
+		sendFunc    ses.SendEmailFunc
+		expectError bool
+	}{
+		"successful email": {
+			user:        &domain.User{Name: "Test", Email: "test@example.com"},
+			sendFunc:    MockSendEmail,
+			expectError: false,
+		},
+		"failed email sending": {
+			user:        &domain.User{Name: "Test", Email: "fail@example.com"},
+			sendFunc:    MockSendEmail,
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var sentBody string
+			sendFunc := func(recipient, body, subject string) error {
+				sentBody = body
+				return tc.sendFunc(recipient, body, subject)
+			}
+
+			err := ses.SendGameFollowEmail(tc.user, follow, domain.FollowReleaseEvent, data, sendFunc)
+
+			if tc.expectError && err == nil {
+				t.Errorf("Expected error but got nil")
+			}
+
+			if !tc.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if !tc.expectError {
+				for _, expected := range []string{
+					"Hello, Test!",
+					"Game Test is out today!",
+					"https://gcstatus.cloud/games/game-test",
+					"https://gcstatus.cloud/follows/unsubscribe/follow-token?event=release",
+				} {
+					if !strings.Contains(sentBody, expected) {
+						t.Errorf("Expected %q in email body, but it was not found", expected)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"gcstatus/pkg/feeds"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderCalendar(t *testing.T) {
+	fixedTime := time.Date(2024, time.March, 5, 10, 30, 0, 0, time.UTC)
+
+	body := feeds.RenderCalendar("GCStatus releases", []feeds.Event{
+		{
+			UID:         "game-1@gcstatus.cloud",
+			Summary:     "Game; Test, Deluxe",
+			Description: "A very long description that must be folded because it goes way beyond the seventy five octets limit.",
+			URL:         "https://gcstatus.cloud/games/game-test",
+			Date:        fixedTime,
+			Stamp:       fixedTime,
+		},
+	})
+
+	for _, expected := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"X-WR-CALNAME:GCStatus releases\r\n",
+		"UID:game-1@gcstatus.cloud\r\n",
+		"DTSTAMP:20240305T103000Z\r\n",
+		"DTSTART;VALUE=DATE:20240305\r\n",
+		"DTEND;VALUE=DATE:20240306\r\n",
+		`SUMMARY:Game\; Test\, Deluxe` + "\r\n",
+		"URL:https://gcstatus.cloud/games/game-test\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		assert.Contains(t, body, expected)
+	}
+
+	for _, line := range strings.Split(body, "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+}
+
+func TestRenderSyndication(t *testing.T) {
+	fixedTime := time.Date(2024, time.March, 5, 10, 30, 0, 0, time.UTC)
+	feed := feeds.Feed{
+		Title:       "GCStatus new games",
+		Link:        "https://gcstatus.cloud/feeds/games",
+		Description: "Games recently added to GCStatus.",
+		Updated:     fixedTime,
+		Items: []feeds.Item{
+			{
+				ID:          "https://gcstatus.cloud/games/game-test",
+				Title:       "Game & Test",
+				Link:        "https://gcstatus.cloud/games/game-test",
+				Description: "Short description",
+				Published:   fixedTime,
+				Updated:     fixedTime,
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		render   func(feeds.Feed) (string, error)
+		expected []string
+	}{
+		"rss": {
+			render: feeds.RenderRSS,
+			expected: []string{
+				`<rss version="2.0">`,
+				"<title>Game &amp; Test</title>",
+				"<guid>https://gcstatus.cloud/games/game-test</guid>",
+				"<pubDate>Tue, 05 Mar 2024 10:30:00 +0000</pubDate>",
+			},
+		},
+		"atom": {
+			render: feeds.RenderAtom,
+			expected: []string{
+				`<feed xmlns="http://www.w3.org/2005/Atom">`,
+				"<title>Game &amp; Test</title>",
+				`<link href="https://gcstatus.cloud/games/game-test"></link>`,
+				"<updated>2024-03-05T10:30:00Z</updated>",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			body, err := tc.render(feed)
+
+			assert.NoError(t, err)
+			for _, expected := range tc.expected {
+				assert.Contains(t, body, expected)
+			}
+		})
+	}
+}
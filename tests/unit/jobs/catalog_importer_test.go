@@ -0,0 +1,304 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/jobs"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSteamApp struct {
+	kind string
+	dlcs []int
+}
+
+// newFakeSteamServer serves the app list and app details endpoints for the
+// given apps. Apps missing from the map answer as unavailable.
+func newFakeSteamServer(t *testing.T, appList []jobs.SteamApp, apps map[int]fakeSteamApp) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ISteamApps/GetAppList/v2/":
+			var entries []string
+			for _, app := range appList {
+				entries = append(entries, fmt.Sprintf(`{"appid":%d,"name":%q}`, app.AppID, app.Name))
+			}
+			fmt.Fprintf(w, `{"applist":{"apps":[%s]}}`, strings.Join(entries, ","))
+		case "/api/appdetails":
+			appID := r.URL.Query().Get("appids")
+			var id int
+			fmt.Sscanf(appID, "%d", &id)
+
+			app, ok := apps[id]
+			if !ok {
+				fmt.Fprintf(w, `{"%s":{"success":false}}`, appID)
+				return
+			}
+
+			dlcs := make([]string, 0, len(app.dlcs))
+			for _, dlc := range app.dlcs {
+				dlcs = append(dlcs, fmt.Sprint(dlc))
+			}
+			fmt.Fprintf(w, `{"%s":{"success":true,"data":{"type":%q,"name":"App %s","required_age":0,"dlc":[%s]}}}`, appID, app.kind, appID, strings.Join(dlcs, ","))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newFakeSteamClient(server *httptest.Server) *jobs.SteamClient {
+	client := jobs.NewSteamClient(server.Client(), server.URL, server.URL, 0)
+	client.ThrottleDelay = time.Millisecond
+	client.MaxRetries = 2
+
+	return client
+}
+
+type MockCatalogImportStore struct {
+	mu         sync.Mutex
+	checkpoint *domain.ImportCheckpoint
+	games      map[string]uint
+	dlcs       map[string]uint
+	failing    map[string]bool
+}
+
+func NewMockCatalogImportStore() *MockCatalogImportStore {
+	return &MockCatalogImportStore{
+		checkpoint: &domain.ImportCheckpoint{Source: jobs.SteamImportSource},
+		games:      make(map[string]uint),
+		dlcs:       make(map[string]uint),
+		failing:    make(map[string]bool),
+	}
+}
+
+func (m *MockCatalogImportStore) LoadCheckpoint(source string) (*domain.ImportCheckpoint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	checkpoint := *m.checkpoint
+	return &checkpoint, nil
+}
+
+func (m *MockCatalogImportStore) SaveCheckpoint(checkpoint *domain.ImportCheckpoint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	saved := *checkpoint
+	m.checkpoint = &saved
+	return nil
+}
+
+func (m *MockCatalogImportStore) UpsertGame(item *jobs.CatalogGame) (jobs.ImportOutcome, uint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	appID := item.Listing.ExternalID
+
+	if m.failing[appID] {
+		return jobs.ImportSkipped, 0, errors.New("db error")
+	}
+
+	if gameID, exists := m.games[appID]; exists {
+		return jobs.ImportUpdated, gameID, nil
+	}
+
+	m.games[appID] = uint(len(m.games) + 1)
+	return jobs.ImportCreated, m.games[appID], nil
+}
+
+func (m *MockCatalogImportStore) UpsertDLC(item *jobs.CatalogDLC, gameID uint) (jobs.ImportOutcome, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	appID := item.Listing.ExternalID
+
+	if _, exists := m.dlcs[appID]; exists {
+		return jobs.ImportUpdated, nil
+	}
+
+	m.dlcs[appID] = gameID
+	return jobs.ImportCreated, nil
+}
+
+func TestSteamClient_Throttling(t *testing.T) {
+	testCases := map[string]struct {
+		throttledResponses int32
+		status             int
+		expectedErr        error
+		expectedStatusErr  bool
+	}{
+		"retries after being throttled": {
+			throttledResponses: 2,
+			status:             http.StatusTooManyRequests,
+		},
+		"retries while steam is unavailable": {
+			throttledResponses: 1,
+			status:             http.StatusServiceUnavailable,
+		},
+		"gives up when throttled for too long": {
+			throttledResponses: 10,
+			status:             http.StatusTooManyRequests,
+			expectedErr:        jobs.ErrCatalogThrottled,
+		},
+		"fails on unexpected status": {
+			throttledResponses: 1,
+			status:             http.StatusForbidden,
+			expectedStatusErr:  true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&calls, 1) <= tc.throttledResponses {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(tc.status)
+					return
+				}
+				fmt.Fprint(w, `{"10":{"success":true,"data":{"type":"game","name":"Game Test"}}}`)
+			}))
+			defer server.Close()
+
+			details, err := newFakeSteamClient(server).AppDetails(context.Background(), 10, "us")
+
+			var statusErr *jobs.CatalogStatusError
+			switch {
+			case tc.expectedStatusErr:
+				assert.ErrorAs(t, err, &statusErr)
+				assert.Equal(t, tc.status, statusErr.StatusCode)
+			case tc.expectedErr != nil:
+				assert.ErrorIs(t, err, tc.expectedErr)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, "Game Test", details.Data.Name)
+				assert.True(t, details.IsGame())
+			}
+		})
+	}
+}
+
+func TestSteamClient_UnavailableApp(t *testing.T) {
+	server := newFakeSteamServer(t, nil, map[int]fakeSteamApp{})
+
+	_, err := newFakeSteamClient(server).AppDetails(context.Background(), 99, "us")
+
+	assert.ErrorIs(t, err, jobs.ErrCatalogItemUnavailable)
+}
+
+func TestCatalogImporter_Run(t *testing.T) {
+	appList := []jobs.SteamApp{
+		{AppID: 40, Name: "Soundtrack"},
+		{AppID: 10, Name: "Game A"},
+		{AppID: 30, Name: "Game B"},
+		{AppID: 10, Name: "Game A"},
+		{AppID: 50, Name: ""},
+		{AppID: 60, Name: "Removed"},
+		{AppID: 70, Name: "Broken"},
+	}
+	apps := map[int]fakeSteamApp{
+		10: {kind: "game", dlcs: []int{11}},
+		11: {kind: "dlc"},
+		30: {kind: "game"},
+		40: {kind: "music"},
+		70: {kind: "game"},
+	}
+
+	server := newFakeSteamServer(t, appList, apps)
+	store := NewMockCatalogImportStore()
+	store.failing["70"] = true
+
+	importer := jobs.NewCatalogImporter(jobs.NewSteamCatalogSource(newFakeSteamClient(server), "us"), store, 3)
+
+	summary, err := importer.Run(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, jobs.ImportSummary{Created: 2, Skipped: 2, Failed: 1}, summary)
+	assert.Equal(t, uint(70), store.checkpoint.LastAppID)
+	assert.NotNil(t, store.checkpoint.FinishedAt)
+	assert.Equal(t, uint(2), store.checkpoint.Created)
+	assert.Equal(t, store.games["10"], store.dlcs["11"])
+	assert.Equal(t, []uint{70}, store.checkpoint.FailedIDs())
+
+	// A finished import retries its failed apps besides the ones added
+	// after its checkpoint.
+	summary, err = importer.Run(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, jobs.ImportSummary{Failed: 1}, summary)
+	assert.Equal(t, []uint{70}, store.checkpoint.FailedIDs())
+	assert.Len(t, store.games, 2)
+
+	delete(store.failing, "70")
+	summary, err = importer.Run(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, jobs.ImportSummary{Created: 1}, summary)
+	assert.Equal(t, uint(70), store.checkpoint.LastAppID)
+	assert.Nil(t, store.checkpoint.FailedAppIDs)
+	assert.Len(t, store.games, 3)
+}
+
+func TestCatalogImporter_ResumesFromCheckpoint(t *testing.T) {
+	appList := []jobs.SteamApp{
+		{AppID: 10, Name: "Game A"},
+		{AppID: 20, Name: "Game B"},
+		{AppID: 30, Name: "Game C"},
+	}
+	apps := map[int]fakeSteamApp{
+		10: {kind: "game"},
+		20: {kind: "game"},
+		30: {kind: "game"},
+	}
+
+	server := newFakeSteamServer(t, appList, apps)
+	store := NewMockCatalogImportStore()
+	store.games["20"] = 7
+	store.checkpoint.LastAppID = 10
+	store.checkpoint.Created = 1
+
+	summary, err := jobs.NewCatalogImporter(jobs.NewSteamCatalogSource(newFakeSteamClient(server), "us"), store, 2).Run(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, jobs.ImportSummary{Created: 2, Updated: 1}, summary)
+	assert.NotContains(t, store.games, "10")
+	assert.Equal(t, uint(30), store.checkpoint.LastAppID)
+}
+
+func TestCatalogImporter_Cancelled(t *testing.T) {
+	server := newFakeSteamServer(t, []jobs.SteamApp{{AppID: 10, Name: "Game A"}}, map[int]fakeSteamApp{10: {kind: "game"}})
+	store := NewMockCatalogImportStore()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := jobs.NewCatalogImporter(jobs.NewSteamCatalogSource(newFakeSteamClient(server), "us"), store, 2).Run(ctx)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, store.checkpoint.FinishedAt)
+	assert.Zero(t, store.checkpoint.LastAppID)
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := jobs.NewRateLimiter(0)
+	limiter.Pause(time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+}
@@ -0,0 +1,259 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/jobs"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSteamSyncDetails(t *testing.T) *jobs.SteamAppDetails {
+	t.Helper()
+
+	var details jobs.SteamAppDetails
+	if err := json.Unmarshal(readFixture(t, "steam/app_10.json"), &details); err != nil {
+		t.Fatalf("failed to decode steam details: %+v", err)
+	}
+
+	return &details
+}
+
+// newSyncedGame builds a game already matching the steam/app_10.json fixture.
+func newSyncedGame() domain.Game {
+	website, legal := "https://game.test", "Legal"
+	supportURL, supportEmail := "https://support.game.test", "support@game.test"
+	obs := ""
+
+	return domain.Game{
+		ID:               1,
+		Slug:             "game-test",
+		Title:            "Game Test",
+		Age:              18,
+		Cover:            "https://cdn.steam/background.jpg",
+		About:            "About game",
+		Description:      "Description",
+		ShortDescription: "Short description",
+		Website:          &website,
+		Legal:            &legal,
+		ReleaseDate:      time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC),
+		Support:          &domain.GameSupport{ID: 1, URL: &supportURL, Email: &supportEmail, GameID: 1},
+		Galleries: []domain.Galleriable{
+			{ID: 1, Path: "https://cdn.steam/shot-1.jpg", MediaTypeID: domain.PhotoTypeID},
+			{ID: 2, Path: "https://cdn.steam/shot-2.jpg", MediaTypeID: domain.PhotoTypeID},
+			{ID: 3, Path: "https://cdn.steam/movie.mp4", MediaTypeID: domain.VideoTypeID},
+		},
+		Genres: []domain.Genreable{
+			{ID: 1, Genre: domain.Genre{Slug: "action"}},
+			{ID: 2, Genre: domain.Genre{Slug: "rpg"}},
+		},
+		Categories: []domain.Categoriable{
+			{ID: 1, Category: domain.Category{Slug: "single-player"}},
+		},
+		Developers: []domain.GameDeveloper{
+			{ID: 1, Developer: domain.Developer{Slug: "studio"}},
+		},
+		Publishers: []domain.GamePublisher{
+			{ID: 1, Publisher: domain.Publisher{Slug: "publisher"}},
+		},
+		Languages: []domain.GameLanguage{
+			{ID: 1, Dubs: true, Language: domain.Language{Name: "English"}},
+			{ID: 2, Dubs: false, Language: domain.Language{Name: "French"}},
+			{ID: 3, Dubs: true, Language: domain.Language{Name: "German"}},
+		},
+		Requirements: []domain.Requirement{
+			{
+				ID:              1,
+				OS:              "Windows 10",
+				RAM:             "8 GB RAM",
+				OBS:             &obs,
+				RequirementType: domain.RequirementType{Potential: "minimum", OS: "windows"},
+			},
+		},
+		DLCs: []domain.DLC{
+			{ID: 1, Stores: []domain.DLCStore{{StoreID: domain.SteamStoreID, StorDLCID: "11"}}},
+		},
+	}
+}
+
+func TestPlanCatalogSync_InSync(t *testing.T) {
+	plan := jobs.PlanCatalogSync(newSyncedGame(), jobs.NormalizeSteamApp(10, newSteamSyncDetails(t)), nil)
+
+	assert.True(t, plan.Empty())
+}
+
+func TestPlanCatalogSync(t *testing.T) {
+	testCases := map[string]struct {
+		change func(game *domain.Game)
+		locked map[string]bool
+		assert func(t *testing.T, plan jobs.CatalogSyncPlan)
+	}{
+		"updates changed fields": {
+			change: func(game *domain.Game) {
+				game.Title = "Old title"
+				game.Description = "Old description"
+			},
+			assert: func(t *testing.T, plan jobs.CatalogSyncPlan) {
+				assert.Equal(t, map[string]any{
+					domain.SyncFieldTitle:       "Game Test",
+					domain.SyncFieldDescription: "Description",
+				}, plan.Fields)
+			},
+		},
+		"keeps locked fields": {
+			change: func(game *domain.Game) {
+				game.Title = "Admin title"
+				game.Description = "Old description"
+			},
+			locked: map[string]bool{domain.SyncFieldTitle: true},
+			assert: func(t *testing.T, plan jobs.CatalogSyncPlan) {
+				assert.Equal(t, map[string]any{domain.SyncFieldDescription: "Description"}, plan.Fields)
+			},
+		},
+		"diffs galleries and keeps uploaded media": {
+			change: func(game *domain.Game) {
+				game.Galleries = []domain.Galleriable{
+					{ID: 1, Path: "https://cdn.steam/shot-1.jpg", MediaTypeID: domain.PhotoTypeID},
+					{ID: 4, Path: "https://cdn.steam/old.jpg", MediaTypeID: domain.PhotoTypeID},
+					{ID: 5, Path: "games/uploaded.jpg", S3: true, MediaTypeID: domain.PhotoTypeID},
+				}
+			},
+			assert: func(t *testing.T, plan jobs.CatalogSyncPlan) {
+				assert.Equal(t, []uint{4}, plan.RemoveGalleries)
+				assert.Len(t, plan.AddGalleries, 2)
+				assert.Equal(t, "https://cdn.steam/shot-2.jpg", plan.AddGalleries[0].Path)
+				assert.Equal(t, uint(domain.VideoTypeID), plan.AddGalleries[1].MediaTypeID)
+			},
+		},
+		"diffs genres, categories and companies by slug": {
+			change: func(game *domain.Game) {
+				game.Genres = []domain.Genreable{
+					{ID: 1, Genre: domain.Genre{Slug: "action"}},
+					{ID: 3, Genre: domain.Genre{Slug: "indie"}},
+					{ID: 4, Genre: domain.Genre{Slug: "action"}},
+				}
+				game.Categories = nil
+				game.Developers = []domain.GameDeveloper{{ID: 2, Developer: domain.Developer{Slug: "old-studio"}}}
+			},
+			assert: func(t *testing.T, plan jobs.CatalogSyncPlan) {
+				assert.Equal(t, []string{"RPG"}, plan.AddGenres)
+				assert.Equal(t, []uint{3, 4}, plan.RemoveGenres)
+				assert.Equal(t, []string{"Single-player"}, plan.AddCategories)
+				assert.Equal(t, []string{"Studio"}, plan.AddDevelopers)
+				assert.Equal(t, []uint{2}, plan.RemoveDevelopers)
+				assert.Empty(t, plan.AddPublishers)
+				assert.Empty(t, plan.RemovePublishers)
+			},
+		},
+		"keeps locked relations": {
+			change: func(game *domain.Game) {
+				game.Genres = []domain.Genreable{{ID: 3, Genre: domain.Genre{Slug: "indie"}}}
+				game.Galleries = nil
+			},
+			locked: map[string]bool{domain.SyncFieldGenres: true, domain.SyncFieldGalleries: true},
+			assert: func(t *testing.T, plan jobs.CatalogSyncPlan) {
+				assert.True(t, plan.Empty())
+			},
+		},
+		"diffs languages": {
+			change: func(game *domain.Game) {
+				game.Languages = []domain.GameLanguage{
+					{ID: 1, Dubs: false, Language: domain.Language{Name: "English"}},
+					{ID: 4, Dubs: false, Language: domain.Language{Name: "Spanish"}},
+				}
+			},
+			assert: func(t *testing.T, plan jobs.CatalogSyncPlan) {
+				assert.Equal(t, map[uint]bool{1: true}, plan.UpdateLanguages)
+				assert.Equal(t, []uint{4}, plan.RemoveLanguages)
+				assert.Equal(t, []jobs.CatalogLanguage{{Name: "French"}, {Name: "German", Dubs: true}}, plan.AddLanguages)
+			},
+		},
+		"diffs requirements": {
+			change: func(game *domain.Game) {
+				game.Requirements = []domain.Requirement{
+					{ID: 1, OS: "Windows 7", RequirementType: domain.RequirementType{Potential: "minimum", OS: "windows"}},
+					{ID: 2, OS: "Ubuntu", RequirementType: domain.RequirementType{Potential: "minimum", OS: "linux"}},
+				}
+			},
+			assert: func(t *testing.T, plan jobs.CatalogSyncPlan) {
+				assert.Len(t, plan.UpdateRequirements, 1)
+				assert.Equal(t, "Windows 10", plan.UpdateRequirements[1].OS)
+				assert.Equal(t, []uint{2}, plan.RemoveRequirements)
+				assert.Empty(t, plan.AddRequirements)
+			},
+		},
+		"removes unlisted steam dlcs only": {
+			change: func(game *domain.Game) {
+				game.DLCs = append(game.DLCs,
+					domain.DLC{ID: 2, Stores: []domain.DLCStore{{StoreID: domain.SteamStoreID, StorDLCID: "12"}}},
+					domain.DLC{ID: 3, Stores: []domain.DLCStore{{StoreID: 99, StorDLCID: "12"}}},
+				)
+			},
+			assert: func(t *testing.T, plan jobs.CatalogSyncPlan) {
+				assert.Equal(t, []uint{2}, plan.RemoveDLCs)
+			},
+		},
+		"updates support": {
+			change: func(game *domain.Game) {
+				oldEmail := "old@game.test"
+				game.Support.Email = &oldEmail
+			},
+			assert: func(t *testing.T, plan jobs.CatalogSyncPlan) {
+				assert.NotNil(t, plan.Support)
+				assert.Equal(t, uint(1), plan.Support.ID)
+				assert.Equal(t, "support@game.test", *plan.Support.Email)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			game := newSyncedGame()
+			tc.change(&game)
+
+			plan := jobs.PlanCatalogSync(game, jobs.NormalizeSteamApp(10, newSteamSyncDetails(t)), tc.locked)
+
+			tc.assert(t, plan)
+		})
+	}
+}
+
+func TestParseSteamLanguages(t *testing.T) {
+	languages := jobs.ParseSteamLanguages(newSteamSyncDetails(t).Data.SupportedLanguages)
+
+	assert.Equal(t, []jobs.CatalogLanguage{
+		{Name: "English", Dubs: true},
+		{Name: "French"},
+		{Name: "German", Dubs: true},
+	}, languages)
+}
+
+func TestCatalogImporter_NotifiesNewDLCsOfImportedGames(t *testing.T) {
+	apps := map[int]fakeSteamApp{
+		10: {kind: "game", dlcs: []int{11, 12}},
+		11: {kind: "dlc"},
+		12: {kind: "dlc"},
+	}
+
+	server := newFakeSteamServer(t, nil, apps)
+	store := NewMockCatalogImportStore()
+	store.games["10"] = 7
+	store.dlcs["11"] = 7
+
+	importer := jobs.NewCatalogImporter(jobs.NewSteamCatalogSource(newFakeSteamClient(server), "us"), store, 1)
+
+	var added []string
+	importer.DLCAdded = func(gameID uint, dlc *jobs.CatalogDLC) {
+		assert.Equal(t, uint(7), gameID)
+		added = append(added, dlc.Name)
+	}
+
+	outcome, err := importer.ImportItem(context.Background(), 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, jobs.ImportUpdated, outcome)
+	assert.Equal(t, []string{"App 12"}, added)
+}
@@ -0,0 +1,211 @@
+package tests
+
+import (
+	"context"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/jobs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %+v", name, err)
+	}
+
+	return data
+}
+
+// newFakeGOGServer serves the recorded GOG responses under testdata/gog.
+// Products without a fixture answer as not found.
+func newFakeGOGServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var fixture string
+		switch {
+		case r.URL.Path == "/v1/catalog":
+			fixture = "catalog_page_" + r.URL.Query().Get("page") + ".json"
+		case strings.HasSuffix(r.URL.Path, "/prices"):
+			fixture = "prices_" + strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/products/"), "/prices") + ".json"
+		case strings.HasPrefix(r.URL.Path, "/products/"):
+			fixture = "product_" + strings.TrimPrefix(r.URL.Path, "/products/") + ".json"
+		}
+
+		data, err := os.ReadFile(filepath.Join("testdata", "gog", fixture))
+		if fixture == "" || err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newFakeGOGSource(t *testing.T) *jobs.GOGCatalogSource {
+	t.Helper()
+
+	server := newFakeGOGServer(t)
+	client := jobs.NewGOGClient(server.Client(), server.URL, server.URL, 0)
+	client.ThrottleDelay = time.Millisecond
+	client.MaxRetries = 2
+
+	return jobs.NewGOGCatalogSource(client, "US")
+}
+
+func TestGOGCatalogSource_List(t *testing.T) {
+	entries, err := newFakeGOGSource(t).List(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []jobs.CatalogEntry{
+		{ID: 1207658924, Name: "Game Test"},
+		{ID: 1207658930, Name: "Another Game"},
+	}, entries)
+}
+
+func TestGOGCatalogSource_Fetch(t *testing.T) {
+	source := newFakeGOGSource(t)
+
+	_, err := source.List(context.Background())
+	assert.NoError(t, err)
+
+	item, err := source.Fetch(context.Background(), 1207658924)
+
+	assert.NoError(t, err)
+	assert.Equal(t, jobs.GOGImportSource, item.Source)
+	assert.Equal(t, jobs.CatalogListing{
+		StoreID:    domain.GOGStoreID,
+		ExternalID: "1207658924",
+		URL:        "https://www.gog.com/en/game/game_test",
+		Price:      jobs.CatalogPrice{Currency: "USD", Initial: 1999, Final: 999},
+	}, item.Listing)
+	assert.Equal(t, "Game Test", item.Title)
+	assert.Equal(t, "Description", item.Description)
+	assert.Equal(t, "Short description", item.ShortDescription)
+	assert.Equal(t, "https://images.gog-statics.com/background.jpg", item.Cover)
+	assert.False(t, item.Free)
+	assert.Equal(t, time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC), item.ReleaseDate.UTC())
+	assert.Equal(t, &jobs.CatalogSupport{URL: "https://www.gog.com/support/game_test"}, item.Support)
+	assert.Equal(t, []jobs.CatalogMedia{
+		{Path: "https://images.gog-statics.com/shot-1_ggvgl_2x.jpg", MediaTypeID: domain.PhotoTypeID},
+		{Path: "https://www.youtube.com/embed/trailer", MediaTypeID: domain.VideoTypeID},
+	}, item.Media)
+	assert.Equal(t, []string{"Action", "Role-playing"}, item.Genres)
+	assert.Equal(t, []string{"Studio"}, item.Developers)
+	assert.Equal(t, []string{"Publisher"}, item.Publishers)
+	assert.Equal(t, []jobs.CatalogLanguage{{Name: "Deutsch"}, {Name: "English"}}, item.Languages)
+	assert.Nil(t, item.Categories)
+	assert.Nil(t, item.Requirements)
+	assert.Nil(t, item.Website)
+	assert.Equal(t, []string{"1207658925"}, item.ListedDLCs)
+
+	assert.Len(t, item.DLCs, 1)
+	assert.Equal(t, "Game Test - Expansion", item.DLCs[0].Name)
+	assert.Equal(t, "1207658925", item.DLCs[0].Listing.ExternalID)
+	assert.Equal(t, "https://images.gog-statics.com/expansion.jpg", item.DLCs[0].Cover)
+	assert.True(t, item.DLCs[0].Free)
+}
+
+func TestGOGCatalogSource_FetchWithoutListing(t *testing.T) {
+	item, err := newFakeGOGSource(t).Fetch(context.Background(), 1207658924)
+
+	assert.NoError(t, err)
+	assert.Nil(t, item.Genres)
+	assert.Nil(t, item.Developers)
+	assert.Nil(t, item.Publishers)
+}
+
+func TestGOGCatalogSource_FetchErrors(t *testing.T) {
+	testCases := map[string]struct {
+		id          uint
+		expectedErr error
+	}{
+		"skips products that are not games": {
+			id:          1207658940,
+			expectedErr: jobs.ErrCatalogItemSkipped,
+		},
+		"reports removed products as unavailable": {
+			id:          1207658999,
+			expectedErr: jobs.ErrCatalogItemUnavailable,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := newFakeGOGSource(t).Fetch(context.Background(), tc.id)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMatchCatalogGame(t *testing.T) {
+	steamGame := domain.Game{
+		Slug:        "game-test",
+		ReleaseDate: time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC),
+		Stores:      []domain.GameStore{{StoreID: domain.SteamStoreID, StoreGameID: "10"}},
+	}
+
+	testCases := map[string]struct {
+		game     domain.Game
+		change   func(item *jobs.CatalogGame)
+		expected bool
+	}{
+		"matches the same game from another store": {
+			game:     steamGame,
+			expected: true,
+		},
+		"matches games without a release date": {
+			game: steamGame,
+			change: func(item *jobs.CatalogGame) {
+				item.ReleaseDate = time.Time{}
+			},
+			expected: true,
+		},
+		"does not match another title": {
+			game: steamGame,
+			change: func(item *jobs.CatalogGame) {
+				item.Title = "Game Test 2"
+			},
+		},
+		"does not match games released years apart": {
+			game: steamGame,
+			change: func(item *jobs.CatalogGame) {
+				item.ReleaseDate = time.Date(2004, time.March, 1, 0, 0, 0, 0, time.UTC)
+			},
+		},
+		"does not match games already listed on the store": {
+			game: domain.Game{
+				Slug:   "game-test",
+				Stores: []domain.GameStore{{StoreID: domain.GOGStoreID, StoreGameID: "1"}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			item, err := newFakeGOGSource(t).Fetch(context.Background(), 1207658924)
+			assert.NoError(t, err)
+
+			if tc.change != nil {
+				tc.change(item)
+			}
+
+			assert.Equal(t, tc.expected, jobs.MatchCatalogGame(tc.game, item))
+		})
+	}
+}
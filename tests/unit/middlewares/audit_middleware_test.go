@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"errors"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/middlewares"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockAuditService struct {
+	auditLogs []domain.AuditLog
+	err       error
+}
+
+func (m *MockAuditService) Record(auditLog *domain.AuditLog) error {
+	m.auditLogs = append(m.auditLogs, *auditLog)
+	return m.err
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	targetID := uint(7)
+	createdID := uint(3)
+
+	testCases := map[string]struct {
+		method          string
+		path            string
+		handler         gin.HandlerFunc
+		expectedLogs    int
+		expectedAction  string
+		expectedTarget  *uint
+		expectedStatus  int
+		expectedChanges string
+	}{
+		"reads are not audited": {
+			method: http.MethodGet,
+			path:   "/admin/games/7",
+			handler: func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"data": gin.H{"id": 7}})
+			},
+			expectedLogs: 0,
+		},
+		"update records the changed fields": {
+			method: http.MethodPut,
+			path:   "/admin/games/7",
+			handler: func(c *gin.Context) {
+				middlewares.SetAuditBefore(c, gin.H{"id": 7, "title": "Old"})
+				c.JSON(http.StatusOK, gin.H{"data": gin.H{"id": 7, "title": "New"}})
+			},
+			expectedLogs:    1,
+			expectedAction:  "PUT /admin/games/:id",
+			expectedTarget:  &targetID,
+			expectedStatus:  http.StatusOK,
+			expectedChanges: `{"title":{"before":"Old","after":"New"}}`,
+		},
+		"create takes the target from the response": {
+			method: http.MethodPost,
+			path:   "/admin/games",
+			handler: func(c *gin.Context) {
+				c.JSON(http.StatusCreated, gin.H{"data": gin.H{"id": 3}})
+			},
+			expectedLogs:    1,
+			expectedAction:  "POST /admin/games",
+			expectedTarget:  &createdID,
+			expectedStatus:  http.StatusCreated,
+			expectedChanges: `{"id":{"before":null,"after":3}}`,
+		},
+		"failed mutations are audited without changes": {
+			method: http.MethodDelete,
+			path:   "/admin/games/7",
+			handler: func(c *gin.Context) {
+				c.JSON(http.StatusNotFound, gin.H{"message": "The game could not be found."})
+			},
+			expectedLogs:   1,
+			expectedAction: "DELETE /admin/games/:id",
+			expectedTarget: &targetID,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			auditService := &MockAuditService{}
+
+			r := gin.New()
+			r.Use(func(c *gin.Context) {
+				c.Set("user_id", &domain.User{ID: 1})
+			})
+			r.Use(middlewares.AuditMiddleware(auditService))
+			r.Handle(tc.method, "/admin/games", tc.handler)
+			r.Handle(tc.method, "/admin/games/:id", tc.handler)
+
+			req, _ := http.NewRequest(tc.method, tc.path, strings.NewReader(""))
+			req.Header.Set("User-Agent", "tests")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Len(t, auditService.auditLogs, tc.expectedLogs)
+			if tc.expectedLogs == 0 {
+				return
+			}
+
+			auditLog := auditService.auditLogs[0]
+			assert.Equal(t, tc.expectedAction, auditLog.Action)
+			assert.Equal(t, "games", auditLog.TargetType)
+			assert.Equal(t, tc.expectedTarget, auditLog.TargetID)
+			assert.Equal(t, tc.expectedStatus, auditLog.Status)
+			assert.Equal(t, uint(1), *auditLog.UserID)
+			assert.Equal(t, "tests", auditLog.UserAgent)
+			if tc.expectedChanges == "" {
+				assert.Nil(t, auditLog.Changes)
+			} else {
+				assert.JSONEq(t, tc.expectedChanges, *auditLog.Changes)
+			}
+		})
+	}
+}
+
+func TestAuditMiddleware_RecordFailureKeepsResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	auditService := &MockAuditService{err: errors.New("database is down")}
+
+	r := gin.New()
+	r.Use(middlewares.AuditMiddleware(auditService))
+	r.POST("/admin/games", func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"data": gin.H{"id": 3}})
+	})
+
+	req, _ := http.NewRequest(http.MethodPost, "/admin/games", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, `{"data":{"id":3}}`, w.Body.String())
+	assert.Nil(t, auditService.auditLogs[0].UserID)
+}
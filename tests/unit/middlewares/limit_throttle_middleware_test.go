@@ -22,6 +22,9 @@ type MockCache struct {
 	GetUserFromCacheFunc       func(userID uint) (*domain.User, bool)
 	SetUserInCacheFunc         func(user *domain.User)
 	RemoveUserFromCacheFunc    func(userID uint)
+	GetUserScopesFunc          func(userID uint) ([]string, bool)
+	SetUserScopesFunc          func(userID uint, scopes []string)
+	RemoveUserScopesFunc       func(userIDs ...uint)
 }
 
 func (m *MockCache) AddThrottleCache(key string) (int64, error) {
@@ -52,6 +55,25 @@ func (m *MockCache) SetUserInCache(user *domain.User) {}
 
 func (m *MockCache) RemoveUserFromCache(userID uint) {}
 
+func (m *MockCache) GetUserScopesFromCache(userID uint) ([]string, bool) {
+	if m.GetUserScopesFunc == nil {
+		return nil, false
+	}
+	return m.GetUserScopesFunc(userID)
+}
+
+func (m *MockCache) SetUserScopesInCache(userID uint, scopes []string) {
+	if m.SetUserScopesFunc != nil {
+		m.SetUserScopesFunc(userID, scopes)
+	}
+}
+
+func (m *MockCache) RemoveUserScopesFromCache(userIDs ...uint) {
+	if m.RemoveUserScopesFunc != nil {
+		m.RemoveUserScopesFunc(userIDs...)
+	}
+}
+
 func TestLimitThrottleMiddleware(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -0,0 +1,153 @@
+package tests
+
+import (
+	"errors"
+	"gcstatus/config"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/middlewares"
+	"gcstatus/internal/utils"
+	"gcstatus/pkg/cache"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockPermissionUserService struct {
+	user         *domain.User
+	adminFetches int
+}
+
+func (m *MockPermissionUserService) GetUserByID(userID uint) (*domain.User, error) {
+	if m.user == nil || m.user.ID != userID {
+		return nil, errors.New("user not found")
+	}
+	return m.user, nil
+}
+
+func (m *MockPermissionUserService) GetUserByIDForAdmin(userID uint) (*domain.User, error) {
+	m.adminFetches++
+	return m.GetUserByID(userID)
+}
+
+func authenticatedRequest(t *testing.T, userID uint) *http.Request {
+	env := config.LoadConfig()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": userID,
+		"iat":     time.Now().Unix(),
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(env.JwtSecret))
+	if err != nil {
+		t.Fatalf("failed to sign the token: %s", err.Error())
+	}
+
+	encryptedToken, err := utils.Encrypt(token, env.JwtSecret)
+	if err != nil {
+		t.Fatalf("failed to encrypt the token: %s", err.Error())
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "/admin", nil)
+	req.AddCookie(&http.Cookie{Name: env.AccessTokenKey, Value: encryptedToken})
+
+	return req
+}
+
+func TestPermissionMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	scopedRole := domain.Role{
+		ID:   2,
+		Name: "Editor",
+		Permissions: []domain.Permissionable{
+			{Permission: domain.Permission{Scope: "view:games"}},
+		},
+	}
+
+	testCases := map[string]struct {
+		user           *domain.User
+		cachedScopes   []string
+		requiredScopes []string
+		expectedStatus int
+		expectedCached []string
+		expectedFetch  int
+	}{
+		"superuser role grants every scope": {
+			user:           &domain.User{ID: 1, Roles: []domain.Roleable{{Role: domain.Role{Name: "Owner"}}}},
+			requiredScopes: []string{"delete:games"},
+			expectedStatus: http.StatusOK,
+			expectedCached: []string{middlewares.SuperuserScope},
+			expectedFetch:  1,
+		},
+		"role permission grants the scope": {
+			user:           &domain.User{ID: 1, Roles: []domain.Roleable{{Role: scopedRole}}},
+			requiredScopes: []string{"view:games"},
+			expectedStatus: http.StatusOK,
+			expectedCached: []string{"view:games"},
+			expectedFetch:  1,
+		},
+		"missing scope is forbidden": {
+			user:           &domain.User{ID: 1, Roles: []domain.Roleable{{Role: scopedRole}}},
+			requiredScopes: []string{"view:games", "update:games"},
+			expectedStatus: http.StatusForbidden,
+			expectedCached: []string{"view:games"},
+			expectedFetch:  1,
+		},
+		"cached scopes skip the permission lookup": {
+			user:           &domain.User{ID: 1},
+			cachedScopes:   []string{"update:games"},
+			requiredScopes: []string{"update:games"},
+			expectedStatus: http.StatusOK,
+			expectedFetch:  0,
+		},
+		"unauthenticated user": {
+			requiredScopes: []string{"view:games"},
+			expectedStatus: http.StatusUnauthorized,
+			expectedFetch:  0,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var cached []string
+			cache.GlobalCache = &MockCache{
+				GetUserScopesFunc: func(userID uint) ([]string, bool) {
+					return tc.cachedScopes, tc.cachedScopes != nil
+				},
+				SetUserScopesFunc: func(userID uint, scopes []string) {
+					cached = scopes
+				},
+			}
+
+			userService := &MockPermissionUserService{user: tc.user}
+			catalog := middlewares.NewScopeCatalog()
+			permissionMiddleware := middlewares.NewPermissionMiddleware(userService, catalog, "Owner")
+
+			r := gin.New()
+			r.GET("/admin", permissionMiddleware(tc.requiredScopes...), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, authenticatedRequest(t, 1))
+
+			assert.Equal(t, tc.expectedStatus, w.Code)
+			assert.Equal(t, tc.expectedCached, cached)
+			assert.Equal(t, tc.expectedFetch, userService.adminFetches)
+			assert.ElementsMatch(t, tc.requiredScopes, catalog.Scopes())
+		})
+	}
+}
+
+func TestScopeCatalog(t *testing.T) {
+	catalog := middlewares.NewScopeCatalog()
+
+	catalog.Add("view:users", "block:users")
+	catalog.Add("view:users", "view:games")
+
+	assert.Equal(t, []string{"block:users", "view:games", "view:users"}, catalog.Scopes())
+}
@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockAdminActionPolicyRepository struct {
+	policies map[uint]*domain.ActionPolicy
+}
+
+func (m *MockAdminActionPolicyRepository) GetAll() ([]domain.ActionPolicy, error) {
+	var policies []domain.ActionPolicy
+	for _, policy := range m.policies {
+		policies = append(policies, *policy)
+	}
+	return policies, nil
+}
+
+func (m *MockAdminActionPolicyRepository) FindByID(id uint) (domain.ActionPolicy, error) {
+	policy, exists := m.policies[id]
+	if !exists {
+		return domain.ActionPolicy{}, gorm.ErrRecordNotFound
+	}
+	return *policy, nil
+}
+
+func (m *MockAdminActionPolicyRepository) ExistsByActionKey(actionKey string, exceptID uint) (bool, error) {
+	for id, policy := range m.policies {
+		if policy.ActionKey == actionKey && id != exceptID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockAdminActionPolicyRepository) Create(policy *domain.ActionPolicy) error {
+	policy.ID = uint(len(m.policies) + 1)
+	m.policies[policy.ID] = policy
+	return nil
+}
+
+func (m *MockAdminActionPolicyRepository) Update(policy *domain.ActionPolicy) error {
+	m.policies[policy.ID] = policy
+	return nil
+}
+
+func (m *MockAdminActionPolicyRepository) Delete(id uint) error {
+	delete(m.policies, id)
+	return nil
+}
+
+func (m *MockAdminActionPolicyRepository) GetDecisions(filters ports_admin.ActionDecisionFilters, offset int, limit int) ([]domain.ActionDecision, int64, error) {
+	return nil, 0, nil
+}
+
+func TestAdminActionPolicyService_Create(t *testing.T) {
+	disabled := false
+
+	testCases := map[string]struct {
+		request         ports_admin.ActionPolicyRequest
+		expectedEnabled bool
+		expectedErr     error
+	}{
+		"creates an enabled policy": {
+			request: ports_admin.ActionPolicyRequest{
+				ActionKey:         domain.CommentGameRequirementKey,
+				CooldownSeconds:   30,
+				MaxPerDay:         20,
+				DiminishAfter:     5,
+				DiminishingFactor: 0.5,
+			},
+			expectedEnabled: true,
+		},
+		"creates a disabled policy": {
+			request: ports_admin.ActionPolicyRequest{
+				ActionKey:     domain.HeartGameRequirementKey,
+				OncePerTarget: true,
+				Enabled:       &disabled,
+			},
+		},
+		"action key not tracked": {
+			request: ports_admin.ActionPolicyRequest{
+				ActionKey: "unknown_action",
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, `The action key "unknown_action" is not tracked.`),
+		},
+		"policy already exists": {
+			request: ports_admin.ActionPolicyRequest{
+				ActionKey: domain.LoginRequirementKey,
+			},
+			expectedErr: errors.NewHttpError(http.StatusConflict, "The policy for this action key already exists."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := &MockAdminActionPolicyRepository{policies: map[uint]*domain.ActionPolicy{
+				1: {ID: 1, ActionKey: domain.LoginRequirementKey, Enabled: true},
+			}}
+			service := usecases_admin.NewAdminActionPolicyService(mockRepo)
+
+			policy, err := service.Create(tc.request)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.request.ActionKey, policy.ActionKey)
+				assert.Equal(t, tc.expectedEnabled, policy.Enabled)
+				assert.NotZero(t, policy.ID)
+			}
+		})
+	}
+}
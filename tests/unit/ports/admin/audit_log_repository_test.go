@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type MockAdminAuditLogRepository struct {
+	auditLogs    []domain.AuditLog
+	offset       int
+	limit        int
+	deletedUntil *time.Time
+}
+
+func (m *MockAdminAuditLogRepository) Create(auditLog *domain.AuditLog) error {
+	auditLog.ID = uint(len(m.auditLogs) + 1)
+	m.auditLogs = append(m.auditLogs, *auditLog)
+	return nil
+}
+
+func (m *MockAdminAuditLogRepository) GetAll(filters ports_admin.AuditLogFilters, offset int, limit int) ([]domain.AuditLog, int64, error) {
+	m.offset = offset
+	m.limit = limit
+	return m.auditLogs, int64(len(m.auditLogs)), nil
+}
+
+func (m *MockAdminAuditLogRepository) DeleteOlderThan(before time.Time) (int64, error) {
+	m.deletedUntil = &before
+	return 1, nil
+}
+
+func TestAdminAuditLogService_GetAll(t *testing.T) {
+	testCases := map[string]struct {
+		filters         ports_admin.AuditLogFilters
+		expectedPage    int
+		expectedPerPage int
+		expectedOffset  int
+	}{
+		"defaults to the first page": {
+			expectedPage:    1,
+			expectedPerPage: 25,
+			expectedOffset:  0,
+		},
+		"requested page": {
+			filters:         ports_admin.AuditLogFilters{Page: 3, PerPage: 10},
+			expectedPage:    3,
+			expectedPerPage: 10,
+			expectedOffset:  20,
+		},
+		"page size is capped": {
+			filters:         ports_admin.AuditLogFilters{Page: 2, PerPage: 1000},
+			expectedPage:    2,
+			expectedPerPage: 100,
+			expectedOffset:  100,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := &MockAdminAuditLogRepository{}
+			service := usecases_admin.NewAdminAuditLogService(mockRepo)
+
+			_, page, _, err := service.GetAll(tc.filters)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedPage, page.Page)
+			assert.Equal(t, tc.expectedPerPage, page.PerPage)
+			assert.Equal(t, tc.expectedOffset, mockRepo.offset)
+			assert.Equal(t, tc.expectedPerPage, mockRepo.limit)
+		})
+	}
+}
+
+func TestAdminAuditLogService_Prune(t *testing.T) {
+	now := time.Now()
+
+	testCases := map[string]struct {
+		retentionDays int
+		expectedUntil *time.Time
+	}{
+		"prunes past the retention": {
+			retentionDays: 30,
+			expectedUntil: func() *time.Time { until := now.AddDate(0, 0, -30); return &until }(),
+		},
+		"zero retention keeps every log": {
+			retentionDays: 0,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := &MockAdminAuditLogRepository{}
+			service := usecases_admin.NewAdminAuditLogService(mockRepo)
+
+			_, err := service.Prune(tc.retentionDays, now)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedUntil, mockRepo.deletedUntil)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockAdminCheckInRewardRepository struct {
+	checkInRewards map[uint]*domain.CheckInReward
+}
+
+func (m *MockAdminCheckInRewardRepository) GetAll() ([]domain.CheckInReward, error) {
+	var checkInRewards []domain.CheckInReward
+	for _, checkInReward := range m.checkInRewards {
+		checkInRewards = append(checkInRewards, *checkInReward)
+	}
+	return checkInRewards, nil
+}
+
+func (m *MockAdminCheckInRewardRepository) FindByID(id uint) (domain.CheckInReward, error) {
+	checkInReward, exists := m.checkInRewards[id]
+	if !exists {
+		return domain.CheckInReward{}, gorm.ErrRecordNotFound
+	}
+	return *checkInReward, nil
+}
+
+func (m *MockAdminCheckInRewardRepository) ExistsByStreak(streak uint, milestone bool, exceptID uint) (bool, error) {
+	for _, checkInReward := range m.checkInRewards {
+		if checkInReward.Streak == streak && checkInReward.Milestone == milestone && checkInReward.ID != exceptID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockAdminCheckInRewardRepository) Create(checkInReward *domain.CheckInReward) error {
+	checkInReward.ID = uint(len(m.checkInRewards) + 1)
+	m.checkInRewards[checkInReward.ID] = checkInReward
+	return nil
+}
+
+func (m *MockAdminCheckInRewardRepository) Update(checkInReward *domain.CheckInReward) error {
+	m.checkInRewards[checkInReward.ID] = checkInReward
+	return nil
+}
+
+func (m *MockAdminCheckInRewardRepository) Delete(id uint) error {
+	delete(m.checkInRewards, id)
+	return nil
+}
+
+func TestAdminCheckInRewardService_Create(t *testing.T) {
+	testCases := map[string]struct {
+		request     ports_admin.CheckInRewardRequest
+		expectedErr error
+	}{
+		"creates a tier": {
+			request: ports_admin.CheckInRewardRequest{Streak: 3, Coins: 20, Experience: 10},
+		},
+		"creates a milestone on the streak of a tier": {
+			request: ports_admin.CheckInRewardRequest{
+				Streak:    1,
+				Milestone: true,
+				Rewards:   []ports_admin.RewardRequest{{RewardableType: domain.RewardableTypeTitles, RewardableID: 4}},
+			},
+		},
+		"streak already taken": {
+			request:     ports_admin.CheckInRewardRequest{Streak: 1, Coins: 5},
+			expectedErr: errors.NewHttpError(http.StatusConflict, "The check-in reward for this streak already exists."),
+		},
+		"gives nothing": {
+			request:     ports_admin.CheckInRewardRequest{Streak: 5},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "The check-in reward must give coins, experience or titles."),
+		},
+		"invalid reward type": {
+			request: ports_admin.CheckInRewardRequest{
+				Streak:  7,
+				Rewards: []ports_admin.RewardRequest{{RewardableType: "badges", RewardableID: 1}},
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, `The reward type "badges" is not valid.`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := &MockAdminCheckInRewardRepository{checkInRewards: map[uint]*domain.CheckInReward{
+				1: {ID: 1, Streak: 1, Coins: 10},
+			}}
+			service := usecases_admin.NewAdminCheckInRewardService(mockRepo)
+
+			checkInReward, err := service.Create(tc.request)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.request.Streak, checkInReward.Streak)
+				assert.Equal(t, tc.request.Milestone, checkInReward.Milestone)
+				assert.Len(t, checkInReward.Rewards, len(tc.request.Rewards))
+			}
+		})
+	}
+}
@@ -3,8 +3,15 @@ package tests
 import (
 	"errors"
 	"gcstatus/internal/domain"
+	self_errors "gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 )
 
 type MockGameRepository struct {
@@ -171,3 +178,154 @@ func TestMockGameRepository_FindByID(t *testing.T) {
 		})
 	}
 }
+
+type MockAdminGameRepository struct {
+	games map[uint]*domain.Game
+}
+
+func NewMockAdminGameRepository() *MockAdminGameRepository {
+	return &MockAdminGameRepository{
+		games: make(map[uint]*domain.Game),
+	}
+}
+
+func (m *MockAdminGameRepository) GetAll() ([]domain.Game, error) {
+	var games []domain.Game
+	for _, game := range m.games {
+		games = append(games, *game)
+	}
+	return games, nil
+}
+
+func (m *MockAdminGameRepository) FindByID(id uint) (domain.Game, error) {
+	game, exists := m.games[id]
+	if !exists {
+		return domain.Game{}, gorm.ErrRecordNotFound
+	}
+	return *game, nil
+}
+
+func (m *MockAdminGameRepository) UpdateCrack(gameID uint, request ports_admin.UpdateCrackRequest) (*domain.Crack, *domain.CrackStatusChange, error) {
+	return nil, nil, nil
+}
+
+func (m *MockAdminGameRepository) GetSyncLocks(gameID uint) ([]domain.GameSyncLock, error) {
+	return nil, nil
+}
+
+func (m *MockAdminGameRepository) UpdateSyncLocks(gameID uint, fields []string) ([]domain.GameSyncLock, error) {
+	return nil, nil
+}
+
+func (m *MockAdminGameRepository) Create(game *domain.Game, crack *ports_admin.UpdateCrackRequest) (*domain.CrackStatusChange, error) {
+	game.ID = uint(len(m.games) + 1)
+	m.games[game.ID] = game
+	return nil, nil
+}
+
+func (m *MockAdminGameRepository) Update(game *domain.Game, crack *ports_admin.UpdateCrackRequest) (*domain.CrackStatusChange, error) {
+	if _, exists := m.games[game.ID]; !exists {
+		return nil, gorm.ErrRecordNotFound
+	}
+	m.games[game.ID] = game
+	return nil, nil
+}
+
+func (m *MockAdminGameRepository) Delete(id uint) error {
+	if _, exists := m.games[id]; !exists {
+		return gorm.ErrRecordNotFound
+	}
+	delete(m.games, id)
+	return nil
+}
+
+func validUpsertGameRequest() ports_admin.UpsertGameRequest {
+	return ports_admin.UpsertGameRequest{
+		Title:            "Game Test",
+		Age:              18,
+		Cover:            "https://placehold.co/600x400/EEE/31343C",
+		About:            "About game",
+		Description:      "Description",
+		ShortDescription: "Short description",
+		ReleaseDate:      time.Now(),
+		GenreIDs:         []uint{1, 2},
+		Stores: []ports_admin.GameStoreRequest{
+			{StoreID: 1, StoreGameID: "10", URL: "https://store.steampowered.com/app/10", Price: 1999},
+		},
+	}
+}
+
+func TestMockAdminGameRepository_Create(t *testing.T) {
+	testCases := map[string]struct {
+		mutate       func(request *ports_admin.UpsertGameRequest)
+		expectedCode int
+	}{
+		"creates the game aggregate": {
+			mutate: func(request *ports_admin.UpsertGameRequest) {},
+		},
+		"invalid condition": {
+			mutate: func(request *ports_admin.UpsertGameRequest) {
+				request.Condition = "legendary"
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		"duplicated store listing": {
+			mutate: func(request *ports_admin.UpsertGameRequest) {
+				request.Stores = append(request.Stores, request.Stores[0])
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		"fails the domain validation": {
+			mutate: func(request *ports_admin.UpsertGameRequest) {
+				request.Cover = ""
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminGameRepository()
+			service := usecases_admin.NewAdminGameService(mockRepo)
+
+			request := validUpsertGameRequest()
+			tc.mutate(&request)
+
+			game, _, err := service.Create(request)
+
+			if tc.expectedCode != 0 {
+				httpErr, ok := err.(*self_errors.HttpError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.expectedCode, httpErr.Code)
+				assert.Empty(t, mockRepo.games)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "game-test", game.Slug)
+			assert.Equal(t, domain.CommomCondition, game.Condition)
+			assert.Len(t, game.Genres, 2)
+			assert.Len(t, game.Stores, 1)
+		})
+	}
+}
+
+func TestMockAdminGameRepository_UpdateAndDelete(t *testing.T) {
+	mockRepo := NewMockAdminGameRepository()
+	mockRepo.games[1] = &domain.Game{ID: 1, Title: "Old title"}
+
+	service := usecases_admin.NewAdminGameService(mockRepo)
+
+	request := validUpsertGameRequest()
+	request.Title = "New title"
+
+	game, _, err := service.Update(1, request)
+	assert.NoError(t, err)
+	assert.Equal(t, "New title", game.Title)
+
+	_, _, err = service.Update(2, request)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	assert.NoError(t, service.Delete(1))
+	assert.ErrorIs(t, service.Delete(1), gorm.ErrRecordNotFound)
+}
@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockAdminJobRepository struct {
+	jobs map[uint]*domain.Job
+}
+
+func NewMockAdminJobRepository() *MockAdminJobRepository {
+	return &MockAdminJobRepository{
+		jobs: make(map[uint]*domain.Job),
+	}
+}
+
+func (m *MockAdminJobRepository) GetAll(filters ports_admin.JobFilters, limit int) ([]domain.Job, error) {
+	var jobs []domain.Job
+	for _, job := range m.jobs {
+		if filters.Status != "" && job.Status != filters.Status {
+			continue
+		}
+		jobs = append(jobs, *job)
+	}
+	return jobs, nil
+}
+
+func (m *MockAdminJobRepository) FindByID(id uint) (domain.Job, error) {
+	job, exists := m.jobs[id]
+	if !exists {
+		return domain.Job{}, gorm.ErrRecordNotFound
+	}
+	return *job, nil
+}
+
+func (m *MockAdminJobRepository) Retry(job *domain.Job) error {
+	job.Status = domain.JobStatusPending
+	job.Attempts = 0
+	m.jobs[job.ID] = job
+	return nil
+}
+
+func (m *MockAdminJobRepository) Cancel(job *domain.Job) error {
+	job.Status = domain.JobStatusCancelled
+	m.jobs[job.ID] = job
+	return nil
+}
+
+func TestMockAdminJobRepository_GetAll(t *testing.T) {
+	mockRepo := NewMockAdminJobRepository()
+	mockRepo.jobs[1] = &domain.Job{ID: 1, Status: domain.JobStatusFailed}
+	mockRepo.jobs[2] = &domain.Job{ID: 2, Status: domain.JobStatusSucceeded}
+
+	service := usecases_admin.NewAdminJobService(mockRepo)
+
+	jobs, err := service.GetAll(ports_admin.JobFilters{Status: domain.JobStatusFailed})
+
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, uint(1), jobs[0].ID)
+}
+
+func TestMockAdminJobRepository_Retry(t *testing.T) {
+	testCases := map[string]struct {
+		job            *domain.Job
+		id             uint
+		expectedErr    error
+		expectedStatus string
+	}{
+		"retries a failed job": {
+			job:            &domain.Job{ID: 1, Status: domain.JobStatusFailed, Attempts: 3},
+			id:             1,
+			expectedStatus: domain.JobStatusPending,
+		},
+		"retries a cancelled job": {
+			job:            &domain.Job{ID: 1, Status: domain.JobStatusCancelled},
+			id:             1,
+			expectedStatus: domain.JobStatusPending,
+		},
+		"refuses to retry a running job": {
+			job:            &domain.Job{ID: 1, Status: domain.JobStatusRunning},
+			id:             1,
+			expectedErr:    errors.NewHttpError(http.StatusConflict, "A running job can not be retried."),
+			expectedStatus: domain.JobStatusRunning,
+		},
+		"job not found": {
+			job:         &domain.Job{ID: 1, Status: domain.JobStatusFailed},
+			id:          2,
+			expectedErr: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminJobRepository()
+			mockRepo.jobs[tc.job.ID] = tc.job
+
+			job, err := usecases_admin.NewAdminJobService(mockRepo).Retry(tc.id)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, job.Status)
+		})
+	}
+}
+
+func TestMockAdminJobRepository_Cancel(t *testing.T) {
+	testCases := map[string]struct {
+		job            *domain.Job
+		expectedErr    error
+		expectedStatus string
+	}{
+		"cancels a pending job": {
+			job:            &domain.Job{ID: 1, Status: domain.JobStatusPending},
+			expectedStatus: domain.JobStatusCancelled,
+		},
+		"cancels a running job": {
+			job:            &domain.Job{ID: 1, Status: domain.JobStatusRunning},
+			expectedStatus: domain.JobStatusCancelled,
+		},
+		"refuses to cancel a finished job": {
+			job:            &domain.Job{ID: 1, Status: domain.JobStatusSucceeded},
+			expectedErr:    errors.NewHttpError(http.StatusConflict, "A succeeded job can not be cancelled."),
+			expectedStatus: domain.JobStatusSucceeded,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminJobRepository()
+			mockRepo.jobs[tc.job.ID] = tc.job
+
+			job, err := usecases_admin.NewAdminJobService(mockRepo).Cancel(tc.job.ID)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, job.Status)
+		})
+	}
+}
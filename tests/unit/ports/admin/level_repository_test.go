@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockAdminLevelRepository struct {
+	levels map[uint]*domain.Level
+}
+
+func (m *MockAdminLevelRepository) GetAll() ([]domain.Level, error) {
+	var levels []domain.Level
+	for _, level := range m.levels {
+		levels = append(levels, *level)
+	}
+	return levels, nil
+}
+
+func (m *MockAdminLevelRepository) FindByID(id uint) (domain.Level, error) {
+	level, exists := m.levels[id]
+	if !exists {
+		return domain.Level{}, gorm.ErrRecordNotFound
+	}
+	return *level, nil
+}
+
+func (m *MockAdminLevelRepository) ExistsByLevel(lvl uint, exceptID uint) (bool, error) {
+	for _, level := range m.levels {
+		if level.Level == lvl && level.ID != exceptID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockAdminLevelRepository) Create(level *domain.Level) error {
+	level.ID = uint(len(m.levels) + 1)
+	m.levels[level.ID] = level
+	return nil
+}
+
+func (m *MockAdminLevelRepository) Update(level *domain.Level) error {
+	m.levels[level.ID] = level
+	return nil
+}
+
+func (m *MockAdminLevelRepository) Delete(id uint) error {
+	delete(m.levels, id)
+	return nil
+}
+
+func TestAdminLevelService_Update(t *testing.T) {
+	testCases := map[string]struct {
+		id          uint
+		request     ports_admin.LevelRequest
+		expectedErr error
+	}{
+		"updates the level and its rewards": {
+			id: 1,
+			request: ports_admin.LevelRequest{
+				Level:      1,
+				Experience: 100,
+				Coins:      20,
+				Rewards:    []ports_admin.RewardRequest{{RewardableType: domain.RewardableTypeTitles, RewardableID: 4}},
+			},
+		},
+		"level number already taken": {
+			id:          1,
+			request:     ports_admin.LevelRequest{Level: 2, Experience: 100, Coins: 20},
+			expectedErr: errors.NewHttpError(http.StatusConflict, "The level already exists."),
+		},
+		"level not found": {
+			id:          9,
+			request:     ports_admin.LevelRequest{Level: 9, Experience: 100, Coins: 20},
+			expectedErr: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := &MockAdminLevelRepository{levels: map[uint]*domain.Level{
+				1: {ID: 1, Level: 1, Experience: 50, Coins: 10},
+				2: {ID: 2, Level: 2, Experience: 150, Coins: 30},
+			}}
+			service := usecases_admin.NewAdminLevelService(mockRepo)
+
+			level, err := service.Update(tc.id, tc.request)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.request.Experience, level.Experience)
+				assert.Len(t, level.Rewards, len(tc.request.Rewards))
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockAdminMissionRepository struct {
+	missions     map[uint]*domain.Mission
+	assignments  map[uint][]uint
+	requirements map[uint]domain.MissionRequirement
+	achievers    []domain.User
+	offset       int
+	limit        int
+}
+
+func NewMockAdminMissionRepository() *MockAdminMissionRepository {
+	return &MockAdminMissionRepository{
+		missions:     make(map[uint]*domain.Mission),
+		assignments:  make(map[uint][]uint),
+		requirements: make(map[uint]domain.MissionRequirement),
+	}
+}
+
+func (m *MockAdminMissionRepository) GetAll() ([]domain.Mission, error) {
+	var missions []domain.Mission
+	for _, mission := range m.missions {
+		missions = append(missions, *mission)
+	}
+	return missions, nil
+}
+
+func (m *MockAdminMissionRepository) FindByID(id uint) (domain.Mission, error) {
+	mission, exists := m.missions[id]
+	if !exists {
+		return domain.Mission{}, gorm.ErrRecordNotFound
+	}
+	return *mission, nil
+}
+
+func (m *MockAdminMissionRepository) Create(mission *domain.Mission) error {
+	mission.ID = uint(len(m.missions) + 1)
+	m.missions[mission.ID] = mission
+	return nil
+}
+
+func (m *MockAdminMissionRepository) Update(mission *domain.Mission) error {
+	m.missions[mission.ID] = mission
+	return nil
+}
+
+func (m *MockAdminMissionRepository) Delete(id uint) error {
+	if _, exists := m.missions[id]; !exists {
+		return gorm.ErrRecordNotFound
+	}
+	delete(m.missions, id)
+	return nil
+}
+
+func (m *MockAdminMissionRepository) SyncAssignments(missionID uint, userIDs []uint) error {
+	m.assignments[missionID] = userIDs
+	return nil
+}
+
+func (m *MockAdminMissionRepository) FindRequirement(missionID uint, requirementID uint) (domain.MissionRequirement, error) {
+	requirement, exists := m.requirements[requirementID]
+	if !exists || requirement.MissionID != missionID {
+		return domain.MissionRequirement{}, gorm.ErrRecordNotFound
+	}
+	return requirement, nil
+}
+
+func (m *MockAdminMissionRepository) RequirementAchievers(requirementID uint, offset int, limit int) ([]domain.User, int64, error) {
+	m.offset = offset
+	m.limit = limit
+	return m.achievers, int64(len(m.achievers)), nil
+}
+
+func TestAdminMissionService_Create(t *testing.T) {
+	validRequirement := ports_admin.TaskRequirementRequest{
+		Task:        "Add 5 games to your library",
+		Key:         domain.AddToLibraryRequirementKey,
+		Goal:        5,
+		Description: "Grow your library.",
+	}
+
+	testCases := map[string]struct {
+		request           ports_admin.MissionRequest
+		expectedErr       error
+		expectedStatus    string
+		expectedFrequency string
+	}{
+		"creates the mission with defaults": {
+			request: ports_admin.MissionRequest{
+				Mission:      "Collector",
+				Description:  "Build your library.",
+				Coins:        10,
+				Experience:   50,
+				Requirements: []ports_admin.TaskRequirementRequest{validRequirement},
+				Rewards: []ports_admin.RewardRequest{
+					{RewardableType: domain.RewardableTypeTitles, RewardableID: 1},
+					{RewardableType: domain.RewardableTypeTitles, RewardableID: 1},
+				},
+			},
+			expectedStatus:    domain.MissionAvailable,
+			expectedFrequency: domain.OneTimeMission,
+		},
+		"invalid frequency": {
+			request: ports_admin.MissionRequest{
+				Mission:     "Collector",
+				Description: "Build your library.",
+				Coins:       10,
+				Experience:  50,
+				Frequency:   "hourly",
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, `The frequency "hourly" is not valid.`),
+		},
+		"untracked action key": {
+			request: ports_admin.MissionRequest{
+				Mission:     "Collector",
+				Description: "Build your library.",
+				Coins:       10,
+				Experience:  50,
+				Requirements: []ports_admin.TaskRequirementRequest{
+					{Task: "Fly", Key: "fly_to_the_moon", Goal: 1, Description: "Fly."},
+				},
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, `The action key "fly_to_the_moon" is not tracked.`),
+		},
+		"invalid reward type": {
+			request: ports_admin.MissionRequest{
+				Mission:     "Collector",
+				Description: "Build your library.",
+				Coins:       10,
+				Experience:  50,
+				Rewards:     []ports_admin.RewardRequest{{RewardableType: "badges", RewardableID: 1}},
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, `The reward type "badges" is not valid.`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminMissionRepository()
+			service := usecases_admin.NewAdminMissionService(mockRepo)
+
+			mission, err := service.Create(tc.request)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, uint(1), mission.ID)
+				assert.Equal(t, tc.expectedStatus, mission.Status)
+				assert.Equal(t, tc.expectedFrequency, mission.Frequency)
+				assert.Len(t, mission.MissionRequirements, len(tc.request.Requirements))
+				assert.Len(t, mission.Rewards, 1)
+			}
+		})
+	}
+}
+
+func TestAdminMissionService_SyncAssignments(t *testing.T) {
+	testCases := map[string]struct {
+		id          uint
+		expectedErr error
+	}{
+		"assigns the mission": {
+			id: 1,
+		},
+		"mission not found": {
+			id:          9,
+			expectedErr: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminMissionRepository()
+			mockRepo.missions[1] = &domain.Mission{ID: 1, Mission: "Collector", ForAll: false}
+			service := usecases_admin.NewAdminMissionService(mockRepo)
+
+			_, err := service.SyncAssignments(tc.id, ports_admin.AssignMissionRequest{UserIDs: []uint{3, 4}})
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, []uint{3, 4}, mockRepo.assignments[tc.id])
+			}
+		})
+	}
+}
+
+func TestAdminMissionService_RequirementAchievers(t *testing.T) {
+	testCases := map[string]struct {
+		missionID      uint
+		requirementID  uint
+		page           ports_admin.PageRequest
+		expectedErr    error
+		expectedOffset int
+		expectedLimit  int
+	}{
+		"lists the achievers": {
+			missionID:      1,
+			requirementID:  2,
+			page:           ports_admin.PageRequest{Page: 2, PerPage: 10},
+			expectedOffset: 10,
+			expectedLimit:  10,
+		},
+		"requirement of another mission": {
+			missionID:     3,
+			requirementID: 2,
+			expectedErr:   errors.NewHttpError(http.StatusNotFound, "The requirement could not be found."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminMissionRepository()
+			mockRepo.requirements[2] = domain.MissionRequirement{ID: 2, MissionID: 1}
+			mockRepo.achievers = []domain.User{{ID: 5}}
+			service := usecases_admin.NewAdminMissionService(mockRepo)
+
+			users, _, total, err := service.RequirementAchievers(tc.missionID, tc.requirementID, tc.page)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Len(t, users, 1)
+				assert.Equal(t, int64(1), total)
+				assert.Equal(t, tc.expectedOffset, mockRepo.offset)
+				assert.Equal(t, tc.expectedLimit, mockRepo.limit)
+			}
+		})
+	}
+}
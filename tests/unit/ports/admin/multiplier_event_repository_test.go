@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockAdminMultiplierEventRepository struct {
+	events map[uint]*domain.MultiplierEvent
+}
+
+func (m *MockAdminMultiplierEventRepository) GetAll() ([]domain.MultiplierEvent, error) {
+	var events []domain.MultiplierEvent
+	for _, event := range m.events {
+		events = append(events, *event)
+	}
+	return events, nil
+}
+
+func (m *MockAdminMultiplierEventRepository) FindByID(id uint) (domain.MultiplierEvent, error) {
+	event, exists := m.events[id]
+	if !exists {
+		return domain.MultiplierEvent{}, gorm.ErrRecordNotFound
+	}
+	return *event, nil
+}
+
+func (m *MockAdminMultiplierEventRepository) Create(event *domain.MultiplierEvent) error {
+	event.ID = uint(len(m.events) + 1)
+	m.events[event.ID] = event
+	return nil
+}
+
+func (m *MockAdminMultiplierEventRepository) Update(event *domain.MultiplierEvent) error {
+	m.events[event.ID] = event
+	return nil
+}
+
+func (m *MockAdminMultiplierEventRepository) Delete(id uint) error {
+	delete(m.events, id)
+	return nil
+}
+
+func TestAdminMultiplierEventService_Create(t *testing.T) {
+	startsAt := time.Date(2026, 10, 24, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		request     ports_admin.MultiplierEventRequest
+		expectedErr error
+	}{
+		"creates a double XP weekend": {
+			request: ports_admin.MultiplierEventRequest{
+				Name:       "Double XP weekend",
+				Scope:      domain.BoosterScopeExperience,
+				Multiplier: 2,
+				StartsAt:   startsAt,
+				EndsAt:     startsAt.Add(48 * time.Hour),
+			},
+		},
+		"creates an event for an action": {
+			request: ports_admin.MultiplierEventRequest{
+				Name:       "Check-in coin rush",
+				Scope:      domain.BoosterScopeCoins,
+				Multiplier: 1.5,
+				ActionKey:  domain.CheckInRequirementKey,
+				StartsAt:   startsAt,
+				EndsAt:     startsAt.Add(24 * time.Hour),
+			},
+		},
+		"unknown scope": {
+			request: ports_admin.MultiplierEventRequest{
+				Name:       "Title rush",
+				Scope:      domain.RewardableTypeTitles,
+				Multiplier: 2,
+				StartsAt:   startsAt,
+				EndsAt:     startsAt.Add(time.Hour),
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "Scope is not valid"),
+		},
+		"ends before it starts": {
+			request: ports_admin.MultiplierEventRequest{
+				Name:       "Backwards",
+				Scope:      domain.BoosterScopeCoins,
+				Multiplier: 2,
+				StartsAt:   startsAt,
+				EndsAt:     startsAt.Add(-time.Hour),
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "EndsAt is not valid"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := &MockAdminMultiplierEventRepository{events: map[uint]*domain.MultiplierEvent{}}
+			service := usecases_admin.NewAdminMultiplierEventService(mockRepo)
+
+			event, err := service.Create(tc.request)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.request.Name, event.Name)
+				assert.Equal(t, tc.request.ActionKey, event.ActionKey)
+				assert.NotZero(t, event.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockAdminPermissionRepository struct {
+	permissions map[uint]*domain.Permission
+	users       map[uint][]uint
+}
+
+func NewMockAdminPermissionRepository() *MockAdminPermissionRepository {
+	return &MockAdminPermissionRepository{
+		permissions: make(map[uint]*domain.Permission),
+		users:       make(map[uint][]uint),
+	}
+}
+
+func (m *MockAdminPermissionRepository) GetAll() ([]domain.Permission, error) {
+	var permissions []domain.Permission
+	for _, permission := range m.permissions {
+		permissions = append(permissions, *permission)
+	}
+	return permissions, nil
+}
+
+func (m *MockAdminPermissionRepository) FindByID(id uint) (domain.Permission, error) {
+	permission, exists := m.permissions[id]
+	if !exists {
+		return domain.Permission{}, gorm.ErrRecordNotFound
+	}
+	return *permission, nil
+}
+
+func (m *MockAdminPermissionRepository) ExistsByScope(scope string, exceptID uint) (bool, error) {
+	for _, permission := range m.permissions {
+		if permission.Scope == scope && permission.ID != exceptID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockAdminPermissionRepository) Create(permission *domain.Permission) error {
+	permission.ID = uint(len(m.permissions) + 1)
+	m.permissions[permission.ID] = permission
+	return nil
+}
+
+func (m *MockAdminPermissionRepository) Save(permission *domain.Permission) error {
+	m.permissions[permission.ID] = permission
+	return nil
+}
+
+func (m *MockAdminPermissionRepository) Delete(id uint) error {
+	if _, exists := m.permissions[id]; !exists {
+		return gorm.ErrRecordNotFound
+	}
+	delete(m.permissions, id)
+	return nil
+}
+
+func (m *MockAdminPermissionRepository) UserIDs(permissionID uint) ([]uint, error) {
+	return m.users[permissionID], nil
+}
+
+func (m *MockAdminPermissionRepository) CreateMissing(scopes []string) ([]domain.Permission, error) {
+	created := []domain.Permission{}
+	for _, scope := range scopes {
+		if exists, _ := m.ExistsByScope(scope, 0); !exists {
+			permission := domain.Permission{Scope: scope}
+			_ = m.Create(&permission)
+			created = append(created, permission)
+		}
+	}
+	return created, nil
+}
+
+func TestAdminPermissionService_Create(t *testing.T) {
+	testCases := map[string]struct {
+		request     ports_admin.PermissionRequest
+		expectedErr error
+	}{
+		"creates the permission": {
+			request: ports_admin.PermissionRequest{Scope: "delete:games"},
+		},
+		"duplicated scope": {
+			request:     ports_admin.PermissionRequest{Scope: "view:games"},
+			expectedErr: errors.NewHttpError(http.StatusConflict, "The permission already exists."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminPermissionRepository()
+			mockRepo.permissions[1] = &domain.Permission{ID: 1, Scope: "view:games"}
+			service := usecases_admin.NewAdminPermissionService(mockRepo)
+
+			_, err := service.Create(tc.request)
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestAdminPermissionService_Delete(t *testing.T) {
+	mockRepo := NewMockAdminPermissionRepository()
+	mockRepo.permissions[1] = &domain.Permission{ID: 1, Scope: "view:games"}
+	mockRepo.users[1] = []uint{2, 3}
+	service := usecases_admin.NewAdminPermissionService(mockRepo)
+
+	userIDs, err := service.Delete(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{2, 3}, userIDs)
+	assert.Empty(t, mockRepo.permissions)
+
+	_, err = service.Delete(1)
+	assert.Equal(t, gorm.ErrRecordNotFound, err)
+}
+
+func TestAdminPermissionService_Catalog(t *testing.T) {
+	mockRepo := NewMockAdminPermissionRepository()
+	mockRepo.permissions[1] = &domain.Permission{ID: 1, Scope: "view:games"}
+	mockRepo.permissions[2] = &domain.Permission{ID: 2, Scope: "view:legacy"}
+	service := usecases_admin.NewAdminPermissionService(mockRepo)
+
+	catalog, err := service.Catalog([]string{"view:games", "update:games"})
+
+	assert.NoError(t, err)
+	assert.Len(t, catalog, 3)
+
+	assert.Equal(t, "update:games", catalog[0].Scope)
+	assert.True(t, catalog[0].InRoutes)
+	assert.Nil(t, catalog[0].Permission)
+
+	assert.Equal(t, "view:games", catalog[1].Scope)
+	assert.True(t, catalog[1].InRoutes)
+	assert.Equal(t, uint(1), catalog[1].Permission.ID)
+
+	assert.Equal(t, "view:legacy", catalog[2].Scope)
+	assert.False(t, catalog[2].InRoutes)
+	assert.Equal(t, uint(2), catalog[2].Permission.ID)
+}
+
+func TestAdminPermissionService_SyncCatalog(t *testing.T) {
+	mockRepo := NewMockAdminPermissionRepository()
+	mockRepo.permissions[1] = &domain.Permission{ID: 1, Scope: "view:games"}
+	service := usecases_admin.NewAdminPermissionService(mockRepo)
+
+	created, err := service.SyncCatalog([]string{"view:games", "update:games"})
+
+	assert.NoError(t, err)
+	assert.Len(t, created, 1)
+	assert.Equal(t, "update:games", created[0].Scope)
+	assert.Len(t, mockRepo.permissions, 2)
+}
@@ -0,0 +1,193 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockAdminQuizRepository struct {
+	quizzes  map[uint]*domain.Quiz
+	answered map[uint]bool
+}
+
+func (m *MockAdminQuizRepository) GetAll() ([]domain.Quiz, error) {
+	var quizzes []domain.Quiz
+	for _, quiz := range m.quizzes {
+		quizzes = append(quizzes, *quiz)
+	}
+	return quizzes, nil
+}
+
+func (m *MockAdminQuizRepository) FindByID(id uint) (domain.Quiz, error) {
+	quiz, exists := m.quizzes[id]
+	if !exists {
+		return domain.Quiz{}, gorm.ErrRecordNotFound
+	}
+	return *quiz, nil
+}
+
+func (m *MockAdminQuizRepository) HasAttempts(id uint) (bool, error) {
+	return m.answered[id], nil
+}
+
+func (m *MockAdminQuizRepository) Create(quiz *domain.Quiz) error {
+	quiz.ID = uint(len(m.quizzes) + 1)
+	m.quizzes[quiz.ID] = quiz
+	return nil
+}
+
+func (m *MockAdminQuizRepository) Update(quiz *domain.Quiz, replaceQuestions bool) error {
+	m.quizzes[quiz.ID] = quiz
+	return nil
+}
+
+func (m *MockAdminQuizRepository) Delete(id uint) error {
+	delete(m.quizzes, id)
+	return nil
+}
+
+func newQuizRequest(kind string) ports_admin.QuizRequest {
+	opensAt := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	return ports_admin.QuizRequest{
+		Kind:     kind,
+		Title:    "Most awaited release",
+		OpensAt:  opensAt,
+		ClosesAt: opensAt.Add(7 * 24 * time.Hour),
+		Questions: []ports_admin.QuizQuestionRequest{
+			{Question: "Who develops it?", Options: []ports_admin.QuizOptionRequest{
+				{Text: "Rockstar", Correct: kind == domain.QuizKindQuiz},
+				{Text: "Valve"},
+			}},
+		},
+	}
+}
+
+func TestAdminQuizService_Create(t *testing.T) {
+	testCases := map[string]struct {
+		request     func() ports_admin.QuizRequest
+		expectedErr error
+	}{
+		"creates a quiz with reward tiers": {
+			request: func() ports_admin.QuizRequest {
+				request := newQuizRequest(domain.QuizKindQuiz)
+				request.Rewards = []ports_admin.QuizRewardRequest{
+					{MinScore: 50, Coins: 100},
+					{MinScore: 100, Rewards: []ports_admin.RewardRequest{{RewardableType: domain.RewardableTypeTitles, RewardableID: 1}}},
+				}
+				return request
+			},
+		},
+		"creates a poll": {
+			request: func() ports_admin.QuizRequest { return newQuizRequest(domain.QuizKindPoll) },
+		},
+		"quiz without a correct option": {
+			request: func() ports_admin.QuizRequest {
+				request := newQuizRequest(domain.QuizKindQuiz)
+				request.Questions[0].Options[0].Correct = false
+				return request
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "The question 1 must have exactly one correct option."),
+		},
+		"empty reward tier": {
+			request: func() ports_admin.QuizRequest {
+				request := newQuizRequest(domain.QuizKindQuiz)
+				request.Rewards = []ports_admin.QuizRewardRequest{{MinScore: 50}}
+				return request
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "Every reward tier must give coins, experience or titles."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := &MockAdminQuizRepository{quizzes: map[uint]*domain.Quiz{}}
+			service := usecases_admin.NewAdminQuizService(mockRepo)
+			request := tc.request()
+
+			quiz, err := service.Create(request)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.NotZero(t, quiz.ID)
+				assert.Equal(t, request.Kind, quiz.Kind)
+				assert.Len(t, quiz.Questions, 1)
+				assert.Len(t, quiz.Rewards, len(request.Rewards))
+			}
+		})
+	}
+}
+
+func TestAdminQuizService_Update(t *testing.T) {
+	testCases := map[string]struct {
+		answered    bool
+		request     func() ports_admin.QuizRequest
+		expectedErr error
+	}{
+		"replaces the questions before any answer": {
+			request: func() ports_admin.QuizRequest { return newQuizRequest(domain.QuizKindQuiz) },
+		},
+		"keeps the questions of an answered quiz": {
+			answered: true,
+			request: func() ports_admin.QuizRequest {
+				request := newQuizRequest(domain.QuizKindQuiz)
+				request.Questions = nil
+				request.ClosesAt = request.ClosesAt.Add(24 * time.Hour)
+				return request
+			},
+		},
+		"questions of an answered quiz": {
+			answered:    true,
+			request:     func() ports_admin.QuizRequest { return newQuizRequest(domain.QuizKindQuiz) },
+			expectedErr: errors.NewHttpError(http.StatusConflict, "The questions can not change once the quiz was answered."),
+		},
+		"kind changed without questions": {
+			request: func() ports_admin.QuizRequest {
+				request := newQuizRequest(domain.QuizKindPoll)
+				request.Questions = nil
+				return request
+			},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "The questions must be given to change the kind of the quiz."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			existing := newQuizRequest(domain.QuizKindQuiz)
+			mockRepo := &MockAdminQuizRepository{
+				quizzes: map[uint]*domain.Quiz{1: {
+					ID:       1,
+					Kind:     existing.Kind,
+					Title:    existing.Title,
+					OpensAt:  existing.OpensAt,
+					ClosesAt: existing.ClosesAt,
+					Questions: []domain.QuizQuestion{
+						{ID: 1, Question: "Who develops it?", Options: []domain.QuizOption{
+							{ID: 1, Text: "Rockstar", Correct: true},
+							{ID: 2, Text: "Valve"},
+						}},
+					},
+				}},
+				answered: map[uint]bool{1: tc.answered},
+			}
+			service := usecases_admin.NewAdminQuizService(mockRepo)
+			request := tc.request()
+
+			quiz, err := service.Update(1, request)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, request.ClosesAt, quiz.ClosesAt)
+				assert.Len(t, quiz.Questions, 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockAdminReferenceRepository[T any] struct {
+	entities map[uint]*T
+	usages   map[uint]int64
+	taken    bool
+	nextID   uint
+	setID    func(entity *T, id uint)
+}
+
+func NewMockAdminCategoryRepository() *MockAdminReferenceRepository[domain.Category] {
+	return &MockAdminReferenceRepository[domain.Category]{
+		entities: make(map[uint]*domain.Category),
+		usages:   make(map[uint]int64),
+		setID:    func(category *domain.Category, id uint) { category.ID = id },
+	}
+}
+
+func (m *MockAdminReferenceRepository[T]) GetAll() ([]T, error) {
+	var entities []T
+	for _, entity := range m.entities {
+		entities = append(entities, *entity)
+	}
+	return entities, nil
+}
+
+func (m *MockAdminReferenceRepository[T]) FindByID(id uint) (T, error) {
+	entity, exists := m.entities[id]
+	if !exists {
+		var empty T
+		return empty, gorm.ErrRecordNotFound
+	}
+	return *entity, nil
+}
+
+func (m *MockAdminReferenceRepository[T]) Exists(conditions map[string]any, exceptID uint) (bool, error) {
+	return m.taken, nil
+}
+
+func (m *MockAdminReferenceRepository[T]) CountUsages(ids ...uint) (map[uint]int64, error) {
+	return m.usages, nil
+}
+
+func (m *MockAdminReferenceRepository[T]) Create(entity *T) error {
+	m.nextID++
+	m.setID(entity, m.nextID)
+	m.entities[m.nextID] = entity
+	return nil
+}
+
+func (m *MockAdminReferenceRepository[T]) Save(entity *T) error {
+	return nil
+}
+
+func (m *MockAdminReferenceRepository[T]) Delete(id uint, reassignTo *uint) error {
+	if _, exists := m.entities[id]; !exists {
+		return gorm.ErrRecordNotFound
+	}
+
+	if reassignTo == nil && m.usages[id] > 0 {
+		return ports_admin.ErrReferenceInUse
+	}
+
+	if reassignTo != nil {
+		m.usages[*reassignTo] += m.usages[id]
+	}
+
+	delete(m.usages, id)
+	delete(m.entities, id)
+	return nil
+}
+
+func TestAdminReferenceService_Create(t *testing.T) {
+	testCases := map[string]struct {
+		request      ports_admin.ReferenceRequest
+		taken        bool
+		expectedSlug string
+		expectedErr  error
+	}{
+		"creates the category with a slug": {
+			request:      ports_admin.ReferenceRequest{Name: "Open World"},
+			expectedSlug: "open-world",
+		},
+		"fails the domain validation": {
+			request:     ports_admin.ReferenceRequest{},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, ""),
+		},
+		"slug already taken": {
+			request:     ports_admin.ReferenceRequest{Name: "Open World"},
+			taken:       true,
+			expectedErr: errors.NewHttpError(http.StatusConflict, "The category already exists."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminCategoryRepository()
+			mockRepo.taken = tc.taken
+
+			category, err := usecases_admin.NewAdminCategoryService(mockRepo).Create(tc.request)
+
+			if tc.expectedErr != nil {
+				httpErr, ok := err.(*errors.HttpError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.expectedErr.(*errors.HttpError).Code, httpErr.Code)
+				assert.Empty(t, mockRepo.entities)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedSlug, category.Slug)
+			assert.Len(t, mockRepo.entities, 1)
+		})
+	}
+}
+
+func TestAdminReferenceService_Delete(t *testing.T) {
+	missingID := uint(9)
+	sameID := uint(1)
+	targetID := uint(2)
+
+	testCases := map[string]struct {
+		usages       int64
+		reassignTo   *uint
+		expectedErr  error
+		expectedLeft int
+	}{
+		"deletes an unused category": {
+			expectedLeft: 1,
+		},
+		"refuses to delete a category in use": {
+			usages:       3,
+			expectedErr:  errors.NewHttpError(http.StatusConflict, "The category is still in use, reassign its records before deleting it."),
+			expectedLeft: 2,
+		},
+		"reassigns the usages to another category": {
+			usages:       3,
+			reassignTo:   &targetID,
+			expectedLeft: 1,
+		},
+		"refuses to reassign to itself": {
+			usages:       3,
+			reassignTo:   &sameID,
+			expectedErr:  errors.NewHttpError(http.StatusUnprocessableEntity, "The category can not be reassigned to itself."),
+			expectedLeft: 2,
+		},
+		"refuses to reassign to a missing category": {
+			usages:       3,
+			reassignTo:   &missingID,
+			expectedErr:  errors.NewHttpError(http.StatusUnprocessableEntity, "The category to reassign to could not be found."),
+			expectedLeft: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminCategoryRepository()
+			mockRepo.entities[1] = &domain.Category{ID: 1, Name: "Action", Slug: "action"}
+			mockRepo.entities[2] = &domain.Category{ID: 2, Name: "Adventure", Slug: "adventure"}
+			mockRepo.usages[1] = tc.usages
+
+			err := usecases_admin.NewAdminCategoryService(mockRepo).Delete(1, tc.reassignTo)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Len(t, mockRepo.entities, tc.expectedLeft)
+
+			if tc.reassignTo != nil && tc.expectedErr == nil {
+				assert.Equal(t, tc.usages, mockRepo.usages[*tc.reassignTo])
+			}
+		})
+	}
+}
+
+func TestAdminReferenceService_GetAll(t *testing.T) {
+	mockRepo := NewMockAdminCategoryRepository()
+	mockRepo.entities[1] = &domain.Category{ID: 1, Name: "Action", Slug: "action"}
+	mockRepo.usages[1] = 7
+
+	categories, usages, err := usecases_admin.NewAdminCategoryService(mockRepo).GetAll()
+
+	assert.NoError(t, err)
+	assert.Len(t, categories, 1)
+	assert.Equal(t, int64(7), usages[1])
+}
@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockAdminRoleRepository struct {
+	roles   map[uint]*domain.Role
+	users   map[uint][]uint
+	deleted []uint
+}
+
+func NewMockAdminRoleRepository() *MockAdminRoleRepository {
+	return &MockAdminRoleRepository{
+		roles: make(map[uint]*domain.Role),
+		users: make(map[uint][]uint),
+	}
+}
+
+func (m *MockAdminRoleRepository) GetAll() ([]domain.Role, error) {
+	var roles []domain.Role
+	for _, role := range m.roles {
+		roles = append(roles, *role)
+	}
+	return roles, nil
+}
+
+func (m *MockAdminRoleRepository) FindByID(id uint) (domain.Role, error) {
+	role, exists := m.roles[id]
+	if !exists {
+		return domain.Role{}, gorm.ErrRecordNotFound
+	}
+	return *role, nil
+}
+
+func (m *MockAdminRoleRepository) ExistsByName(name string, exceptID uint) (bool, error) {
+	for _, role := range m.roles {
+		if role.Name == name && role.ID != exceptID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockAdminRoleRepository) Create(role *domain.Role, permissionIDs []uint) error {
+	role.ID = uint(len(m.roles) + 1)
+	return m.Update(role, permissionIDs)
+}
+
+func (m *MockAdminRoleRepository) Update(role *domain.Role, permissionIDs []uint) error {
+	role.Permissions = nil
+	for _, permissionID := range permissionIDs {
+		role.Permissions = append(role.Permissions, domain.Permissionable{PermissionID: permissionID})
+	}
+	m.roles[role.ID] = role
+	return nil
+}
+
+func (m *MockAdminRoleRepository) Delete(id uint) error {
+	delete(m.roles, id)
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+
+func (m *MockAdminRoleRepository) UserIDs(roleID uint) ([]uint, error) {
+	return m.users[roleID], nil
+}
+
+func TestAdminRoleService_Create(t *testing.T) {
+	testCases := map[string]struct {
+		request     ports_admin.RoleRequest
+		expectedErr error
+	}{
+		"creates the role": {
+			request: ports_admin.RoleRequest{Name: "Editor", PermissionIDs: []uint{1, 2}},
+		},
+		"duplicated name": {
+			request:     ports_admin.RoleRequest{Name: "Technology"},
+			expectedErr: errors.NewHttpError(http.StatusConflict, "The role already exists."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminRoleRepository()
+			mockRepo.roles[1] = &domain.Role{ID: 1, Name: "Technology"}
+			service := usecases_admin.NewAdminRoleService(mockRepo, "Technology")
+
+			role, err := service.Create(tc.request)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, uint(2), role.ID)
+				assert.Len(t, role.Permissions, len(tc.request.PermissionIDs))
+			}
+		})
+	}
+}
+
+func TestAdminRoleService_Update(t *testing.T) {
+	testCases := map[string]struct {
+		id              uint
+		request         ports_admin.RoleRequest
+		expectedErr     error
+		expectedUserIDs []uint
+	}{
+		"updates the role and returns its users": {
+			id:              2,
+			request:         ports_admin.RoleRequest{Name: "Editors", PermissionIDs: []uint{3}},
+			expectedUserIDs: []uint{5, 6},
+		},
+		"superuser role can not be renamed": {
+			id:          1,
+			request:     ports_admin.RoleRequest{Name: "Tech"},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "The superuser role can not be renamed."),
+		},
+		"role not found": {
+			id:          3,
+			request:     ports_admin.RoleRequest{Name: "Editors"},
+			expectedErr: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminRoleRepository()
+			mockRepo.roles[1] = &domain.Role{ID: 1, Name: "Technology"}
+			mockRepo.roles[2] = &domain.Role{ID: 2, Name: "Editor"}
+			mockRepo.users[2] = []uint{5, 6}
+			service := usecases_admin.NewAdminRoleService(mockRepo, "Technology")
+
+			role, userIDs, err := service.Update(tc.id, tc.request)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedUserIDs, userIDs)
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.request.Name, role.Name)
+			}
+		})
+	}
+}
+
+func TestAdminRoleService_Delete(t *testing.T) {
+	testCases := map[string]struct {
+		id              uint
+		expectedErr     error
+		expectedUserIDs []uint
+		expectedDeleted []uint
+	}{
+		"deletes the role and returns its users": {
+			id:              2,
+			expectedUserIDs: []uint{5},
+			expectedDeleted: []uint{2},
+		},
+		"superuser role can not be deleted": {
+			id:          1,
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "The superuser role can not be deleted."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminRoleRepository()
+			mockRepo.roles[1] = &domain.Role{ID: 1, Name: "Technology"}
+			mockRepo.roles[2] = &domain.Role{ID: 2, Name: "Editor"}
+			mockRepo.users[2] = []uint{5}
+			service := usecases_admin.NewAdminRoleService(mockRepo, "Technology")
+
+			userIDs, err := service.Delete(tc.id)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedUserIDs, userIDs)
+			assert.Equal(t, tc.expectedDeleted, mockRepo.deleted)
+		})
+	}
+}
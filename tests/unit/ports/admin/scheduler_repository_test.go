@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockAdminSchedulerRepository struct {
+	jobs map[string]*domain.ScheduledJob
+	runs []domain.ScheduledRun
+}
+
+func NewMockAdminSchedulerRepository() *MockAdminSchedulerRepository {
+	return &MockAdminSchedulerRepository{
+		jobs: make(map[string]*domain.ScheduledJob),
+	}
+}
+
+func (m *MockAdminSchedulerRepository) GetJobs() ([]domain.ScheduledJob, error) {
+	var jobs []domain.ScheduledJob
+	for _, job := range m.jobs {
+		jobs = append(jobs, *job)
+	}
+	return jobs, nil
+}
+
+func (m *MockAdminSchedulerRepository) FindJob(name string) (domain.ScheduledJob, error) {
+	job, exists := m.jobs[name]
+	if !exists {
+		return domain.ScheduledJob{}, gorm.ErrRecordNotFound
+	}
+	return *job, nil
+}
+
+func (m *MockAdminSchedulerRepository) GetRuns(filters ports_admin.ScheduledRunFilters, offset int, limit int) ([]domain.ScheduledRun, int64, error) {
+	var runs []domain.ScheduledRun
+	for _, run := range m.runs {
+		if filters.Job != "" && run.JobName != filters.Job {
+			continue
+		}
+		runs = append(runs, run)
+	}
+	return runs, int64(len(runs)), nil
+}
+
+func (m *MockAdminSchedulerRepository) HasPendingRun(jobName string) (bool, error) {
+	for _, run := range m.runs {
+		if run.JobName == jobName && run.Status == domain.ScheduledRunPending {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockAdminSchedulerRepository) CreateRun(run *domain.ScheduledRun) error {
+	run.ID = uint(len(m.runs) + 1)
+	m.runs = append(m.runs, *run)
+	return nil
+}
+
+func TestMockAdminSchedulerRepository_GetRuns(t *testing.T) {
+	mockRepo := NewMockAdminSchedulerRepository()
+	mockRepo.runs = []domain.ScheduledRun{
+		{ID: 1, JobName: domain.ScheduledJobResetMissions, Status: domain.ScheduledRunSucceeded},
+		{ID: 2, JobName: domain.ScheduledJobPrunePasswordResets, Status: domain.ScheduledRunFailed},
+	}
+
+	service := usecases_admin.NewAdminSchedulerService(mockRepo)
+
+	runs, page, total, err := service.GetRuns(ports_admin.ScheduledRunFilters{Job: domain.ScheduledJobResetMissions})
+
+	assert.NoError(t, err)
+	assert.Len(t, runs, 1)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, 1, page.Page)
+	assert.Equal(t, 25, page.PerPage)
+}
+
+func TestMockAdminSchedulerRepository_Trigger(t *testing.T) {
+	testCases := map[string]struct {
+		name        string
+		runs        []domain.ScheduledRun
+		expectedErr error
+	}{
+		"queues a manual run": {
+			name: domain.ScheduledJobPrunePasswordResets,
+			runs: []domain.ScheduledRun{{ID: 1, JobName: domain.ScheduledJobPrunePasswordResets, Status: domain.ScheduledRunRunning}},
+		},
+		"unknown job": {
+			name:        "unknown:job",
+			expectedErr: gorm.ErrRecordNotFound,
+		},
+		"run already waiting": {
+			name:        domain.ScheduledJobPrunePasswordResets,
+			runs:        []domain.ScheduledRun{{ID: 1, JobName: domain.ScheduledJobPrunePasswordResets, Status: domain.ScheduledRunPending}},
+			expectedErr: errors.NewHttpError(http.StatusConflict, "The job already has a run waiting to start."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminSchedulerRepository()
+			mockRepo.jobs[domain.ScheduledJobPrunePasswordResets] = &domain.ScheduledJob{ID: 1, Name: domain.ScheduledJobPrunePasswordResets, Schedule: "@hourly"}
+			mockRepo.runs = tc.runs
+
+			service := usecases_admin.NewAdminSchedulerService(mockRepo)
+
+			run, err := service.Trigger(tc.name, 7)
+
+			if tc.expectedErr != nil {
+				assert.Equal(t, tc.expectedErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, domain.ScheduledTriggerManual, run.Trigger)
+			assert.Equal(t, domain.ScheduledRunPending, run.Status)
+			assert.Equal(t, uint(7), *run.TriggeredBy)
+			assert.Len(t, mockRepo.runs, len(tc.runs)+1)
+		})
+	}
+}
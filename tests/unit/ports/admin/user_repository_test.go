@@ -0,0 +1,255 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	ports_admin "gcstatus/internal/ports/admin"
+	usecases_admin "gcstatus/internal/usecases/admin"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockAdminUserRepository struct {
+	users  map[uint]*domain.User
+	resets []domain.PasswordReset
+}
+
+func NewMockAdminUserRepository() *MockAdminUserRepository {
+	return &MockAdminUserRepository{
+		users: make(map[uint]*domain.User),
+	}
+}
+
+func (m *MockAdminUserRepository) GetAll(filters ports_admin.UserFilters, limit int) ([]domain.User, error) {
+	var users []domain.User
+	for _, user := range m.users {
+		if filters.Blocked != nil && user.Blocked != *filters.Blocked {
+			continue
+		}
+		users = append(users, *user)
+	}
+	return users, nil
+}
+
+func (m *MockAdminUserRepository) FindByID(id uint) (domain.User, error) {
+	user, exists := m.users[id]
+	if !exists {
+		return domain.User{}, gorm.ErrRecordNotFound
+	}
+	return *user, nil
+}
+
+func (m *MockAdminUserRepository) Block(id uint, reason string, until *time.Time) error {
+	user, exists := m.users[id]
+	if !exists {
+		return gorm.ErrRecordNotFound
+	}
+	user.Blocked = true
+	user.BlockedReason = &reason
+	user.BlockedUntil = until
+	return nil
+}
+
+func (m *MockAdminUserRepository) Unblock(id uint) error {
+	user, exists := m.users[id]
+	if !exists {
+		return gorm.ErrRecordNotFound
+	}
+	user.Blocked = false
+	user.BlockedReason = nil
+	user.BlockedUntil = nil
+	return nil
+}
+
+func (m *MockAdminUserRepository) AdjustWallet(id uint, amount int, description string) (domain.Transaction, error) {
+	user, exists := m.users[id]
+	if !exists {
+		return domain.Transaction{}, gorm.ErrRecordNotFound
+	}
+	if user.Wallet.Amount+amount < 0 {
+		return domain.Transaction{}, errors.NewHttpError(http.StatusUnprocessableEntity, "The wallet balance can not go below zero.")
+	}
+	user.Wallet.Amount += amount
+	return domain.Transaction{UserID: id, Description: description}, nil
+}
+
+func (m *MockAdminUserRepository) GrantTitle(id uint, titleID uint) (domain.UserTitle, error) {
+	return domain.UserTitle{UserID: id, TitleID: titleID}, nil
+}
+
+func (m *MockAdminUserRepository) RevokeSessions(id uint, at time.Time) error {
+	user, exists := m.users[id]
+	if !exists {
+		return gorm.ErrRecordNotFound
+	}
+	user.SessionsRevokedAt = &at
+	return nil
+}
+
+func (m *MockAdminUserRepository) CreatePasswordReset(id uint, reset *domain.PasswordReset) error {
+	if err := m.RevokeSessions(id, reset.CreatedAt); err != nil {
+		return err
+	}
+	m.resets = append(m.resets, *reset)
+	return nil
+}
+
+func (m *MockAdminUserRepository) SyncRoles(id uint, roleIDs []uint) error {
+	user, exists := m.users[id]
+	if !exists {
+		return gorm.ErrRecordNotFound
+	}
+	user.Roles = nil
+	for _, roleID := range roleIDs {
+		user.Roles = append(user.Roles, domain.Roleable{RoleableID: id, RoleID: roleID, Role: domain.Role{ID: roleID}})
+	}
+	return nil
+}
+
+func (m *MockAdminUserRepository) SyncPermissions(id uint, permissionIDs []uint) error {
+	user, exists := m.users[id]
+	if !exists {
+		return gorm.ErrRecordNotFound
+	}
+	user.Permissions = nil
+	for _, permissionID := range permissionIDs {
+		user.Permissions = append(user.Permissions, domain.Permissionable{PermissionableID: id, PermissionID: permissionID})
+	}
+	return nil
+}
+
+func TestAdminUserService_Block(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	testCases := map[string]struct {
+		actorID     uint
+		id          uint
+		request     ports_admin.BlockUserRequest
+		expectedErr error
+	}{
+		"blocks the user": {
+			actorID: 1,
+			id:      2,
+			request: ports_admin.BlockUserRequest{Reason: "Spam", Until: &future},
+		},
+		"can not block yourself": {
+			actorID:     2,
+			id:          2,
+			request:     ports_admin.BlockUserRequest{Reason: "Spam"},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "You can not block yourself."),
+		},
+		"expiry in the past": {
+			actorID:     1,
+			id:          2,
+			request:     ports_admin.BlockUserRequest{Reason: "Spam", Until: &past},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "The block expiry must be in the future."),
+		},
+		"user not found": {
+			actorID:     1,
+			id:          3,
+			request:     ports_admin.BlockUserRequest{Reason: "Spam"},
+			expectedErr: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminUserRepository()
+			mockRepo.users[2] = &domain.User{ID: 2}
+			service := usecases_admin.NewAdminUserService(mockRepo)
+
+			user, err := service.Block(tc.actorID, tc.id, tc.request)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.True(t, user.IsBlocked(time.Now()))
+				assert.Equal(t, tc.request.Reason, *user.BlockedReason)
+			}
+		})
+	}
+}
+
+func TestAdminUserService_Unblock(t *testing.T) {
+	reason := "Spam"
+	mockRepo := NewMockAdminUserRepository()
+	mockRepo.users[1] = &domain.User{ID: 1, Blocked: true, BlockedReason: &reason}
+	service := usecases_admin.NewAdminUserService(mockRepo)
+
+	user, err := service.Unblock(1)
+
+	assert.NoError(t, err)
+	assert.False(t, user.Blocked)
+	assert.Nil(t, user.BlockedReason)
+}
+
+func TestAdminUserService_AdjustWallet(t *testing.T) {
+	testCases := map[string]struct {
+		amount         int
+		expectedErr    error
+		expectedAmount int
+	}{
+		"credits the wallet": {
+			amount:         50,
+			expectedAmount: 150,
+		},
+		"debits the wallet": {
+			amount:         -100,
+			expectedAmount: 0,
+		},
+		"refuses to debit below zero": {
+			amount:         -101,
+			expectedErr:    errors.NewHttpError(http.StatusUnprocessableEntity, "The wallet balance can not go below zero."),
+			expectedAmount: 100,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockAdminUserRepository()
+			mockRepo.users[1] = &domain.User{ID: 1, Wallet: domain.Wallet{Amount: 100}}
+			service := usecases_admin.NewAdminUserService(mockRepo)
+
+			_, err := service.AdjustWallet(1, ports_admin.AdjustWalletRequest{Amount: tc.amount, Description: "Manual adjustment"})
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedAmount, mockRepo.users[1].Wallet.Amount)
+		})
+	}
+}
+
+func TestAdminUserService_ResetPassword(t *testing.T) {
+	mockRepo := NewMockAdminUserRepository()
+	mockRepo.users[1] = &domain.User{ID: 1, Email: "john@example.com"}
+	service := usecases_admin.NewAdminUserService(mockRepo)
+
+	user, err := service.ResetPassword(1, "token")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "john@example.com", user.Email)
+	assert.Len(t, mockRepo.resets, 1)
+	assert.Equal(t, "token", mockRepo.resets[0].Token)
+	assert.True(t, mockRepo.resets[0].ExpiresAt.After(time.Now()))
+	assert.NotNil(t, mockRepo.users[1].SessionsRevokedAt)
+
+	_, err = service.ResetPassword(2, "token")
+	assert.Equal(t, gorm.ErrRecordNotFound, err)
+}
+
+func TestAdminUserService_SyncRoles(t *testing.T) {
+	mockRepo := NewMockAdminUserRepository()
+	mockRepo.users[1] = &domain.User{ID: 1}
+	service := usecases_admin.NewAdminUserService(mockRepo)
+
+	user, err := service.SyncRoles(1, ports_admin.SyncUserRolesRequest{RoleIDs: []uint{1, 2}})
+
+	assert.NoError(t, err)
+	assert.Len(t, user.Roles, 2)
+
+	_, err = service.SyncRoles(2, ports_admin.SyncUserRolesRequest{RoleIDs: []uint{1}})
+	assert.Equal(t, gorm.ErrRecordNotFound, err)
+}
@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	"gcstatus/internal/usecases"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCheckInRepository struct {
+	streaks  map[uint]*domain.CheckInStreak
+	checkIns []domain.CheckIn
+	table    []domain.CheckInReward
+	wallets  map[uint]uint
+}
+
+func NewMockCheckInRepository() *MockCheckInRepository {
+	return &MockCheckInRepository{
+		streaks: make(map[uint]*domain.CheckInStreak),
+		wallets: make(map[uint]uint),
+	}
+}
+
+func (m *MockCheckInRepository) GetStreak(userID uint) (domain.CheckInStreak, error) {
+	if streak, exists := m.streaks[userID]; exists {
+		return *streak, nil
+	}
+
+	return domain.CheckInStreak{UserID: userID}, nil
+}
+
+func (m *MockCheckInRepository) GetRecent(userID uint, limit int) ([]domain.CheckIn, error) {
+	var checkIns []domain.CheckIn
+	for _, checkIn := range m.checkIns {
+		if checkIn.UserID == userID {
+			checkIns = append(checkIns, checkIn)
+		}
+	}
+
+	return checkIns, nil
+}
+
+func (m *MockCheckInRepository) GetRewardTable() ([]domain.CheckInReward, error) {
+	return m.table, nil
+}
+
+func (m *MockCheckInRepository) CheckIn(userID uint, day time.Time, table []domain.CheckInReward) (domain.CheckInResult, error) {
+	streak, _ := m.GetStreak(userID)
+	frozenDays, ok := streak.CheckIn(day)
+	if !ok {
+		return domain.CheckInResult{}, errors.NewHttpError(http.StatusConflict, "You have already checked in today.")
+	}
+
+	m.streaks[userID] = &streak
+
+	rewards := domain.CheckInRewardsFor(table, streak.Current)
+	checkIn := domain.CheckIn{UserID: userID, Day: day, Streak: streak.Current}
+	for _, reward := range rewards {
+		checkIn.Coins += reward.Coins
+		checkIn.Experience += reward.Experience
+	}
+
+	m.checkIns = append(m.checkIns, checkIn)
+
+	return domain.CheckInResult{CheckIn: checkIn, Streak: streak, FrozenDays: frozenDays, Rewards: rewards}, nil
+}
+
+func (m *MockCheckInRepository) BuyFreeze(userID uint, cost uint, limit uint) (domain.CheckInStreak, domain.Transaction, error) {
+	streak, _ := m.GetStreak(userID)
+	if streak.Freezes >= limit {
+		return streak, domain.Transaction{}, errors.NewHttpError(http.StatusUnprocessableEntity, "limit")
+	}
+
+	if m.wallets[userID] < cost {
+		return streak, domain.Transaction{}, errors.NewHttpError(http.StatusUnprocessableEntity, "Insufficient funds to purchase the streak freeze!")
+	}
+
+	m.wallets[userID] -= cost
+	streak.Freezes++
+	m.streaks[userID] = &streak
+
+	return streak, domain.Transaction{UserID: userID, Amount: cost, TransactionTypeID: domain.SubtractionTransactionTypeID}, nil
+}
+
+func TestMockCheckInRepository_CheckIn(t *testing.T) {
+	mockRepo := NewMockCheckInRepository()
+	mockRepo.table = []domain.CheckInReward{
+		{Streak: 1, Coins: 10},
+		{Streak: 2, Milestone: true, Experience: 50},
+	}
+
+	service := usecases.NewCheckInService(mockRepo, 100, 2)
+	user := &domain.User{ID: 1, Timezone: "America/Sao_Paulo"}
+
+	// 01:30 UTC is still the previous evening in São Paulo.
+	first, err := service.CheckIn(user, time.Date(2026, 10, 19, 1, 30, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), first.CheckIn.Day)
+	assert.Equal(t, uint(10), first.CheckIn.Coins)
+
+	_, err = service.CheckIn(user, time.Date(2026, 10, 19, 2, 30, 0, 0, time.UTC))
+	assert.Equal(t, errors.NewHttpError(http.StatusConflict, "You have already checked in today."), err)
+
+	second, err := service.CheckIn(user, time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, uint(2), second.Streak.Current)
+	assert.Equal(t, uint(10), second.CheckIn.Coins)
+	assert.Equal(t, uint(50), second.CheckIn.Experience)
+
+	streak, recent, checkedInToday, err := service.GetStatus(user, time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.True(t, checkedInToday)
+	assert.Len(t, recent, 2)
+	assert.Equal(t, uint(2), streak.Longest)
+}
+
+func TestMockCheckInRepository_BuyFreeze(t *testing.T) {
+	mockRepo := NewMockCheckInRepository()
+	mockRepo.wallets[1] = 250
+
+	service := usecases.NewCheckInService(mockRepo, 100, 2)
+
+	streak, transaction, err := service.BuyFreeze(1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), streak.Freezes)
+	assert.Equal(t, uint(100), transaction.Amount)
+
+	_, _, err = service.BuyFreeze(1)
+	assert.NoError(t, err)
+
+	_, _, err = service.BuyFreeze(1)
+	assert.Error(t, err)
+	assert.Equal(t, uint(50), mockRepo.wallets[1])
+}
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/usecases"
+	"gcstatus/internal/utils"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCrackRepository struct {
+	changes map[string][]domain.CrackStatusChange
+	cracks  []domain.Crack
+}
+
+func NewMockCrackRepository() *MockCrackRepository {
+	return &MockCrackRepository{
+		changes: make(map[string][]domain.CrackStatusChange),
+	}
+}
+
+func (m *MockCrackRepository) GetHistoryByGameSlug(slug string) ([]domain.CrackStatusChange, error) {
+	return m.changes[slug], nil
+}
+
+func (m *MockCrackRepository) FindForStats() ([]domain.Crack, error) {
+	return m.cracks, nil
+}
+
+func TestMockCrackRepository_GetHistoryByGameSlug(t *testing.T) {
+	mockRepo := NewMockCrackRepository()
+	mockRepo.changes["game-test"] = []domain.CrackStatusChange{
+		{ID: 1, Status: domain.UncrackedStatus, GameID: 1},
+		{ID: 2, Status: domain.CrackedStatus, PreviousStatus: domain.UncrackedStatus, GameID: 1},
+	}
+
+	testCases := map[string]struct {
+		slug        string
+		expectedLen int
+	}{
+		"game with timeline":    {slug: "game-test", expectedLen: 2},
+		"game without timeline": {slug: "unknown", expectedLen: 0},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			changes, err := mockRepo.GetHistoryByGameSlug(tc.slug)
+
+			assert.NoError(t, err)
+			assert.Len(t, changes, tc.expectedLen)
+		})
+	}
+}
+
+func TestMockCrackRepository_Stats(t *testing.T) {
+	mockRepo := NewMockCrackRepository()
+	denuvo := domain.Protection{ID: 1, Name: "Denuvo", Slug: "denuvo"}
+	steamStub := domain.Protection{ID: 2, Name: "Steam Stub", Slug: "steam-stub"}
+	empress := domain.Cracker{ID: 1, Name: "Empress", Slug: "empress"}
+	mockRepo.cracks = []domain.Crack{
+		{Status: domain.CrackedStatus, DaysToCrack: utils.UintPtr(40), Protection: denuvo, Cracker: empress},
+		{Status: domain.UncrackedStatus, Protection: denuvo},
+		{Status: domain.CrackedSameDay, DaysToCrack: utils.UintPtr(0), Protection: steamStub, Cracker: empress},
+	}
+
+	service := usecases.NewCrackService(mockRepo)
+
+	byProtection, err := service.StatsByProtection()
+	assert.NoError(t, err)
+	assert.Len(t, byProtection, 2)
+	assert.Equal(t, "Denuvo", byProtection[0].Name)
+	assert.Equal(t, uint(2), byProtection[0].Stats.Total)
+	assert.Equal(t, float64(50), byProtection[0].Stats.UncrackedPercentage)
+	assert.Equal(t, float64(40), *byProtection[0].Stats.MedianDaysToCrack)
+	assert.Equal(t, "Steam Stub", byProtection[1].Name)
+
+	byCracker, err := service.StatsByCracker()
+	assert.NoError(t, err)
+	assert.Len(t, byCracker, 1)
+	assert.Equal(t, uint(2), byCracker[0].Stats.Cracked)
+	assert.Equal(t, float64(20), *byCracker[0].Stats.MedianDaysToCrack)
+}
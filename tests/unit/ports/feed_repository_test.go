@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+	"gcstatus/internal/usecases"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockFeedRepository struct {
+	games   []domain.Game
+	follows map[uint][]uint
+	tokens  map[uint]*domain.FeedToken
+}
+
+func NewMockFeedRepository() *MockFeedRepository {
+	return &MockFeedRepository{
+		follows: make(map[uint][]uint),
+		tokens:  make(map[uint]*domain.FeedToken),
+	}
+}
+
+func (m *MockFeedRepository) UpcomingReleases(filters ports.ReleaseFeedFilters) ([]domain.Game, error) {
+	if filters.FollowedBy == 0 {
+		return m.games, nil
+	}
+
+	var games []domain.Game
+	for _, game := range m.games {
+		for _, gameID := range m.follows[filters.FollowedBy] {
+			if game.ID == gameID {
+				games = append(games, game)
+			}
+		}
+	}
+
+	return games, nil
+}
+
+func (m *MockFeedRepository) LatestGames(limit int) ([]domain.Game, error) {
+	return m.games, nil
+}
+
+func (m *MockFeedRepository) LatestDLCs(limit int) ([]domain.DLC, error) {
+	return nil, nil
+}
+
+func (m *MockFeedRepository) LatestCrackChanges(limit int) ([]domain.CrackStatusChange, error) {
+	return nil, nil
+}
+
+func (m *MockFeedRepository) FindToken(token string) (*domain.FeedToken, error) {
+	for _, feedToken := range m.tokens {
+		if feedToken.Token == token {
+			return feedToken, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockFeedRepository) FindTokenForUser(userID uint) (*domain.FeedToken, error) {
+	if feedToken, exists := m.tokens[userID]; exists {
+		return feedToken, nil
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockFeedRepository) SaveToken(token *domain.FeedToken) error {
+	m.tokens[token.UserID] = token
+	return nil
+}
+
+func TestMockFeedRepository_FollowedReleases(t *testing.T) {
+	mockRepo := NewMockFeedRepository()
+	mockRepo.games = []domain.Game{{ID: 1}, {ID: 2}, {ID: 3}}
+	mockRepo.follows[1] = []uint{2, 3}
+
+	service := usecases.NewFeedService(mockRepo)
+
+	feedToken, err := service.GetOrCreateToken(1)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, feedToken.Token)
+
+	sameToken, err := service.GetOrCreateToken(1)
+	assert.NoError(t, err)
+	assert.Equal(t, feedToken.Token, sameToken.Token)
+
+	games, err := service.FollowedReleases(feedToken.Token)
+	assert.NoError(t, err)
+	assert.Len(t, games, 2)
+
+	_, err = service.FollowedReleases("invalid")
+	assert.Error(t, err)
+}
+
+func TestMockFeedRepository_RotateToken(t *testing.T) {
+	mockRepo := NewMockFeedRepository()
+	service := usecases.NewFeedService(mockRepo)
+
+	feedToken, err := service.GetOrCreateToken(1)
+	assert.NoError(t, err)
+	previous := feedToken.Token
+
+	rotated, err := service.RotateToken(1)
+	assert.NoError(t, err)
+	assert.NotEqual(t, previous, rotated.Token)
+
+	_, err = service.FollowedReleases(previous)
+	assert.Error(t, err)
+}
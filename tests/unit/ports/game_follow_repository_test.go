@@ -0,0 +1,177 @@
+package tests
+
+import (
+	"errors"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+	"gcstatus/internal/usecases"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockGameFollowRepository struct {
+	follows map[uint]*domain.GameFollow
+	games   map[uint]bool
+}
+
+func NewMockGameFollowRepository() *MockGameFollowRepository {
+	return &MockGameFollowRepository{
+		follows: make(map[uint]*domain.GameFollow),
+		games:   map[uint]bool{1: true, 2: true},
+	}
+}
+
+func (m *MockGameFollowRepository) GetAllForUser(userID uint) ([]domain.GameFollow, error) {
+	var follows []domain.GameFollow
+	for _, follow := range m.follows {
+		if follow.UserID == userID {
+			follows = append(follows, *follow)
+		}
+	}
+
+	return follows, nil
+}
+
+func (m *MockGameFollowRepository) FindByID(id uint) (*domain.GameFollow, error) {
+	if follow, exists := m.follows[id]; exists {
+		return follow, nil
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockGameFollowRepository) FindForUser(gameID uint, userID uint) (*domain.GameFollow, error) {
+	for _, follow := range m.follows {
+		if follow.GameID == gameID && follow.UserID == userID {
+			return follow, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockGameFollowRepository) FindByToken(token string) (*domain.GameFollow, error) {
+	for _, follow := range m.follows {
+		if follow.Token == token {
+			return follow, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockGameFollowRepository) FindFollowersForEvent(gameID uint, event string) ([]domain.GameFollow, error) {
+	var follows []domain.GameFollow
+	for _, follow := range m.follows {
+		if follow.GameID == gameID && follow.Notifies(event) {
+			follows = append(follows, *follow)
+		}
+	}
+
+	return follows, nil
+}
+
+func (m *MockGameFollowRepository) GameExists(gameID uint) (bool, error) {
+	return m.games[gameID], nil
+}
+
+func (m *MockGameFollowRepository) Save(follow *domain.GameFollow) error {
+	if follow == nil {
+		return errors.New("invalid game follow data")
+	}
+	if follow.ID == 0 {
+		follow.ID = uint(len(m.follows) + 1)
+	}
+	m.follows[follow.ID] = follow
+	return nil
+}
+
+func (m *MockGameFollowRepository) Delete(id uint) error {
+	if _, exists := m.follows[id]; !exists {
+		return errors.New("game follow not found")
+	}
+	delete(m.follows, id)
+	return nil
+}
+
+func TestMockGameFollowRepository_FindFollowersForEvent(t *testing.T) {
+	mockRepo := NewMockGameFollowRepository()
+
+	for _, follow := range []*domain.GameFollow{
+		{ID: 1, NotifyRelease: true, NotifyCrack: true, GameID: 1, UserID: 1},
+		{ID: 2, NotifyRelease: true, GameID: 1, UserID: 2},
+		{ID: 3, NotifyCrack: true, GameID: 2, UserID: 1},
+	} {
+		if err := mockRepo.Save(follow); err != nil {
+			t.Fatalf("failed to save the game follow: %s", err.Error())
+		}
+	}
+
+	testCases := map[string]struct {
+		gameID      uint
+		event       string
+		expectedLen int
+	}{
+		"every follower opted in": {gameID: 1, event: domain.FollowReleaseEvent, expectedLen: 2},
+		"some followers opted in": {gameID: 1, event: domain.FollowCrackEvent, expectedLen: 1},
+		"no follower opted in":    {gameID: 2, event: domain.FollowPriceEvent, expectedLen: 0},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			follows, err := mockRepo.FindFollowersForEvent(tc.gameID, tc.event)
+
+			assert.NoError(t, err)
+			assert.Len(t, follows, tc.expectedLen)
+		})
+	}
+}
+
+func TestMockGameFollowRepository_Upsert(t *testing.T) {
+	mockRepo := NewMockGameFollowRepository()
+	service := usecases.NewGameFollowService(mockRepo)
+	disabled := false
+
+	follow, err := service.Upsert(1, ports.UpsertGameFollowRequest{GameID: 1, NotifyPrice: &disabled})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, follow.Token)
+	assert.True(t, follow.NotifyRelease)
+	assert.True(t, follow.NotifyCrack)
+	assert.False(t, follow.NotifyPrice)
+	assert.True(t, follow.NotifyDLC)
+
+	updated, err := service.Upsert(1, ports.UpsertGameFollowRequest{GameID: 1, NotifyDLC: &disabled})
+	assert.NoError(t, err)
+	assert.Equal(t, follow.ID, updated.ID)
+	assert.False(t, updated.NotifyPrice)
+	assert.False(t, updated.NotifyDLC)
+
+	_, err = service.Upsert(1, ports.UpsertGameFollowRequest{GameID: 999})
+	assert.Error(t, err)
+}
+
+func TestMockGameFollowRepository_Unsubscribe(t *testing.T) {
+	mockRepo := NewMockGameFollowRepository()
+	service := usecases.NewGameFollowService(mockRepo)
+
+	for _, follow := range []*domain.GameFollow{
+		{ID: 1, NotifyRelease: true, NotifyCrack: true, Token: "first", GameID: 1, UserID: 1},
+		{ID: 2, NotifyRelease: true, Token: "second", GameID: 2, UserID: 1},
+	} {
+		if err := mockRepo.Save(follow); err != nil {
+			t.Fatalf("failed to save the game follow: %s", err.Error())
+		}
+	}
+
+	assert.NoError(t, service.Unsubscribe("first", domain.FollowCrackEvent))
+	assert.True(t, mockRepo.follows[1].NotifyRelease)
+	assert.False(t, mockRepo.follows[1].NotifyCrack)
+
+	assert.NoError(t, service.Unsubscribe("second", ""))
+	assert.Nil(t, mockRepo.follows[2])
+
+	assert.Error(t, service.Unsubscribe("first", "unknown"))
+	assert.Error(t, service.Unsubscribe("missing", ""))
+}
@@ -103,7 +103,7 @@ func (m *MockGameRepository) FindByClassification(classification string, filtera
 	return games, nil
 }
 
-func (m *MockGameRepository) CalendarGames() ([]domain.Game, error) {
+func (m *MockGameRepository) CalendarGames(followedBy uint) ([]domain.Game, error) {
 	var games []domain.Game
 	now := time.Now().UTC().Truncate(24 * time.Hour)
 
@@ -595,7 +595,7 @@ func TestMockGameRepository_CalendarGames(t *testing.T) {
 
 			tt.mockBehavior(mockRepo)
 
-			games, _ := mockRepo.CalendarGames()
+			games, _ := mockRepo.CalendarGames(0)
 
 			if len(games) != tt.expectedCount {
 				t.Errorf("expected %d games, got %d", tt.expectedCount, len(games))
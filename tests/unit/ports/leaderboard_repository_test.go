@@ -0,0 +1,223 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+	"gcstatus/internal/usecases"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type MockLeaderboardStore struct {
+	boards map[string]map[uint]float64
+}
+
+func NewMockLeaderboardStore() *MockLeaderboardStore {
+	return &MockLeaderboardStore{boards: make(map[string]map[uint]float64)}
+}
+
+func (m *MockLeaderboardStore) Increment(key string, userID uint, points float64, expiresAt *time.Time) error {
+	if m.boards[key] == nil {
+		m.boards[key] = make(map[uint]float64)
+	}
+
+	m.boards[key][userID] += points
+
+	return nil
+}
+
+func (m *MockLeaderboardStore) Count(key string) (int64, error) {
+	return int64(len(m.boards[key])), nil
+}
+
+func (m *MockLeaderboardStore) sorted(key string) []domain.LeaderboardScore {
+	scores := make([]domain.LeaderboardScore, 0, len(m.boards[key]))
+	for userID, score := range m.boards[key] {
+		scores = append(scores, domain.LeaderboardScore{UserID: userID, Score: score})
+	}
+
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score == scores[j].Score {
+			return scores[i].UserID > scores[j].UserID
+		}
+
+		return scores[i].Score > scores[j].Score
+	})
+
+	for i := range scores {
+		scores[i].Rank = int64(i + 1)
+	}
+
+	return scores
+}
+
+func (m *MockLeaderboardStore) Range(key string, start int64, stop int64) ([]domain.LeaderboardScore, error) {
+	scores := m.sorted(key)
+	if start >= int64(len(scores)) {
+		return nil, nil
+	}
+
+	if stop >= int64(len(scores)) {
+		stop = int64(len(scores)) - 1
+	}
+
+	return scores[start : stop+1], nil
+}
+
+func (m *MockLeaderboardStore) Ranks(key string, userIDs []uint) ([]domain.LeaderboardScore, error) {
+	var ranks []domain.LeaderboardScore
+	for _, score := range m.sorted(key) {
+		for _, userID := range userIDs {
+			if score.UserID == userID {
+				ranks = append(ranks, score)
+			}
+		}
+	}
+
+	return ranks, nil
+}
+
+func (m *MockLeaderboardStore) Replace(key string, scores map[uint]float64, expiresAt *time.Time) error {
+	m.boards[key] = scores
+	return nil
+}
+
+type MockLeaderboardRepository struct {
+	blocked []uint
+	scores  map[string]map[uint]float64
+}
+
+func NewMockLeaderboardRepository() *MockLeaderboardRepository {
+	return &MockLeaderboardRepository{scores: make(map[string]map[uint]float64)}
+}
+
+func (m *MockLeaderboardRepository) GetUsersByIDs(ids []uint) ([]domain.User, error) {
+	users := make([]domain.User, 0, len(ids))
+	for _, id := range ids {
+		users = append(users, domain.User{ID: id})
+	}
+
+	return users, nil
+}
+
+func (m *MockLeaderboardRepository) GetBlockedUserIDs(now time.Time) ([]uint, error) {
+	return m.blocked, nil
+}
+
+func (m *MockLeaderboardRepository) GetScores(period domain.LeaderboardPeriod) (map[uint]float64, error) {
+	scores := make(map[uint]float64)
+	for userID, score := range m.scores[period.Key()] {
+		scores[userID] = score
+	}
+
+	return scores, nil
+}
+
+func userIDsOf(entries []domain.LeaderboardEntry) []uint {
+	ids := make([]uint, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.User.ID)
+	}
+
+	return ids
+}
+
+func newLeaderboardFixture(now time.Time) (*usecases.LeaderboardService, *MockLeaderboardStore, *MockLeaderboardRepository) {
+	store := NewMockLeaderboardStore()
+	repo := NewMockLeaderboardRepository()
+
+	// User 3 holds the second place but is blocked.
+	key := domain.NewLeaderboardPeriod(domain.LeaderboardCoins, domain.LeaderboardAllTime, now).Key()
+	for userID, score := range map[uint]float64{1: 500, 3: 400, 2: 300, 4: 200, 5: 100} {
+		store.Increment(key, userID, score, nil)
+	}
+	repo.blocked = []uint{3}
+
+	return usecases.NewLeaderboardService(store, repo), store, repo
+}
+
+func TestMockLeaderboardRepository_GetPage(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	service, _, _ := newLeaderboardFixture(now)
+
+	entries, query, total, err := service.GetPage(domain.LeaderboardCoins, ports.LeaderboardQuery{PerPage: 2}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+	assert.Equal(t, domain.LeaderboardAllTime, query.Window)
+	assert.Equal(t, []uint{1, 2}, userIDsOf(entries))
+	assert.Equal(t, int64(2), entries[1].Rank)
+
+	entries, _, _, err = service.GetPage(domain.LeaderboardCoins, ports.LeaderboardQuery{Page: 2, PerPage: 2}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{4, 5}, userIDsOf(entries))
+	assert.Equal(t, int64(3), entries[0].Rank)
+
+	_, _, _, err = service.GetPage("hearts", ports.LeaderboardQuery{}, now)
+	assert.Error(t, err)
+}
+
+func TestMockLeaderboardRepository_GetRank(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	service, _, _ := newLeaderboardFixture(now)
+
+	testCases := map[string]struct {
+		userID uint
+		rank   int64
+		ranked bool
+	}{
+		"above the blocked user": {userID: 1, rank: 1, ranked: true},
+		"below the blocked user": {userID: 4, rank: 3, ranked: true},
+		"blocked user":           {userID: 3},
+		"unranked user":          {userID: 9},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			entry, err := service.GetRank(domain.LeaderboardCoins, ports.LeaderboardQuery{}, tc.userID, now)
+			assert.NoError(t, err)
+
+			if !tc.ranked {
+				assert.Nil(t, entry)
+				return
+			}
+
+			assert.Equal(t, tc.rank, entry.Rank)
+			assert.Equal(t, tc.userID, entry.User.ID)
+		})
+	}
+}
+
+func TestMockLeaderboardRepository_GetAround(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	service, _, _ := newLeaderboardFixture(now)
+
+	entries, err := service.GetAround(domain.LeaderboardCoins, ports.LeaderboardQuery{Radius: 1}, 4, now)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{2, 4, 5}, userIDsOf(entries))
+	assert.Equal(t, []int64{2, 3, 4}, []int64{entries[0].Rank, entries[1].Rank, entries[2].Rank})
+
+	entries, err = service.GetAround(domain.LeaderboardCoins, ports.LeaderboardQuery{Radius: 1}, 1, now)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{1, 2}, userIDsOf(entries))
+
+	entries, err = service.GetAround(domain.LeaderboardCoins, ports.LeaderboardQuery{}, 3, now)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestMockLeaderboardRepository_Rebuild(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	service, store, repo := newLeaderboardFixture(now)
+
+	weekly := domain.NewLeaderboardPeriod(domain.LeaderboardTitles, domain.LeaderboardWeekly, now).Key()
+	repo.scores[weekly] = map[uint]float64{1: 2, 3: 5}
+
+	assert.NoError(t, service.Rebuild(now))
+	assert.Equal(t, map[uint]float64{1: 2}, store.boards[weekly])
+
+	allTime := domain.NewLeaderboardPeriod(domain.LeaderboardCoins, domain.LeaderboardAllTime, now).Key()
+	assert.Empty(t, store.boards[allTime])
+}
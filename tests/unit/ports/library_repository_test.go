@@ -0,0 +1,386 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+	"gcstatus/internal/usecases"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockLibraryRepository struct {
+	entries map[uint]*domain.Libraryable
+	tracked map[string]bool
+}
+
+func NewMockLibraryRepository() *MockLibraryRepository {
+	return &MockLibraryRepository{
+		entries: make(map[uint]*domain.Libraryable),
+	}
+}
+
+func (m *MockLibraryRepository) GetAllForUser(userID uint, status string, onlyPublic bool) ([]domain.Libraryable, error) {
+	var entries []domain.Libraryable
+	for _, entry := range m.entries {
+		if entry.UserID != userID {
+			continue
+		}
+
+		if status != "" && entry.Status != status {
+			continue
+		}
+
+		if onlyPublic && entry.Private {
+			continue
+		}
+
+		entries = append(entries, *entry)
+	}
+
+	return entries, nil
+}
+
+func (m *MockLibraryRepository) FindByID(id uint) (*domain.Libraryable, error) {
+	if entry, exists := m.entries[id]; exists {
+		return entry, nil
+	}
+
+	return nil, errors.New("library entry not found")
+}
+
+func (m *MockLibraryRepository) FindForUser(libraryableID uint, libraryableType string, userID uint) (*domain.Libraryable, error) {
+	for _, entry := range m.entries {
+		if entry.LibraryableID == libraryableID && entry.LibraryableType == libraryableType && entry.UserID == userID {
+			return entry, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockLibraryRepository) LibraryableExists(libraryableID uint, libraryableType string) (bool, error) {
+	return libraryableID > 0 && (libraryableType == domain.LibraryableTypeGames || libraryableType == domain.LibraryableTypeDLCs), nil
+}
+
+func (m *MockLibraryRepository) HasTrackedAction(userID uint, actionKey string, libraryableID uint, libraryableType string) (bool, error) {
+	return m.tracked[fmt.Sprintf("%d:%s:%s:%d", userID, actionKey, libraryableType, libraryableID)], nil
+}
+
+func (m *MockLibraryRepository) Save(libraryable *domain.Libraryable) error {
+	if libraryable == nil {
+		return errors.New("invalid library entry data")
+	}
+	m.entries[libraryable.ID] = libraryable
+	return nil
+}
+
+func (m *MockLibraryRepository) Delete(id uint) error {
+	if _, exists := m.entries[id]; !exists {
+		return errors.New("library entry not found")
+	}
+	delete(m.entries, id)
+	return nil
+}
+
+func (m *MockLibraryRepository) GetStatsForUser(userID uint) (domain.LibraryStats, error) {
+	var stats domain.LibraryStats
+	for _, entry := range m.entries {
+		if entry.UserID != userID {
+			continue
+		}
+
+		stats.Total++
+		if entry.HoursPlayed != nil {
+			stats.HoursPlayed += *entry.HoursPlayed
+		}
+
+		switch entry.Status {
+		case domain.LibraryWishlist:
+			stats.Wishlist++
+		case domain.LibraryPlaying:
+			stats.Playing++
+		case domain.LibraryCompleted:
+			stats.Completed++
+		case domain.LibraryDropped:
+			stats.Dropped++
+		case domain.LibraryBacklog:
+			stats.Backlog++
+		}
+	}
+
+	return stats, nil
+}
+
+func TestMockLibraryRepository_Save(t *testing.T) {
+	mockRepo := NewMockLibraryRepository()
+
+	testCases := map[string]struct {
+		input         *domain.Libraryable
+		expectedError bool
+	}{
+		"valid input": {
+			input: &domain.Libraryable{
+				ID:              1,
+				Status:          domain.LibraryPlaying,
+				LibraryableID:   1,
+				LibraryableType: "games",
+				UserID:          1,
+			},
+			expectedError: false,
+		},
+		"nil input": {
+			input:         nil,
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := mockRepo.Save(tc.input)
+
+			if tc.expectedError {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if mockRepo.entries[tc.input.ID] == nil {
+					t.Fatalf("expected library entry to be saved, but it wasn't")
+				}
+			}
+		})
+	}
+}
+
+func TestMockLibraryRepository_GetAllForUser(t *testing.T) {
+	mockRepo := NewMockLibraryRepository()
+
+	entries := []*domain.Libraryable{
+		{ID: 1, Status: domain.LibraryPlaying, LibraryableID: 1, LibraryableType: "games", UserID: 1},
+		{ID: 2, Status: domain.LibraryCompleted, LibraryableID: 2, LibraryableType: "games", UserID: 1, Private: true},
+		{ID: 3, Status: domain.LibraryPlaying, LibraryableID: 1, LibraryableType: "games", UserID: 2},
+	}
+
+	for _, entry := range entries {
+		if err := mockRepo.Save(entry); err != nil {
+			t.Fatalf("failed to save the library entry: %s", err.Error())
+		}
+	}
+
+	testCases := map[string]struct {
+		userID      uint
+		status      string
+		onlyPublic  bool
+		expectedLen int
+	}{
+		"all entries": {
+			userID:      1,
+			expectedLen: 2,
+		},
+		"filtered by status": {
+			userID:      1,
+			status:      domain.LibraryCompleted,
+			expectedLen: 1,
+		},
+		"only public entries": {
+			userID:      1,
+			onlyPublic:  true,
+			expectedLen: 1,
+		},
+		"user without entries": {
+			userID:      3,
+			expectedLen: 0,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result, err := mockRepo.GetAllForUser(tc.userID, tc.status, tc.onlyPublic)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if len(result) != tc.expectedLen {
+				t.Fatalf("expected %d entries, got %d", tc.expectedLen, len(result))
+			}
+		})
+	}
+}
+
+func TestMockLibraryRepository_FindForUser(t *testing.T) {
+	mockRepo := NewMockLibraryRepository()
+
+	if err := mockRepo.Save(&domain.Libraryable{
+		ID:              1,
+		Status:          domain.LibraryWishlist,
+		LibraryableID:   1,
+		LibraryableType: "games",
+		UserID:          1,
+	}); err != nil {
+		t.Fatalf("failed to save the library entry: %s", err.Error())
+	}
+
+	testCases := map[string]struct {
+		libraryableID   uint
+		libraryableType string
+		userID          uint
+		expectedError   bool
+	}{
+		"valid payload": {
+			libraryableID:   1,
+			libraryableType: "games",
+			userID:          1,
+			expectedError:   false,
+		},
+		"entry of another user": {
+			libraryableID:   1,
+			libraryableType: "games",
+			userID:          2,
+			expectedError:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result, err := mockRepo.FindForUser(tc.libraryableID, tc.libraryableType, tc.userID)
+
+			if tc.expectedError {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if result != nil {
+					t.Fatalf("expected result to be nil, got %v", result)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if result == nil || result.LibraryableID != tc.libraryableID || result.UserID != tc.userID {
+					t.Fatalf("expected library entry %v, got %v", tc, result)
+				}
+			}
+		})
+	}
+}
+
+func TestMockLibraryRepository_GetStatsForUser(t *testing.T) {
+	mockRepo := NewMockLibraryRepository()
+	hoursPlayed := uint(20)
+
+	entries := []*domain.Libraryable{
+		{ID: 1, Status: domain.LibraryPlaying, HoursPlayed: &hoursPlayed, UserID: 1},
+		{ID: 2, Status: domain.LibraryCompleted, HoursPlayed: &hoursPlayed, UserID: 1},
+		{ID: 3, Status: domain.LibraryBacklog, UserID: 1},
+	}
+
+	for _, entry := range entries {
+		if err := mockRepo.Save(entry); err != nil {
+			t.Fatalf("failed to save the library entry: %s", err.Error())
+		}
+	}
+
+	stats, err := mockRepo.GetStatsForUser(1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := domain.LibraryStats{Total: 3, Playing: 1, Completed: 1, Backlog: 1, HoursPlayed: 40}
+	if stats != expected {
+		t.Fatalf("expected stats %+v, got %+v", expected, stats)
+	}
+}
+
+func TestMockLibraryRepository_Delete(t *testing.T) {
+	mockRepo := NewMockLibraryRepository()
+
+	if err := mockRepo.Save(&domain.Libraryable{
+		ID:              1,
+		Status:          domain.LibraryDropped,
+		LibraryableID:   1,
+		LibraryableType: "games",
+		UserID:          1,
+	}); err != nil {
+		t.Fatalf("failed to save the library entry: %s", err.Error())
+	}
+
+	testCases := map[string]struct {
+		id            uint
+		expectedError bool
+	}{
+		"valid ID": {
+			id:            1,
+			expectedError: false,
+		},
+		"invalid ID": {
+			id:            999,
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := mockRepo.Delete(tc.id)
+
+			if tc.expectedError {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if mockRepo.entries[tc.id] != nil {
+					t.Fatalf("expected library entry to be deleted, but it wasn't")
+				}
+			}
+		})
+	}
+}
+
+func TestLibraryService_UpsertActionKeys(t *testing.T) {
+	completed := domain.LibraryCompleted
+
+	testCases := map[string]struct {
+		tracked            map[string]bool
+		expectedActionKeys []string
+	}{
+		"first time in the library": {
+			expectedActionKeys: []string{domain.AddToLibraryRequirementKey, domain.CompleteGameRequirementKey},
+		},
+		"added back after removal": {
+			tracked: map[string]bool{
+				"1:" + domain.AddToLibraryRequirementKey + ":games:1": true,
+				"1:" + domain.CompleteGameRequirementKey + ":games:1": true,
+			},
+		},
+		"completed for the first time after removal": {
+			tracked: map[string]bool{
+				"1:" + domain.AddToLibraryRequirementKey + ":games:1": true,
+			},
+			expectedActionKeys: []string{domain.CompleteGameRequirementKey},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockLibraryRepository()
+			mockRepo.tracked = tc.tracked
+			service := usecases.NewLibraryService(mockRepo)
+
+			_, actionKeys, err := service.Upsert(1, ports.UpsertLibraryRequest{
+				LibraryableID:   1,
+				LibraryableType: domain.LibraryableTypeGames,
+				Status:          completed,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedActionKeys, actionKeys)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/usecases"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type MockMultiplierRepository struct {
+	events   []domain.MultiplierEvent
+	boosters []domain.UserBooster
+}
+
+func (m *MockMultiplierRepository) GetActive(userID uint, at time.Time) ([]domain.MultiplierEvent, []domain.UserBooster, error) {
+	var boosters []domain.UserBooster
+	for _, booster := range m.boosters {
+		if booster.UserID == userID && booster.IsActive(at) {
+			boosters = append(boosters, booster)
+		}
+	}
+
+	var events []domain.MultiplierEvent
+	for _, event := range m.events {
+		if event.IsActive(at) {
+			events = append(events, event)
+		}
+	}
+
+	return events, boosters, nil
+}
+
+func (m *MockMultiplierRepository) GetPurchasableBoosters() ([]domain.Booster, error) {
+	return nil, nil
+}
+
+func (m *MockMultiplierRepository) BuyBooster(userID uint, boosterID uint) (domain.UserBooster, domain.Transaction, error) {
+	return domain.UserBooster{}, domain.Transaction{}, nil
+}
+
+func TestMultiplierService_GetMultipliers(t *testing.T) {
+	now := time.Now()
+	repo := &MockMultiplierRepository{
+		events: []domain.MultiplierEvent{
+			{ID: 1, Scope: domain.BoosterScopeExperience, Multiplier: 2, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+			{ID: 2, Scope: domain.BoosterScopeCoins, Multiplier: 3, ActionKey: domain.CompleteMissionActionKey, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+		},
+		boosters: []domain.UserBooster{
+			{ID: 1, UserID: 1, Scope: domain.BoosterScopeExperience, Multiplier: 1.5, ExpiresAt: now.Add(time.Hour)},
+			{ID: 2, UserID: 2, Scope: domain.BoosterScopeCoins, Multiplier: 2, ExpiresAt: now.Add(time.Hour)},
+		},
+	}
+	service := usecases.NewMultiplierService(repo)
+
+	multipliers, err := service.GetMultipliers(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, multipliers.Experience)
+	assert.Equal(t, 1.0, multipliers.Coins)
+	assert.Len(t, multipliers.Events, 2)
+	assert.Len(t, multipliers.Boosters, 1)
+}
@@ -42,6 +42,17 @@ func (m *MockPasswordResetRepository) DeletePasswordResetByID(id uint) error {
 	return nil
 }
 
+func (m *MockPasswordResetRepository) DeleteExpiredPasswordResets(before time.Time) (int64, error) {
+	var deleted int64
+	for id, pr := range m.passwordResets {
+		if pr.ExpiresAt.Before(before) {
+			delete(m.passwordResets, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
 func TestMockPasswordResetRepository_CreatePasswordReset(t *testing.T) {
 	mockRepo := NewMockPasswordResetRepository()
 	fixedTime := time.Now()
@@ -0,0 +1,217 @@
+package tests
+
+import (
+	"errors"
+	"gcstatus/internal/domain"
+	"testing"
+)
+
+type MockPriceAlertRepository struct {
+	alerts map[uint]*domain.PriceAlert
+	games  map[uint]bool
+}
+
+func NewMockPriceAlertRepository() *MockPriceAlertRepository {
+	return &MockPriceAlertRepository{
+		alerts: make(map[uint]*domain.PriceAlert),
+		games:  map[uint]bool{1: true, 2: true},
+	}
+}
+
+func (m *MockPriceAlertRepository) GetAllForUser(userID uint) ([]domain.PriceAlert, error) {
+	var alerts []domain.PriceAlert
+	for _, alert := range m.alerts {
+		if alert.UserID == userID {
+			alerts = append(alerts, *alert)
+		}
+	}
+
+	return alerts, nil
+}
+
+func (m *MockPriceAlertRepository) FindByID(id uint) (*domain.PriceAlert, error) {
+	if alert, exists := m.alerts[id]; exists {
+		return alert, nil
+	}
+
+	return nil, errors.New("price alert not found")
+}
+
+func (m *MockPriceAlertRepository) FindForUser(gameID uint, userID uint) (*domain.PriceAlert, error) {
+	for _, alert := range m.alerts {
+		if alert.GameID == gameID && alert.UserID == userID {
+			return alert, nil
+		}
+	}
+
+	return nil, errors.New("price alert not found")
+}
+
+func (m *MockPriceAlertRepository) GameExists(gameID uint) (bool, error) {
+	return m.games[gameID], nil
+}
+
+func (m *MockPriceAlertRepository) Save(alert *domain.PriceAlert) error {
+	if alert == nil {
+		return errors.New("invalid price alert data")
+	}
+	m.alerts[alert.ID] = alert
+	return nil
+}
+
+func (m *MockPriceAlertRepository) Delete(id uint) error {
+	if _, exists := m.alerts[id]; !exists {
+		return errors.New("price alert not found")
+	}
+	delete(m.alerts, id)
+	return nil
+}
+
+func TestMockPriceAlertRepository_Save(t *testing.T) {
+	mockRepo := NewMockPriceAlertRepository()
+
+	testCases := map[string]struct {
+		input         *domain.PriceAlert
+		expectedError bool
+	}{
+		"valid input": {
+			input: &domain.PriceAlert{
+				ID:          1,
+				AnyDiscount: true,
+				Active:      true,
+				GameID:      1,
+				UserID:      1,
+			},
+			expectedError: false,
+		},
+		"nil input": {
+			input:         nil,
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := mockRepo.Save(tc.input)
+
+			if tc.expectedError {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if mockRepo.alerts[tc.input.ID] == nil {
+					t.Fatalf("expected price alert to be saved, but it wasn't")
+				}
+			}
+		})
+	}
+}
+
+func TestMockPriceAlertRepository_GetAllForUser(t *testing.T) {
+	mockRepo := NewMockPriceAlertRepository()
+
+	for _, alert := range []*domain.PriceAlert{
+		{ID: 1, AnyDiscount: true, GameID: 1, UserID: 1},
+		{ID: 2, AnyDiscount: true, GameID: 2, UserID: 1},
+		{ID: 3, AnyDiscount: true, GameID: 1, UserID: 2},
+	} {
+		if err := mockRepo.Save(alert); err != nil {
+			t.Fatalf("failed to save the price alert: %s", err.Error())
+		}
+	}
+
+	testCases := map[string]struct {
+		userID      uint
+		expectedLen int
+	}{
+		"user with alerts":    {userID: 1, expectedLen: 2},
+		"user without alerts": {userID: 3, expectedLen: 0},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			alerts, err := mockRepo.GetAllForUser(tc.userID)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if len(alerts) != tc.expectedLen {
+				t.Fatalf("expected %d alerts, got %d", tc.expectedLen, len(alerts))
+			}
+		})
+	}
+}
+
+func TestMockPriceAlertRepository_FindForUser(t *testing.T) {
+	mockRepo := NewMockPriceAlertRepository()
+
+	if err := mockRepo.Save(&domain.PriceAlert{ID: 1, AnyDiscount: true, GameID: 1, UserID: 1}); err != nil {
+		t.Fatalf("failed to save the price alert: %s", err.Error())
+	}
+
+	testCases := map[string]struct {
+		gameID        uint
+		userID        uint
+		expectedError bool
+	}{
+		"valid payload":         {gameID: 1, userID: 1, expectedError: false},
+		"alert of another user": {gameID: 1, userID: 2, expectedError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			alert, err := mockRepo.FindForUser(tc.gameID, tc.userID)
+
+			if tc.expectedError {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if alert.GameID != tc.gameID || alert.UserID != tc.userID {
+					t.Fatalf("expected price alert %v, got %v", tc, alert)
+				}
+			}
+		})
+	}
+}
+
+func TestMockPriceAlertRepository_Delete(t *testing.T) {
+	mockRepo := NewMockPriceAlertRepository()
+
+	if err := mockRepo.Save(&domain.PriceAlert{ID: 1, AnyDiscount: true, GameID: 1, UserID: 1}); err != nil {
+		t.Fatalf("failed to save the price alert: %s", err.Error())
+	}
+
+	testCases := map[string]struct {
+		id            uint
+		expectedError bool
+	}{
+		"valid ID":   {id: 1, expectedError: false},
+		"invalid ID": {id: 999, expectedError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := mockRepo.Delete(tc.id)
+
+			if tc.expectedError {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if mockRepo.alerts[tc.id] != nil {
+					t.Fatalf("expected price alert to be deleted, but it wasn't")
+				}
+			}
+		})
+	}
+}
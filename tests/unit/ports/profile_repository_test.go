@@ -63,6 +63,16 @@ func (m *MockProfileRepository) UpdateSocials(profileID uint, request ports.Upda
 	return nil
 }
 
+func (m *MockProfileRepository) UpdateRegion(profileID uint, region string) error {
+	profile, exists := m.profiles[profileID]
+	if !exists {
+		return errors.New("profile not found")
+	}
+	profile.Region = region
+	m.profiles[profileID] = profile
+	return nil
+}
+
 func TestMockProfileRepository_UpdatePicture(t *testing.T) {
 	mockRepo := NewMockProfileRepository()
 
@@ -175,3 +185,48 @@ func TestMockProfileRepository_UpdateSocials(t *testing.T) {
 		})
 	}
 }
+
+func TestMockProfileRepository_UpdateRegion(t *testing.T) {
+	mockRepo := NewMockProfileRepository()
+
+	mockRepo.profiles[1] = &domain.Profile{
+		ID:     1,
+		Region: "us",
+	}
+
+	testCases := map[string]struct {
+		profileID     uint
+		region        string
+		expectedError bool
+	}{
+		"valid profile": {
+			profileID:     1,
+			region:        "br",
+			expectedError: false,
+		},
+		"invalid profile": {
+			profileID:     999,
+			region:        "br",
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := mockRepo.UpdateRegion(tc.profileID, tc.region)
+
+			if tc.expectedError {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if mockRepo.profiles[tc.profileID].Region != tc.region {
+					t.Fatalf("expected region to be updated to %s, but got %s", tc.region, mockRepo.profiles[tc.profileID].Region)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	"gcstatus/internal/ports"
+	"gcstatus/internal/usecases"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type MockQuizRepository struct {
+	quizzes  map[uint]domain.Quiz
+	attempts []domain.QuizAttempt
+}
+
+var _ ports.QuizRepository = &MockQuizRepository{}
+
+func (m *MockQuizRepository) GetOpen(gameID *uint, at time.Time) ([]domain.Quiz, error) {
+	var quizzes []domain.Quiz
+	for _, quiz := range m.quizzes {
+		if quiz.IsOpen(at) && (gameID == nil || (quiz.GameID != nil && *quiz.GameID == *gameID)) {
+			quizzes = append(quizzes, quiz)
+		}
+	}
+	return quizzes, nil
+}
+
+func (m *MockQuizRepository) FindByID(id uint) (domain.Quiz, error) {
+	quiz, exists := m.quizzes[id]
+	if !exists {
+		return domain.Quiz{}, gorm.ErrRecordNotFound
+	}
+	return quiz, nil
+}
+
+func (m *MockQuizRepository) GetAttempts(userID uint, quizIDs []uint) ([]domain.QuizAttempt, error) {
+	var attempts []domain.QuizAttempt
+	for _, attempt := range m.attempts {
+		for _, quizID := range quizIDs {
+			if attempt.UserID == userID && attempt.QuizID == quizID {
+				attempts = append(attempts, attempt)
+			}
+		}
+	}
+	return attempts, nil
+}
+
+func (m *MockQuizRepository) CreateAttempt(attempt *domain.QuizAttempt) error {
+	attempt.ID = uint(len(m.attempts) + 1)
+	m.attempts = append(m.attempts, *attempt)
+	return nil
+}
+
+func (m *MockQuizRepository) GetResults(quiz domain.Quiz) (domain.QuizResults, error) {
+	results := domain.QuizResults{Quiz: quiz, Votes: map[uint]uint{}}
+	for _, attempt := range m.attempts {
+		if attempt.QuizID != quiz.ID {
+			continue
+		}
+
+		results.Attempts++
+		for _, answer := range attempt.Answers {
+			results.Votes[answer.OptionID]++
+		}
+	}
+	return results, nil
+}
+
+func newMockQuizRepository(now time.Time) *MockQuizRepository {
+	questions := []domain.QuizQuestion{
+		{ID: 1, Question: "Who develops it?", Options: []domain.QuizOption{
+			{ID: 1, Text: "Rockstar", Correct: true},
+			{ID: 2, Text: "Valve"},
+		}},
+	}
+
+	return &MockQuizRepository{quizzes: map[uint]domain.Quiz{
+		1: {ID: 1, Kind: domain.QuizKindQuiz, Title: "Open", OpensAt: now.Add(-time.Hour), ClosesAt: now.Add(time.Hour), Questions: questions},
+		2: {ID: 2, Kind: domain.QuizKindQuiz, Title: "Closed", OpensAt: now.Add(-2 * time.Hour), ClosesAt: now.Add(-time.Hour), Questions: questions},
+	}}
+}
+
+func TestQuizService_Answer(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	answer := func(questionID uint, optionID uint) ports.AnswerQuizRequest {
+		return ports.AnswerQuizRequest{Answers: []ports.QuizAnswerRequest{{QuestionID: questionID, OptionID: optionID}}}
+	}
+
+	testCases := map[string]struct {
+		quizID        uint
+		request       ports.AnswerQuizRequest
+		answeredFirst bool
+		expectedScore uint
+		expectedErr   error
+	}{
+		"right answer": {
+			quizID:        1,
+			request:       answer(1, 1),
+			expectedScore: 100,
+		},
+		"wrong answer": {
+			quizID:  1,
+			request: answer(1, 2),
+		},
+		"closed quiz": {
+			quizID:      2,
+			request:     answer(1, 1),
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "The quiz is not open to answers."),
+		},
+		"already answered": {
+			quizID:        1,
+			request:       answer(1, 1),
+			answeredFirst: true,
+			expectedErr:   errors.NewHttpError(http.StatusConflict, "You have already answered this quiz."),
+		},
+		"option of no question": {
+			quizID:      1,
+			request:     answer(1, 9),
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "The option 9 is not an answer to the question 1."),
+		},
+		"question answered twice": {
+			quizID: 1,
+			request: ports.AnswerQuizRequest{Answers: []ports.QuizAnswerRequest{
+				{QuestionID: 1, OptionID: 1},
+				{QuestionID: 1, OptionID: 2},
+			}},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "Every question can only be answered once."),
+		},
+		"quiz not found": {
+			quizID:      9,
+			request:     answer(1, 1),
+			expectedErr: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := newMockQuizRepository(now)
+			service := usecases.NewQuizService(mockRepo)
+
+			if tc.answeredFirst {
+				_, _, err := service.Answer(1, tc.quizID, tc.request, now)
+				assert.NoError(t, err)
+			}
+
+			_, attempt, err := service.Answer(1, tc.quizID, tc.request, now)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.NotZero(t, attempt.ID)
+				assert.Equal(t, tc.expectedScore, attempt.Score)
+			}
+		})
+	}
+}
+
+func TestQuizService_GetResults(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	mockRepo := newMockQuizRepository(now)
+	service := usecases.NewQuizService(mockRepo)
+
+	_, err := service.GetResults(1, 1, now)
+	assert.Equal(t, errors.NewHttpError(http.StatusForbidden, "The results are shown once you answer the quiz or it closes."), err)
+
+	_, _, err = service.Answer(1, 1, ports.AnswerQuizRequest{Answers: []ports.QuizAnswerRequest{{QuestionID: 1, OptionID: 2}}}, now)
+	assert.NoError(t, err)
+
+	results, err := service.GetResults(1, 1, now)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), results.Attempts)
+	assert.Equal(t, uint(1), results.Votes[2])
+
+	results, err = service.GetResults(2, 2, now)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(0), results.Attempts)
+}
+
+func TestQuizService_GetOpen(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	mockRepo := newMockQuizRepository(now)
+	mockRepo.attempts = []domain.QuizAttempt{{ID: 1, QuizID: 1, UserID: 1}}
+	service := usecases.NewQuizService(mockRepo)
+
+	quizzes, attempts, err := service.GetOpen(1, nil, now)
+
+	assert.NoError(t, err)
+	assert.Len(t, quizzes, 1)
+	assert.Equal(t, uint(1), quizzes[0].ID)
+	assert.Contains(t, attempts, uint(1))
+}
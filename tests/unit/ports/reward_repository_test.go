@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/errors"
+	"gcstatus/internal/usecases"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type MockRewardRepository struct {
+	grants      map[string]bool
+	wallets     map[uint]uint
+	titles      map[uint][]uint
+	multipliers domain.Multipliers
+}
+
+func NewMockRewardRepository() *MockRewardRepository {
+	return &MockRewardRepository{
+		grants:  make(map[string]bool),
+		wallets: make(map[uint]uint),
+		titles:  make(map[uint][]uint),
+	}
+}
+
+func (m *MockRewardRepository) GrantRewards(grant domain.RewardGrant, rewards []domain.Reward) (domain.GrantedRewards, error) {
+	granted := domain.GrantedRewards{Grant: grant, Multipliers: m.multipliers}
+	userID := grant.UserID
+
+	if m.grants[grant.Key] {
+		granted.Duplicate = true
+		return granted, nil
+	}
+
+	m.grants[grant.Key] = true
+
+	for _, reward := range m.multipliers.Apply(rewards) {
+		switch reward.RewardableType {
+		case domain.RewardableTypeCoins:
+			m.wallets[userID] += reward.Quantity()
+			granted.Coins += reward.Quantity()
+		case domain.RewardableTypeExperience:
+			granted.Experience += reward.Quantity()
+		case domain.RewardableTypeTitles:
+			m.titles[userID] = append(m.titles[userID], reward.RewardableID)
+			granted.TitleIDs = append(granted.TitleIDs, reward.RewardableID)
+		}
+	}
+
+	return granted, nil
+}
+
+func TestRewardService_GrantRewards(t *testing.T) {
+	testCases := map[string]struct {
+		rewards       []domain.Reward
+		multipliers   domain.Multipliers
+		repeat        bool
+		expectedErr   error
+		expectedCoins uint
+		duplicate     bool
+	}{
+		"grants coins, experience and titles": {
+			rewards:       append(domain.AmountRewards(30, 120), domain.Reward{RewardableType: domain.RewardableTypeTitles, RewardableID: 2}),
+			expectedCoins: 30,
+		},
+		"coins are multiplied": {
+			rewards:       domain.AmountRewards(30, 120),
+			multipliers:   domain.Multipliers{Experience: 2, Coins: 1.5},
+			expectedCoins: 45,
+		},
+		"same key is granted once": {
+			rewards:       domain.AmountRewards(30, 0),
+			repeat:        true,
+			expectedCoins: 30,
+			duplicate:     true,
+		},
+		"invalid reward type": {
+			rewards:     []domain.Reward{{RewardableType: "badges", RewardableID: 1}},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, `The reward type "badges" is not valid.`),
+		},
+		"entity reward without entity": {
+			rewards:     []domain.Reward{{RewardableType: domain.RewardableTypeItems, Amount: 3}},
+			expectedErr: errors.NewHttpError(http.StatusUnprocessableEntity, "The items reward is missing the rewarded entity."),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			repo := NewMockRewardRepository()
+			repo.multipliers = tc.multipliers
+			service := usecases.NewRewardService(repo)
+			grant := domain.RewardGrant{UserID: 1, Key: "missions:1:message", Reason: "mission Collect", ActionKey: domain.CompleteMissionActionKey}
+
+			granted, err := service.GrantRewards(grant, tc.rewards)
+			if tc.repeat {
+				granted, err = service.GrantRewards(grant, tc.rewards)
+			}
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.duplicate, granted.Duplicate)
+			assert.Equal(t, tc.expectedCoins, repo.wallets[1])
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"gcstatus/internal/domain"
 	"gcstatus/internal/ports"
+	"gcstatus/internal/usecases"
 	"testing"
 	"time"
 
@@ -16,6 +17,10 @@ type MockTaskRepository struct {
 	TitleProgress       map[uint]*domain.TitleProgress
 	MissionProgress     map[uint]*domain.MissionProgress
 	UserTitles          map[uint]map[uint]bool
+	Actions             []domain.UserAction
+	TargetAttributes    map[uint]map[string][]string
+	Policy              *domain.ActionPolicy
+	Decisions           []domain.ActionDecision
 }
 
 var _ ports.TaskRepository = &MockTaskRepository{}
@@ -27,6 +32,7 @@ func NewMockTaskRepository() *MockTaskRepository {
 		TitleProgress:       make(map[uint]*domain.TitleProgress),
 		MissionProgress:     make(map[uint]*domain.MissionProgress),
 		UserTitles:          make(map[uint]map[uint]bool),
+		TargetAttributes:    make(map[uint]map[string][]string),
 	}
 }
 
@@ -102,6 +108,67 @@ func (m *MockTaskRepository) AwardTitleToUser(userID uint, titleID uint) error {
 	return nil
 }
 
+func (m *MockTaskRepository) RecordAction(action *domain.UserAction) error {
+	action.ID = uint(len(m.Actions) + 1)
+	m.Actions = append(m.Actions, *action)
+	return nil
+}
+
+func (m *MockTaskRepository) GetUserActions(userID uint, actionKey string, since *time.Time, until *time.Time) ([]domain.UserAction, error) {
+	var actions []domain.UserAction
+	for _, action := range m.Actions {
+		if action.UserID != userID || action.Key != actionKey {
+			continue
+		}
+
+		if since != nil && action.OccurredAt.Before(*since) {
+			continue
+		}
+
+		if until != nil && !action.OccurredAt.Before(*until) {
+			continue
+		}
+
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+func (m *MockTaskRepository) GetTargetAttributes(targetType string, targetID uint) (map[string][]string, error) {
+	return m.TargetAttributes[targetID], nil
+}
+
+func (m *MockTaskRepository) GetActionPolicy(actionKey string) (*domain.ActionPolicy, error) {
+	if m.Policy == nil || m.Policy.ActionKey != actionKey {
+		return nil, nil
+	}
+	return m.Policy, nil
+}
+
+func (m *MockTaskRepository) DecideAction(action domain.UserAction, policy domain.ActionPolicy) (domain.ActionDecision, error) {
+	var counted []domain.ActionDecision
+	targetSeen := false
+	for _, decision := range m.Decisions {
+		if decision.UserID != action.UserID || decision.ActionKey != action.Key || decision.Weight <= 0 {
+			continue
+		}
+
+		if !decision.OccurredAt.Before(policy.Since(action.OccurredAt)) {
+			counted = append(counted, decision)
+		}
+
+		if policy.OncePerTarget && action.TargetType != nil && action.TargetID != nil &&
+			decision.TargetType != nil && *decision.TargetType == *action.TargetType &&
+			decision.TargetID != nil && *decision.TargetID == *action.TargetID {
+			targetSeen = true
+		}
+	}
+
+	decision := policy.Decide(action, counted, targetSeen)
+	m.Decisions = append(m.Decisions, decision)
+	return decision, nil
+}
+
 func MockTaskRepository_TestGetTitleRequirementsByKey(t *testing.T) {
 	mockRepo := NewMockTaskRepository()
 
@@ -447,3 +514,184 @@ func MockTaskRepository_TestAwardTitleToUser(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskService_TrackAction(t *testing.T) {
+	games := domain.ActionTargetGames
+	withinDays := uint(7)
+	conditions := `{"genres":["rpg"]}`
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	target := func(id uint) *uint {
+		return &id
+	}
+
+	testCases := map[string]struct {
+		rule      domain.TaskRule
+		goal      int
+		actions   []domain.UserAction
+		progress  int
+		completed bool
+	}{
+		"plain counter adds the increments": {
+			rule: domain.TaskRule{Type: domain.RequirementCounter},
+			goal: 5,
+			actions: []domain.UserAction{
+				{UserID: 1, Key: domain.CommentGameRequirementKey, Increment: 2, OccurredAt: now},
+				{UserID: 1, Key: domain.CommentGameRequirementKey, Increment: 1, OccurredAt: now},
+			},
+			progress: 3,
+		},
+		"distinct counts each target once": {
+			rule: domain.TaskRule{Type: domain.RequirementDistinct},
+			goal: 2,
+			actions: []domain.UserAction{
+				{UserID: 1, Key: domain.CommentGameRequirementKey, TargetType: &games, TargetID: target(1), OccurredAt: now},
+				{UserID: 1, Key: domain.CommentGameRequirementKey, TargetType: &games, TargetID: target(1), OccurredAt: now},
+				{UserID: 1, Key: domain.CommentGameRequirementKey, TargetType: &games, TargetID: target(2), OccurredAt: now},
+			},
+			progress:  2,
+			completed: true,
+		},
+		"streak counts consecutive days within the window": {
+			rule: domain.TaskRule{Type: domain.RequirementStreak, WithinDays: &withinDays},
+			goal: 7,
+			actions: []domain.UserAction{
+				{UserID: 1, Key: domain.LoginRequirementKey, OccurredAt: now.AddDate(0, 0, -20)},
+				{UserID: 1, Key: domain.LoginRequirementKey, OccurredAt: now.AddDate(0, 0, -2)},
+				{UserID: 1, Key: domain.LoginRequirementKey, OccurredAt: now.AddDate(0, 0, -1)},
+				{UserID: 1, Key: domain.LoginRequirementKey, OccurredAt: now},
+			},
+			progress: 3,
+		},
+		"conditions match the attributes of the target": {
+			rule: domain.TaskRule{Type: domain.RequirementCounter, Conditions: &conditions},
+			goal: 5,
+			actions: []domain.UserAction{
+				{UserID: 1, Key: domain.HeartGameRequirementKey, TargetType: &games, TargetID: target(1), OccurredAt: now},
+				{UserID: 1, Key: domain.HeartGameRequirementKey, TargetType: &games, TargetID: target(2), OccurredAt: now},
+			},
+			progress: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockTaskRepository()
+			mockRepo.TargetAttributes[1] = map[string][]string{"genres": {"rpg"}}
+			mockRepo.TargetAttributes[2] = map[string][]string{"genres": {"racing"}}
+			mockRepo.TitleRequirements = []domain.TitleRequirement{
+				{ID: 1, TitleID: 1, Key: tc.actions[0].Key, Goal: tc.goal, TaskRule: tc.rule},
+			}
+			mockRepo.MissionRequirements = []domain.MissionRequirement{
+				{ID: 1, MissionID: 1, Key: tc.actions[0].Key, Goal: tc.goal, TaskRule: tc.rule},
+			}
+
+			service := usecases.NewTaskService(mockRepo)
+			for _, action := range tc.actions {
+				assert.NoError(t, service.TrackAction(action))
+			}
+
+			assert.Len(t, mockRepo.Actions, len(tc.actions))
+			assert.Equal(t, tc.progress, mockRepo.TitleProgress[1].Progress)
+			assert.Equal(t, tc.completed, mockRepo.TitleProgress[1].Completed)
+			assert.Equal(t, tc.progress, mockRepo.MissionProgress[1].Progress)
+			assert.Equal(t, tc.completed, mockRepo.MissionProgress[1].Completed)
+
+			hasTitle, _ := mockRepo.UserHasTitle(1, 1)
+			assert.Equal(t, tc.completed, hasTitle)
+		})
+	}
+}
+
+func TestTaskService_TrackActionWithPolicy(t *testing.T) {
+	games := domain.ActionTargetGames
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	target := func(id uint) *uint {
+		return &id
+	}
+
+	testCases := map[string]struct {
+		policy   domain.ActionPolicy
+		actions  []domain.UserAction
+		progress int
+		recorded int
+		outcomes []string
+	}{
+		"cooldown refuses the actions too close together": {
+			policy: domain.ActionPolicy{ID: 1, CooldownSeconds: 60},
+			actions: []domain.UserAction{
+				{UserID: 1, OccurredAt: now},
+				{UserID: 1, OccurredAt: now.Add(30 * time.Second)},
+				{UserID: 1, OccurredAt: now.Add(2 * time.Minute)},
+			},
+			progress: 2,
+			recorded: 2,
+			outcomes: []string{domain.ActionDecisionAllowed, domain.ActionDecisionCooldown, domain.ActionDecisionAllowed},
+		},
+		"daily limit refuses the actions past it": {
+			policy: domain.ActionPolicy{ID: 1, MaxPerDay: 2},
+			actions: []domain.UserAction{
+				{UserID: 1, OccurredAt: now},
+				{UserID: 1, OccurredAt: now.Add(time.Minute)},
+				{UserID: 1, OccurredAt: now.Add(2 * time.Minute)},
+			},
+			progress: 2,
+			recorded: 2,
+			outcomes: []string{domain.ActionDecisionAllowed, domain.ActionDecisionAllowed, domain.ActionDecisionDailyLimit},
+		},
+		"once per target refuses the same target": {
+			policy: domain.ActionPolicy{ID: 1, OncePerTarget: true},
+			actions: []domain.UserAction{
+				{UserID: 1, TargetType: &games, TargetID: target(1), OccurredAt: now},
+				{UserID: 1, TargetType: &games, TargetID: target(1), OccurredAt: now.Add(time.Minute)},
+				{UserID: 1, TargetType: &games, TargetID: target(2), OccurredAt: now.Add(2 * time.Minute)},
+			},
+			progress: 2,
+			recorded: 2,
+			outcomes: []string{domain.ActionDecisionAllowed, domain.ActionDecisionRepeatedTarget, domain.ActionDecisionAllowed},
+		},
+		"diminishing returns slow the progress down": {
+			policy: domain.ActionPolicy{ID: 1, DiminishAfter: 1, DiminishingFactor: 0.5},
+			actions: []domain.UserAction{
+				{UserID: 1, OccurredAt: now},
+				{UserID: 1, OccurredAt: now.Add(time.Minute)},
+				{UserID: 1, OccurredAt: now.Add(2 * time.Minute)},
+			},
+			progress: 1,
+			recorded: 1,
+			outcomes: []string{domain.ActionDecisionAllowed, domain.ActionDecisionDiminished, domain.ActionDecisionDiminished},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := NewMockTaskRepository()
+			tc.policy.ActionKey = domain.CommentGameRequirementKey
+			tc.policy.Enabled = true
+			mockRepo.Policy = &tc.policy
+			mockRepo.TitleRequirements = []domain.TitleRequirement{
+				{ID: 1, TitleID: 1, Key: domain.CommentGameRequirementKey, Goal: 10, TaskRule: domain.TaskRule{Type: domain.RequirementCounter}},
+			}
+			mockRepo.MissionRequirements = []domain.MissionRequirement{
+				{ID: 1, MissionID: 1, Key: domain.CommentGameRequirementKey, Goal: 10, TaskRule: domain.TaskRule{Type: domain.RequirementCounter}},
+			}
+
+			service := usecases.NewTaskService(mockRepo)
+			for _, action := range tc.actions {
+				action.Key = domain.CommentGameRequirementKey
+				assert.NoError(t, service.TrackAction(action))
+			}
+
+			var outcomes []string
+			for _, decision := range mockRepo.Decisions {
+				outcomes = append(outcomes, decision.Outcome)
+			}
+
+			assert.Equal(t, tc.outcomes, outcomes)
+			assert.Len(t, mockRepo.Actions, tc.recorded)
+			assert.Equal(t, tc.progress, mockRepo.TitleProgress[1].Progress)
+			assert.Equal(t, tc.progress, mockRepo.MissionProgress[1].Progress)
+		})
+	}
+}
@@ -65,6 +65,15 @@ func (m *MockUserRepository) FindUserByEmailOrNickname(emailOrNickname string) (
 	return nil, errors.New("user not found")
 }
 
+func (m *MockUserRepository) FindUserByNickname(nickname string) (*domain.User, error) {
+	for _, user := range m.users {
+		if user.Nickname == nickname {
+			return user, nil
+		}
+	}
+	return nil, errors.New("user not found")
+}
+
 func (m *MockUserRepository) UpdateUserPassword(userID uint, hashedPassword string) error {
 	user, exists := m.users[userID]
 	if !exists {
@@ -135,7 +144,7 @@ func (m *MockUserRepository) CreateWithProfile(user *domain.User) error {
 	return nil
 }
 
-func (m *MockUserRepository) AddExperience(userID uint, experienceAmount uint, awardTitleToUserFunc func(userID uint, titleID uint) error) error {
+func (m *MockUserRepository) AddExperience(userID uint, experienceAmount uint) error {
 	user, exists := m.users[userID]
 	if !exists {
 		return errors.New("user not found")
@@ -170,10 +179,8 @@ func (m *MockUserRepository) AddExperience(userID uint, experienceAmount uint, a
 
 		if user.Experience >= nextLevel.Experience {
 			for _, reward := range nextLevel.Rewards {
-				if reward.RewardableType == "titles" {
-					if err := awardTitleToUserFunc(user.ID, reward.RewardableID); err != nil {
-						return fmt.Errorf("error awarding title: %w", err)
-					}
+				if reward.RewardableType == domain.RewardableTypeTitles {
+					user.Titles = append(user.Titles, domain.UserTitle{UserID: user.ID, TitleID: reward.RewardableID})
 				}
 			}
 
@@ -633,33 +640,26 @@ func TestMockUserRepository_UpdateUserBasics(t *testing.T) {
 }
 
 func TestMockUserRepository_AddExperience(t *testing.T) {
-	type mockAwardTitleFuncCall struct {
-		userID  uint
-		titleID uint
-		err     error
-	}
-
 	testCases := map[string]struct {
-		userID              uint
-		experienceAmount    uint
-		users               map[uint]*domain.User
-		levels              []domain.Level
-		mockAwardTitleCalls []mockAwardTitleFuncCall
-		expectedError       error
-		expectedExperience  uint
-		expectedLevelID     uint
-		expectedCoins       int
+		userID             uint
+		experienceAmount   uint
+		users              map[uint]*domain.User
+		levels             []domain.Level
+		expectedTitleIDs   []uint
+		expectedError      error
+		expectedExperience uint
+		expectedLevelID    uint
+		expectedCoins      int
 	}{
 		"user not found": {
-			userID:              1,
-			experienceAmount:    100,
-			users:               map[uint]*domain.User{},
-			levels:              []domain.Level{},
-			expectedError:       errors.New("user not found"),
-			expectedExperience:  0,
-			expectedLevelID:     0,
-			expectedCoins:       0,
-			mockAwardTitleCalls: nil,
+			userID:             1,
+			experienceAmount:   100,
+			users:              map[uint]*domain.User{},
+			levels:             []domain.Level{},
+			expectedError:      errors.New("user not found"),
+			expectedExperience: 0,
+			expectedLevelID:    0,
+			expectedCoins:      0,
 		},
 		"level up with reward title": {
 			userID:           1,
@@ -677,9 +677,7 @@ func TestMockUserRepository_AddExperience(t *testing.T) {
 			expectedExperience: 200,
 			expectedLevelID:    2,
 			expectedCoins:      100,
-			mockAwardTitleCalls: []mockAwardTitleFuncCall{
-				{userID: 1, titleID: 1, err: nil},
-			},
+			expectedTitleIDs:   []uint{1},
 		},
 		"no level up, insufficient experience": {
 			userID:           1,
@@ -691,11 +689,10 @@ func TestMockUserRepository_AddExperience(t *testing.T) {
 				{ID: 1, Level: 1, Experience: 100},
 				{ID: 2, Level: 2, Experience: 400, Coins: 100},
 			},
-			expectedError:       nil,
-			expectedExperience:  100,
-			expectedLevelID:     1,
-			expectedCoins:       0,
-			mockAwardTitleCalls: nil,
+			expectedError:      nil,
+			expectedExperience: 100,
+			expectedLevelID:    1,
+			expectedCoins:      0,
 		},
 	}
 
@@ -706,18 +703,7 @@ func TestMockUserRepository_AddExperience(t *testing.T) {
 				levels: tc.levels,
 			}
 
-			var awardedTitles []mockAwardTitleFuncCall
-			awardTitleToUserFunc := func(userID uint, titleID uint) error {
-				for _, call := range tc.mockAwardTitleCalls {
-					if call.userID == userID && call.titleID == titleID {
-						awardedTitles = append(awardedTitles, call)
-						return call.err
-					}
-				}
-				return nil
-			}
-
-			err := mockRepo.AddExperience(tc.userID, tc.experienceAmount, awardTitleToUserFunc)
+			err := mockRepo.AddExperience(tc.userID, tc.experienceAmount)
 
 			if tc.expectedError != nil {
 				assert.EqualError(t, err, tc.expectedError.Error())
@@ -729,13 +715,13 @@ func TestMockUserRepository_AddExperience(t *testing.T) {
 				assert.Equal(t, tc.expectedExperience, user.Experience)
 				assert.Equal(t, tc.expectedLevelID, user.LevelID)
 				assert.Equal(t, tc.expectedCoins, user.Wallet.Amount)
-			}
 
-			assert.Equal(t, len(tc.mockAwardTitleCalls), len(awardedTitles))
-			for i, call := range tc.mockAwardTitleCalls {
-				assert.Equal(t, call.userID, awardedTitles[i].userID)
-				assert.Equal(t, call.titleID, awardedTitles[i].titleID)
-				assert.Equal(t, call.err, awardedTitles[i].err)
+				var titleIDs []uint
+				for _, userTitle := range user.Titles {
+					titleIDs = append(titleIDs, userTitle.TitleID)
+				}
+
+				assert.Equal(t, tc.expectedTitleIDs, titleIDs)
 			}
 		})
 	}
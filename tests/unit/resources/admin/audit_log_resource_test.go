@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"encoding/json"
+	"gcstatus/internal/domain"
+	resources_admin "gcstatus/internal/resources/admin"
+	"gcstatus/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformAuditLog(t *testing.T) {
+	fixedTime := time.Now()
+	userID := uint(1)
+	targetID := uint(7)
+	changes := `{"title":{"before":"Old","after":"New"}}`
+	invalidChanges := "not json"
+
+	testCases := map[string]struct {
+		input    domain.AuditLog
+		expected resources_admin.AuditLogResource
+	}{
+		"audit log with actor": {
+			input: domain.AuditLog{
+				ID:         1,
+				UserID:     &userID,
+				User:       &domain.User{ID: 1, Name: "Admin", Nickname: "admin", Email: "admin@example.com"},
+				Action:     "PUT /admin/games/:id",
+				TargetType: "games",
+				TargetID:   &targetID,
+				Changes:    &changes,
+				Status:     200,
+				IP:         "127.0.0.1",
+				UserAgent:  "tests",
+				CreatedAt:  fixedTime,
+			},
+			expected: resources_admin.AuditLogResource{
+				ID:         1,
+				Actor:      &resources_admin.AuditActorResource{ID: 1, Name: "Admin", Nickname: "admin", Email: "admin@example.com"},
+				Action:     "PUT /admin/games/:id",
+				TargetType: "games",
+				TargetID:   &targetID,
+				Changes:    json.RawMessage(changes),
+				Status:     200,
+				IP:         "127.0.0.1",
+				UserAgent:  "tests",
+				CreatedAt:  utils.FormatTimestamp(fixedTime),
+			},
+		},
+		"audit log with invalid changes": {
+			input: domain.AuditLog{
+				ID:        2,
+				Action:    "POST /admin/login",
+				Changes:   &invalidChanges,
+				Status:    401,
+				CreatedAt: fixedTime,
+			},
+			expected: resources_admin.AuditLogResource{
+				ID:        2,
+				Action:    "POST /admin/login",
+				Changes:   json.RawMessage("{}"),
+				Status:    401,
+				CreatedAt: utils.FormatTimestamp(fixedTime),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resources_admin.TransformAuditLog(tc.input))
+		})
+	}
+}
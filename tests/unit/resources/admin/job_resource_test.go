@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"encoding/json"
+	"gcstatus/internal/domain"
+	resources_admin "gcstatus/internal/resources/admin"
+	"gcstatus/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformJob(t *testing.T) {
+	fixedTime := time.Now()
+	errorMessage := "store answered 500"
+	formattedTime := utils.FormatTimestamp(fixedTime)
+
+	testCases := map[string]struct {
+		input    domain.Job
+		expected resources_admin.JobResource
+	}{
+		"failed job": {
+			input: domain.Job{
+				ID:          1,
+				Type:        domain.JobTypeCatalogImportItem,
+				Payload:     `{"source":"steam","id":10}`,
+				Status:      domain.JobStatusFailed,
+				Attempts:    3,
+				MaxAttempts: 3,
+				Error:       &errorMessage,
+				AvailableAt: fixedTime,
+				StartedAt:   &fixedTime,
+				FinishedAt:  &fixedTime,
+				CreatedAt:   fixedTime,
+				UpdatedAt:   fixedTime,
+			},
+			expected: resources_admin.JobResource{
+				ID:          1,
+				Type:        domain.JobTypeCatalogImportItem,
+				Payload:     json.RawMessage(`{"source":"steam","id":10}`),
+				Status:      domain.JobStatusFailed,
+				Attempts:    3,
+				MaxAttempts: 3,
+				Error:       &errorMessage,
+				AvailableAt: formattedTime,
+				StartedAt:   &formattedTime,
+				FinishedAt:  &formattedTime,
+				CreatedAt:   formattedTime,
+				UpdatedAt:   formattedTime,
+			},
+		},
+		"pending job with invalid payload": {
+			input: domain.Job{
+				ID:          2,
+				Type:        domain.JobTypeSendEmail,
+				Payload:     "not json",
+				Status:      domain.JobStatusPending,
+				MaxAttempts: 3,
+				AvailableAt: fixedTime,
+				CreatedAt:   fixedTime,
+				UpdatedAt:   fixedTime,
+			},
+			expected: resources_admin.JobResource{
+				ID:          2,
+				Type:        domain.JobTypeSendEmail,
+				Payload:     json.RawMessage("null"),
+				Status:      domain.JobStatusPending,
+				MaxAttempts: 3,
+				AvailableAt: formattedTime,
+				CreatedAt:   formattedTime,
+				UpdatedAt:   formattedTime,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resources_admin.TransformJob(tc.input))
+		})
+	}
+}
+
+func TestTransformJobs(t *testing.T) {
+	jobs := []domain.Job{
+		{ID: 1, Payload: "{}", Status: domain.JobStatusSucceeded},
+		{ID: 2, Payload: "{}", Status: domain.JobStatusRunning},
+	}
+
+	resources := resources_admin.TransformJobs(jobs)
+
+	assert.Len(t, resources, 2)
+	assert.Equal(t, uint(2), resources[1].ID)
+	assert.Empty(t, resources_admin.TransformJobs(nil))
+}
@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	resources_admin "gcstatus/internal/resources/admin"
+	"gcstatus/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformManagedUser(t *testing.T) {
+	fixedTime := time.Now()
+	reason := "Cheating"
+	formattedTime := utils.FormatTimestamp(fixedTime)
+
+	testCases := map[string]struct {
+		input    domain.User
+		expected resources_admin.ManagedUserResource
+	}{
+		"active user": {
+			input: domain.User{
+				ID:         1,
+				Name:       "John Doe",
+				Email:      "john@example.com",
+				Nickname:   "johnny",
+				Experience: 100,
+				CreatedAt:  fixedTime,
+				Roles: []domain.Roleable{
+					{Role: domain.Role{ID: 1, Name: "Administrator"}},
+				},
+			},
+			expected: resources_admin.ManagedUserResource{
+				ID:         1,
+				Name:       "John Doe",
+				Email:      "john@example.com",
+				Nickname:   "johnny",
+				Experience: 100,
+				CreatedAt:  formattedTime,
+				Roles: []resources_admin.RoleResource{
+					{ID: 1, Name: "Administrator", Permissions: []resources_admin.PermissionResource{}},
+				},
+			},
+		},
+		"blocked user with revoked sessions": {
+			input: domain.User{
+				ID:                2,
+				Name:              "Jane Doe",
+				Email:             "jane@example.com",
+				Nickname:          "jane",
+				Blocked:           true,
+				BlockedReason:     &reason,
+				BlockedUntil:      &fixedTime,
+				SessionsRevokedAt: &fixedTime,
+				CreatedAt:         fixedTime,
+			},
+			expected: resources_admin.ManagedUserResource{
+				ID:                2,
+				Name:              "Jane Doe",
+				Email:             "jane@example.com",
+				Nickname:          "jane",
+				Blocked:           true,
+				BlockedReason:     &reason,
+				BlockedUntil:      &formattedTime,
+				SessionsRevokedAt: &formattedTime,
+				CreatedAt:         formattedTime,
+				Roles:             []resources_admin.RoleResource{},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resources_admin.TransformManagedUser(tc.input))
+		})
+	}
+}
+
+func TestTransformManagedUserMissions(t *testing.T) {
+	fixedTime := time.Now()
+	formattedTime := utils.FormatTimestamp(fixedTime)
+	daily := domain.Mission{ID: 1, Mission: "Daily comments", Frequency: domain.DailyMission}
+	weekly := domain.Mission{ID: 2, Mission: "Weekly hearts", Frequency: domain.WeeklyMission}
+
+	user := domain.User{
+		Missions: []domain.UserMission{
+			{ID: 1, MissionID: 1, Mission: daily, Completed: true, LastCompletedAt: fixedTime},
+		},
+		MissionProgresses: []domain.MissionProgress{
+			{ID: 1, Progress: 3, Completed: true, MissionRequirement: &domain.MissionRequirement{ID: 1, Task: "Comment", Goal: 3, MissionID: 1, Mission: daily}},
+			{ID: 2, Progress: 1, MissionRequirement: &domain.MissionRequirement{ID: 2, Task: "Heart", Goal: 5, MissionID: 2, Mission: weekly}},
+		},
+	}
+
+	missions := resources_admin.TransformManagedUserMissions(user)
+
+	assert.Len(t, missions, 2)
+
+	assert.Equal(t, uint(1), missions[0].ID)
+	assert.True(t, missions[0].Completed)
+	assert.Equal(t, &formattedTime, missions[0].LastCompletedAt)
+	assert.Len(t, missions[0].Requirements, 1)
+	assert.Equal(t, uint(3), missions[0].Requirements[0].MissionProgress.Progress)
+
+	assert.Equal(t, uint(2), missions[1].ID)
+	assert.False(t, missions[1].Completed)
+	assert.Nil(t, missions[1].LastCompletedAt)
+	assert.Equal(t, 5, missions[1].Requirements[0].Goal)
+	assert.Equal(t, uint(1), missions[1].Requirements[0].MissionProgress.Progress)
+
+	assert.Equal(t, []resources_admin.ManagedUserMissionResource{}, resources_admin.TransformManagedUserMissions(domain.User{}))
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	resources_admin "gcstatus/internal/resources/admin"
+	"gcstatus/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformMission(t *testing.T) {
+	fixedTime := time.Now()
+	conditions := `{"genres":["rpg"]}`
+
+	testCases := map[string]struct {
+		input    domain.Mission
+		expected resources_admin.MissionResource
+	}{
+		"mission with requirements, rewards and assignments": {
+			input: domain.Mission{
+				ID:          1,
+				Mission:     "Collector",
+				Description: "Build your library.",
+				Status:      domain.MissionAvailable,
+				Coins:       10,
+				Experience:  50,
+				Frequency:   domain.WeeklyMission,
+				ResetTime:   fixedTime,
+				CreatedAt:   fixedTime,
+				UpdatedAt:   fixedTime,
+				MissionRequirements: []domain.MissionRequirement{
+					{ID: 2, Task: "Add 5 games", Key: domain.AddToLibraryRequirementKey, Goal: 5, Description: "Grow your library.", TaskRule: domain.TaskRule{Type: domain.RequirementDistinct, Conditions: &conditions}},
+				},
+				Rewards: []domain.Reward{
+					{ID: 3, RewardableType: domain.RewardableTypeTitles, RewardableID: 4, Rewardable: &domain.Title{ID: 4, Title: "Collector", Status: domain.TitleAvailable}},
+				},
+				Assignments: []domain.UserMissionAssignment{
+					{UserID: 5, User: domain.User{ID: 5, Name: "Player", Nickname: "player", Email: "player@example.com", CreatedAt: fixedTime}},
+				},
+			},
+			expected: resources_admin.MissionResource{
+				ID:          1,
+				Mission:     "Collector",
+				Description: "Build your library.",
+				Status:      domain.MissionAvailable,
+				Coins:       10,
+				Experience:  50,
+				Frequency:   domain.WeeklyMission,
+				ResetTime:   utils.FormatTimestamp(fixedTime),
+				CreatedAt:   utils.FormatTimestamp(fixedTime),
+				UpdatedAt:   utils.FormatTimestamp(fixedTime),
+				Requirements: []resources_admin.TaskRequirementResource{
+					{ID: 2, Task: "Add 5 games", Key: domain.AddToLibraryRequirementKey, Goal: 5, Description: "Grow your library.", Type: domain.RequirementDistinct, Conditions: map[string][]string{"genres": {"rpg"}}},
+				},
+				Rewards: []resources_admin.RewardResource{
+					{ID: 3, RewardableType: domain.RewardableTypeTitles, RewardableID: 4, Amount: 1, Title: &resources_admin.MinimalTitleResource{ID: 4, Title: "Collector", Status: domain.TitleAvailable}},
+				},
+				AssignedUsers: []resources_admin.MinimalUserResource{
+					{ID: 5, Name: "Player", Nickname: "player", Email: "player@example.com", CreatedAt: utils.FormatTimestamp(fixedTime)},
+				},
+			},
+		},
+		"mission without relations": {
+			input: domain.Mission{
+				ID:        2,
+				Mission:   "Empty",
+				ResetTime: fixedTime,
+				CreatedAt: fixedTime,
+				UpdatedAt: fixedTime,
+			},
+			expected: resources_admin.MissionResource{
+				ID:            2,
+				Mission:       "Empty",
+				ResetTime:     utils.FormatTimestamp(fixedTime),
+				CreatedAt:     utils.FormatTimestamp(fixedTime),
+				UpdatedAt:     utils.FormatTimestamp(fixedTime),
+				Requirements:  []resources_admin.TaskRequirementResource{},
+				Rewards:       []resources_admin.RewardResource{},
+				AssignedUsers: []resources_admin.MinimalUserResource{},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resources_admin.TransformMission(tc.input))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"gcstatus/internal/domain"
+	ports_admin "gcstatus/internal/ports/admin"
 	resources_admin "gcstatus/internal/resources/admin"
 	"reflect"
 	"testing"
@@ -39,3 +40,21 @@ func TestTransformPermission(t *testing.T) {
 		})
 	}
 }
+
+func TestTransformPermissionCatalog(t *testing.T) {
+	catalog := []ports_admin.PermissionCatalogEntry{
+		{Scope: "update:games", InRoutes: true},
+		{Scope: "view:games", InRoutes: true, Permission: &domain.Permission{ID: 1, Scope: "view:games"}},
+	}
+
+	expected := []resources_admin.PermissionCatalogResource{
+		{Scope: "update:games", InRoutes: true},
+		{Scope: "view:games", InRoutes: true, Permission: &resources_admin.PermissionResource{ID: 1, Scope: "view:games"}},
+	}
+
+	result := resources_admin.TransformPermissionCatalog(catalog)
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, result)
+	}
+}
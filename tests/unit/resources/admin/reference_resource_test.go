@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"encoding/json"
+	"gcstatus/internal/domain"
+	resources_admin "gcstatus/internal/resources/admin"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformReferences(t *testing.T) {
+	languages := []domain.Language{
+		{ID: 1, Name: "English", ISO: "en"},
+		{ID: 2, Name: "Portuguese", ISO: "pt_BR"},
+	}
+
+	references := resources_admin.TransformReferences(
+		languages,
+		resources_admin.TransformLanguage,
+		map[uint]int64{1: 12},
+		func(language domain.Language) uint { return language.ID },
+	)
+
+	data, err := json.Marshal(references)
+	assert.NoError(t, err)
+
+	var decoded []map[string]any
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+
+	assert.Len(t, decoded, 2)
+	assert.Equal(t, "English", decoded[0]["name"])
+	assert.Equal(t, "en", decoded[0]["iso"])
+	assert.Equal(t, float64(12), decoded[0]["usages"])
+	assert.Equal(t, float64(0), decoded[1]["usages"])
+}
@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	resources_admin "gcstatus/internal/resources/admin"
+	"gcstatus/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformScheduledJob(t *testing.T) {
+	fixedTime := time.Now()
+	formattedTime := utils.FormatTimestamp(fixedTime)
+
+	testCases := map[string]struct {
+		input    domain.ScheduledJob
+		expected resources_admin.ScheduledJobResource
+	}{
+		"job that already ran": {
+			input: domain.ScheduledJob{
+				ID:        1,
+				Name:      domain.ScheduledJobResetMissions,
+				Schedule:  "@hourly",
+				NextRunAt: &fixedTime,
+				LastRunAt: &fixedTime,
+				CreatedAt: fixedTime,
+				UpdatedAt: fixedTime,
+			},
+			expected: resources_admin.ScheduledJobResource{
+				ID:        1,
+				Name:      domain.ScheduledJobResetMissions,
+				Schedule:  "@hourly",
+				NextRunAt: &formattedTime,
+				LastRunAt: &formattedTime,
+				CreatedAt: formattedTime,
+				UpdatedAt: formattedTime,
+			},
+		},
+		"job that never ran": {
+			input: domain.ScheduledJob{
+				ID:        2,
+				Name:      domain.ScheduledJobPrunePasswordResets,
+				Schedule:  "@hourly",
+				NextRunAt: &fixedTime,
+				CreatedAt: fixedTime,
+				UpdatedAt: fixedTime,
+			},
+			expected: resources_admin.ScheduledJobResource{
+				ID:        2,
+				Name:      domain.ScheduledJobPrunePasswordResets,
+				Schedule:  "@hourly",
+				NextRunAt: &formattedTime,
+				CreatedAt: formattedTime,
+				UpdatedAt: formattedTime,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resources_admin.TransformScheduledJob(tc.input))
+		})
+	}
+}
+
+func TestTransformScheduledRun(t *testing.T) {
+	fixedTime := time.Now()
+	formattedTime := utils.FormatTimestamp(fixedTime)
+	owner := "api-1:42:0a1b2c3d"
+	errorMessage := "database is gone"
+	duration := int64(1250)
+	triggeredBy := uint(7)
+
+	testCases := map[string]struct {
+		input    domain.ScheduledRun
+		expected resources_admin.ScheduledRunResource
+	}{
+		"failed manual run": {
+			input: domain.ScheduledRun{
+				ID:          1,
+				JobName:     domain.ScheduledJobPruneAuditLogs,
+				Trigger:     domain.ScheduledTriggerManual,
+				Status:      domain.ScheduledRunFailed,
+				Owner:       &owner,
+				TriggeredBy: &triggeredBy,
+				Error:       &errorMessage,
+				StartedAt:   &fixedTime,
+				FinishedAt:  &fixedTime,
+				DurationMs:  &duration,
+				CreatedAt:   fixedTime,
+				UpdatedAt:   fixedTime,
+			},
+			expected: resources_admin.ScheduledRunResource{
+				ID:          1,
+				JobName:     domain.ScheduledJobPruneAuditLogs,
+				Trigger:     domain.ScheduledTriggerManual,
+				Status:      domain.ScheduledRunFailed,
+				Owner:       &owner,
+				TriggeredBy: &triggeredBy,
+				Error:       &errorMessage,
+				StartedAt:   &formattedTime,
+				FinishedAt:  &formattedTime,
+				DurationMs:  &duration,
+				CreatedAt:   formattedTime,
+				UpdatedAt:   formattedTime,
+			},
+		},
+		"pending scheduled run": {
+			input: domain.ScheduledRun{
+				ID:        2,
+				JobName:   domain.ScheduledJobResetMissions,
+				Trigger:   domain.ScheduledTriggerSchedule,
+				Status:    domain.ScheduledRunPending,
+				CreatedAt: fixedTime,
+				UpdatedAt: fixedTime,
+			},
+			expected: resources_admin.ScheduledRunResource{
+				ID:        2,
+				JobName:   domain.ScheduledJobResetMissions,
+				Trigger:   domain.ScheduledTriggerSchedule,
+				Status:    domain.ScheduledRunPending,
+				CreatedAt: formattedTime,
+				UpdatedAt: formattedTime,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resources_admin.TransformScheduledRun(tc.input))
+		})
+	}
+}
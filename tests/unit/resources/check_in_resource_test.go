@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformCheckInResult(t *testing.T) {
+	day := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	result := domain.CheckInResult{
+		CheckIn: domain.CheckIn{Day: day, Streak: 5, Coins: 25, Experience: 10},
+		Streak:  domain.CheckInStreak{Current: 5, Longest: 8, Freezes: 0, LastCheckInOn: &day},
+		FrozenDays: []time.Time{
+			day.AddDate(0, 0, -2),
+			day.AddDate(0, 0, -1),
+		},
+	}
+
+	expected := resources.CheckInResultResource{
+		CheckIn:    resources.CheckInResource{Day: "2026-10-19", Streak: 5, Coins: 25, Experience: 10},
+		Streak:     resources.CheckInStreakResource{Current: 5, Longest: 8, LastCheckInOn: utils.StringPtr("2026-10-19")},
+		FrozenDays: []string{"2026-10-17", "2026-10-18"},
+	}
+
+	assert.Equal(t, expected, resources.TransformCheckInResult(result))
+}
+
+func TestTransformCheckInStatus(t *testing.T) {
+	status := resources.TransformCheckInStatus(domain.CheckInStreak{}, nil, false, 100)
+
+	assert.Equal(t, resources.CheckInStatusResource{
+		Streak:     resources.CheckInStreakResource{},
+		FreezeCost: 100,
+		Recent:     []resources.CheckInResource{},
+	}, status)
+}
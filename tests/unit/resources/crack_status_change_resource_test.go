@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/ports"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/utils"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTransformCrackStatusChange(t *testing.T) {
+	fixedTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	previousStatus := domain.UncrackedStatus
+	crackedAt := utils.FormatTimestamp(fixedTime)
+
+	testCases := map[string]struct {
+		input    domain.CrackStatusChange
+		expected resources.CrackStatusChangeResource
+	}{
+		"initial entry": {
+			input: domain.CrackStatusChange{
+				ID:        1,
+				Status:    domain.UncrackedStatus,
+				CreatedAt: fixedTime,
+			},
+			expected: resources.CrackStatusChangeResource{
+				ID:        1,
+				Status:    domain.UncrackedStatus,
+				ChangedAt: utils.FormatTimestamp(fixedTime),
+			},
+		},
+		"cracked entry": {
+			input: domain.CrackStatusChange{
+				ID:             2,
+				Status:         domain.CrackedStatus,
+				PreviousStatus: domain.UncrackedStatus,
+				CrackedAt:      &fixedTime,
+				DaysToCrack:    utils.UintPtr(12),
+				CreatedAt:      fixedTime,
+				Cracker:        domain.Cracker{ID: 1, Name: "Cracker", Slug: "cracker", Acting: true},
+				Protection:     domain.Protection{ID: 1, Name: "Denuvo", Slug: "denuvo"},
+			},
+			expected: resources.CrackStatusChangeResource{
+				ID:             2,
+				Status:         domain.CrackedStatus,
+				PreviousStatus: &previousStatus,
+				CrackedAt:      &crackedAt,
+				DaysToCrack:    utils.UintPtr(12),
+				ChangedAt:      utils.FormatTimestamp(fixedTime),
+				By:             &resources.CrackerResource{ID: 1, Name: "Cracker", Slug: "cracker", Acting: true},
+				Protection:     &resources.ProtectionResource{ID: 1, Name: "Denuvo", Slug: "denuvo"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := resources.TransformCrackStatusChange(tc.input)
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestTransformCrackStats(t *testing.T) {
+	median := 7.5
+
+	input := ports.CrackGroupStats{
+		ID:   1,
+		Name: "Denuvo",
+		Slug: "denuvo",
+		Stats: domain.CrackStats{
+			Total:               3,
+			Cracked:             2,
+			Uncracked:           1,
+			UncrackedPercentage: 33.33,
+			MedianDaysToCrack:   &median,
+		},
+	}
+
+	expected := resources.CrackStatsResource{
+		ID:                  1,
+		Name:                "Denuvo",
+		Slug:                "denuvo",
+		Total:               3,
+		Cracked:             2,
+		Uncracked:           1,
+		UncrackedPercentage: 33.33,
+		MedianDaysToCrack:   &median,
+	}
+
+	result := resources.TransformCrackStats(input)
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, result)
+	}
+}
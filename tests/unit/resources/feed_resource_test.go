@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/utils"
+	"gcstatus/pkg/feeds"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTransformReleaseEvents(t *testing.T) {
+	fixedTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	games := []domain.Game{
+		{
+			ID:               1,
+			Title:            "Game Test",
+			Slug:             "game-test",
+			ShortDescription: "Short description",
+			ReleaseDate:      fixedTime,
+			UpdatedAt:        fixedTime,
+		},
+	}
+
+	expected := []feeds.Event{
+		{
+			UID:         "game-1@gcstatus.cloud",
+			Summary:     "Game Test",
+			Description: "Short description",
+			URL:         "https://gcstatus.cloud/games/game-test",
+			Date:        fixedTime,
+			Stamp:       fixedTime,
+		},
+	}
+
+	if result := resources.TransformReleaseEvents(games); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, result)
+	}
+}
+
+func TestTransformFeedItems(t *testing.T) {
+	fixedTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	game := domain.Game{ID: 1, Title: "Game Test", Slug: "game-test", ShortDescription: "Short description", CreatedAt: fixedTime, UpdatedAt: fixedTime}
+
+	testCases := map[string]struct {
+		transform func() []feeds.Item
+		expected  []feeds.Item
+	}{
+		"games": {
+			transform: func() []feeds.Item {
+				return resources.TransformGameFeedItems([]domain.Game{game})
+			},
+			expected: []feeds.Item{
+				{
+					ID:          "https://gcstatus.cloud/games/game-test",
+					Title:       "Game Test",
+					Link:        "https://gcstatus.cloud/games/game-test",
+					Description: "Short description",
+					Published:   fixedTime,
+					Updated:     fixedTime,
+				},
+			},
+		},
+		"dlcs": {
+			transform: func() []feeds.Item {
+				return resources.TransformDLCFeedItems([]domain.DLC{
+					{ID: 2, Name: "Expansion", ShortDescription: "New areas", CreatedAt: fixedTime, UpdatedAt: fixedTime, Game: game},
+				})
+			},
+			expected: []feeds.Item{
+				{
+					ID:          "https://gcstatus.cloud/dlcs/2",
+					Title:       "Game Test: Expansion",
+					Link:        "https://gcstatus.cloud/games/game-test",
+					Description: "New areas",
+					Published:   fixedTime,
+					Updated:     fixedTime,
+				},
+			},
+		},
+		"crack changes": {
+			transform: func() []feeds.Item {
+				return resources.TransformCrackChangeFeedItems([]domain.CrackStatusChange{
+					{
+						ID:          3,
+						Status:      domain.CrackedStatus,
+						DaysToCrack: utils.UintPtr(10),
+						CreatedAt:   fixedTime,
+						UpdatedAt:   fixedTime,
+						Game:        game,
+						Cracker:     domain.Cracker{ID: 1, Name: "Empress"},
+					},
+				})
+			},
+			expected: []feeds.Item{
+				{
+					ID:          "https://gcstatus.cloud/cracks/changes/3",
+					Title:       "Game Test was cracked after 10 days!",
+					Link:        "https://gcstatus.cloud/games/game-test",
+					Description: "Cracked by Empress.",
+					Published:   fixedTime,
+					Updated:     fixedTime,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := tc.transform(); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/utils"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTransformGameFollow(t *testing.T) {
+	fixedTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		input    domain.GameFollow
+		expected resources.GameFollowResource
+	}{
+		"follow with game": {
+			input: domain.GameFollow{
+				ID:            1,
+				NotifyRelease: true,
+				NotifyCrack:   true,
+				Token:         "secret",
+				GameID:        1,
+				CreatedAt:     fixedTime,
+				UpdatedAt:     fixedTime,
+				Game: domain.Game{
+					ID:    1,
+					Title: "Game Test",
+					Slug:  "game-test",
+					Cover: "https://placehold.co/600x400/EEE/31343C",
+				},
+			},
+			expected: resources.GameFollowResource{
+				ID:            1,
+				NotifyRelease: true,
+				NotifyCrack:   true,
+				GameID:        1,
+				Game: &resources.LibraryItemResource{
+					ID:    1,
+					Title: "Game Test",
+					Slug:  "game-test",
+					Cover: "https://placehold.co/600x400/EEE/31343C",
+				},
+				CreatedAt: utils.FormatTimestamp(fixedTime),
+				UpdatedAt: utils.FormatTimestamp(fixedTime),
+			},
+		},
+		"follow without loaded game": {
+			input: domain.GameFollow{
+				ID:          2,
+				NotifyPrice: true,
+				NotifyDLC:   true,
+				GameID:      2,
+				CreatedAt:   fixedTime,
+				UpdatedAt:   fixedTime,
+			},
+			expected: resources.GameFollowResource{
+				ID:          2,
+				NotifyPrice: true,
+				NotifyDLC:   true,
+				GameID:      2,
+				CreatedAt:   utils.FormatTimestamp(fixedTime),
+				UpdatedAt:   utils.FormatTimestamp(fixedTime),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := resources.TransformGameFollow(tc.input)
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestTransformGameFollows(t *testing.T) {
+	follows := []domain.GameFollow{{ID: 1, GameID: 1}, {ID: 2, GameID: 2}}
+
+	result := resources.TransformGameFollows(follows)
+
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 follows, got %d", len(result))
+	}
+}
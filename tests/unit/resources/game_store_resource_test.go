@@ -70,6 +70,53 @@ func TestTransformGameStore(t *testing.T) {
 				},
 			},
 		},
+		"discounted transformation": {
+			input: domain.GameStore{
+				ID:           2,
+				Price:        9999,
+				InitialPrice: 19999,
+				LowestPrice:  7999,
+				URL:          "https://google.com",
+				StoreGameID:  "1",
+			},
+			expected: resources.GameStoreResource{
+				ID:           2,
+				Price:        9999,
+				InitialPrice: 19999,
+				LowestPrice:  7999,
+				Discount:     50,
+				URL:          "https://google.com",
+				StoreGameID:  "1",
+			},
+		},
+		"with region price": {
+			input: domain.GameStore{
+				ID:          3,
+				Price:       1999,
+				URL:         "https://google.com",
+				StoreGameID: "1",
+				Prices: []domain.StorePrice{
+					{
+						Region:       "br",
+						Currency:     "BRL",
+						InitialPrice: 4999,
+						FinalPrice:   4999,
+					},
+				},
+			},
+			expected: resources.GameStoreResource{
+				ID:          3,
+				Price:       1999,
+				URL:         "https://google.com",
+				StoreGameID: "1",
+				RegionPrice: &resources.StorePriceResource{
+					Region:       "br",
+					Currency:     "BRL",
+					InitialPrice: 4999,
+					FinalPrice:   4999,
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
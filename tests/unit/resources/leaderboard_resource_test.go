@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/utils"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformLeaderboardEntries(t *testing.T) {
+	entries := []domain.LeaderboardEntry{
+		{
+			Rank:  1,
+			Score: 500,
+			User: domain.User{
+				ID:       1,
+				Name:     "John Doe",
+				Nickname: "johndoe",
+				Email:    "john@example.com",
+				Profile:  domain.Profile{Photo: "photo-key-1"},
+			},
+		},
+		{
+			Rank:  2,
+			Score: 300,
+			User:  domain.User{ID: 2, Name: "Jane Doe", Nickname: "janedoe"},
+		},
+	}
+
+	expected := []resources.LeaderboardEntryResource{
+		{
+			Rank:  1,
+			Score: 500,
+			User: resources.LeaderboardUserResource{
+				ID:       1,
+				Name:     "John Doe",
+				Nickname: "johndoe",
+				Photo:    utils.StringPtr("https://mock-presigned-url.com/photo-key-1"),
+			},
+		},
+		{
+			Rank:  2,
+			Score: 300,
+			User:  resources.LeaderboardUserResource{ID: 2, Name: "Jane Doe", Nickname: "janedoe"},
+		},
+	}
+
+	assert.Equal(t, expected, resources.TransformLeaderboardEntries(entries, &MockS3Client{}))
+	assert.Empty(t, resources.TransformLeaderboardEntries(nil, &MockS3Client{}))
+}
@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/utils"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTransformLibraryable(t *testing.T) {
+	fixedTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	completedAt := utils.FormatTimestamp(fixedTime)
+	hoursPlayed := uint(42)
+
+	testCases := map[string]struct {
+		input    domain.Libraryable
+		expected resources.LibraryableResource
+	}{
+		"completed game entry": {
+			input: domain.Libraryable{
+				ID:              1,
+				Status:          domain.LibraryCompleted,
+				HoursPlayed:     &hoursPlayed,
+				CompletedAt:     &fixedTime,
+				LibraryableID:   1,
+				LibraryableType: domain.LibraryableTypeGames,
+				CreatedAt:       fixedTime,
+				UpdatedAt:       fixedTime,
+				Libraryable: &domain.Game{
+					ID:    1,
+					Title: "Game Test",
+					Slug:  "game-test",
+					Cover: "https://placehold.co/600x400/EEE/31343C",
+				},
+			},
+			expected: resources.LibraryableResource{
+				ID:              1,
+				Status:          domain.LibraryCompleted,
+				HoursPlayed:     &hoursPlayed,
+				CompletedAt:     &completedAt,
+				LibraryableID:   1,
+				LibraryableType: domain.LibraryableTypeGames,
+				Item: &resources.LibraryItemResource{
+					ID:    1,
+					Title: "Game Test",
+					Slug:  "game-test",
+					Cover: "https://placehold.co/600x400/EEE/31343C",
+				},
+				CreatedAt: utils.FormatTimestamp(fixedTime),
+				UpdatedAt: utils.FormatTimestamp(fixedTime),
+			},
+		},
+		"private dlc entry": {
+			input: domain.Libraryable{
+				ID:              2,
+				Status:          domain.LibraryWishlist,
+				Private:         true,
+				LibraryableID:   3,
+				LibraryableType: domain.LibraryableTypeDLCs,
+				CreatedAt:       fixedTime,
+				UpdatedAt:       fixedTime,
+				Libraryable: &domain.DLC{
+					ID:    3,
+					Name:  "DLC Test",
+					Cover: "https://placehold.co/600x400/EEE/31343C",
+				},
+			},
+			expected: resources.LibraryableResource{
+				ID:              2,
+				Status:          domain.LibraryWishlist,
+				Private:         true,
+				LibraryableID:   3,
+				LibraryableType: domain.LibraryableTypeDLCs,
+				Item: &resources.LibraryItemResource{
+					ID:    3,
+					Title: "DLC Test",
+					Cover: "https://placehold.co/600x400/EEE/31343C",
+				},
+				CreatedAt: utils.FormatTimestamp(fixedTime),
+				UpdatedAt: utils.FormatTimestamp(fixedTime),
+			},
+		},
+		"entry without loaded item": {
+			input: domain.Libraryable{
+				ID:              3,
+				Status:          domain.LibraryBacklog,
+				LibraryableID:   4,
+				LibraryableType: domain.LibraryableTypeGames,
+				CreatedAt:       fixedTime,
+				UpdatedAt:       fixedTime,
+			},
+			expected: resources.LibraryableResource{
+				ID:              3,
+				Status:          domain.LibraryBacklog,
+				LibraryableID:   4,
+				LibraryableType: domain.LibraryableTypeGames,
+				CreatedAt:       utils.FormatTimestamp(fixedTime),
+				UpdatedAt:       utils.FormatTimestamp(fixedTime),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := resources.TransformLibraryable(tc.input)
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestTransformLibraryables(t *testing.T) {
+	testCases := map[string]struct {
+		input       []domain.Libraryable
+		expectedLen int
+	}{
+		"empty library": {
+			input:       nil,
+			expectedLen: 0,
+		},
+		"multiple entries": {
+			input: []domain.Libraryable{
+				{ID: 1, Status: domain.LibraryPlaying},
+				{ID: 2, Status: domain.LibraryDropped},
+			},
+			expectedLen: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := resources.TransformLibraryables(tc.input)
+
+			if result == nil {
+				t.Fatalf("Expected non nil slice, got nil")
+			}
+
+			if len(result) != tc.expectedLen {
+				t.Errorf("Expected %d entries, got %d", tc.expectedLen, len(result))
+			}
+		})
+	}
+}
+
+func TestTransformLibraryStats(t *testing.T) {
+	stats := domain.LibraryStats{
+		Total:       10,
+		Wishlist:    1,
+		Playing:     2,
+		Completed:   3,
+		Dropped:     1,
+		Backlog:     3,
+		HoursPlayed: 250,
+	}
+
+	expected := &resources.LibraryStatsResource{
+		Total:       10,
+		Wishlist:    1,
+		Playing:     2,
+		Completed:   3,
+		Dropped:     1,
+		Backlog:     3,
+		HoursPlayed: 250,
+	}
+
+	result := resources.TransformLibraryStats(stats)
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, result)
+	}
+}
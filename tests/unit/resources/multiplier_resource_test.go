@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformMultipliers(t *testing.T) {
+	fixedTime := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		input    domain.Multipliers
+		expected *resources.MultipliersResource
+	}{
+		"nothing running": {
+			input: domain.Multipliers{},
+			expected: &resources.MultipliersResource{
+				Experience: 1,
+				Coins:      1,
+				Events:     []resources.MultiplierEventResource{},
+				Boosters:   []resources.UserBoosterResource{},
+			},
+		},
+		"event and booster": {
+			input: domain.Multipliers{
+				Experience: 3,
+				Coins:      1,
+				Events: []domain.MultiplierEvent{
+					{ID: 1, Name: "Double XP weekend", Scope: domain.BoosterScopeExperience, Multiplier: 2, StartsAt: fixedTime, EndsAt: fixedTime.Add(48 * time.Hour)},
+					{ID: 2, Name: "Mission rush", Scope: domain.BoosterScopeCoins, Multiplier: 2, ActionKey: domain.CompleteMissionActionKey, StartsAt: fixedTime, EndsAt: fixedTime.Add(time.Hour)},
+				},
+				Boosters: []domain.UserBooster{
+					{
+						ID:         5,
+						Scope:      domain.BoosterScopeExperience,
+						Multiplier: 1.5,
+						ExpiresAt:  fixedTime.Add(time.Hour),
+						Booster:    domain.Booster{ID: 4, Name: "XP potion", Scope: domain.BoosterScopeExperience, Multiplier: 1.5, DurationMinutes: 60, Cost: 100, Purchasable: true, CreatedAt: fixedTime},
+					},
+				},
+			},
+			expected: &resources.MultipliersResource{
+				Experience: 3,
+				Coins:      1,
+				Events: []resources.MultiplierEventResource{
+					{ID: 1, Name: "Double XP weekend", Scope: domain.BoosterScopeExperience, Multiplier: 2, StartsAt: utils.FormatTimestamp(fixedTime), EndsAt: utils.FormatTimestamp(fixedTime.Add(48 * time.Hour))},
+					{ID: 2, Name: "Mission rush", Scope: domain.BoosterScopeCoins, Multiplier: 2, ActionKey: utils.StringPtr(domain.CompleteMissionActionKey), StartsAt: utils.FormatTimestamp(fixedTime), EndsAt: utils.FormatTimestamp(fixedTime.Add(time.Hour))},
+				},
+				Boosters: []resources.UserBoosterResource{
+					{
+						ID:         5,
+						Scope:      domain.BoosterScopeExperience,
+						Multiplier: 1.5,
+						ExpiresAt:  utils.FormatTimestamp(fixedTime.Add(time.Hour)),
+						Booster: &resources.BoosterResource{
+							ID:              4,
+							Name:            "XP potion",
+							Scope:           domain.BoosterScopeExperience,
+							Multiplier:      1.5,
+							DurationMinutes: 60,
+							Cost:            100,
+							Purchasable:     true,
+							CreatedAt:       utils.FormatTimestamp(fixedTime),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resources.TransformMultipliers(tc.input))
+		})
+	}
+}
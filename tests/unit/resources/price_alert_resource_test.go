@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/resources"
+	"gcstatus/internal/utils"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTransformPriceAlert(t *testing.T) {
+	fixedTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	targetPrice := uint(4999)
+
+	testCases := map[string]struct {
+		input    domain.PriceAlert
+		expected resources.PriceAlertResource
+	}{
+		"alert with game": {
+			input: domain.PriceAlert{
+				ID:          1,
+				TargetPrice: &targetPrice,
+				Active:      true,
+				GameID:      1,
+				CreatedAt:   fixedTime,
+				UpdatedAt:   fixedTime,
+				Game: domain.Game{
+					ID:    1,
+					Title: "Game Test",
+					Slug:  "game-test",
+					Cover: "https://placehold.co/600x400/EEE/31343C",
+				},
+			},
+			expected: resources.PriceAlertResource{
+				ID:          1,
+				TargetPrice: &targetPrice,
+				Active:      true,
+				GameID:      1,
+				Game: &resources.LibraryItemResource{
+					ID:    1,
+					Title: "Game Test",
+					Slug:  "game-test",
+					Cover: "https://placehold.co/600x400/EEE/31343C",
+				},
+				CreatedAt: utils.FormatTimestamp(fixedTime),
+				UpdatedAt: utils.FormatTimestamp(fixedTime),
+			},
+		},
+		"alert without loaded game": {
+			input: domain.PriceAlert{
+				ID:          2,
+				AnyDiscount: true,
+				Active:      true,
+				GameID:      2,
+				CreatedAt:   fixedTime,
+				UpdatedAt:   fixedTime,
+			},
+			expected: resources.PriceAlertResource{
+				ID:          2,
+				AnyDiscount: true,
+				Active:      true,
+				GameID:      2,
+				CreatedAt:   utils.FormatTimestamp(fixedTime),
+				UpdatedAt:   utils.FormatTimestamp(fixedTime),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := resources.TransformPriceAlert(tc.input)
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestTransformPriceAlerts(t *testing.T) {
+	result := resources.TransformPriceAlerts(nil)
+
+	if result == nil || len(result) != 0 {
+		t.Errorf("Expected empty non nil slice, got %+v", result)
+	}
+}
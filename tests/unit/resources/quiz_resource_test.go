@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/resources"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformQuiz(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	gameID := uint(5)
+
+	newQuiz := func(kind string, closesAt time.Time) domain.Quiz {
+		return domain.Quiz{
+			ID:       1,
+			Kind:     kind,
+			Title:    "Most awaited release",
+			GameID:   &gameID,
+			Game:     &domain.Game{ID: gameID, Title: "GTA VI", Slug: "gta-vi"},
+			OpensAt:  now.Add(-time.Hour),
+			ClosesAt: closesAt,
+			Questions: []domain.QuizQuestion{
+				{ID: 1, Question: "Who develops it?", Options: []domain.QuizOption{
+					{ID: 1, Text: "Rockstar", Correct: kind == domain.QuizKindQuiz},
+					{ID: 2, Text: "Valve"},
+				}},
+			},
+			Rewards: []domain.QuizReward{{MinScore: 100, Coins: 50}},
+		}
+	}
+
+	attempt := &domain.QuizAttempt{
+		QuizID:  1,
+		UserID:  1,
+		Correct: 1,
+		Score:   100,
+		Answers: []domain.QuizAnswer{{QuestionID: 1, OptionID: 1, Correct: true}},
+	}
+
+	testCases := map[string]struct {
+		quiz    domain.Quiz
+		attempt *domain.QuizAttempt
+		reveal  bool
+	}{
+		"open quiz not answered": {
+			quiz: newQuiz(domain.QuizKindQuiz, now.Add(time.Hour)),
+		},
+		"open quiz answered": {
+			quiz:    newQuiz(domain.QuizKindQuiz, now.Add(time.Hour)),
+			attempt: attempt,
+			reveal:  true,
+		},
+		"closed quiz": {
+			quiz:   newQuiz(domain.QuizKindQuiz, now.Add(-time.Minute)),
+			reveal: true,
+		},
+		"closed poll": {
+			quiz: newQuiz(domain.QuizKindPoll, now.Add(-time.Minute)),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource := resources.TransformQuiz(tc.quiz, tc.attempt, now)
+
+			assert.Equal(t, tc.attempt != nil, resource.Answered)
+			assert.Equal(t, tc.quiz.IsOpen(now), resource.Open)
+			assert.Equal(t, "gta-vi", resource.Game.Slug)
+			assert.Len(t, resource.Questions[0].Options, 2)
+			assert.Len(t, resource.Rewards, 1)
+
+			correct := resource.Questions[0].Options[0].Correct
+			if tc.reveal {
+				assert.NotNil(t, correct)
+				assert.True(t, *correct)
+			} else {
+				assert.Nil(t, correct)
+			}
+
+			if tc.attempt != nil {
+				assert.Equal(t, uint(100), resource.Attempt.Score)
+				assert.Equal(t, 1, resource.Attempt.Questions)
+				assert.Len(t, resource.Attempt.Rewards, 1)
+			} else {
+				assert.Nil(t, resource.Attempt)
+			}
+		})
+	}
+}
+
+func TestTransformQuizResults(t *testing.T) {
+	quiz := domain.Quiz{
+		ID:    1,
+		Kind:  domain.QuizKindPoll,
+		Title: "Most awaited release",
+		Questions: []domain.QuizQuestion{
+			{ID: 1, Question: "Which one?", Options: []domain.QuizOption{
+				{ID: 1, Text: "GTA VI"},
+				{ID: 2, Text: "Half-Life 3"},
+			}},
+		},
+	}
+
+	resource := resources.TransformQuizResults(domain.QuizResults{
+		Quiz:     quiz,
+		Attempts: 4,
+		Votes:    map[uint]uint{1: 3, 2: 1},
+	})
+
+	assert.Equal(t, uint(4), resource.Attempts)
+	assert.Nil(t, resource.AverageScore)
+	assert.Equal(t, uint(3), resource.Questions[0].Options[0].Votes)
+	assert.Equal(t, 75.0, resource.Questions[0].Options[0].Share)
+	assert.Equal(t, 25.0, resource.Questions[0].Options[1].Share)
+	assert.Nil(t, resource.Questions[0].Options[0].Correct)
+}
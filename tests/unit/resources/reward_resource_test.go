@@ -72,6 +72,32 @@ func TestTransformReward(t *testing.T) {
 	}
 }
 
+func TestTransformReward_Booster(t *testing.T) {
+	reward := domain.Reward{
+		ID:             2,
+		RewardableType: domain.RewardableTypeBoosters,
+		RewardableID:   4,
+		Amount:         2,
+		Rewardable: &domain.Booster{
+			ID:              4,
+			Name:            "Double XP",
+			Scope:           domain.BoosterScopeExperience,
+			Multiplier:      2,
+			DurationMinutes: 60,
+		},
+	}
+
+	result := resources.TransformReward(reward)
+
+	if result.Amount != 2 || result.Booster == nil || result.Booster.Name != "Double XP" || result.Booster.Multiplier != 2 {
+		t.Errorf("Expected the booster reward, got %+v", result)
+	}
+
+	if result.Title != nil || result.Item != nil || result.Cosmetic != nil {
+		t.Errorf("Expected only the booster to be set, got %+v", result)
+	}
+}
+
 func TestTransformRewards(t *testing.T) {
 	fixedTime := time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)
 
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"gcstatus/internal/domain"
+	"gcstatus/internal/resources"
+	"reflect"
+	"testing"
+)
+
+func TestTransformStorePrice(t *testing.T) {
+	testCases := map[string]struct {
+		input    domain.StorePrice
+		expected *resources.StorePriceResource
+	}{
+		"as nil": {
+			input:    domain.StorePrice{},
+			expected: &resources.StorePriceResource{},
+		},
+		"regional price": {
+			input: domain.StorePrice{
+				ID:            1,
+				Region:        "br",
+				Currency:      "BRL",
+				InitialPrice:  19999,
+				FinalPrice:    9999,
+				Discount:      50,
+				PriceableID:   1,
+				PriceableType: domain.PriceableTypeGameStores,
+			},
+			expected: &resources.StorePriceResource{
+				Region:       "br",
+				Currency:     "BRL",
+				InitialPrice: 19999,
+				FinalPrice:   9999,
+				Discount:     50,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := resources.TransformStorePrice(tc.input)
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, result)
+			}
+		})
+	}
+}
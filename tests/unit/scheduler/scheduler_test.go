@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"context"
+	"gcstatus/internal/domain"
+	"gcstatus/pkg/scheduler"
+	testutils "gcstatus/tests/utils"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	insertLeaseQuery = "INSERT INTO `scheduler_leases` (`created_at`,`updated_at`,`deleted_at`,`name`,`owner`,`expires_at`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`"
+	renewLeaseQuery  = "UPDATE `scheduler_leases` SET `expires_at`=?,`owner`=?,`updated_at`=? WHERE (name = ? AND (owner = ? OR expires_at < ?)) AND `scheduler_leases`.`deleted_at` IS NULL"
+	selectDueQuery   = "SELECT * FROM `scheduled_jobs` WHERE (name IN (?) AND next_run_at <= ?) AND `scheduled_jobs`.`deleted_at` IS NULL"
+	selectManualRuns = "SELECT * FROM `scheduled_runs` WHERE (job_name IN (?) AND status = ? AND `trigger` = ?) AND `scheduled_runs`.`deleted_at` IS NULL ORDER BY id ASC"
+)
+
+func expectLeaderLease(mock sqlmock.Sqlmock, inserted int64, renewed int64) {
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(insertLeaseQuery)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, domain.SchedulerLeaderLease, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, inserted))
+	mock.ExpectCommit()
+
+	if inserted == 1 {
+		return
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(renewLeaseQuery)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), domain.SchedulerLeaderLease, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, renewed))
+	mock.ExpectCommit()
+}
+
+func TestScheduler_Tick(t *testing.T) {
+	testCases := map[string]struct {
+		inserted       int64
+		renewed        int64
+		expectedLeader bool
+	}{
+		"claims a free leadership": {
+			inserted:       1,
+			expectedLeader: true,
+		},
+		"renews or takes over an expired leadership": {
+			renewed:        1,
+			expectedLeader: true,
+		},
+		"follows the current leader": {
+			expectedLeader: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+
+			s := scheduler.NewScheduler(gormDB)
+			assert.NoError(t, s.Register(domain.ScheduledJobPrunePasswordResets, "@hourly", func(ctx context.Context) error {
+				return nil
+			}))
+
+			expectLeaderLease(mock, tc.inserted, tc.renewed)
+
+			if tc.expectedLeader {
+				mock.ExpectQuery(regexp.QuoteMeta(selectDueQuery)).
+					WithArgs(domain.ScheduledJobPrunePasswordResets, sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+				mock.ExpectQuery(regexp.QuoteMeta(selectManualRuns)).
+					WithArgs(domain.ScheduledJobPrunePasswordResets, domain.ScheduledRunPending, domain.ScheduledTriggerManual).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "job_name"}))
+			}
+
+			err := s.Tick(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedLeader, s.IsLeader())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestScheduler_Register(t *testing.T) {
+	gormDB, _ := testutils.Setup(t)
+
+	s := scheduler.NewScheduler(gormDB)
+	task := func(ctx context.Context) error { return nil }
+
+	assert.NoError(t, s.Register(domain.ScheduledJobResetMissions, "@hourly", task))
+	assert.NoError(t, s.Register(domain.ScheduledJobPruneAuditLogs, "0 3 * * *", task))
+	assert.Error(t, s.Register("broken", "every tuesday", task))
+	assert.Equal(t, []string{domain.ScheduledJobPruneAuditLogs, domain.ScheduledJobResetMissions}, s.Names())
+}
+
+func TestScheduler_RunsDueJob(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	due := now.Add(-time.Minute)
+
+	s := scheduler.NewScheduler(gormDB)
+	s.Now = func() time.Time { return now }
+
+	// The job waits for the tick to end, so the run is finished after the
+	// manual runs are checked.
+	release := make(chan struct{})
+	ran := make(chan struct{}, 1)
+	assert.NoError(t, s.Register(domain.ScheduledJobPrunePasswordResets, "@hourly", func(ctx context.Context) error {
+		<-release
+		ran <- struct{}{}
+		return nil
+	}))
+
+	expectLeaderLease(mock, 1, 0)
+
+	mock.ExpectQuery(regexp.QuoteMeta(selectDueQuery)).
+		WithArgs(domain.ScheduledJobPrunePasswordResets, now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "schedule", "next_run_at"}).
+			AddRow(1, domain.ScheduledJobPrunePasswordResets, "@hourly", due))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `scheduled_jobs` SET `next_run_at`=?,`updated_at`=? WHERE (id = ? AND next_run_at = ?) AND `scheduled_jobs`.`deleted_at` IS NULL")).
+		WithArgs(now.Add(time.Hour), sqlmock.AnyArg(), 1, due).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `scheduled_runs`")).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.ExpectCommit()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(insertLeaseQuery)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, domain.SchedulerJobLease(domain.ScheduledJobPrunePasswordResets), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `scheduled_runs` SET `error`=?,`finished_at`=?,`status`=?,`updated_at`=? WHERE (job_name = ? AND status = ?) AND `scheduled_runs`.`deleted_at` IS NULL")).
+		WithArgs("The run was interrupted.", now, domain.ScheduledRunFailed, sqlmock.AnyArg(), domain.ScheduledJobPrunePasswordResets, domain.ScheduledRunRunning).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `scheduled_runs` SET `owner`=?,`started_at`=?,`status`=?,`updated_at`=? WHERE (id = ? AND status = ?) AND `scheduled_runs`.`deleted_at` IS NULL")).
+		WithArgs(sqlmock.AnyArg(), now, domain.ScheduledRunRunning, sqlmock.AnyArg(), 3, domain.ScheduledRunPending).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	mock.ExpectQuery(regexp.QuoteMeta(selectManualRuns)).
+		WithArgs(domain.ScheduledJobPrunePasswordResets, domain.ScheduledRunPending, domain.ScheduledTriggerManual).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "job_name"}))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `scheduled_runs` SET `duration_ms`=?,`finished_at`=?,`status`=?,`updated_at`=? WHERE id = ? AND `scheduled_runs`.`deleted_at` IS NULL")).
+		WithArgs(int64(0), now, domain.ScheduledRunSucceeded, sqlmock.AnyArg(), 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `scheduled_jobs` SET `last_run_at`=?,`updated_at`=? WHERE name = ? AND `scheduled_jobs`.`deleted_at` IS NULL")).
+		WithArgs(now, sqlmock.AnyArg(), domain.ScheduledJobPrunePasswordResets).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `scheduler_leases` SET `expires_at`=?,`updated_at`=? WHERE (name = ? AND owner = ?) AND `scheduler_leases`.`deleted_at` IS NULL")).
+		WithArgs(now, sqlmock.AnyArg(), domain.SchedulerJobLease(domain.ScheduledJobPrunePasswordResets), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, s.Tick(context.Background()))
+	close(release)
+
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("the due job did not run")
+	}
+
+	assert.Eventually(t, func() bool {
+		return mock.ExpectationsWereMet() == nil
+	}, time.Second, 10*time.Millisecond)
+}
@@ -3,6 +3,8 @@ package tests
 import (
 	"errors"
 	"gcstatus/internal/utils"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -384,3 +386,95 @@ func TestSlugify(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRegions(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected []string
+	}{
+		"single region":          {input: "us", expected: []string{"us"}},
+		"multiple regions":       {input: "us,br,eu", expected: []string{"us", "br", "eu"}},
+		"normalizes and dedupes": {input: " US, br ,us,, BR", expected: []string{"us", "br"}},
+		"empty string":           {input: "", expected: nil},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, utils.ParseRegions(tc.input))
+		})
+	}
+}
+
+func TestResolveRegion(t *testing.T) {
+	regions := []string{"us", "br", "eu"}
+
+	tests := map[string]struct {
+		regions    []string
+		candidates []string
+		expected   string
+	}{
+		"first valid candidate":    {regions: regions, candidates: []string{"BR", "eu"}, expected: "br"},
+		"skips unknown candidates": {regions: regions, candidates: []string{"jp", "", "eu"}, expected: "eu"},
+		"falls back to default":    {regions: regions, candidates: []string{"jp"}, expected: "us"},
+		"no candidates":            {regions: regions, expected: "us"},
+		"no regions configured":    {regions: nil, candidates: []string{"us"}, expected: ""},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, utils.ResolveRegion(tc.regions, tc.candidates...))
+		})
+	}
+}
+
+func TestIsNotModified(t *testing.T) {
+	lastModified := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		headers  map[string]string
+		expected bool
+	}{
+		"no conditional headers": {
+			headers:  map[string]string{},
+			expected: false,
+		},
+		"matching etag": {
+			headers:  map[string]string{"If-None-Match": `"abc", "def"`},
+			expected: true,
+		},
+		"weak matching etag": {
+			headers:  map[string]string{"If-None-Match": `W/"def"`},
+			expected: true,
+		},
+		"stale etag wins over date": {
+			headers: map[string]string{
+				"If-None-Match":     `"old"`,
+				"If-Modified-Since": lastModified.Format(http.TimeFormat),
+			},
+			expected: false,
+		},
+		"not modified since": {
+			headers:  map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)},
+			expected: true,
+		},
+		"modified since": {
+			headers:  map[string]string{"If-Modified-Since": lastModified.Add(-time.Hour).Format(http.TimeFormat)},
+			expected: false,
+		},
+		"invalid date": {
+			headers:  map[string]string{"If-Modified-Since": "yesterday"},
+			expected: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/feeds/games", nil)
+			for key, value := range tc.headers {
+				req.Header.Set(key, value)
+			}
+
+			assert.Equal(t, tc.expected, utils.IsNotModified(req, `"def"`, lastModified))
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"gcstatus/internal/domain"
+	"gcstatus/pkg/worker"
+	testutils "gcstatus/tests/utils"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	selectNextJobQuery = "SELECT * FROM `jobs` WHERE (status = ? AND available_at <= ? AND type IN (?)) AND `jobs`.`deleted_at` IS NULL ORDER BY available_at ASC, id ASC,`jobs`.`id` LIMIT ?"
+	claimJobQuery      = "UPDATE `jobs` SET `attempts`=attempts + 1,`locked_until`=?,`started_at`=?,`status`=?,`updated_at`=? WHERE (id = ? AND status = ?) AND `jobs`.`deleted_at` IS NULL"
+)
+
+func expectJobClaim(mock sqlmock.Sqlmock, attempts uint, maxAttempts uint) {
+	rows := sqlmock.NewRows([]string{"id", "type", "payload", "status", "attempts", "max_attempts"}).
+		AddRow(1, domain.JobTypeSendEmail, `{"recipient":"user@example.com"}`, domain.JobStatusPending, attempts, maxAttempts)
+	mock.ExpectQuery(regexp.QuoteMeta(selectNextJobQuery)).
+		WithArgs(domain.JobStatusPending, sqlmock.AnyArg(), domain.JobTypeSendEmail, 1).
+		WillReturnRows(rows)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(claimJobQuery)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), domain.JobStatusRunning, sqlmock.AnyArg(), 1, domain.JobStatusPending).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+}
+
+func TestPool_RunNext(t *testing.T) {
+	testCases := map[string]struct {
+		attempts     uint
+		handlerErr   error
+		mockBehavior func(mock sqlmock.Sqlmock)
+	}{
+		"marks the job as succeeded": {
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `jobs` SET `error`=?,`finished_at`=?,`locked_until`=?,`status`=?,`updated_at`=? WHERE (id = ? AND status = ?) AND `jobs`.`deleted_at` IS NULL")).
+					WithArgs(nil, sqlmock.AnyArg(), nil, domain.JobStatusSucceeded, sqlmock.AnyArg(), 1, domain.JobStatusRunning).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		"sends a failing job back to the queue": {
+			handlerErr: errors.New("mail provider down"),
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `jobs` SET `available_at`=?,`error`=?,`finished_at`=?,`locked_until`=?,`status`=?,`updated_at`=? WHERE (id = ? AND status = ?) AND `jobs`.`deleted_at` IS NULL")).
+					WithArgs(sqlmock.AnyArg(), "mail provider down", nil, nil, domain.JobStatusPending, sqlmock.AnyArg(), 1, domain.JobStatusRunning).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		"fails a job out of attempts": {
+			attempts:   2,
+			handlerErr: errors.New("mail provider down"),
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `jobs` SET `error`=?,`finished_at`=?,`locked_until`=?,`status`=?,`updated_at`=? WHERE (id = ? AND status = ?) AND `jobs`.`deleted_at` IS NULL")).
+					WithArgs("mail provider down", sqlmock.AnyArg(), nil, domain.JobStatusFailed, sqlmock.AnyArg(), 1, domain.JobStatusRunning).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gormDB, mock := testutils.Setup(t)
+
+			pool := worker.NewPool(gormDB, 1)
+			pool.CancelCheckInterval = time.Hour
+
+			var received string
+			pool.Register(domain.JobTypeSendEmail, func(ctx context.Context, payload []byte) error {
+				received = string(payload)
+				return tc.handlerErr
+			})
+
+			expectJobClaim(mock, tc.attempts, domain.DefaultJobMaxAttempts)
+			tc.mockBehavior(mock)
+
+			ran, err := pool.RunNext(context.Background())
+
+			assert.True(t, ran)
+			assert.NoError(t, err)
+			assert.Equal(t, `{"recipient":"user@example.com"}`, received)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPool_RunNextRecoversPanics(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+
+	pool := worker.NewPool(gormDB, 1)
+	pool.CancelCheckInterval = time.Hour
+	pool.Register(domain.JobTypeSendEmail, func(ctx context.Context, payload []byte) error {
+		panic("unexpected payload")
+	})
+
+	expectJobClaim(mock, 2, domain.DefaultJobMaxAttempts)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `jobs` SET `error`=?,`finished_at`=?,`locked_until`=?,`status`=?,`updated_at`=? WHERE (id = ? AND status = ?) AND `jobs`.`deleted_at` IS NULL")).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, domain.JobStatusFailed, sqlmock.AnyArg(), 1, domain.JobStatusRunning).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	ran, err := pool.RunNext(context.Background())
+
+	assert.True(t, ran)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPool_RunNextWithoutJobs(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+
+	pool := worker.NewPool(gormDB, 1)
+	pool.Register(domain.JobTypeSendEmail, func(ctx context.Context, payload []byte) error {
+		t.Fatal("no job should run")
+		return nil
+	})
+
+	mock.ExpectQuery(regexp.QuoteMeta(selectNextJobQuery)).
+		WithArgs(domain.JobStatusPending, sqlmock.AnyArg(), domain.JobTypeSendEmail, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	ran, err := pool.RunNext(context.Background())
+
+	assert.False(t, ran)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPool_RunNextSkipsJobsClaimedElsewhere(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+
+	pool := worker.NewPool(gormDB, 1)
+	pool.Register(domain.JobTypeSendEmail, func(ctx context.Context, payload []byte) error {
+		t.Fatal("a job claimed by another worker should not run")
+		return nil
+	})
+
+	rows := sqlmock.NewRows([]string{"id", "type", "status"}).AddRow(1, domain.JobTypeSendEmail, domain.JobStatusPending)
+	mock.ExpectQuery(regexp.QuoteMeta(selectNextJobQuery)).
+		WithArgs(domain.JobStatusPending, sqlmock.AnyArg(), domain.JobTypeSendEmail, 1).
+		WillReturnRows(rows)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(claimJobQuery)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), domain.JobStatusRunning, sqlmock.AnyArg(), 1, domain.JobStatusPending).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	ran, err := pool.RunNext(context.Background())
+
+	assert.False(t, ran)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPool_RequeueStale(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	pool := worker.NewPool(gormDB, 1)
+	pool.Now = func() time.Time { return now }
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `jobs` SET `available_at`=?,`locked_until`=?,`status`=?,`updated_at`=? WHERE (status = ? AND locked_until < ?) AND `jobs`.`deleted_at` IS NULL")).
+		WithArgs(now, nil, domain.JobStatusPending, sqlmock.AnyArg(), domain.JobStatusRunning, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := pool.RequeueStale()
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	gormDB, mock := testutils.Setup(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `jobs`")).
+		WithArgs(
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			domain.JobTypeSendEmail,
+			`{"recipient":"user@example.com","subject":"Hello","body":"Body"}`,
+			domain.JobStatusPending,
+			0,
+			domain.DefaultJobMaxAttempts,
+			nil,
+			sqlmock.AnyArg(),
+			nil,
+			nil,
+			nil,
+		).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	job, err := worker.NewDispatcher(gormDB).Dispatch(domain.JobTypeSendEmail, worker.EmailPayload{
+		Recipient: "user@example.com",
+		Subject:   "Hello",
+		Body:      "Body",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), job.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}